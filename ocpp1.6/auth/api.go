@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the tags of the store on r, e.g. the group of the rest api:
+//
+//	GET    /idtags         all the tags
+//	GET    /idtags/:idTag  one tag with the authorization of the server
+//	PUT    /idtags/:idTag  inserts or replaces a tag, the body is a Tag
+//	DELETE /idtags/:idTag  removes a tag, the plugin answers it afterwards
+func (s *Service) RegisterAPI(r gin.IRouter) {
+	r.GET("/idtags", s.list)
+	r.GET("/idtags/:idTag", s.get)
+	r.PUT("/idtags/:idTag", s.put)
+	r.DELETE("/idtags/:idTag", s.delete)
+}
+
+func (s *Service) list(c *gin.Context) {
+	tags, err := s.store.List()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+func (s *Service) get(c *gin.Context) {
+	idTag := c.Param("idTag")
+	tag, err := s.store.Get(idTag)
+	if err == nil {
+		var info interface{}
+		if info, err = s.local(idTag); err == nil {
+			c.JSON(http.StatusOK, gin.H{"tag": tag, "idTagInfo": info})
+			return
+		}
+	}
+	if errors.Is(err, ErrNotFound) {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("id tag(%s) not found", idTag)})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+}
+
+func (s *Service) put(c *gin.Context) {
+	tag := &Tag{}
+	if err := c.ShouldBindJSON(tag); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	if tag.IdTag == "" {
+		tag.IdTag = c.Param("idTag")
+	}
+	if tag.IdTag != c.Param("idTag") {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("id tag(%s) differs from the path", tag.IdTag)})
+		return
+	}
+	if err := tag.validate(); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	if err := s.store.Put(tag); err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tag)
+}
+
+func (s *Service) delete(c *gin.Context) {
+	if err := s.store.Delete(c.Param("idTag")); err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
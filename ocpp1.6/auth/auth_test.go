@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"ocpp16/transaction"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//backend accepts every tag, or fails while down
+type backend struct {
+	down  bool
+	calls int
+}
+
+func (b *backend) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		b.calls++
+		if b.down {
+			return nil, errors.New("backend down")
+		}
+		info := protocol.IdTagInfo{Status: Accepted, ParentIdTag: "fleet"}
+		switch action {
+		case protocol.AuthorizeName:
+			return &protocol.AuthorizeResponse{IdTagInfo: info}, nil
+		case protocol.StartTransactionName:
+			return &protocol.StartTransactionResponse{IdTagInfo: info}, nil
+		}
+		return &protocol.StopTransactionResponse{IdTagInfo: &info}, nil
+	}, true
+}
+
+func (b *backend) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	return nil, false
+}
+
+func (b *backend) ChargingPointOnline(id string) error {
+	return nil
+}
+
+func (b *backend) ChargingPointOffline(id string) error {
+	return nil
+}
+
+type activeTransactions []*transaction.Transaction
+
+func (a activeTransactions) ActiveByTag(idTag string) []*transaction.Transaction {
+	var found []*transaction.Transaction
+	for _, t := range a {
+		if t.IdTag == idTag || t.ParentIdTag == idTag {
+			found = append(found, t)
+		}
+	}
+	return found
+}
+
+func authorize(t *testing.T, plugin ocpp16server.PassivePlugin, idTag string) (protocol.AuthorizationStatus, error) {
+	handler, _ := plugin.RequestHandler(protocol.AuthorizeName)
+	res, err := handler(context.Background(), "CP001", "1", &protocol.AuthorizeRequest{IdTag: protocol.IdToken(idTag)})
+	if err != nil {
+		return "", err
+	}
+	return res.(*protocol.AuthorizeResponse).IdTagInfo.Status, nil
+}
+
+func TestService(t *testing.T) {
+	store := NewMemoryStore()
+	past := time.Now().Add(-time.Hour)
+	for _, tag := range []*Tag{
+		{IdTag: "alice", Status: Accepted},
+		{IdTag: "bob", Status: Blocked},
+		{IdTag: "carol", Status: Accepted, ExpiryDate: &past},
+		{IdTag: "parent", Status: Blocked},
+		{IdTag: "child", ParentIdTag: "parent", Status: Accepted},
+		{IdTag: "driver", ParentIdTag: "company", Status: Accepted},
+		{IdTag: "colleague", ParentIdTag: "company", Status: Accepted},
+	} {
+		if err := store.Put(tag); err != nil {
+			t.Fatal(err)
+		}
+	}
+	active := activeTransactions{{ID: 1, ChargePointID: "CP002", ConnectorID: 1, IdTag: "colleague", ParentIdTag: "company", State: transaction.Active}}
+	s := NewService(store, active, Config{CacheTTL: time.Minute})
+	b := &backend{}
+	plugin := s.Wrap(b)
+
+	for idTag, want := range map[string]protocol.AuthorizationStatus{
+		"alice": Accepted, "bob": Blocked, "carol": Expired, "child": Blocked, "driver": Accepted, "colleague": Accepted, "remote": Accepted,
+	} {
+		if status, err := authorize(t, plugin, idTag); err != nil || status != want {
+			t.Fatalf("%s got %s(%v), want %s", idTag, status, err, want)
+		}
+	}
+	if b.calls != 1 {
+		t.Fatalf("local tags reached the backend, %d calls", b.calls)
+	}
+
+	//the cached answer is used while the backend is down, until the ttl elapses
+	b.down = true
+	if status, err := authorize(t, plugin, "remote"); err != nil || status != Accepted {
+		t.Fatalf("cached tag got %s(%v)", status, err)
+	}
+	if _, err := authorize(t, plugin, "unknown"); err == nil {
+		t.Fatal("unknown tag authorized while the backend is down")
+	}
+	s.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if _, err := authorize(t, plugin, "remote"); err == nil {
+		t.Fatal("cache outlived its ttl")
+	}
+
+	//a tag of the parent of a transaction can not start another one, a StartTransaction sent again on the connector
+	//of the transaction is no concurrent one
+	handler, _ := plugin.RequestHandler(protocol.StartTransactionName)
+	connectorID, meterStart := 1, 0
+	for _, tt := range []struct {
+		id, idTag string
+		want      protocol.AuthorizationStatus
+	}{
+		{"CP001", "driver", ConcurrentTx},
+		{"CP001", "colleague", ConcurrentTx},
+		{"CP001", "alice", Accepted},
+		{"CP002", "colleague", Accepted},
+	} {
+		res, err := handler(context.Background(), tt.id, "2", &protocol.StartTransactionRequest{ConnectorId: &connectorID, IdTag: protocol.IdToken(tt.idTag), MeterStart: &meterStart, Timestamp: "2021-01-01T10:00:00Z"})
+		if err != nil || res.(*protocol.StartTransactionResponse).IdTagInfo.Status != tt.want {
+			t.Fatalf("%s on %s got %+v(%v), want %s", tt.idTag, tt.id, res, err, tt.want)
+		}
+	}
+	handler, _ = plugin.RequestHandler(protocol.StopTransactionName)
+	res, err := handler(context.Background(), "CP002", "3", &protocol.StopTransactionRequest{IdTag: "unknown", TransactionId: &connectorID, MeterStop: &meterStart, Timestamp: "2021-01-01T11:00:00Z"})
+	if err != nil || res.(*protocol.StopTransactionResponse).IdTagInfo != nil {
+		t.Fatalf("unexpected stop %+v(%v)", res, err)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "idtags.json")
+	f, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Put(&Tag{IdTag: "alice", Status: Accepted})
+	f.Put(&Tag{IdTag: "bob", Status: Blocked})
+	f.Delete("alice")
+	if err = f.Put(&Tag{IdTag: "carol", Status: "Unknown"}); err == nil {
+		t.Fatal("invalid status stored")
+	}
+	if f, err = NewFileStore(path); err != nil {
+		t.Fatal(err)
+	}
+	tags, _ := f.List()
+	if len(tags) != 1 || tags[0].IdTag != "bob" || tags[0].Status != Blocked {
+		t.Fatalf("unexpected tags %+v", tags)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	s := NewService(NewMemoryStore(), nil, Config{})
+	s.RegisterAPI(engine)
+	do := func(method string, url string, body string) int {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w.Code
+	}
+	if code := do(http.MethodPut, "/idtags/alice", `{"status":"Accepted"}`); code != http.StatusOK {
+		t.Fatalf("put got %d", code)
+	}
+	if code := do(http.MethodPut, "/idtags/alice", `{"idTag":"bob","status":"Accepted"}`); code != http.StatusBadRequest {
+		t.Fatalf("put of another tag got %d", code)
+	}
+	if code := do(http.MethodGet, "/idtags/alice", ""); code != http.StatusOK {
+		t.Fatalf("get got %d", code)
+	}
+	if code := do(http.MethodDelete, "/idtags/alice", ""); code != http.StatusNoContent {
+		t.Fatalf("delete got %d", code)
+	}
+	if code := do(http.MethodGet, "/idtags/alice", ""); code != http.StatusNotFound {
+		t.Fatalf("get of a deleted tag got %d", code)
+	}
+}
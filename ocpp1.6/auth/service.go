@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"ocpp16/transaction"
+	"sync"
+	"time"
+)
+
+//Transactions are the open transactions of all the charging points, e.g. a *transaction.Manager
+type Transactions interface {
+	//ActiveByTag returns the open transactions started with idTag or with a tag whose parent is idTag
+	ActiveByTag(idTag string) []*transaction.Transaction
+}
+
+type Config struct {
+	CacheTTL  time.Duration //how long an answer of the plugin is used while the plugin fails, no cache if 0
+	CacheSize int           //answers cached at most, 10000 if 0
+}
+
+type cached struct {
+	info protocol.IdTagInfo
+	at   time.Time
+}
+
+type Service struct {
+	store        Store
+	transactions Transactions
+	conf         Config
+	mu           sync.Mutex
+	cache        map[string]cached
+	now          func() time.Time
+}
+
+//NewService answers the tags of store, transactions detect the ConcurrentTx and can be nil
+func NewService(store Store, transactions Transactions, conf Config) *Service {
+	if conf.CacheSize <= 0 {
+		conf.CacheSize = 10000
+	}
+	return &Service{store: store, transactions: transactions, conf: conf, cache: make(map[string]cached), now: time.Now}
+}
+
+//status returns the status of tag at now, an expired tag is Expired
+func status(tag *Tag, now time.Time) protocol.AuthorizationStatus {
+	if tag.Status == Accepted && tag.ExpiryDate != nil && tag.ExpiryDate.Before(now) {
+		return Expired
+	}
+	return tag.Status
+}
+
+//local returns the authorization of a tag of the store, a tag whose parent is not Accepted gets the status of its parent
+func (s *Service) local(idTag string) (*protocol.IdTagInfo, error) {
+	tag, err := s.store.Get(idTag)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	info := &protocol.IdTagInfo{Status: status(tag, now), ParentIdTag: protocol.IdToken(tag.ParentIdTag)}
+	if tag.ExpiryDate != nil {
+		info.ExpiryDate = tag.ExpiryDate.UTC().Format(protocol.ISO8601)
+	}
+	if tag.ParentIdTag != "" && info.Status == Accepted {
+		parent, err := s.store.Get(tag.ParentIdTag)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		if parent != nil && status(parent, now) != Accepted {
+			info.Status = status(parent, now)
+		}
+	}
+	return info, nil
+}
+
+func (s *Service) cached(idTag string) (*protocol.IdTagInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.cache[idTag]
+	if !ok || s.now().Sub(c.at) >= s.conf.CacheTTL {
+		return nil, false
+	}
+	info := c.info
+	if expiry, err := time.Parse(time.RFC3339Nano, info.ExpiryDate); err == nil && info.Status == Accepted && expiry.Before(s.now()) {
+		info.Status = Expired
+	}
+	return &info, true
+}
+
+func (s *Service) remember(idTag string, info *protocol.IdTagInfo) {
+	if s.conf.CacheTTL <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if _, ok := s.cache[idTag]; !ok && len(s.cache) >= s.conf.CacheSize {
+		for tag, c := range s.cache {
+			if now.Sub(c.at) >= s.conf.CacheTTL {
+				delete(s.cache, tag)
+			}
+		}
+		if len(s.cache) >= s.conf.CacheSize {
+			return
+		}
+	}
+	s.cache[idTag] = cached{info: *info, at: now}
+}
+
+//Authorize returns the authorization of idTag: from the store for its tags, otherwise from remote, the answer of the
+//plugin, which is cached. While remote fails the cached answer is used, remote returns nil when the plugin does not
+//decide and the tag is Invalid
+func (s *Service) Authorize(idTag string, remote func() (*protocol.IdTagInfo, error)) (*protocol.IdTagInfo, error) {
+	info, err := s.local(idTag)
+	if err == nil || !errors.Is(err, ErrNotFound) {
+		return info, err
+	}
+	info, err = remote()
+	if err != nil {
+		if info, ok := s.cached(idTag); ok {
+			return info, nil
+		}
+		return nil, err
+	}
+	if info == nil {
+		return &protocol.IdTagInfo{Status: Invalid}, nil
+	}
+	s.remember(idTag, info)
+	return info, nil
+}
+
+//group returns the parent of idTag, or idTag without parent
+func (s *Service) group(idTag string) string {
+	if tag, err := s.store.Get(idTag); err == nil {
+		if tag.ParentIdTag != "" {
+			return tag.ParentIdTag
+		}
+		return idTag
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.cache[idTag]; ok && c.info.ParentIdTag != "" {
+		return string(c.info.ParentIdTag)
+	}
+	return idTag
+}
+
+//Concurrent reports whether idTag, or a tag of the same parent, is in a transaction of any charging point but the
+//one on the connector of the charging point id, which is a StartTransaction sent again. connectorID -1 skips none.
+//The parent of a transaction is the one answering its StartTransaction
+func (s *Service) Concurrent(idTag string, id string, connectorID int) bool {
+	if s.transactions == nil {
+		return false
+	}
+	group := s.group(idTag)
+	active := s.transactions.ActiveByTag(idTag)
+	if group != idTag {
+		active = append(active, s.transactions.ActiveByTag(group)...)
+	}
+	for _, t := range active {
+		if t.ChargePointID == id && t.ConnectorID == connectorID {
+			continue
+		}
+		if t.IdTag == idTag || t.IdTag == group || t.ParentIdTag == group {
+			return true
+		}
+	}
+	return false
+}
+
+//concurrent turns an Accepted info into ConcurrentTx when the tag is in another transaction
+func (s *Service) concurrent(info *protocol.IdTagInfo, idTag string, id string, connectorID int) {
+	if info.Status == Accepted && s.Concurrent(idTag, id, connectorID) {
+		info.Status = ConcurrentTx
+	}
+}
+
+type authorizedPlugin struct {
+	ocpp16server.PassivePlugin
+	s *Service
+}
+
+//Wrap returns plugin with the authorizations answered by s, register it on the server in place of plugin. Authorize
+//reaches plugin for the tags out of the store only, StartTransaction and StopTransaction always reach it and get the
+//idTagInfo of s. A StopTransaction is answered even when plugin fails, the transaction is over anyway. ConcurrentTx
+//answers StartTransaction only, Authorize does not start a transaction
+func (s *Service) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &authorizedPlugin{PassivePlugin: plugin, s: s}
+}
+
+func (p *authorizedPlugin) next(ctx context.Context, action string, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return nil, nil
+	}
+	return handler(ctx, id, uniqueid, request)
+}
+
+func (p *authorizedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	switch action {
+	case protocol.AuthorizeName:
+		return p.authorize, true
+	case protocol.StartTransactionName:
+		return p.startTransaction, true
+	case protocol.StopTransactionName:
+		return p.stopTransaction, true
+	}
+	return p.PassivePlugin.RequestHandler(action)
+}
+
+func (p *authorizedPlugin) authorize(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	idTag := string(request.(*protocol.AuthorizeRequest).IdTag)
+	info, err := p.s.Authorize(idTag, func() (*protocol.IdTagInfo, error) {
+		res, err := p.next(ctx, protocol.AuthorizeName, id, uniqueid, request)
+		if response, ok := res.(*protocol.AuthorizeResponse); ok && response != nil && err == nil {
+			return &response.IdTagInfo, nil
+		}
+		return nil, err
+	})
+	if err != nil {
+		return nil, ocpp16server.InternalError(err)
+	}
+	return &protocol.AuthorizeResponse{IdTagInfo: *info}, nil
+}
+
+func (p *authorizedPlugin) startTransaction(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	req := request.(*protocol.StartTransactionRequest)
+	res, err := p.next(ctx, protocol.StartTransactionName, id, uniqueid, request)
+	response, _ := res.(*protocol.StartTransactionResponse)
+	if response == nil {
+		response = &protocol.StartTransactionResponse{}
+	}
+	info, err := p.s.Authorize(string(req.IdTag), func() (*protocol.IdTagInfo, error) {
+		if err != nil || res == nil {
+			return nil, err
+		}
+		return &response.IdTagInfo, nil
+	})
+	if err != nil {
+		return nil, ocpp16server.InternalError(err)
+	}
+	connectorID := -1
+	if req.ConnectorId != nil {
+		connectorID = *req.ConnectorId
+	}
+	p.s.concurrent(info, string(req.IdTag), id, connectorID)
+	response.IdTagInfo = *info
+	return response, nil
+}
+
+func (p *authorizedPlugin) stopTransaction(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	req := request.(*protocol.StopTransactionRequest)
+	res, err := p.next(ctx, protocol.StopTransactionName, id, uniqueid, request)
+	response, _ := res.(*protocol.StopTransactionResponse)
+	if response == nil || err != nil {
+		response = &protocol.StopTransactionResponse{}
+	}
+	if req.IdTag == "" {
+		return response, nil
+	}
+	info, err := p.s.Authorize(string(req.IdTag), func() (*protocol.IdTagInfo, error) {
+		if err != nil {
+			return nil, err
+		}
+		return response.IdTagInfo, nil
+	})
+	if err == nil && info.Status != Invalid {
+		response.IdTagInfo = info
+	}
+	return response, nil
+}
@@ -0,0 +1,172 @@
+//Package auth answers the authorizations of the id tags: Authorize and the idTagInfo of StartTransaction and
+//StopTransaction. The tags of a local Store are answered by the server, the others by the passive plugin, whose
+//answers are cached to keep authorizing while it is unavailable
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrNotFound = errors.New("id tag not found")
+
+const (
+	Accepted     protocol.AuthorizationStatus = "Accepted"
+	Blocked      protocol.AuthorizationStatus = "Blocked"
+	Expired      protocol.AuthorizationStatus = "Expired"
+	Invalid      protocol.AuthorizationStatus = "Invalid"
+	ConcurrentTx protocol.AuthorizationStatus = "ConcurrentTx"
+)
+
+//Tag is an id tag of the local store
+type Tag struct {
+	IdTag       string                       `json:"idTag"`
+	ParentIdTag string                       `json:"parentIdTag,omitempty"`
+	Status      protocol.AuthorizationStatus `json:"status"` //Accepted, Blocked, Expired or Invalid
+	ExpiryDate  *time.Time                   `json:"expiryDate,omitempty"`
+}
+
+func (t *Tag) validate() error {
+	if t.IdTag == "" || len(t.IdTag) > 20 || len(t.ParentIdTag) > 20 {
+		return fmt.Errorf("invalid id tag(%s) or parent(%s)", t.IdTag, t.ParentIdTag)
+	}
+	switch t.Status {
+	case Accepted, Blocked, Expired, Invalid:
+		return nil
+	}
+	return fmt.Errorf("invalid status(%s) of id tag(%s)", t.Status, t.IdTag)
+}
+
+//Store holds the id tags answered by the server
+type Store interface {
+	//Get returns the tag idTag, ErrNotFound if there is none
+	Get(idTag string) (*Tag, error)
+	//Put inserts tag or replaces the tag with its id
+	Put(tag *Tag) error
+	Delete(idTag string) error
+	//List returns the tags ordered by id
+	List() ([]*Tag, error)
+	Close() error
+}
+
+//MemoryStore loses the tags on a restart
+type MemoryStore struct {
+	tags map[string]Tag
+	sync.RWMutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tags: make(map[string]Tag)}
+}
+
+func (m *MemoryStore) Get(idTag string) (*Tag, error) {
+	m.RLock()
+	defer m.RUnlock()
+	tag, ok := m.tags[idTag]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &tag, nil
+}
+
+func (m *MemoryStore) Put(tag *Tag) error {
+	if err := tag.validate(); err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.tags[tag.IdTag] = *tag
+	return nil
+}
+
+func (m *MemoryStore) Delete(idTag string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.tags, idTag)
+	return nil
+}
+
+func (m *MemoryStore) List() ([]*Tag, error) {
+	m.RLock()
+	defer m.RUnlock()
+	tags := make([]*Tag, 0, len(m.tags))
+	for _, tag := range m.tags {
+		tag := tag
+		tags = append(tags, &tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].IdTag < tags[j].IdTag })
+	return tags, nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+//FileStore keeps the tags in memory and writes all of them to a json file on every change, the file can be edited
+//while the server is stopped
+type FileStore struct {
+	path string
+	*MemoryStore
+	mu sync.Mutex
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("id tag file store path is empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f := &FileStore{path: path, MemoryStore: NewMemoryStore()}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tags []*Tag
+	if err = json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("id tag file(%s) corrupted, err(%v)", path, err)
+	}
+	for _, tag := range tags {
+		if err = f.MemoryStore.Put(tag); err != nil {
+			return nil, fmt.Errorf("id tag file(%s), %w", path, err)
+		}
+	}
+	return f, nil
+}
+
+//save replaces the file by the tags of the memory store
+func (f *FileStore) save() error {
+	tags, _ := f.MemoryStore.List()
+	data, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(f.path, data)
+}
+
+func (f *FileStore) Put(tag *Tag) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.MemoryStore.Put(tag); err != nil {
+		return err
+	}
+	return f.save()
+}
+
+func (f *FileStore) Delete(idTag string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.MemoryStore.Delete(idTag)
+	return f.save()
+}
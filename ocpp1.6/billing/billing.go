@@ -0,0 +1,237 @@
+//Package billing computes the charge detail record of a closed transaction: the energy delivered, from the meter
+//readings of StartTransaction and StopTransaction or from the sampled energy registers and intervals, the duration,
+//the idle time and the cost of a Tariff
+package billing
+
+import (
+	"math"
+	"ocpp16/transaction"
+	"sort"
+	"time"
+)
+
+const (
+	MeasurandRegister = "Energy.Active.Import.Register" //the default measurand of the sampled values
+	MeasurandInterval = "Energy.Active.Import.Interval"
+)
+
+//ChargeDetailRecord is the bill of a transaction, the energies are in Wh
+type ChargeDetailRecord struct {
+	TransactionID int               `json:"transactionId"`
+	ChargePointID string            `json:"chargePointId"`
+	ConnectorID   int               `json:"connectorId"`
+	IdTag         string            `json:"idTag"`
+	State         transaction.State `json:"state"`
+	StartTime     time.Time         `json:"startTime"`
+	StopTime      time.Time         `json:"stopTime"`
+	Duration      int64             `json:"duration"`     //seconds
+	IdleDuration  int64             `json:"idleDuration"` //seconds
+	Energy        float64           `json:"energy"`
+	Currency      string            `json:"currency"`
+	StartFee      float64           `json:"startFee"`
+	EnergyCost    float64           `json:"energyCost"`
+	TimeCost      float64           `json:"timeCost"`
+	IdleCost      float64           `json:"idleCost"`
+	Total         float64           `json:"total"`
+	Periods       []Period          `json:"periods"`
+	Corrective    bool              `json:"corrective,omitempty"` //replaces the record of the transaction billed when it was Orphaned
+}
+
+//Period is a part of the transaction with the same prices
+type Period struct {
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Energy    float64   `json:"energy"`
+	PerKWh    float64   `json:"perKWh"`
+	PerMinute float64   `json:"perMinute"`
+	Cost      float64   `json:"cost"`
+}
+
+//point is a reading of the energy delivered since the start of the transaction
+type point struct {
+	at     time.Time
+	energy float64
+}
+
+//curve returns the energy delivered over the transaction. The registers are read relative to MeterStart, the
+//intervals are summed up when there is no register, an interval counts at its timestamp
+func curve(t *transaction.Transaction, stop time.Time) []point {
+	var registers, intervals []point
+	for _, mv := range t.MeterValues {
+		at, err := time.Parse(time.RFC3339Nano, mv.TimeStamp)
+		if err != nil {
+			continue
+		}
+		for _, v := range mv.SampledValue {
+			f, ok := transaction.Energy(v)
+			if !ok {
+				continue
+			}
+			switch v.Measurand {
+			case "", MeasurandRegister:
+				registers = append(registers, point{at, f - float64(t.MeterStart)})
+			case MeasurandInterval:
+				intervals = append(intervals, point{at, f})
+			}
+		}
+	}
+	points := registers
+	if len(registers) == 0 {
+		sort.SliceStable(intervals, func(i, j int) bool { return intervals[i].at.Before(intervals[j].at) })
+		sum := 0.0
+		for _, p := range intervals {
+			sum += p.energy
+			points = append(points, point{p.at, sum})
+		}
+	}
+	points = append(points, point{t.StartTime, 0})
+	if t.MeterStop != nil {
+		points = append(points, point{stop, float64(*t.MeterStop - t.MeterStart)})
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].at.Before(points[j].at) })
+	//the readings outside of the transaction are dropped, a reading never goes below the one before
+	c := points[:0]
+	for _, p := range points {
+		if p.at.Before(t.StartTime) || p.at.After(stop) {
+			continue
+		}
+		if len(c) > 0 && p.energy < c[len(c)-1].energy {
+			p.energy = c[len(c)-1].energy
+		}
+		c = append(c, p)
+	}
+	return c
+}
+
+//at interpolates the energy delivered at t
+func at(c []point, t time.Time) float64 {
+	i := sort.Search(len(c), func(i int) bool { return !c[i].at.Before(t) })
+	switch {
+	case i == 0:
+		return c[0].energy
+	case i == len(c):
+		return c[len(c)-1].energy
+	}
+	a, b := c[i-1], c[i]
+	span := b.at.Sub(a.at)
+	if span <= 0 {
+		return b.energy
+	}
+	return a.energy + (b.energy-a.energy)*float64(t.Sub(a.at))/float64(span)
+}
+
+//idle returns the time the average power between two readings stays below power W
+func idle(c []point, power float64) time.Duration {
+	var d time.Duration
+	for i := 1; i < len(c); i++ {
+		span := c[i].at.Sub(c[i-1].at)
+		if span > 0 && (c[i].energy-c[i-1].energy)/span.Hours() < power {
+			d += span
+		}
+	}
+	return d
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+//Receiver is implemented by the passive plugins that take the charge detail records
+type Receiver interface {
+	ChargeDetailRecord(cdr *ChargeDetailRecord) error
+}
+
+type Engine struct {
+	tariffs  *Tariffs
+	receiver Receiver
+	onError  func(err error)
+}
+
+//NewEngine bills with tariffs and sends the records to receiver, onError gets the errors of receiver. Both can be nil
+func NewEngine(tariffs *Tariffs, receiver Receiver, onError func(err error)) (*Engine, error) {
+	if err := tariffs.init(); err != nil {
+		return nil, err
+	}
+	return &Engine{tariffs: tariffs, receiver: receiver, onError: onError}, nil
+}
+
+//Handle bills tx and sends its record to the receiver, it is the close handler of transaction.Manager. A transaction
+//is billed once, or twice when it is closed as Orphaned and finished later: the second record is Corrective and replaces
+//the first one of its TransactionID
+func (e *Engine) Handle(tx *transaction.Transaction) {
+	if e.receiver == nil {
+		return
+	}
+	if err := e.receiver.ChargeDetailRecord(e.Bill(tx)); err != nil && e.onError != nil {
+		e.onError(err)
+	}
+}
+
+//Bill computes the record of tx with the tariff of its charging point, an open transaction is billed up to now
+func (e *Engine) Bill(tx *transaction.Transaction) *ChargeDetailRecord {
+	t := e.tariffs
+	stop := time.Now()
+	if tx.StopTime != nil {
+		stop = *tx.StopTime
+	}
+	if stop.Before(tx.StartTime) {
+		stop = tx.StartTime
+	}
+	tariff := t.For(tx.ChargePointID)
+	c := curve(tx, stop)
+	cdr := &ChargeDetailRecord{
+		TransactionID: tx.ID,
+		ChargePointID: tx.ChargePointID,
+		ConnectorID:   tx.ConnectorID,
+		IdTag:         tx.IdTag,
+		State:         tx.State,
+		StartTime:     tx.StartTime,
+		StopTime:      stop,
+		Duration:      int64(stop.Sub(tx.StartTime) / time.Second),
+		Energy:        c[len(c)-1].energy,
+		Currency:      tariff.Currency,
+		StartFee:      tariff.StartFee,
+		Corrective:    tx.WasOrphaned,
+	}
+
+	//the periods split at the readings and at the boundaries of the windows
+	times := []time.Time{tx.StartTime, stop}
+	for _, p := range c {
+		times = append(times, p.at)
+	}
+	for i := range tariff.Windows {
+		times = tariff.Windows[i].boundaries(tx.StartTime.In(t.location), stop.In(t.location), times)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	for i := 1; i < len(times); i++ {
+		from, to := times[i-1], times[i]
+		if !to.After(from) {
+			continue
+		}
+		perKWh, perMinute := tariff.rates(from.Add(to.Sub(from) / 2).In(t.location))
+		energy := at(c, to) - at(c, from)
+		if n := len(cdr.Periods); n > 0 && cdr.Periods[n-1].PerKWh == perKWh && cdr.Periods[n-1].PerMinute == perMinute {
+			cdr.Periods[n-1].End = to
+			cdr.Periods[n-1].Energy += energy
+			continue
+		}
+		cdr.Periods = append(cdr.Periods, Period{Start: from, End: to, Energy: energy, PerKWh: perKWh, PerMinute: perMinute})
+	}
+	for i := range cdr.Periods {
+		p := &cdr.Periods[i]
+		energyCost := p.Energy / 1000 * p.PerKWh
+		timeCost := p.End.Sub(p.Start).Minutes() * p.PerMinute
+		p.Cost = round(energyCost + timeCost)
+		cdr.EnergyCost += energyCost
+		cdr.TimeCost += timeCost
+	}
+
+	idleTime := idle(c, t.IdlePower)
+	cdr.IdleDuration = int64(idleTime / time.Second)
+	if billed := idleTime.Minutes() - tariff.IdleGraceMinutes; billed > 0 {
+		cdr.IdleCost = billed * tariff.IdlePerMinute
+	}
+	cdr.EnergyCost, cdr.TimeCost, cdr.IdleCost = round(cdr.EnergyCost), round(cdr.TimeCost), round(cdr.IdleCost)
+	cdr.Total = round(cdr.StartFee + cdr.EnergyCost + cdr.TimeCost + cdr.IdleCost)
+	return cdr
+}
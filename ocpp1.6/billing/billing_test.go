@@ -0,0 +1,139 @@
+package billing
+
+import (
+	"context"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/transaction"
+	"testing"
+	"time"
+)
+
+func mustTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func sampled(timestamp string, value string, unit string, measurand protocol.Measurand) protocol.MeterValue {
+	return protocol.MeterValue{TimeStamp: timestamp, SampledValue: []protocol.SampledValue{{Value: value, Unit: protocol.UnitOfMeasure(unit), Measurand: measurand}}}
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func newEngine(t *testing.T, receiver Receiver) *Engine {
+	e, err := NewEngine(&Tariffs{
+		Default: Tariff{
+			Currency:         "EUR",
+			StartFee:         1,
+			PerKWh:           0.3,
+			PerMinute:        0.01,
+			IdlePerMinute:    0.1,
+			IdleGraceMinutes: 10,
+			Windows:          []Window{{Start: "22:00", End: "06:00", PerKWh: float(0.2)}},
+		},
+		ChargePoints: map[string]Tariff{
+			"CP002": {Currency: "EUR", PerKWh: 0.5, Windows: []Window{{Start: "00:00", End: "00:00", Weekdays: []time.Weekday{time.Saturday}, PerKWh: float(0)}}},
+		},
+	}, receiver, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestBill(t *testing.T) {
+	e := newEngine(t, nil)
+	stop, meterStop := mustTime("2021-01-01T23:30:00Z"), 11000
+	cdr := e.Bill(&transaction.Transaction{
+		ID:            1,
+		ChargePointID: "CP001",
+		MeterStart:    1000,
+		StartTime:     mustTime("2021-01-01T21:00:00Z"),
+		State:         transaction.Finished,
+		MeterStop:     &meterStop,
+		StopTime:      &stop,
+		MeterValues: []protocol.MeterValue{
+			sampled("2021-01-01T21:30:00Z", "6000", "Wh", ""),
+			sampled("2021-01-01T22:30:00Z", "11", "kWh", MeasurandRegister),
+			sampled("2021-01-01T22:45:00Z", "32", "A", "Current.Import"),
+			sampled("2021-01-01T23:00:00Z", "11000", "Wh", ""),
+		},
+	})
+	if cdr.Energy != 10000 || cdr.Duration != 9000 || cdr.IdleDuration != 3600 {
+		t.Fatalf("unexpected energy or durations %+v", cdr)
+	}
+	//7.5kWh before 22:00 at 0.3, 2.5kWh at night at 0.2, 150 minutes, 50 idle minutes beyond the grace
+	if cdr.EnergyCost != 2.75 || cdr.TimeCost != 1.5 || cdr.IdleCost != 5 || cdr.Total != 10.25 || cdr.Currency != "EUR" {
+		t.Fatalf("unexpected costs %+v", cdr)
+	}
+	if len(cdr.Periods) != 2 || !cdr.Periods[1].Start.Equal(mustTime("2021-01-01T22:00:00Z")) || cdr.Periods[0].Cost != 2.85 || cdr.Periods[1].Cost != 1.4 {
+		t.Fatalf("unexpected periods %+v", cdr.Periods)
+	}
+
+	//intervals without a register, free on saturdays for CP002
+	stop = mustTime("2021-01-02T01:00:00Z")
+	cdr = e.Bill(&transaction.Transaction{
+		ID:            2,
+		ChargePointID: "CP002",
+		StartTime:     mustTime("2021-01-01T23:00:00Z"),
+		StopTime:      &stop,
+		MeterValues: []protocol.MeterValue{
+			sampled("2021-01-02T00:00:00Z", "2", "kWh", MeasurandInterval),
+			sampled("2021-01-02T01:00:00Z", "3000", "Wh", MeasurandInterval),
+		},
+	})
+	if cdr.Energy != 5000 || cdr.EnergyCost != 1 || cdr.Total != 1 || len(cdr.Periods) != 2 {
+		t.Fatalf("unexpected record %+v", cdr)
+	}
+}
+
+type receiver chan *ChargeDetailRecord
+
+func (r receiver) ChargeDetailRecord(cdr *ChargeDetailRecord) error {
+	r <- cdr
+	return nil
+}
+
+func TestHandle(t *testing.T) {
+	records := make(receiver, 1)
+	e := newEngine(t, records)
+	m, _ := transaction.NewManager(transaction.NewMemoryRepository())
+	m.SetCloseHandler(e.Handle)
+	plugin := m.Wrap(local.NewActionPlugin())
+	call := func(req protocol.Request) protocol.Response {
+		handler, _ := plugin.RequestHandler(req.Action())
+		res, err := handler(context.Background(), "CP001", "1", req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	connectorID, meterStart, meterStop := 1, 0, 2000
+	res := call(&protocol.StartTransactionRequest{ConnectorId: &connectorID, IdTag: "tag", MeterStart: &meterStart, Timestamp: "2021-01-01T10:00:00Z"})
+	call(&protocol.StopTransactionRequest{TransactionId: res.(*protocol.StartTransactionResponse).TransactionId, MeterStop: &meterStop, Timestamp: "2021-01-01T10:30:00Z"})
+	cdr := <-records
+	if cdr.Energy != 2000 || cdr.Total != 1.9 || cdr.State != transaction.Finished || cdr.Corrective {
+		t.Fatalf("unexpected record %+v", cdr)
+	}
+
+	//the record of a transaction orphaned by a reboot is replaced by the one of its StopTransaction
+	res = call(&protocol.StartTransactionRequest{ConnectorId: &connectorID, IdTag: "tag", MeterStart: &meterStart, Timestamp: "2021-01-01T11:00:00Z"})
+	txID := res.(*protocol.StartTransactionResponse).TransactionId
+	call(&protocol.BootNotificationRequest{ChargePointVendor: "v", ChargePointModel: "m"})
+	if cdr = <-records; cdr.TransactionID != *txID || cdr.State != transaction.Orphaned || cdr.Corrective {
+		t.Fatalf("unexpected record %+v", cdr)
+	}
+	call(&protocol.StopTransactionRequest{TransactionId: txID, MeterStop: &meterStop, Timestamp: "2021-01-01T11:30:00Z"})
+	if cdr = <-records; cdr.TransactionID != *txID || cdr.State != transaction.Finished || cdr.Energy != 2000 || !cdr.Corrective {
+		t.Fatalf("unexpected record %+v", cdr)
+	}
+	call(&protocol.StopTransactionRequest{TransactionId: txID, MeterStop: &meterStop, Timestamp: "2021-01-01T11:30:00Z"})
+	if len(records) != 0 {
+		t.Fatalf("transaction billed again %+v", <-records)
+	}
+}
@@ -0,0 +1,172 @@
+package billing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+//Tariff prices a transaction, the prices are in Currency
+type Tariff struct {
+	Currency         string   `json:"currency"`
+	StartFee         float64  `json:"startFee"`         //per transaction
+	PerKWh           float64  `json:"perKWh"`           //of the energy delivered
+	PerMinute        float64  `json:"perMinute"`        //of the duration of the transaction
+	IdlePerMinute    float64  `json:"idlePerMinute"`    //of the idle time beyond IdleGraceMinutes
+	IdleGraceMinutes float64  `json:"idleGraceMinutes"` //of idle time free of charge
+	Windows          []Window `json:"windows"`          //time of use prices, the first window matching a time applies
+}
+
+//Window replaces the prices of its tariff from Start to End, e.g. "22:00" to "06:00" for the nights
+type Window struct {
+	Start     string         `json:"start"`              //15:04 in the time zone of the tariffs
+	End       string         `json:"end"`                //a window ending at or before its start ends on the next day
+	Weekdays  []time.Weekday `json:"weekdays,omitempty"` //the days the window starts on, 0 is Sunday, every day if empty
+	PerKWh    *float64       `json:"perKWh,omitempty"`
+	PerMinute *float64       `json:"perMinute,omitempty"`
+	start     time.Duration
+	end       time.Duration
+}
+
+//Tariffs are the tariff of every charging point, the json of the tariff file
+type Tariffs struct {
+	TimeZone     string            `json:"timeZone"`     //IANA name of the time zone of the windows, UTC if empty
+	IdlePower    float64           `json:"idlePower"`    //W, the vehicle is idle while the average power is below, 100 if 0
+	Default      Tariff            `json:"default"`      //of the charging points without their own
+	ChargePoints map[string]Tariff `json:"chargePoints"` //charging point id -> tariff
+	location     *time.Location
+}
+
+func LoadTariffs(path string) (*Tariffs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tariffs := &Tariffs{}
+	if err = json.Unmarshal(data, tariffs); err != nil {
+		return nil, fmt.Errorf("tariff file(%s) invalid, %v", path, err)
+	}
+	return tariffs, nil
+}
+
+//init checks the tariffs and parses the time zone and the windows
+func (t *Tariffs) init() error {
+	var err error
+	if t.location, err = time.LoadLocation(t.TimeZone); err != nil {
+		return err
+	}
+	if t.IdlePower <= 0 {
+		t.IdlePower = 100
+	}
+	if err = t.Default.init(); err != nil {
+		return fmt.Errorf("default tariff, %w", err)
+	}
+	for id, tariff := range t.ChargePoints {
+		if err = tariff.init(); err != nil {
+			return fmt.Errorf("tariff of %s, %w", id, err)
+		}
+		t.ChargePoints[id] = tariff
+	}
+	return nil
+}
+
+//For returns the tariff of the charging point id
+func (t *Tariffs) For(id string) Tariff {
+	if tariff, ok := t.ChargePoints[id]; ok {
+		return tariff
+	}
+	return t.Default
+}
+
+func (t *Tariff) init() error {
+	windows := make([]Window, len(t.Windows))
+	for i, w := range t.Windows {
+		start, err := parseClock(w.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			return err
+		}
+		if end <= start {
+			end += 24 * time.Hour
+		}
+		w.start, w.end = start, end
+		windows[i] = w
+	}
+	t.Windows = windows
+	return nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	c, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day(%s)", s)
+	}
+	return time.Duration(c.Hour())*time.Hour + time.Duration(c.Minute())*time.Minute, nil
+}
+
+func (w *Window) onWeekday(day time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range w.Weekdays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+//contains reports whether the window started on the day of t, or the day before, covers t. The window is offset from
+//midnight, on the days of a daylight saving change it is an hour off the wall clock
+func (w *Window) contains(t time.Time) bool {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	for _, day := range []time.Time{midnight, midnight.AddDate(0, 0, -1)} {
+		if !w.onWeekday(day.Weekday()) {
+			continue
+		}
+		if !t.Before(day.Add(w.start)) && t.Before(day.Add(w.end)) {
+			return true
+		}
+	}
+	return false
+}
+
+//boundaries appends the starts and ends of the window in [from, to)
+func (w *Window) boundaries(from, to time.Time, times []time.Time) []time.Time {
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, from.Location()).AddDate(0, 0, -1); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !w.onWeekday(day.Weekday()) {
+			continue
+		}
+		for _, b := range []time.Time{day.Add(w.start), day.Add(w.end)} {
+			if b.After(from) && b.Before(to) {
+				times = append(times, b)
+			}
+		}
+	}
+	return times
+}
+
+//rates returns the prices at t
+func (t *Tariff) rates(at time.Time) (perKWh float64, perMinute float64) {
+	perKWh, perMinute = t.PerKWh, t.PerMinute
+	for i := range t.Windows {
+		w := &t.Windows[i]
+		if !w.contains(at) {
+			continue
+		}
+		if w.PerKWh != nil {
+			perKWh = *w.PerKWh
+		}
+		if w.PerMinute != nil {
+			perMinute = *w.PerMinute
+		}
+		break
+	}
+	return
+}
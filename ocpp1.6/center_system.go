@@ -1,17 +1,53 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"ocpp16/auth"
+	"ocpp16/billing"
 	"ocpp16/config"
+	"ocpp16/configuration"
+	"ocpp16/conformance"
+	"ocpp16/connectorstatus"
+	"ocpp16/events"
+	"ocpp16/filetransfer"
+	"ocpp16/firmware"
+	"ocpp16/loadbalancing"
+	"ocpp16/locallist"
 	"ocpp16/logwriter"
 	// active "ocpp16/plugin/active/local"
 	// passive "ocpp16/plugin/passive/local"
 	log "github.com/sirupsen/logrus"
 	cli "github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"net"
+	"ocpp16/mqtt"
+	grpcactive "ocpp16/plugin/active/grpc"
+	mqttactive "ocpp16/plugin/active/mqtt"
 	active "ocpp16/plugin/active/rpcx"
+	"ocpp16/plugin/active/rest"
+	grpcpassive "ocpp16/plugin/passive/grpc"
+	webhook "ocpp16/plugin/passive/http"
+	local "ocpp16/plugin/passive/local"
+	mqttpassive "ocpp16/plugin/passive/mqtt"
 	passive "ocpp16/plugin/passive/rpcx"
+	"ocpp16/protocol"
+	"ocpp16/reservation"
 	ocpp16server "ocpp16/server"
+	"ocpp16/simulator"
+	"ocpp16/smartcharging"
+	"ocpp16/transaction"
 	"os"
+	"os/signal"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 )
 
@@ -37,6 +73,121 @@ func main() {
 					},
 				},
 			},
+			{
+				Name:   "simulate",
+				Usage:  "simulate charging points connecting to a central system",
+				Action: simulate,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "url",
+						Usage:    "service uri of the central system without the charging point id, e.g. ws://127.0.0.1:8090/ocpp/<uuid>",
+						Required: true,
+						Aliases:  []string{"u"},
+					},
+					&cli.IntFlag{
+						Name:    "count",
+						Usage:   "number of charging points",
+						Value:   1,
+						Aliases: []string{"n"},
+					},
+					&cli.IntFlag{
+						Name:  "connectors",
+						Usage: "connectors per charging point",
+						Value: 2,
+					},
+					&cli.StringFlag{
+						Name:  "prefix",
+						Usage: "prefix of the charging point ids",
+						Value: "SIM",
+					},
+					&cli.StringFlag{
+						Name:  "password",
+						Usage: "http basic auth password of the charging points",
+					},
+					&cli.StringFlag{
+						Name:  "idtag",
+						Usage: "id tag presented by the simulated drivers",
+						Value: "SIMTAG0001",
+					},
+					&cli.BoolFlag{
+						Name:  "auto",
+						Usage: "start charging sessions without remote start",
+						Value: true,
+					},
+					&cli.DurationFlag{
+						Name:  "idle",
+						Usage: "mean idle time of a connector between two sessions",
+						Value: time.Minute,
+					},
+					&cli.DurationFlag{
+						Name:  "duration",
+						Usage: "duration of a charging session",
+						Value: 5 * time.Minute,
+					},
+					&cli.DurationFlag{
+						Name:  "meter-interval",
+						Usage: "interval of the meter values while charging",
+						Value: 30 * time.Second,
+					},
+					&cli.Float64Flag{
+						Name:  "power",
+						Usage: "charging power in W",
+						Value: 11000,
+					},
+					&cli.StringFlag{
+						Name:  "log-level",
+						Usage: "log level of the simulator",
+						Value: "info",
+					},
+				},
+			},
+			{
+				Name:   "conformance",
+				Usage:  "run the ocpp1.6J test cases against a central system",
+				Action: conformanceTest,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "url",
+						Usage:    "service uri of the central system without the charging point id, e.g. ws://127.0.0.1:8090/ocpp/<uuid>",
+						Required: true,
+						Aliases:  []string{"u"},
+					},
+					&cli.StringFlag{
+						Name:  "cases",
+						Usage: "directory of the test cases, the builtin test cases when empty",
+					},
+					&cli.StringFlag{
+						Name:  "run",
+						Usage: "run only the test cases whose name matches the regular expression",
+					},
+					&cli.StringFlag{
+						Name:  "prefix",
+						Usage: "prefix of the charging point ids",
+						Value: "CONF",
+					},
+					&cli.StringFlag{
+						Name:  "password",
+						Usage: "http basic auth password of the charging points",
+					},
+					&cli.DurationFlag{
+						Name:  "timeout",
+						Usage: "timeout of every expectation",
+						Value: 10 * time.Second,
+					},
+					&cli.StringFlag{
+						Name:  "junit",
+						Usage: "file of the JUnit XML report",
+					},
+					&cli.StringFlag{
+						Name:  "api",
+						Usage: "base url of the rest api of the central system, e.g. http://127.0.0.1:8090/api/v1, to run the test cases where it sends calls",
+					},
+					&cli.StringFlag{
+						Name:  "token",
+						Usage: "bearer token of the rest api",
+					},
+				},
+			},
 		},
 		Authors: []*cli.Author{
 			{
@@ -78,6 +229,7 @@ func initLogger() *log.Logger {
 	lg.SetLevel(lv)
 	return lg
 }
+
 func serve(c *cli.Context) error {
 	config.ParseFile(c.String("config"))
 	config.Print()
@@ -85,10 +237,256 @@ func serve(c *cli.Context) error {
 	lg := initLogger()
 	ocpp16server.SetLogger(lg)
 	ocpp16server.WithOptions(ocpp16server.SupportCustomConversion(conf.UseConvert), ocpp16server.SupportObjectPool(conf.UsePool))
+	//the passive plugins of passive_plugin and their wrappers speak ocpp1.6 only, ocpp2.0.1 has its own
+	var ocpp201Plugin ocpp16server.ActionPlugin
+	for _, name := range conf.Subprotocols {
+		if strings.TrimSpace(name) != ocpp16server.OCPP201 {
+			continue
+		}
+		switch conf.OCPP201Plugin {
+		case "", "local":
+			ocpp201Plugin = local.NewOCPP201ActionPlugin()
+		default:
+			return fmt.Errorf("not support ocpp2.0.1 passive plugin(%s) current", conf.OCPP201Plugin)
+		}
+	}
+	if conf.RESTEnable && conf.RESTToken == "" {
+		return fmt.Errorf("rest_enable needs rest_token, the api sends any call to the charging points")
+	}
 	server := ocpp16server.NewDefaultServer()
 	defer server.Stop()
-	actionPlugin := passive.NewActionPlugin()
+	if len(conf.EventSinks) > 0 {
+		stream, err := newEventStream(lg)
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		server.SetEventStream(stream)
+	}
+	var mqttClient *mqtt.Client
+	if conf.PassivePlugin == "mqtt" || conf.MQTTActiveEnable {
+		client, err := connectMQTT(lg)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		mqttClient = client
+	}
+	var actionPlugin ocpp16server.PassivePlugin
+	switch conf.PassivePlugin {
+	case "", "rpcx":
+		actionPlugin = passive.NewActionPlugin()
+	case "webhook":
+		actionPlugin = webhook.NewActionPluginFromConfig()
+	case "mqtt":
+		p, err := mqttpassive.NewActionPlugin(mqttClient, mqttpassive.Config{
+			Prefix:   conf.MQTTTopicPrefix,
+			Wait:     conf.MQTTWaitActions,
+			Timeout:  time.Duration(conf.MQTTReplyTimeout) * time.Second,
+			Fallback: conf.MQTTFallback,
+		})
+		if err != nil {
+			return err
+		}
+		actionPlugin = p
+	case "grpc":
+		creds := insecure.NewCredentials()
+		if conf.GRPCTLS {
+			tlsConf, err := clientTLS(conf.GRPCCA, conf.GRPCCert, conf.GRPCKey)
+			if err != nil {
+				return fmt.Errorf("grpc backend tls, %w", err)
+			}
+			creds = credentials.NewTLS(tlsConf)
+		}
+		conn, err := grpc.Dial(conf.GRPCBackend, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("dial grpc backend(%s), %w", conf.GRPCBackend, err)
+		}
+		defer conn.Close()
+		actionPlugin = grpcpassive.NewActionPlugin(conn, grpcpassive.Config{
+			Timeout:  time.Duration(conf.GRPCTimeout) * time.Second,
+			Fallback: conf.GRPCFallback,
+		})
+	default:
+		return fmt.Errorf("not support passive plugin(%s) current", conf.PassivePlugin)
+	}
+	//the passive plugin before the wrappers, it receives the records and the events
+	backend := actionPlugin
+	var transactions *transaction.Manager
+	if conf.TransactionStore != "" {
+		repo, err := newTransactionRepository()
+		if err != nil {
+			return err
+		}
+		defer repo.Close()
+		if transactions, err = transaction.NewManager(repo); err != nil {
+			return err
+		}
+		if conf.BillingTariffFile != "" {
+			if err = bill(transactions, backend, lg); err != nil {
+				return err
+			}
+		}
+	}
+	var authorization *auth.Service
+	if conf.AuthStore != "" {
+		store, err := newAuthStore()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+		var active auth.Transactions
+		if transactions != nil {
+			active = transactions
+		}
+		authorization = auth.NewService(store, active, auth.Config{CacheTTL: time.Duration(conf.AuthCacheTTL) * time.Second})
+		actionPlugin = authorization.Wrap(actionPlugin)
+	}
+	if transactions != nil {
+		//the transactions get the idTagInfo answered by the authorization
+		actionPlugin = transactions.Wrap(actionPlugin)
+	}
+	var localList *locallist.Manager
+	if conf.LocalListEnable {
+		var err error
+		if localList, err = locallist.NewManager(server, locallist.Config{Path: conf.LocalListPath, MaxDiff: conf.LocalListMaxDiff}); err != nil {
+			return err
+		}
+		localList.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("sync local list of id(%s), error(%v)", id, err)
+		})
+		actionPlugin = localList.Wrap(actionPlugin)
+	}
+	var smartCharging *smartcharging.Manager
+	if conf.SmartCharging {
+		var err error
+		if smartCharging, err = smartcharging.NewManager(server, smartcharging.Config{Path: conf.SmartChargingPath, Voltage: float64(conf.ChargingVoltage)}); err != nil {
+			return err
+		}
+		smartCharging.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("save charging profiles of id(%s), error(%v)", id, err)
+		})
+		//outside of the transactions, the TransactionId of StartTransaction is allocated
+		actionPlugin = smartCharging.Wrap(actionPlugin)
+	}
+	var balancer *loadbalancing.Balancer
+	if conf.LoadBalancing {
+		if smartCharging == nil {
+			return fmt.Errorf("load_balancing_enable needs smart_charging_enable")
+		}
+		var err error
+		balancer, err = loadbalancing.NewBalancer(smartCharging, loadbalancing.Config{
+			Path:    conf.LoadBalancingPath,
+			Voltage: float64(conf.ChargingVoltage),
+			Unit:    protocol.ChargingRateUnitType(conf.LoadBalancingUnit),
+		})
+		if err != nil {
+			return err
+		}
+		balancer.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("load balancing of id(%s), error(%v)", id, err)
+		})
+		//outside of the smart charging, which must know the transactions of the TxProfiles
+		actionPlugin = balancer.Wrap(actionPlugin)
+	}
+	var reservations *reservation.Manager
+	if conf.Reservations {
+		receiver, _ := backend.(reservation.Receiver)
+		var err error
+		if reservations, err = reservation.NewManager(server, receiver, reservation.Config{Path: conf.ReservationPath}); err != nil {
+			return err
+		}
+		reservations.SetErrorHandler(func(id string, err error) {
+			lg.Warnf("reservation of id(%s), %v", id, err)
+		})
+		//the ReserveNow of the active plugins are checked against the reservations too
+		server.SetCallHooks(reservations.Check)
+		actionPlugin = reservations.Wrap(actionPlugin)
+	}
+	var campaigns *firmware.Manager
+	if conf.FirmwareCampaigns {
+		var err error
+		if campaigns, err = firmware.NewManager(server, firmware.Config{Path: conf.FirmwarePath}); err != nil {
+			return err
+		}
+		campaigns.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("firmware campaign of id(%s), error(%v)", id, err)
+		})
+		actionPlugin = campaigns.Wrap(actionPlugin)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go campaigns.Run(ctx)
+	}
+	var files *filetransfer.Service
+	if conf.FileServer {
+		var err error
+		if files, err = newFileServer(); err != nil {
+			return err
+		}
+		files.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("save diagnostics of id(%s), error(%v)", id, err)
+		})
+		//the locations are signed whatever plugin or manager sends the call
+		server.SetCallHooks(files.Build)
+		files.RegisterHandlers(server.Router())
+		actionPlugin = files.Wrap(actionPlugin)
+		if conf.FileServerFTP != "" {
+			l, err := net.Listen("tcp", conf.FileServerFTP)
+			if err != nil {
+				return fmt.Errorf("listen ftp(%s), %w", conf.FileServerFTP, err)
+			}
+			defer l.Close()
+			go func() {
+				if err := files.ServeFTP(l); err != nil {
+					lg.Errorf("ftp serve %s, error(%v)", conf.FileServerFTP, err)
+				}
+			}()
+		}
+	}
+	var configurations *configuration.Manager
+	if conf.ConfigManager {
+		var err error
+		configurations, err = configuration.NewManager(server, configuration.Config{
+			Path:       conf.ConfigManagerPath,
+			ResetDelay: time.Duration(conf.ConfigResetDelay) * time.Second,
+		})
+		if err != nil {
+			return err
+		}
+		configurations.SetErrorHandler(func(id string, err error) {
+			lg.Errorf("configuration of id(%s), error(%v)", id, err)
+		})
+		actionPlugin = configurations.Wrap(actionPlugin)
+	}
+	var statuses *connectorstatus.Tracker
+	if conf.StatusTracker {
+		statuses = connectorstatus.NewTracker(connectorstatus.Config{History: conf.StatusHistory})
+		statuses.SetErrorHandler(func(id string, err error) {
+			lg.Warnf("status of id(%s), %v", id, err)
+		})
+		actionPlugin = statuses.Wrap(actionPlugin)
+	}
+	if conf.GRPCListen != "" {
+		l, err := net.Listen("tcp", conf.GRPCListen)
+		if err != nil {
+			return fmt.Errorf("listen grpc(%s), %w", conf.GRPCListen, err)
+		}
+		g := grpc.NewServer()
+		//the event streams see the requests and the connections through the wrapped passive plugin
+		actionPlugin = grpcactive.NewActiveCallPlugin(server, g).Wrap(actionPlugin)
+		go func() {
+			if err := g.Serve(l); err != nil {
+				lg.Errorf("grpc serve %s, error(%v)", conf.GRPCListen, err)
+			}
+		}()
+		defer g.Stop()
+	}
 	server.RegisterActionPlugin(actionPlugin)
+	if ocpp201Plugin != nil {
+		if err := server.RegisterSubprotocolActionPlugin(ocpp16server.OCPP201, ocpp201Plugin); err != nil {
+			return err
+		}
+	}
 	server.SetConnectHandlers(func(ws *ocpp16server.Wsconn) error {
 		lg.Debugf("id(%s) connect,time(%s)", ws.ID(), time.Now().Format(time.RFC3339))
 		return nil
@@ -102,6 +500,45 @@ func serve(c *cli.Context) error {
 		return actionPlugin.ChargingPointOffline(ws.ID())
 	})
 	server.RegisterActiveCallHandler(server.HandleActiveCall, active.NewActiveCallPlugin)
+	server.RegisterSyncActiveCallHandler(server.Call, active.NewSyncActiveCallPlugin)
+	if conf.RESTEnable {
+		rest.NewActiveCallPlugin(server, rest.TokenAuth(conf.RESTToken))
+		if transactions != nil {
+			transactions.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if authorization != nil {
+			authorization.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if localList != nil {
+			localList.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if smartCharging != nil {
+			smartCharging.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if balancer != nil {
+			balancer.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if reservations != nil {
+			reservations.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if campaigns != nil {
+			campaigns.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if files != nil {
+			files.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if configurations != nil {
+			configurations.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+		if statuses != nil {
+			statuses.RegisterAPI(server.Router().Group(rest.BasePath, rest.TokenAuth(conf.RESTToken)))
+		}
+	}
+	if conf.MQTTActiveEnable {
+		if err := mqttactive.NewActiveCallPlugin(server, mqttClient, mqttactive.Config{Prefix: conf.MQTTTopicPrefix}); err != nil {
+			return err
+		}
+	}
 	ServiceAddr, ServiceURI := conf.ServiceAddr, conf.ServiceURI
 	if conf.WsEnable {
 		wsAddr := fmt.Sprintf("%s:%d", ServiceAddr, conf.WsPort)
@@ -113,3 +550,253 @@ func serve(c *cli.Context) error {
 	}
 	return nil
 }
+
+func newTransactionRepository() (transaction.Repository, error) {
+	conf := config.GCONF
+	switch conf.TransactionStore {
+	case "memory":
+		return transaction.NewMemoryRepository(), nil
+	case "file":
+		return transaction.NewFileRepository(conf.TransactionPath)
+	}
+	return nil, fmt.Errorf("not support transaction store(%s) current", conf.TransactionStore)
+}
+
+func newAuthStore() (auth.Store, error) {
+	conf := config.GCONF
+	switch conf.AuthStore {
+	case "memory":
+		return auth.NewMemoryStore(), nil
+	case "file":
+		return auth.NewFileStore(conf.AuthPath)
+	}
+	return nil, fmt.Errorf("not support auth store(%s) current", conf.AuthStore)
+}
+
+//newFileServer serves the files of file_server_dir, over ftp too when file_server_ftp_listen is set
+func newFileServer() (*filetransfer.Service, error) {
+	conf := config.GCONF
+	fc := filetransfer.Config{
+		Dir:       conf.FileServerDir,
+		HTTPURL:   conf.FileServerURL,
+		FTPURL:    conf.FileServerFTPURL,
+		Scheme:    conf.FileServerScheme,
+		Secret:    conf.FileServerSecret,
+		TTL:       time.Duration(conf.FileServerTTL) * time.Second,
+		MaxUpload: int64(conf.FileServerMaxFile) << 20,
+		Quota:     int64(conf.FileServerQuota) << 20,
+	}
+	if conf.FileServerPorts != "" {
+		lo, hi, _ := strings.Cut(conf.FileServerPorts, "-")
+		var err error
+		if fc.PassiveMin, err = strconv.Atoi(lo); err == nil {
+			fc.PassiveMax, err = strconv.Atoi(hi)
+		}
+		if err != nil || fc.PassiveMin <= 0 || fc.PassiveMax < fc.PassiveMin {
+			return nil, fmt.Errorf("invalid file_server_ftp_passive_ports(%s)", conf.FileServerPorts)
+		}
+	}
+	return filetransfer.NewService(fc)
+}
+
+//bill sends the charge detail records of the closed transactions to plugin, when it takes them
+func bill(transactions *transaction.Manager, plugin ocpp16server.PassivePlugin, lg *log.Logger) error {
+	tariffs, err := billing.LoadTariffs(config.GCONF.BillingTariffFile)
+	if err != nil {
+		return err
+	}
+	receiver, _ := plugin.(billing.Receiver)
+	engine, err := billing.NewEngine(tariffs, receiver, func(err error) {
+		lg.Errorf("send charge detail record, error(%v)", err)
+	})
+	if err != nil {
+		return err
+	}
+	transactions.SetCloseHandler(engine.Handle)
+	return nil
+}
+
+//newEventStream streams the frames to the sinks of event_sinks
+func newEventStream(lg *log.Logger) (*events.Stream, error) {
+	conf := config.GCONF
+	var sinks []events.Sink
+	for _, name := range conf.EventSinks {
+		switch strings.TrimSpace(name) {
+		case "kafka":
+			kafkaConf := events.KafkaConfig{
+				Brokers:  conf.EventKafkaBrokers,
+				Topic:    conf.EventKafkaTopic,
+				Acks:     int16(conf.EventKafkaAcks),
+				Retries:  conf.EventKafkaRetries,
+				SASL:     conf.EventKafkaSASL,
+				Username: conf.EventKafkaUser,
+				Password: conf.EventKafkaPass,
+			}
+			if conf.EventKafkaTLS {
+				tlsConf, err := clientTLS(conf.EventKafkaCA, "", "")
+				if err != nil {
+					return nil, err
+				}
+				kafkaConf.TLS = tlsConf
+			}
+			sink, err := events.NewKafkaSink(kafkaConf)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case "file":
+			sinks = append(sinks, events.NewFileSink(conf.EventFileDir, conf.EventFileMaxNum, conf.EventFileMaxDisk))
+		default:
+			return nil, fmt.Errorf("not support event sink(%s) current", name)
+		}
+	}
+	return events.NewStream(events.Options{
+		Node:          conf.NodeAddr,
+		BufferSize:    conf.EventBufferSize,
+		BatchSize:     conf.EventBatchSize,
+		FlushInterval: time.Duration(conf.EventFlushMs) * time.Millisecond,
+		Retries:       conf.EventRetries,
+		OnError: func(err error) {
+			lg.Errorf("event stream, error(%v)", err)
+		},
+	}, sinks...), nil
+}
+
+//clientTLS verifies the server with the certificate authorities of the pem file ca, or of the system if empty, and
+//presents the client certificate of cert and key if set
+func clientTLS(ca string, cert string, key string) (*tls.Config, error) {
+	conf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if ca != "" {
+		data, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, err
+		}
+		conf.RootCAs = x509.NewCertPool()
+		if !conf.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate found in %s", ca)
+		}
+	}
+	if cert != "" {
+		certificate, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, err
+		}
+		conf.Certificates = []tls.Certificate{certificate}
+	}
+	return conf, nil
+}
+
+//connectMQTT connects to mqtt_broker
+func connectMQTT(lg *log.Logger) (*mqtt.Client, error) {
+	conf := config.GCONF
+	clientID := conf.MQTTClientID
+	if clientID == "" {
+		clientID = "ocpp16"
+	}
+	opts := mqtt.Options{
+		Addr:         conf.MQTTBroker,
+		ClientID:     clientID,
+		Username:     conf.MQTTUsername,
+		Password:     conf.MQTTPassword,
+		CleanSession: conf.MQTTCleanSession,
+		OnConnectionLost: func(err error) {
+			lg.Errorf("mqtt connection to %s lost, error(%v)", conf.MQTTBroker, err)
+		},
+		OnReconnect: func() {
+			lg.Infof("mqtt reconnected to %s", conf.MQTTBroker)
+		},
+	}
+	if conf.MQTTTLS {
+		tlsConf, err := clientTLS(conf.MQTTCA, conf.MQTTCert, conf.MQTTKey)
+		if err != nil {
+			return nil, err
+		}
+		opts.TLS = tlsConf
+	}
+	client, err := mqtt.Connect(opts)
+	if err != nil {
+		return nil, fmt.Errorf("connect mqtt broker(%s), %w", conf.MQTTBroker, err)
+	}
+	return client, nil
+}
+
+func simulate(c *cli.Context) error {
+	lg := log.New()
+	lg.SetFormatter(&log.TextFormatter{TimestampFormat: time.RFC3339, FullTimestamp: true})
+	lv, err := log.ParseLevel(c.String("log-level"))
+	if err != nil {
+		lv = log.InfoLevel
+	}
+	lg.SetLevel(lv)
+	simulator.SetLogger(lg)
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return simulator.Run(ctx, simulator.Config{
+		URL:            c.String("url"),
+		Count:          c.Int("count"),
+		Connectors:     c.Int("connectors"),
+		IDPrefix:       c.String("prefix"),
+		Password:       c.String("password"),
+		IdTag:          c.String("idtag"),
+		AutoCharge:     c.Bool("auto"),
+		Idle:           c.Duration("idle"),
+		ChargeDuration: c.Duration("duration"),
+		MeterInterval:  c.Duration("meter-interval"),
+		Power:          c.Float64("power"),
+	})
+}
+
+func conformanceTest(c *cli.Context) error {
+	cases := conformance.Builtin()
+	if dir := c.String("cases"); dir != "" {
+		var err error
+		if cases, err = conformance.Load(dir); err != nil {
+			return err
+		}
+	}
+	if pattern := c.String("run"); pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		selected := cases[:0]
+		for _, tc := range cases {
+			if re.MatchString(tc.Name) {
+				selected = append(selected, tc)
+			}
+		}
+		cases = selected
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	runner := &conformance.Runner{
+		URL:      c.String("url"),
+		IDPrefix: c.String("prefix"),
+		Password: c.String("password"),
+		Timeout:  c.Duration("timeout"),
+	}
+	if api := c.String("api"); api != "" {
+		runner.Trigger = conformance.RESTTrigger(strings.TrimSuffix(api, "/"), path.Base(strings.TrimSuffix(runner.URL, "/")), c.String("token"))
+	}
+	results := runner.Run(ctx, cases)
+	count := map[string]int{}
+	for _, result := range results {
+		count[result.Status]++
+		fmt.Printf("%-8s %-32s %s %s\n", result.Status, result.Name, result.Duration.Round(time.Millisecond), result.Message)
+	}
+	fmt.Printf("%d passed, %d failed, %d errors, %d skipped\n", count[conformance.StatusPassed], count[conformance.StatusFailed], count[conformance.StatusError], count[conformance.StatusSkipped])
+	if path := c.String("junit"); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err = conformance.WriteJUnit(f, "ocpp1.6", results); err != nil {
+			return err
+		}
+	}
+	if count[conformance.StatusFailed]+count[conformance.StatusError] > 0 {
+		return cli.Exit("conformance test failed", 1)
+	}
+	return nil
+}
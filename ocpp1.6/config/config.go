@@ -21,6 +21,9 @@ type GConf struct {
 	WssPort           int      `label:"wss_port"`
 	TLSCertificate    string   `label:"tls_cert"`
 	TLSCertificateKey string   `label:"tls_key"`
+	TLSClientCA       string   `label:"tls_client_ca"`
+	SecurityProfile   int      `label:"security_profile"` // 0 none, 1 basic auth, 2 basic auth over tls, 3 client certificate
+	AuthFile          string   `label:"auth_file"`
 	HeartbeatTimeout  int      `label:"heartbeat_timeout"`
 	ResponseTimeout   int      `label:"response_timeout"`
 	ETCDList          []string `label:"etcd_list" parse_func:"parse_string_list"`
@@ -33,6 +36,99 @@ type GConf struct {
 	LogLevel          string   `label:"log_level"` // trace, debug, info, warn[ing], error, fatal, panic
 	LogMaxDiskUsage   int64    `label:"log_max_disk_usage" parse_func:"parse_bytes"`
 	LogMaxFileNum     int64    `label:"log_max_file_num" parse_func:"parse_bytes"`
+	NodeAddr          string   `label:"node_addr"`
+	ForwardSecret     string   `label:"forward_secret"`
+	SessionStore      string   `label:"session_store"` // memory, file, etcd
+	SessionStorePath  string   `label:"session_store_path"`
+	SessionStoreTTL   int      `label:"session_store_ttl"`
+	ForwardTLS        bool     `label:"forward_tls" parse_func:"parse_bool"`
+	ForwardCA         string   `label:"forward_tls_ca"`
+	Subprotocols      []string `label:"subprotocols" parse_func:"parse_string_list"` // ocpp2.0.1, ocpp1.6
+	SchemaValidation  bool     `label:"schema_validation" parse_func:"parse_bool"`
+	OCPP201Plugin     string   `label:"ocpp201_passive_plugin"` // local
+	RESTEnable        bool     `label:"rest_enable" parse_func:"parse_bool"`
+	RESTToken         string   `label:"rest_token"`
+	PassivePlugin     string   `label:"passive_plugin"` // rpcx, webhook, mqtt, grpc
+	WebhookURL        string   `label:"webhook_url"`
+	WebhookActionURLs []string `label:"webhook_action_urls" parse_func:"parse_string_list"` // action=url
+	WebhookTimeout    int      `label:"webhook_timeout"`
+	WebhookRetries    int      `label:"webhook_retries"`
+	WebhookSecret     string   `label:"webhook_secret"`
+	WebhookFallback   string   `label:"webhook_fallback"` // callerror, default, none
+	MQTTBroker        string   `label:"mqtt_broker"`
+	MQTTClientID      string   `label:"mqtt_client_id"`
+	MQTTUsername      string   `label:"mqtt_username"`
+	MQTTPassword      string   `label:"mqtt_password"`
+	MQTTTLS           bool     `label:"mqtt_tls" parse_func:"parse_bool"`
+	MQTTCA            string   `label:"mqtt_tls_ca"`
+	MQTTCert          string   `label:"mqtt_tls_cert"`
+	MQTTKey           string   `label:"mqtt_tls_key"`
+	MQTTCleanSession  bool     `label:"mqtt_clean_session" parse_func:"parse_bool"`
+	MQTTTopicPrefix   string   `label:"mqtt_topic_prefix"`
+	MQTTWaitActions   []string `label:"mqtt_wait_actions" parse_func:"parse_string_list"`
+	MQTTReplyTimeout  int      `label:"mqtt_reply_timeout"`
+	MQTTFallback      string   `label:"mqtt_fallback"` // callerror, default, none
+	MQTTActiveEnable  bool     `label:"mqtt_active_enable" parse_func:"parse_bool"`
+	GRPCListen        string   `label:"grpc_listen"`
+	GRPCBackend       string   `label:"grpc_backend"`
+	GRPCTLS           bool     `label:"grpc_backend_tls" parse_func:"parse_bool"`
+	GRPCCA            string   `label:"grpc_backend_tls_ca"`
+	GRPCCert          string   `label:"grpc_backend_tls_cert"`
+	GRPCKey           string   `label:"grpc_backend_tls_key"`
+	GRPCTimeout       int      `label:"grpc_timeout"`
+	GRPCFallback      string   `label:"grpc_fallback"`     // callerror, default, none
+	TransactionStore  string   `label:"transaction_store"` // memory, file
+	TransactionPath   string   `label:"transaction_store_path"`
+	BillingTariffFile string   `label:"billing_tariff_file"`
+	AuthStore         string   `label:"auth_store"` // memory, file
+	AuthPath          string   `label:"auth_store_path"`
+	AuthCacheTTL      int      `label:"auth_cache_ttl"`
+	LocalListEnable   bool     `label:"local_list_enable" parse_func:"parse_bool"`
+	LocalListPath     string   `label:"local_list_path"`
+	LocalListMaxDiff  int      `label:"local_list_max_diff"`
+	SmartCharging     bool     `label:"smart_charging_enable" parse_func:"parse_bool"`
+	SmartChargingPath string   `label:"smart_charging_path"`
+	ChargingVoltage   int      `label:"smart_charging_voltage"`
+	LoadBalancing     bool     `label:"load_balancing_enable" parse_func:"parse_bool"`
+	LoadBalancingPath string   `label:"load_balancing_path"`
+	LoadBalancingUnit string   `label:"load_balancing_unit"`
+	Reservations      bool     `label:"reservation_enable" parse_func:"parse_bool"`
+	ReservationPath   string   `label:"reservation_path"`
+	FirmwareCampaigns bool     `label:"firmware_campaign_enable" parse_func:"parse_bool"`
+	FirmwarePath      string   `label:"firmware_campaign_path"`
+	FileServer        bool     `label:"file_server_enable" parse_func:"parse_bool"`
+	FileServerDir     string   `label:"file_server_dir"`
+	FileServerURL     string   `label:"file_server_http_url"`
+	FileServerFTP     string   `label:"file_server_ftp_listen"`
+	FileServerFTPURL  string   `label:"file_server_ftp_url"`
+	FileServerPorts   string   `label:"file_server_ftp_passive_ports"` // min-max
+	FileServerScheme  string   `label:"file_server_scheme"`            // http, ftp
+	FileServerSecret  string   `label:"file_server_secret"`
+	FileServerTTL     int      `label:"file_server_url_ttl"`
+	FileServerMaxFile int      `label:"file_server_max_upload"` // MiB
+	FileServerQuota   int      `label:"file_server_quota"`      // MiB
+	ConfigManager     bool     `label:"config_manager_enable" parse_func:"parse_bool"`
+	ConfigManagerPath string   `label:"config_manager_path"`
+	ConfigResetDelay  int      `label:"config_manager_reset_delay"`
+	StatusTracker     bool     `label:"status_tracker_enable" parse_func:"parse_bool"`
+	StatusHistory     int      `label:"status_tracker_history"`
+	EventSinks        []string `label:"event_sinks" parse_func:"parse_string_list"` // kafka, file
+	EventKafkaBrokers []string `label:"event_kafka_brokers" parse_func:"parse_string_list"`
+	EventKafkaTopic   string   `label:"event_kafka_topic"`
+	EventKafkaAcks    int      `label:"event_kafka_acks"`
+	EventKafkaRetries int      `label:"event_kafka_retries"`
+	EventKafkaTLS     bool     `label:"event_kafka_tls" parse_func:"parse_bool"`
+	EventKafkaCA      string   `label:"event_kafka_tls_ca"`
+	EventKafkaSASL    string   `label:"event_kafka_sasl"` // PLAIN, SCRAM-SHA-256, SCRAM-SHA-512
+	EventKafkaUser    string   `label:"event_kafka_username"`
+	EventKafkaPass    string   `label:"event_kafka_password"`
+	EventFileDir      string   `label:"event_file_dir"`
+	EventFileMaxDisk  int64    `label:"event_file_max_disk_usage" parse_func:"parse_bytes"`
+	EventFileMaxNum   int64    `label:"event_file_max_num" parse_func:"parse_bytes"`
+	EventBufferSize   int      `label:"event_buffer_size"`
+	EventBatchSize    int      `label:"event_batch_size"`
+	EventFlushMs      int      `label:"event_flush_interval"`
+	EventRetries      int      `label:"event_retries"`
 }
 
 var (
@@ -0,0 +1,94 @@
+package configuration
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the groups and the audit of the configuration on r, e.g. the group of the rest api:
+//
+//	GET    /configuration/groups
+//	GET    /configuration/groups/:group
+//	PUT    /configuration/groups/:group                    the body is the group, chargePoints and keys
+//	DELETE /configuration/groups/:group
+//	GET    /configuration/chargepoints                     the keys of the charging points, actual and desired
+//	GET    /configuration/chargepoints/:id
+//	POST   /configuration/chargepoints/:id/reconcile       reads and reconciles id now
+//	GET    /configuration/drift?chargePointId=             the keys differing from their desired value and why
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/configuration/groups", m.listGroups)
+	r.GET("/configuration/groups/:group", m.getGroup)
+	r.PUT("/configuration/groups/:group", m.putGroup)
+	r.DELETE("/configuration/groups/:group", m.deleteGroup)
+	r.GET("/configuration/chargepoints", m.listChargePoints)
+	r.GET("/configuration/chargepoints/:id", m.getChargePoint)
+	r.POST("/configuration/chargepoints/:id/reconcile", m.reconcile)
+	r.GET("/configuration/drift", m.drift)
+}
+
+func (m *Manager) listGroups(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Groups())
+}
+
+func (m *Manager) getGroup(c *gin.Context) {
+	g, ok := m.Group(c.Param("group"))
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("group(%s) not found", c.Param("group"))})
+		return
+	}
+	c.JSON(http.StatusOK, g)
+}
+
+func (m *Manager) putGroup(c *gin.Context) {
+	var g Group
+	if err := c.ShouldBindJSON(&g); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	g.ID = c.Param("group")
+	if err := m.PutGroup(g); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, g)
+}
+
+func (m *Manager) deleteGroup(c *gin.Context) {
+	if err := m.DeleteGroup(c.Param("group")); err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (m *Manager) listChargePoints(c *gin.Context) {
+	c.JSON(http.StatusOK, m.ChargePoints())
+}
+
+func (m *Manager) getChargePoint(c *gin.Context) {
+	cp, ok := m.ChargePoint(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("charge point(%s) not found", c.Param("id"))})
+		return
+	}
+	c.JSON(http.StatusOK, cp)
+}
+
+func (m *Manager) reconcile(c *gin.Context) {
+	if err := m.Reconcile(c.Request.Context(), c.Param("id")); err != nil {
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+		return
+	}
+	cp, _ := m.ChargePoint(c.Param("id"))
+	c.JSON(http.StatusOK, cp)
+}
+
+func (m *Manager) drift(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Drift(c.Query("chargePointId")))
+}
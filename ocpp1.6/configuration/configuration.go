@@ -0,0 +1,230 @@
+//Package configuration keeps the configuration of the charging points at a desired state: the desired keys are set per
+//group of charging points, the actual configuration is read with GetConfiguration after every BootNotification and
+//the differences are applied with ChangeConfiguration. what a charging point refused is recorded for the audit of the
+//drift across the fleet
+package configuration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+//the ChangeConfiguration statuses, unexported in protocol, and the reasons of a drift
+const (
+	Accepted       = "Accepted"
+	Rejected       = "Rejected"
+	RebootRequired = "RebootRequired"
+	NotSupported   = "NotSupported"
+	Unknown        = "Unknown"  //reported in the unknownKey of GetConfiguration
+	Readonly       = "Readonly" //reported readonly by GetConfiguration
+	Pending        = "Pending"  //not applied yet
+)
+
+//Group is the desired configuration of a set of charging points
+type Group struct {
+	ID           string            `json:"id"`
+	ChargePoints []string          `json:"chargePoints,omitempty"` //the default group of all the charging points if empty
+	Keys         map[string]string `json:"keys"`
+}
+
+func (g *Group) validate() error {
+	if g.ID == "" {
+		return errors.New("group id missing")
+	}
+	for key, value := range g.Keys {
+		if key == "" || len(key) > 50 || len(value) > 500 {
+			return fmt.Errorf("invalid key(%s), at most 50 characters and its value 500", key)
+		}
+	}
+	return nil
+}
+
+func (g *Group) member(id string) bool {
+	for _, cp := range g.ChargePoints {
+		if cp == id {
+			return true
+		}
+	}
+	return false
+}
+
+//Key is a configuration key of a charging point
+type Key struct {
+	Key       string     `json:"key"`
+	Value     *string    `json:"value,omitempty"` //last read or accepted, nil if never reported
+	Readonly  bool       `json:"readonly,omitempty"`
+	Unknown   bool       `json:"unknown,omitempty"`
+	Desired   *string    `json:"desired,omitempty"`
+	Sent      *string    `json:"sent,omitempty"`   //the value of the last ChangeConfiguration
+	Status    string     `json:"status,omitempty"` //its answer
+	ChangedAt *time.Time `json:"changedAt,omitempty"`
+}
+
+//ChargePoint is the configuration of a charging point as last read and changed
+type ChargePoint struct {
+	ID           string          `json:"chargePointId"`
+	Group        string          `json:"group,omitempty"`
+	Keys         map[string]*Key `json:"keys"`
+	ReadAt       *time.Time      `json:"readAt,omitempty"`       //of the last GetConfiguration
+	ReconciledAt *time.Time      `json:"reconciledAt,omitempty"` //the last time the differences were applied
+	ResetPending bool            `json:"resetPending,omitempty"` //a key needs a Reset to take effect
+	ResetAt      *time.Time      `json:"resetAt,omitempty"`      //of the last Reset accepted
+	Error        string          `json:"error,omitempty"`        //of the last reconciliation
+}
+
+func (cp *ChargePoint) key(name string) *Key {
+	k, ok := cp.Keys[name]
+	if !ok {
+		k = &Key{Key: name}
+		cp.Keys[name] = k
+	}
+	return k
+}
+
+//clone copies cp with the desired values
+func (cp *ChargePoint) clone(desired map[string]string) *ChargePoint {
+	clone := *cp
+	clone.Keys = make(map[string]*Key, len(cp.Keys))
+	for name, k := range cp.Keys {
+		key := *k
+		clone.Keys[name] = &key
+	}
+	for name, value := range desired {
+		v := value
+		clone.key(name).Desired = &v
+	}
+	return &clone
+}
+
+//equal compares two values, the lists of values with or without spaces after the commas and the booleans in any case
+func equal(a string, b string) bool {
+	normalize := func(s string) string {
+		parts := strings.Split(s, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return strings.Join(parts, ",")
+	}
+	return strings.EqualFold(normalize(a), normalize(b))
+}
+
+//drift returns why the key differs from its desired value, an empty string if it does not
+func (k *Key) drift(desired string) string {
+	switch {
+	case k.Value != nil && equal(*k.Value, desired):
+		return ""
+	case k.Unknown:
+		return Unknown
+	case k.Readonly:
+		return Readonly
+	case k.Sent != nil && *k.Sent == desired && (k.Status == Rejected || k.Status == NotSupported || k.Status == RebootRequired):
+		return k.Status
+	}
+	return Pending
+}
+
+//Drift is a key of a charging point differing from its desired value
+type Drift struct {
+	ChargePoint string  `json:"chargePointId"`
+	Key         string  `json:"key"`
+	Value       *string `json:"value,omitempty"`
+	Desired     string  `json:"desired"`
+	Reason      string  `json:"reason"` //Rejected, RebootRequired, NotSupported, Unknown, Readonly or Pending
+}
+
+//state is what the manager saves
+type state struct {
+	Groups       []*Group                `json:"groups"`
+	ChargePoints map[string]*ChargePoint `json:"chargePoints"`
+}
+
+//desired returns the group of id and its desired keys, the keys of the default group overridden by the ones of the
+//group listing id
+func (s *state) desired(id string) (string, map[string]string) {
+	var def, group *Group
+	for _, g := range s.Groups {
+		if len(g.ChargePoints) == 0 {
+			def = g
+		} else if g.member(id) {
+			group = g
+		}
+	}
+	desired := make(map[string]string)
+	name := ""
+	for _, g := range []*Group{def, group} {
+		if g == nil {
+			continue
+		}
+		name = g.ID
+		for key, value := range g.Keys {
+			desired[key] = value
+		}
+	}
+	return name, desired
+}
+
+//put replaces or adds g, a charging point is listed by one group at most and there is one default group at most
+func (s *state) put(g *Group) error {
+	groups := make([]*Group, 0, len(s.Groups)+1)
+	for _, other := range s.Groups {
+		if other.ID == g.ID {
+			continue
+		}
+		if len(g.ChargePoints) == 0 && len(other.ChargePoints) == 0 {
+			return fmt.Errorf("group(%s) is the default group already", other.ID)
+		}
+		for _, id := range g.ChargePoints {
+			if other.member(id) {
+				return fmt.Errorf("charge point(%s) is in the group(%s) already", id, other.ID)
+			}
+		}
+		groups = append(groups, other)
+	}
+	groups = append(groups, g)
+	sort.Slice(groups, func(i, j int) bool { return groups[i].ID < groups[j].ID })
+	s.Groups = groups
+	return nil
+}
+
+func load(path string) (*state, error) {
+	s := &state{ChargePoints: make(map[string]*ChargePoint)}
+	if path == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("configuration file(%s) corrupted, err(%v)", path, err)
+	}
+	if s.ChargePoints == nil {
+		s.ChargePoints = make(map[string]*ChargePoint)
+	}
+	return s, nil
+}
+
+//save writes the state to path, nothing if path is empty
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
@@ -0,0 +1,215 @@
+package configuration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//chargePoint reports its first 3 keys only unless asked for, needs a reboot for the keys of reboot and rejects
+//the values of rejected
+type chargePoint struct {
+	mu       sync.Mutex
+	keys     []string
+	values   map[string]string
+	readonly map[string]bool
+	reboot   map[string]bool
+	rejected map[string]bool
+	changes  []string
+	resets   int
+}
+
+func newChargePoint() *chargePoint {
+	return &chargePoint{
+		keys:     []string{"HeartbeatInterval", "MeterValuesSampledData", "NumberOfConnectors", "WebSocketPingInterval", "LocalPreAuthorize"},
+		values:   map[string]string{"HeartbeatInterval": "300", "MeterValuesSampledData": "Energy.Active.Import.Register", "NumberOfConnectors": "2", "WebSocketPingInterval": "0", "LocalPreAuthorize": "false"},
+		readonly: map[string]bool{"NumberOfConnectors": true},
+		reboot:   map[string]bool{"WebSocketPingInterval": true},
+		rejected: map[string]bool{"LocalPreAuthorize": true},
+	}
+}
+
+func (cp *chargePoint) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	switch req := call.Request.(type) {
+	case protocol.GetConfigurationRequest:
+		res := &protocol.GetConfigurationResponse{}
+		keys := req.Key
+		if len(keys) == 0 {
+			keys = cp.keys[:3]
+		}
+		for _, key := range keys {
+			value, ok := cp.values[key]
+			if !ok {
+				res.UnknownKey = append(res.UnknownKey, key)
+				continue
+			}
+			readonly := cp.readonly[key]
+			res.ConfigurationKey = append(res.ConfigurationKey, protocol.ConfigurationKey{Key: key, Value: value, Readonly: &readonly})
+		}
+		return res, nil, nil
+	case protocol.ChangeConfigurationRequest:
+		cp.changes = append(cp.changes, req.Key)
+		var status protocol.ConfigurationStatus
+		switch _, ok := cp.values[req.Key]; {
+		case !ok:
+			status = NotSupported
+		case cp.readonly[req.Key] || cp.rejected[req.Key]:
+			status = Rejected
+		case cp.reboot[req.Key]:
+			status = RebootRequired
+		default:
+			status, cp.values[req.Key] = Accepted, req.Value
+		}
+		return &protocol.ChangeConfigurationResponse{Status: status}, nil, nil
+	case protocol.ResetRequest:
+		cp.resets++
+		return &protocol.ResetResponse{Status: "Accepted"}, nil, nil
+	}
+	return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+}
+
+func (cp *chargePoint) take() ([]string, int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	changes, resets := cp.changes, cp.resets
+	cp.changes, cp.resets = nil, 0
+	return changes, resets
+}
+
+//accepting accepts the BootNotifications the local plugin answers with nothing
+type accepting struct {
+	*local.LocalActionPlugin
+}
+
+func (a accepting) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	if action == protocol.BootNotificationName {
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			return &protocol.BootNotificationResponse{Status: "Accepted", CurrentTime: time.Now().UTC().Format(time.RFC3339), Interval: new(int)}, nil
+		}, true
+	}
+	return a.LocalActionPlugin.RequestHandler(action)
+}
+
+func reasons(drift []Drift) string {
+	var s []string
+	for _, d := range drift {
+		s = append(s, d.Key+":"+d.Reason)
+	}
+	return strings.Join(s, ",")
+}
+
+func TestReconcile(t *testing.T) {
+	cp := newChargePoint()
+	path := filepath.Join(t.TempDir(), "configuration.json")
+	m, err := NewManager(cp, Config{Path: path, Delay: time.Millisecond, ResetDelay: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := m.Wrap(accepting{local.NewActionPlugin()})
+	handle := func(request protocol.Request) {
+		handler, _ := plugin.RequestHandler(request.Action())
+		if _, err := handler(context.Background(), "CP001", protocol.NewUniqueID(), request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = m.PutGroup(Group{ID: "fleet", Keys: map[string]string{"HeartbeatInterval": "600", "MeterValuesSampledData": "Energy.Active.Import.Register"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.PutGroup(Group{ID: "ac", Keys: map[string]string{"HeartbeatInterval": "120"}}); err == nil {
+		t.Fatal("second default group accepted")
+	}
+	if err = m.PutGroup(Group{ID: "ac", ChargePoints: []string{"CP001"}, Keys: map[string]string{
+		"HeartbeatInterval":     "120",
+		"NumberOfConnectors":    "3",
+		"WebSocketPingInterval": "60",
+		"LocalPreAuthorize":     "true",
+		"ConnectionTimeOut":     "90",
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	plugin.ChargingPointOnline("CP001")
+	handle(&protocol.BootNotificationRequest{ChargePointVendor: "Tsinglink", ChargePointModel: "AC7"})
+	time.Sleep(10 * time.Millisecond)
+	if changes, _ := cp.take(); strings.Join(changes, ",") != "HeartbeatInterval,LocalPreAuthorize,WebSocketPingInterval" {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+	if drift := reasons(m.Drift("")); drift != "ConnectionTimeOut:Unknown,LocalPreAuthorize:Rejected,NumberOfConnectors:Readonly,WebSocketPingInterval:RebootRequired" {
+		t.Fatalf("unexpected drift %s", drift)
+	}
+
+	//no Reset while a transaction runs
+	handle(&protocol.StartTransactionRequest{ConnectorId: new(int), IdTag: "tag", MeterStart: new(int), Timestamp: time.Now().UTC().Format(time.RFC3339)})
+	time.Sleep(50 * time.Millisecond)
+	if _, resets := cp.take(); resets != 0 {
+		t.Fatal("reset during a transaction")
+	}
+	handle(&protocol.StopTransactionRequest{MeterStop: new(int), TransactionId: new(int), Timestamp: time.Now().UTC().Format(time.RFC3339)})
+	time.Sleep(50 * time.Millisecond)
+	if _, resets := cp.take(); resets != 1 {
+		t.Fatalf("%d resets after the transaction", resets)
+	}
+
+	//the value needing the reboot is applied by it, the refused ones are not sent again
+	cp.mu.Lock()
+	cp.values["WebSocketPingInterval"] = "60"
+	cp.mu.Unlock()
+	handle(&protocol.BootNotificationRequest{ChargePointVendor: "Tsinglink", ChargePointModel: "AC7"})
+	time.Sleep(10 * time.Millisecond)
+	if changes, resets := cp.take(); len(changes) != 0 || resets != 0 {
+		t.Fatalf("unexpected changes %v and %d resets", changes, resets)
+	}
+	if drift := reasons(m.Drift("CP001")); drift != "ConnectionTimeOut:Unknown,LocalPreAuthorize:Rejected,NumberOfConnectors:Readonly" {
+		t.Fatalf("unexpected drift %s", drift)
+	}
+
+	//a new desired value is applied at once, the state survives a restart
+	if err = m.PutGroup(Group{ID: "fleet", Keys: map[string]string{"MeterValuesSampledData": "Energy.Active.Import.Register, Power.Active.Import"}}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if changes, _ := cp.take(); strings.Join(changes, ",") != "MeterValuesSampledData" {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+	if m, err = NewManager(cp, Config{Path: path}); err != nil {
+		t.Fatal(err)
+	}
+	c, ok := m.ChargePoint("CP001")
+	if !ok || c.Group != "ac" || *c.Keys["MeterValuesSampledData"].Value != "Energy.Active.Import.Register, Power.Active.Import" || !c.Keys["NumberOfConnectors"].Readonly {
+		t.Fatalf("unexpected configuration %+v", c)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	m, _ := NewManager(newChargePoint(), Config{})
+	m.RegisterAPI(engine)
+	do := func(method string, url string, body string) (int, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w.Code, w.Body.String()
+	}
+	if code, body := do(http.MethodPut, "/configuration/groups/fleet", `{"keys":{"HeartbeatInterval":"600"}}`); code != http.StatusOK {
+		t.Fatalf("put got %d %s", code, body)
+	}
+	if code, body := do(http.MethodPost, "/configuration/chargepoints/CP001/reconcile", ""); code != http.StatusOK || !strings.Contains(body, `"desired":"600"`) {
+		t.Fatalf("reconcile got %d %s", code, body)
+	}
+	if code, body := do(http.MethodGet, "/configuration/drift", ""); code != http.StatusOK || body != `[]` {
+		t.Fatalf("drift got %d %s", code, body)
+	}
+	if code, body := do(http.MethodDelete, "/configuration/groups/other", ""); code != http.StatusNotFound {
+		t.Fatalf("delete got %d %s", code, body)
+	}
+}
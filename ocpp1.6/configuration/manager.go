@@ -0,0 +1,489 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+//Server is the part of *ocpp16server.Server the manager needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Config struct {
+	Path       string             //json file of the groups and of the configuration of the charging points, in memory only if empty
+	Timeout    time.Duration      //of a call to a charging point, 30s if 0
+	Delay      time.Duration      //from the BootNotification of a charging point to its reconciliation, 2s if 0
+	ResetDelay time.Duration      //from a RebootRequired to the Reset, delayed while a transaction runs, 1m if 0
+	ResetType  protocol.ResetType //Soft if empty
+}
+
+type point struct {
+	online       bool
+	transactions int
+	reconciling  bool
+	pending      bool
+	reset        *time.Timer
+}
+
+type Manager struct {
+	server  Server
+	conf    Config
+	mu      sync.Mutex
+	state   *state
+	points  map[string]*point
+	now     func() time.Time
+	onError func(id string, err error)
+}
+
+//NewManager loads the groups and the configuration of the charging points of conf.Path
+func NewManager(s Server, conf Config) (*Manager, error) {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 30 * time.Second
+	}
+	if conf.Delay <= 0 {
+		conf.Delay = 2 * time.Second
+	}
+	if conf.ResetDelay <= 0 {
+		conf.ResetDelay = time.Minute
+	}
+	if conf.ResetType == "" {
+		conf.ResetType = "Soft"
+	}
+	st, err := load(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{server: s, conf: conf, state: st, points: make(map[string]*point), now: time.Now}, nil
+}
+
+//SetErrorHandler gets the errors of the reconciliations and the resets run in the background and of saving the state
+func (m *Manager) SetErrorHandler(fn func(id string, err error)) {
+	m.onError = fn
+}
+
+func (m *Manager) handle(id string, err error) {
+	if err != nil && m.onError != nil {
+		m.onError(id, err)
+	}
+}
+
+func (m *Manager) point(id string) *point {
+	p, ok := m.points[id]
+	if !ok {
+		p = &point{}
+		m.points[id] = p
+	}
+	return p
+}
+
+func (m *Manager) chargePoint(id string) *ChargePoint {
+	cp, ok := m.state.ChargePoints[id]
+	if !ok {
+		cp = &ChargePoint{ID: id, Keys: make(map[string]*Key)}
+		m.state.ChargePoints[id] = cp
+	}
+	return cp
+}
+
+//save writes the state, m.mu is held
+func (m *Manager) save(id string) {
+	m.handle(id, m.state.save(m.conf.Path))
+}
+
+//Groups returns the groups by id
+func (m *Manager) Groups() []Group {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	groups := make([]Group, 0, len(m.state.Groups))
+	for _, g := range m.state.Groups {
+		groups = append(groups, *g)
+	}
+	return groups
+}
+
+//Group returns the group id
+func (m *Manager) Group(id string) (Group, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, g := range m.state.Groups {
+		if g.ID == id {
+			return *g, true
+		}
+	}
+	return Group{}, false
+}
+
+//PutGroup replaces or adds g and reconciles the online charging points whose desired keys change
+func (m *Manager) PutGroup(g Group) error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	before := m.desired()
+	if err := m.state.put(&g); err != nil {
+		return err
+	}
+	m.save(g.ID)
+	m.changed(before)
+	return nil
+}
+
+//DeleteGroup removes the group id, the charging points keep their configuration
+func (m *Manager) DeleteGroup(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	before := m.desired()
+	for i, g := range m.state.Groups {
+		if g.ID == id {
+			m.state.Groups = append(m.state.Groups[:i], m.state.Groups[i+1:]...)
+			m.save(id)
+			m.changed(before)
+			return nil
+		}
+	}
+	return fmt.Errorf("group(%s) not found", id)
+}
+
+//desired returns the desired keys of the online charging points, m.mu is held
+func (m *Manager) desired() map[string]map[string]string {
+	desired := make(map[string]map[string]string)
+	for id, p := range m.points {
+		if p.online {
+			_, desired[id] = m.state.desired(id)
+		}
+	}
+	return desired
+}
+
+//changed reconciles the online charging points whose desired keys differ from before, m.mu is held
+func (m *Manager) changed(before map[string]map[string]string) {
+	for id, desired := range m.desired() {
+		if !reflect.DeepEqual(before[id], desired) {
+			m.trigger(id)
+		}
+	}
+}
+
+//ChargePoints returns the configuration of the charging points by id, with the desired values
+func (m *Manager) ChargePoints() []*ChargePoint {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cps := make([]*ChargePoint, 0, len(m.state.ChargePoints))
+	for id, cp := range m.state.ChargePoints {
+		_, desired := m.state.desired(id)
+		cps = append(cps, cp.clone(desired))
+	}
+	sort.Slice(cps, func(i, j int) bool { return cps[i].ID < cps[j].ID })
+	return cps
+}
+
+//ChargePoint returns the configuration of id, with the desired values
+func (m *Manager) ChargePoint(id string) (*ChargePoint, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.state.ChargePoints[id]
+	if !ok {
+		return nil, false
+	}
+	_, desired := m.state.desired(id)
+	return cp.clone(desired), true
+}
+
+//Drift returns the keys differing from their desired value, of id or of all the charging points read once if id is empty
+func (m *Manager) Drift(id string) []Drift {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	drift := []Drift{}
+	for cpID, cp := range m.state.ChargePoints {
+		if id != "" && cpID != id {
+			continue
+		}
+		_, desired := m.state.desired(cpID)
+		for name, value := range desired {
+			k, ok := cp.Keys[name]
+			if !ok {
+				k = &Key{Key: name}
+			}
+			if reason := k.drift(value); reason != "" {
+				drift = append(drift, Drift{ChargePoint: cpID, Key: name, Value: k.Value, Desired: value, Reason: reason})
+			}
+		}
+	}
+	sort.Slice(drift, func(i, j int) bool {
+		if drift[i].ChargePoint != drift[j].ChargePoint {
+			return drift[i].ChargePoint < drift[j].ChargePoint
+		}
+		return drift[i].Key < drift[j].Key
+	})
+	return drift
+}
+
+//read records the keys of GetConfiguration, all of them when keys is empty, and returns the keys reported
+func (m *Manager) read(ctx context.Context, id string, keys []string) (map[string]bool, error) {
+	res, err := ocpp16server.Send(ctx, m.server, id, protocol.GetConfigurationRequest{Key: keys}, m.conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.(*protocol.GetConfigurationResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected GetConfiguration reply %+v", res)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := m.chargePoint(id)
+	reported := make(map[string]bool)
+	for _, ck := range reply.ConfigurationKey {
+		k := cp.key(ck.Key)
+		value := ck.Value
+		k.Value, k.Readonly, k.Unknown = &value, ck.Readonly != nil && *ck.Readonly, false
+		reported[ck.Key] = true
+	}
+	for _, name := range reply.UnknownKey {
+		k := cp.key(name)
+		k.Value, k.Readonly, k.Unknown = nil, false, true
+		reported[name] = true
+	}
+	now := m.now()
+	cp.ReadAt = &now
+	return reported, nil
+}
+
+//Reconcile reads the configuration of id, then changes the keys differing from their desired value. a key unknown,
+//readonly, or refused with the same value before is left as it is, so a charging point is never rebooted in a loop.
+//a RebootRequired schedules a Reset
+func (m *Manager) Reconcile(ctx context.Context, id string) (err error) {
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		cp := m.chargePoint(id)
+		cp.Error = ""
+		if err != nil {
+			cp.Error = err.Error()
+		}
+		m.save(id)
+	}()
+	reported, err := m.read(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	//a charging point may return a part of its keys only, the desired ones are asked for explicitly
+	m.mu.Lock()
+	_, desired := m.state.desired(id)
+	var missing []string
+	for name := range desired {
+		if !reported[name] {
+			missing = append(missing, name)
+		}
+	}
+	m.mu.Unlock()
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		if _, err = m.read(ctx, id, missing); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	group, desired := m.state.desired(id)
+	cp := m.chargePoint(id)
+	cp.Group = group
+	var changes []string
+	for name, value := range desired {
+		if cp.key(name).drift(value) == Pending {
+			changes = append(changes, name)
+		}
+	}
+	m.mu.Unlock()
+	sort.Strings(changes)
+	reboot := false
+	for _, name := range changes {
+		value := desired[name]
+		res, err := ocpp16server.Send(ctx, m.server, id, protocol.ChangeConfigurationRequest{Key: name, Value: value}, m.conf.Timeout)
+		if err != nil {
+			return err
+		}
+		reply, ok := res.(*protocol.ChangeConfigurationResponse)
+		if !ok {
+			return fmt.Errorf("unexpected ChangeConfiguration reply %+v", res)
+		}
+		m.mu.Lock()
+		k, now := cp.key(name), m.now()
+		k.Sent, k.Status, k.ChangedAt = &value, string(reply.Status), &now
+		switch k.Status {
+		case Accepted:
+			k.Value = &value
+		case RebootRequired:
+			reboot = true
+		}
+		m.mu.Unlock()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	cp.ReconciledAt = &now
+	if reboot {
+		cp.ResetPending = true
+		m.schedule(id)
+	}
+	return nil
+}
+
+//trigger reconciles id in the background, once more after the running reconciliation if there is one, m.mu is held
+func (m *Manager) trigger(id string) {
+	p := m.point(id)
+	if p.reconciling {
+		p.pending = true
+		return
+	}
+	p.reconciling = true
+	go func() {
+		for {
+			m.handle(id, m.Reconcile(context.Background(), id))
+			m.mu.Lock()
+			if !p.pending || !p.online {
+				p.reconciling, p.pending = false, false
+				m.mu.Unlock()
+				return
+			}
+			p.pending = false
+			m.mu.Unlock()
+		}
+	}()
+}
+
+//schedule resets id after the reset delay, m.mu is held
+func (m *Manager) schedule(id string) {
+	p := m.point(id)
+	if p.reset != nil {
+		p.reset.Stop()
+	}
+	p.reset = time.AfterFunc(m.conf.ResetDelay, func() { m.reset(id) })
+}
+
+//reset sends the Reset of a RebootRequired, later while a transaction runs, when id is back online if it is offline
+func (m *Manager) reset(id string) {
+	m.mu.Lock()
+	p, cp := m.point(id), m.chargePoint(id)
+	p.reset = nil
+	switch {
+	case !cp.ResetPending || !p.online:
+		m.mu.Unlock()
+		return
+	case p.transactions > 0:
+		m.schedule(id)
+		m.mu.Unlock()
+		return
+	}
+	m.mu.Unlock()
+	res, err := ocpp16server.Send(context.Background(), m.server, id, protocol.ResetRequest{Type: m.conf.ResetType}, m.conf.Timeout)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		if reply, ok := res.(*protocol.ResetResponse); !ok || reply.Status != "Accepted" {
+			err = fmt.Errorf("%s Reset not accepted, %+v", m.conf.ResetType, res)
+		}
+	}
+	if err != nil {
+		cp.Error = err.Error()
+		m.save(id)
+		m.handle(id, err)
+		return
+	}
+	now := m.now()
+	cp.ResetPending, cp.ResetAt = false, &now
+	m.save(id)
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin reconciling the charging points that boot, register it on the server in place of plugin.
+//it counts the transactions so no Reset interrupts one
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+func (p *managedPlugin) ChargingPointOnline(id string) error {
+	p.m.mu.Lock()
+	pt := p.m.point(id)
+	pt.online = true
+	if cp, ok := p.m.state.ChargePoints[id]; ok && cp.ResetPending && pt.reset == nil {
+		p.m.schedule(id)
+	}
+	p.m.mu.Unlock()
+	return p.PassivePlugin.ChargingPointOnline(id)
+}
+
+func (p *managedPlugin) ChargingPointOffline(id string) error {
+	p.m.mu.Lock()
+	p.m.point(id).online = false
+	p.m.mu.Unlock()
+	return p.PassivePlugin.ChargingPointOffline(id)
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return handler, ok
+	}
+	switch action {
+	case protocol.BootNotificationName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			res, err := handler(ctx, id, uniqueid, request)
+			if boot, ok := res.(*protocol.BootNotificationResponse); ok && err == nil && boot.Status == "Accepted" {
+				p.m.booted(id)
+			}
+			return res, err
+		}, true
+	case protocol.StartTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			p.m.mu.Lock()
+			p.m.point(id).transactions++
+			p.m.mu.Unlock()
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	case protocol.StopTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			p.m.mu.Lock()
+			if pt := p.m.point(id); pt.transactions > 0 {
+				pt.transactions--
+			}
+			p.m.mu.Unlock()
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	}
+	return handler, ok
+}
+
+//booted forgets the transactions and the pending Reset of id, which rebooted, then reconciles it after the delay,
+//the reply to its BootNotification goes out first
+func (m *Manager) booted(id string) {
+	m.mu.Lock()
+	p := m.point(id)
+	p.transactions = 0
+	if p.reset != nil {
+		p.reset.Stop()
+		p.reset = nil
+	}
+	if cp, ok := m.state.ChargePoints[id]; ok {
+		cp.ResetPending = false
+	}
+	m.mu.Unlock()
+	time.AfterFunc(m.conf.Delay, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.point(id).online {
+			m.trigger(id)
+		}
+	})
+}
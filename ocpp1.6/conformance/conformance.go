@@ -0,0 +1,216 @@
+//Package conformance runs scripted ocpp1.6J test cases against a central system, the runner plays the charging point.
+//
+//A test case is a text file, one step per line, lines starting with # are comments:
+//
+//	send <Action> <payload>        send a call to the central system
+//	expect result                  wait for the CallResult of the last call, the payload must match the response schema
+//	expect error [<ErrorCode>...]  wait for the CallError of the last call, with one of the error codes if any
+//	expect call <Action>           wait for a call of the central system, the payload must match the request schema
+//	reply <payload>                answer the last call of the central system
+//	reply error <ErrorCode> [desc] answer the last call of the central system with a CallError
+//	trigger <Action> <payload>     ask the central system to send a call through Runner.Trigger
+//	assert <path> <op> [value...]  check a field of the last payload received, ops: == != in exists absent int string datetime
+//	save <path> <name>             keep a field of the last payload received as ${name}
+//	wait <duration>                sleep
+//
+//${now} and ${id} expand to the current time and the charging point id, paths are dotted, e.g. idTagInfo.status or configurationKey.0.key
+package conformance
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:embed testcases/*.txt
+var testcases embed.FS
+
+const (
+	opSend    = "send"
+	opExpect  = "expect"
+	opReply   = "reply"
+	opTrigger = "trigger"
+	opAssert  = "assert"
+	opSave    = "save"
+	opWait    = "wait"
+)
+
+//Step is one line of a test case
+type Step struct {
+	Line int
+	Op   string
+	Args []string //send, trigger: action and payload; reply: payload or error, code and description; others: the words
+}
+
+func (s Step) String() string {
+	return fmt.Sprintf("line %d: %s %s", s.Line, s.Op, strings.Join(s.Args, " "))
+}
+
+//TestCase is a scripted scenario, it runs on its own connection
+type TestCase struct {
+	Name        string
+	Description string //leading comment lines
+	Steps       []Step
+}
+
+//needsTrigger reports whether the test case asks the central system to send calls
+func (tc *TestCase) needsTrigger() bool {
+	for _, step := range tc.Steps {
+		if step.Op == opTrigger {
+			return true
+		}
+	}
+	return false
+}
+
+//Parse reads a test case
+func Parse(name string, r io.Reader) (*TestCase, error) {
+	tc := &TestCase{Name: name}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var description []string
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "#") {
+			if len(tc.Steps) == 0 {
+				description = append(description, strings.TrimSpace(strings.TrimPrefix(text, "#")))
+			}
+			continue
+		}
+		step, err := parseStep(line, text)
+		if err != nil {
+			return nil, fmt.Errorf("%s %v", name, err)
+		}
+		tc.Steps = append(tc.Steps, step)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	tc.Description = strings.Join(description, " ")
+	return tc, nil
+}
+
+func parseStep(line int, text string) (Step, error) {
+	op, rest := cut(text)
+	step := Step{Line: line, Op: op}
+	switch op {
+	case opSend, opTrigger:
+		action, payload := cut(rest)
+		if action == "" || payload == "" {
+			return step, fmt.Errorf("line %d: %s needs an action and a payload", line, op)
+		}
+		step.Args = []string{action, payload}
+	case opReply:
+		if rest == "" {
+			return step, fmt.Errorf("line %d: reply needs a payload", line)
+		}
+		if word, desc := cut(rest); word == "error" {
+			code, desc := cut(desc)
+			if code == "" {
+				return step, fmt.Errorf("line %d: reply error needs an error code", line)
+			}
+			step.Args = []string{"error", code, desc}
+		} else {
+			step.Args = []string{rest}
+		}
+	case opExpect:
+		step.Args = strings.Fields(rest)
+		if len(step.Args) == 0 {
+			return step, fmt.Errorf("line %d: expect needs result, error or call", line)
+		}
+		switch step.Args[0] {
+		case "result":
+		case "error":
+		case "call":
+			if len(step.Args) != 2 {
+				return step, fmt.Errorf("line %d: expect call needs an action", line)
+			}
+		default:
+			return step, fmt.Errorf("line %d: unknown expectation %s", line, step.Args[0])
+		}
+	case opAssert:
+		step.Args = strings.Fields(rest)
+		if len(step.Args) < 2 {
+			return step, fmt.Errorf("line %d: assert needs a path and an op", line)
+		}
+		switch step.Args[1] {
+		case "==", "!=", "in":
+			if len(step.Args) < 3 {
+				return step, fmt.Errorf("line %d: assert %s needs a value", line, step.Args[1])
+			}
+		case "exists", "absent", "int", "string", "datetime":
+		default:
+			return step, fmt.Errorf("line %d: unknown assert op %s", line, step.Args[1])
+		}
+	case opSave:
+		step.Args = strings.Fields(rest)
+		if len(step.Args) != 2 {
+			return step, fmt.Errorf("line %d: save needs a path and a name", line)
+		}
+	case opWait:
+		if _, err := time.ParseDuration(rest); err != nil {
+			return step, fmt.Errorf("line %d: %v", line, err)
+		}
+		step.Args = []string{rest}
+	default:
+		return step, fmt.Errorf("line %d: unknown step %s", line, op)
+	}
+	return step, nil
+}
+
+//cut splits the first word of s from the rest
+func cut(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		return s[:i], strings.TrimSpace(s[i+1:])
+	}
+	return s, ""
+}
+
+//LoadFS reads the test cases of the .txt files in dir of fsys, sorted by name
+func LoadFS(fsys fs.FS, dir string) ([]*TestCase, error) {
+	names, err := fs.Glob(fsys, path.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var cases []*TestCase
+	for _, name := range names {
+		f, err := fsys.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		tc, err := Parse(strings.TrimSuffix(path.Base(name), ".txt"), f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, tc)
+	}
+	return cases, nil
+}
+
+//Load reads the test cases of the .txt files in dir
+func Load(dir string) ([]*TestCase, error) {
+	return LoadFS(os.DirFS(dir), ".")
+}
+
+//Builtin returns the test cases shipped with the package, one per message of the ocpp1.6J test case list
+//plus the error handling of malformed calls
+func Builtin() []*TestCase {
+	cases, err := LoadFS(testcases, "testcases")
+	if err != nil {
+		panic(err)
+	}
+	return cases
+}
@@ -0,0 +1,151 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"ocpp16/config"
+	"ocpp16/plugin/active/rest"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const stationName = "8d4c3bd6-2a3c-4b8e-9c1d-1b8f0d7a5f10"
+
+//testPlugin answers the calls of the charging points with valid responses
+type testPlugin struct {
+	transactionId int32
+}
+
+func (p *testPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	var res protocol.Response
+	switch action {
+	case protocol.BootNotificationName:
+		interval := 300
+		res = &protocol.BootNotificationResponse{Status: "Accepted", CurrentTime: time.Now().UTC().Format(protocol.ISO8601), Interval: &interval}
+	case protocol.HeartbeatName:
+		res = &protocol.HeartbeatResponse{CurrentTime: time.Now().UTC().Format(protocol.ISO8601)}
+	case protocol.AuthorizeName:
+		res = &protocol.AuthorizeResponse{IdTagInfo: protocol.IdTagInfo{Status: "Accepted"}}
+	case protocol.StartTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			transactionId := int(atomic.AddInt32(&p.transactionId, 1))
+			return &protocol.StartTransactionResponse{IdTagInfo: protocol.IdTagInfo{Status: "Accepted"}, TransactionId: &transactionId}, nil
+		}, true
+	case protocol.StopTransactionName:
+		res = &protocol.StopTransactionResponse{}
+	case protocol.DataTransferName:
+		res = &protocol.DataTransferResponse{Status: "UnknownVendorId"}
+	case protocol.StatusNotificationName:
+		res = &protocol.StatusNotificationResponse{}
+	case protocol.MeterValuesName:
+		res = &protocol.MeterValuesResponse{}
+	case protocol.DiagnosticsStatusNotificationName:
+		res = &protocol.DiagnosticsStatusNotificationResponse{}
+	case protocol.FirmwareStatusNotificationName:
+		res = &protocol.FirmwareStatusNotificationResponse{}
+	default:
+		return nil, false
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		return res, nil
+	}, true
+}
+
+func (p *testPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	return func(ctx context.Context, id string, uniqueid string, response protocol.Response) error {
+		return nil
+	}, true
+}
+
+func freeAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestParse(t *testing.T) {
+	tc, err := Parse("case", strings.NewReader(`# a test case
+send Heartbeat {}
+expect result
+assert currentTime datetime
+reply error NotSupported not today
+wait 10ms
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.Description != "a test case" || len(tc.Steps) != 5 || tc.Steps[0].Args[0] != "Heartbeat" || tc.Steps[3].Args[2] != "not today" {
+		t.Fatalf("unexpected test case %+v", tc)
+	}
+	for _, text := range []string{"send Heartbeat", "expect nothing", "assert status ~ Accepted", "wait forever", "jump 3"} {
+		if _, err := Parse("case", strings.NewReader(text)); err == nil {
+			t.Errorf("%s parsed", text)
+		}
+	}
+}
+
+func TestBuiltinAgainstServer(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	config.GCONF = config.GConf{HeartbeatTimeout: 30, ResponseTimeout: 10}
+	lg := logrus.New()
+	lg.SetOutput(&bytes.Buffer{})
+	ocpp16server.SetLogger(lg)
+	s := ocpp16server.NewDefaultServer()
+	s.RegisterActionPlugin(&testPlugin{})
+	s.SetSchemaValidation(true)
+	rest.NewActiveCallPlugin(s, rest.TokenAuth("secret"))
+	addr := freeAddr(t)
+	go s.Serve(addr, "/ocpp/:name/:id")
+	for i := 0; ; i++ {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		if i == 50 {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	runner := &Runner{
+		URL:     fmt.Sprintf("ws://%s/ocpp/%s", addr, stationName),
+		Timeout: 5 * time.Second,
+		Trigger: RESTTrigger(fmt.Sprintf("http://%s%s", addr, rest.BasePath), stationName, "secret"),
+	}
+	results := runner.Run(context.Background(), Builtin())
+	if len(results) != 31 {
+		t.Fatalf("%d results, want 31", len(results))
+	}
+	for _, result := range results {
+		if result.Status != StatusPassed {
+			t.Errorf("%s %s: %s (%v)", result.Name, result.Status, result.Message, result.Step)
+		}
+	}
+	var report bytes.Buffer
+	if err := WriteJUnit(&report, "ocpp1.6", results); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(report.String(), `<testsuite name="ocpp1.6" tests="31" failures="0" errors="0" skipped="0"`) {
+		t.Fatalf("unexpected report %s", report.String())
+	}
+}
+
+func TestSkipWithoutTrigger(t *testing.T) {
+	runner := &Runner{URL: "ws://127.0.0.1:1/ocpp/" + stationName}
+	tc, _ := Parse("Reset", strings.NewReader("trigger Reset {\"type\":\"Soft\"}\nexpect call Reset\n"))
+	if result := runner.RunCase(context.Background(), "CONF001", tc); result.Status != StatusSkipped {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
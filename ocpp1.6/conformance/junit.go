@@ -0,0 +1,79 @@
+package conformance
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+//WriteJUnit writes the results as a JUnit XML report of one test suite
+func WriteJUnit(w io.Writer, suite string, results []Result) error {
+	s := junitTestSuite{Name: suite, Tests: len(results), Timestamp: time.Now().UTC().Format(time.RFC3339)}
+	var total time.Duration
+	for _, result := range results {
+		total += result.Duration
+		tc := junitTestCase{Name: result.Name, ClassName: suite, Time: seconds(result.Duration)}
+		message := &junitMessage{Message: result.Message}
+		if result.Step != nil {
+			message.Text = result.Step.String()
+		}
+		switch result.Status {
+		case StatusFailed:
+			s.Failures++
+			tc.Failure = message
+		case StatusError:
+			s.Errors++
+			tc.Error = message
+		case StatusSkipped:
+			s.Skipped++
+			tc.Skipped = message
+		}
+		s.Cases = append(s.Cases, tc)
+	}
+	s.Time = seconds(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{s}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
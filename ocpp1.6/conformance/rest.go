@@ -0,0 +1,48 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+//RESTTrigger triggers the calls through the api of the rest active plugin, api is its base url, e.g. http://127.0.0.1:8090/api/v1.
+//name is the first part of the server id of the charging points, i.e. the last segment of Runner.URL, token the bearer token if any
+func RESTTrigger(api string, name string, token string) TriggerFunc {
+	return func(ctx context.Context, id string, action string, payload json.RawMessage) error {
+		u := fmt.Sprintf("%s/chargepoints/%s/actions/%s?wait=true", api, url.PathEscape(name+"-"+id), url.PathEscape(action))
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("trigger %s failed, status(%d), body(%s)", action, resp.StatusCode, body)
+		}
+		var reply struct {
+			CallError *struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"callError"`
+		}
+		if err = json.Unmarshal(body, &reply); err != nil {
+			return err
+		}
+		if reply.CallError != nil {
+			return fmt.Errorf("CallError %s", reply.CallError.ErrorCode)
+		}
+		return nil
+	}
+}
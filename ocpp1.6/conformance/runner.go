@@ -0,0 +1,524 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"ocpp16/protocol"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"  //an expectation or an assertion does not hold
+	StatusError   = "error"   //the test case could not run, e.g. the connection failed
+	StatusSkipped = "skipped" //the test case needs a trigger and the runner has none
+)
+
+//TriggerFunc asks the central system to send a call to the charging point id, e.g. through an active plugin.
+//it may block until the charging point answers
+type TriggerFunc func(ctx context.Context, id string, action string, payload json.RawMessage) error
+
+//Runner plays the charging point of the test cases
+type Runner struct {
+	URL      string        //service uri of the central system without the charging point id
+	IDPrefix string        //the id of a test case connection is IDPrefix followed by the test case number
+	Password string        //http basic auth password, empty for none
+	Timeout  time.Duration //timeout of every expectation
+	Trigger  TriggerFunc
+}
+
+//Result is the outcome of a test case
+type Result struct {
+	Name     string
+	Status   string
+	Message  string
+	Step     *Step //the step that failed
+	Duration time.Duration
+}
+
+type failure struct {
+	error
+}
+
+//Run runs the test cases one after another
+func (r *Runner) Run(ctx context.Context, cases []*TestCase) []Result {
+	results := make([]Result, 0, len(cases))
+	for i, tc := range cases {
+		id := fmt.Sprintf("%s%03d", r.idPrefix(), i+1)
+		results = append(results, r.RunCase(ctx, id, tc))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return results
+}
+
+func (r *Runner) idPrefix() string {
+	if r.IDPrefix == "" {
+		return "CONF"
+	}
+	return r.IDPrefix
+}
+
+func (r *Runner) timeout() time.Duration {
+	if r.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return r.Timeout
+}
+
+//RunCase runs one test case as the charging point id
+func (r *Runner) RunCase(ctx context.Context, id string, tc *TestCase) Result {
+	start := time.Now()
+	result := Result{Name: tc.Name, Status: StatusPassed}
+	if r.Trigger == nil && tc.needsTrigger() {
+		result.Status, result.Message = StatusSkipped, "no trigger to make the central system send calls"
+		return result
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s, err := r.connect(ctx, id)
+	if err != nil {
+		result.Status, result.Message = StatusError, err.Error()
+		result.Duration = time.Since(start)
+		return result
+	}
+	defer s.close()
+	for i := range tc.Steps {
+		step := tc.Steps[i]
+		if err = s.run(ctx, step); err != nil {
+			result.Status, result.Message, result.Step = StatusError, err.Error(), &step
+			var f failure
+			if errors.As(err, &f) {
+				result.Status = StatusFailed
+			}
+			break
+		}
+	}
+	result.Duration = time.Since(start)
+	return result
+}
+
+type frame struct {
+	messageType protocol.MessageType
+	uniqueid    string
+	action      string
+	payload     json.RawMessage
+	errorCode   string
+	description string
+}
+
+//session is the connection of a running test case
+type session struct {
+	runner    *Runner
+	id        string
+	conn      *websocket.Conn
+	seq       uint64
+	frames    chan *frame
+	readErr   chan error
+	triggered chan error
+	done      chan struct{}
+	vars      map[string]string
+
+	lastAction   string          //action of the last call sent
+	lastUniqueid string          //uniqueid of the last call sent
+	lastCall     *frame          //last call received
+	queue        []*frame        //calls received while waiting for something else
+	payload      json.RawMessage //last payload received
+}
+
+func (r *Runner) connect(ctx context.Context, id string) (*session, error) {
+	header := http.Header{}
+	if r.Password != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(id, r.Password)
+		header.Set("Authorization", req.Header.Get("Authorization"))
+	}
+	dialer := websocket.Dialer{
+		Subprotocols:     []string{"ocpp1.6"},
+		HandshakeTimeout: r.timeout(),
+	}
+	conn, res, err := dialer.DialContext(ctx, strings.TrimRight(r.URL, "/")+"/"+id, header)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("connect error, err:(%v), status(%s)", err, res.Status)
+		}
+		return nil, fmt.Errorf("connect error, err:(%v)", err)
+	}
+	if conn.Subprotocol() != "ocpp1.6" {
+		conn.Close()
+		return nil, fmt.Errorf("subprotocol(%s) negotiated instead of ocpp1.6", conn.Subprotocol())
+	}
+	s := &session{
+		runner:    r,
+		id:        id,
+		conn:      conn,
+		frames:    make(chan *frame, 64),
+		readErr:   make(chan error, 1),
+		triggered: make(chan error, 8),
+		done:      make(chan struct{}),
+		vars:      map[string]string{"id": id},
+	}
+	go s.readLoop()
+	return s, nil
+}
+
+func (s *session) close() {
+	close(s.done)
+	s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	s.conn.Close()
+}
+
+func (s *session) readLoop() {
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			s.readErr <- err
+			return
+		}
+		f, err := parseFrame(data)
+		if err != nil {
+			s.readErr <- fmt.Errorf("invalid message %s, err:(%v)", data, err)
+			return
+		}
+		select {
+		case s.frames <- f:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func parseFrame(data []byte) (*frame, error) {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) < 3 {
+		return nil, errors.New("less than 3 elements")
+	}
+	f := &frame{}
+	if err := json.Unmarshal(fields[0], &f.messageType); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(fields[1], &f.uniqueid); err != nil {
+		return nil, err
+	}
+	switch f.messageType {
+	case protocol.CALL:
+		if len(fields) != 4 {
+			return nil, errors.New("call must have 4 elements")
+		}
+		if err := json.Unmarshal(fields[2], &f.action); err != nil {
+			return nil, err
+		}
+		f.payload = fields[3]
+	case protocol.CALL_RESULT:
+		f.payload = fields[2]
+	case protocol.CALL_ERROR:
+		if len(fields) != 5 {
+			return nil, errors.New("CallError must have 5 elements")
+		}
+		if err := json.Unmarshal(fields[2], &f.errorCode); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(fields[3], &f.description); err != nil {
+			return nil, err
+		}
+		f.payload, _ = json.Marshal(map[string]interface{}{"errorCode": f.errorCode, "errorDescription": f.description, "errorDetails": fields[4]})
+	default:
+		return nil, fmt.Errorf("unknown message type %d", f.messageType)
+	}
+	return f, nil
+}
+
+func (s *session) expand(text string) string {
+	if !strings.Contains(text, "${") {
+		return text
+	}
+	text = strings.ReplaceAll(text, "${now}", time.Now().UTC().Format(protocol.ISO8601))
+	for name, value := range s.vars {
+		text = strings.ReplaceAll(text, "${"+name+"}", value)
+	}
+	return text
+}
+
+func (s *session) write(message []interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	s.conn.SetWriteDeadline(time.Now().Add(s.runner.timeout()))
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *session) run(ctx context.Context, step Step) error {
+	switch step.Op {
+	case opSend:
+		payload := s.expand(step.Args[1])
+		if !json.Valid([]byte(payload)) {
+			return fmt.Errorf("invalid payload %s", payload)
+		}
+		s.lastAction = step.Args[0]
+		s.lastUniqueid = strconv.FormatUint(atomic.AddUint64(&s.seq, 1), 10)
+		return s.write([]interface{}{protocol.CALL, s.lastUniqueid, s.lastAction, json.RawMessage(payload)})
+	case opTrigger:
+		payload := s.expand(step.Args[1])
+		if !json.Valid([]byte(payload)) {
+			return fmt.Errorf("invalid payload %s", payload)
+		}
+		go func() {
+			if err := s.runner.Trigger(ctx, s.id, step.Args[0], json.RawMessage(payload)); err != nil {
+				select {
+				case s.triggered <- fmt.Errorf("trigger %s error, err:(%v)", step.Args[0], err):
+				default:
+				}
+			}
+		}()
+		return nil
+	case opExpect:
+		return s.expect(ctx, step.Args)
+	case opReply:
+		if s.lastCall == nil {
+			return errors.New("no call to reply to")
+		}
+		if step.Args[0] == "error" {
+			return s.write([]interface{}{protocol.CALL_ERROR, s.lastCall.uniqueid, step.Args[1], step.Args[2], struct{}{}})
+		}
+		payload := s.expand(step.Args[0])
+		if !json.Valid([]byte(payload)) {
+			return fmt.Errorf("invalid payload %s", payload)
+		}
+		return s.write([]interface{}{protocol.CALL_RESULT, s.lastCall.uniqueid, json.RawMessage(payload)})
+	case opAssert:
+		return s.assert(step.Args)
+	case opSave:
+		value, ok, err := lookup(s.payload, step.Args[0])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return failure{fmt.Errorf("%s absent in %s", step.Args[0], s.payload)}
+		}
+		s.vars[step.Args[1]] = value
+		return nil
+	case opWait:
+		d, _ := time.ParseDuration(step.Args[0])
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown step %s", step.Op)
+}
+
+//next waits for the next frame
+func (s *session) next(ctx context.Context, timer <-chan time.Time, what string) (*frame, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timer:
+		return nil, failure{fmt.Errorf("timeout waiting for %s", what)}
+	case err := <-s.readErr:
+		return nil, fmt.Errorf("connection closed waiting for %s, err:(%v)", what, err)
+	case err := <-s.triggered:
+		return nil, err
+	case f := <-s.frames:
+		return f, nil
+	}
+}
+
+func (s *session) expect(ctx context.Context, args []string) error {
+	timer := time.NewTimer(s.runner.timeout())
+	defer timer.Stop()
+	switch args[0] {
+	case "result", "error":
+		if s.lastUniqueid == "" {
+			return errors.New("no call sent")
+		}
+		what := fmt.Sprintf("the %s of %s", args[0], s.lastAction)
+		for {
+			f, err := s.next(ctx, timer.C, what)
+			if err != nil {
+				return err
+			}
+			if f.messageType == protocol.CALL {
+				s.queue = append(s.queue, f)
+				continue
+			}
+			if f.uniqueid != s.lastUniqueid {
+				return failure{fmt.Errorf("reply of uniqueid(%s) while waiting for %s(%s)", f.uniqueid, what, s.lastUniqueid)}
+			}
+			s.payload = f.payload
+			if args[0] == "result" {
+				if f.messageType != protocol.CALL_RESULT {
+					return failure{fmt.Errorf("CallError %s %s instead of the result of %s", f.errorCode, f.description, s.lastAction)}
+				}
+				return checkSchema(s.lastAction, f.payload, false)
+			}
+			if f.messageType != protocol.CALL_ERROR {
+				return failure{fmt.Errorf("CallResult %s instead of an error", f.payload)}
+			}
+			if codes := args[1:]; len(codes) > 0 && !contains(codes, f.errorCode) {
+				return failure{fmt.Errorf("error code %s, want one of %s", f.errorCode, strings.Join(codes, " "))}
+			}
+			return nil
+		}
+	default:
+		action := args[1]
+		for i, f := range s.queue {
+			if f.action == action {
+				s.queue = append(s.queue[:i], s.queue[i+1:]...)
+				s.lastCall, s.payload = f, f.payload
+				return checkSchema(action, f.payload, true)
+			}
+		}
+		for {
+			f, err := s.next(ctx, timer.C, "the call "+action)
+			if err != nil {
+				return err
+			}
+			if f.messageType != protocol.CALL {
+				continue
+			}
+			if f.action != action {
+				s.queue = append(s.queue, f)
+				continue
+			}
+			s.lastCall, s.payload = f, f.payload
+			return checkSchema(action, f.payload, true)
+		}
+	}
+}
+
+//checkSchema decodes the payload into the request or response type of the action and validates it,
+//unknown properties are rejected like additionalProperties false of the json schemas
+func checkSchema(action string, payload json.RawMessage, request bool) error {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil
+	}
+	typ := ocpptrait.ResponseType()
+	if request {
+		typ = ocpptrait.RequestType()
+	}
+	ptr := reflect.New(typ)
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(ptr.Interface()); err != nil {
+		return failure{fmt.Errorf("payload %s does not match %s, err:(%v)", payload, typ.Name(), err)}
+	}
+	if err := protocol.Validate.Struct(ptr.Interface()); err != nil {
+		return failure{fmt.Errorf("payload %s does not match %s, err:(%v)", payload, typ.Name(), err)}
+	}
+	return nil
+}
+
+func (s *session) assert(args []string) error {
+	path, op := args[0], args[1]
+	values := make([]string, 0, len(args)-2)
+	for _, value := range args[2:] {
+		values = append(values, s.expand(value))
+	}
+	value, ok, err := lookup(s.payload, path)
+	if err != nil {
+		return err
+	}
+	if op == "absent" {
+		if ok {
+			return failure{fmt.Errorf("%s is %s, want absent", path, value)}
+		}
+		return nil
+	}
+	if !ok {
+		return failure{fmt.Errorf("%s absent in %s", path, s.payload)}
+	}
+	switch op {
+	case "==":
+		if want := strings.Join(values, " "); value != want {
+			return failure{fmt.Errorf("%s is %s, want %s", path, value, want)}
+		}
+	case "!=":
+		if want := strings.Join(values, " "); value == want {
+			return failure{fmt.Errorf("%s is %s", path, value)}
+		}
+	case "in":
+		if !contains(values, value) {
+			return failure{fmt.Errorf("%s is %s, want one of %s", path, value, strings.Join(values, " "))}
+		}
+	case "int":
+		if _, err := strconv.Atoi(value); err != nil {
+			return failure{fmt.Errorf("%s is %s, want an integer", path, value)}
+		}
+	case "string":
+		if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
+			return failure{fmt.Errorf("%s is %s, want a string", path, value)}
+		}
+	case "datetime":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return failure{fmt.Errorf("%s is %s, want a date time", path, value)}
+		}
+	}
+	return nil
+}
+
+//lookup returns the field at the dotted path of the payload, strings unquoted and other values as json
+func lookup(payload json.RawMessage, path string) (string, bool, error) {
+	if payload == nil {
+		return "", false, errors.New("no payload received")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return "", false, err
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			child, ok := node[key]
+			if !ok {
+				return "", false, nil
+			}
+			v = child
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return "", false, nil
+			}
+			v = node[i]
+		default:
+			return "", false, nil
+		}
+	}
+	switch value := v.(type) {
+	case string:
+		return value, true, nil
+	case json.Number:
+		return value.String(), true, nil
+	default:
+		data, _ := json.Marshal(value)
+		return string(data), true, nil
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
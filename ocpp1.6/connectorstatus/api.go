@@ -0,0 +1,58 @@
+package connectorstatus
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the statuses on r, e.g. the group of the rest api:
+//
+//	GET    /connectors?chargePointId=                    the latest status of the connectors, connector 0 included
+//	GET    /connectors/:id/:connectorId                  the latest status of a connector
+//	GET    /connectors/:id/:connectorId/history          its statuses, oldest first
+func (t *Tracker) RegisterAPI(r gin.IRouter) {
+	r.GET("/connectors", t.list)
+	r.GET("/connectors/:id/:connectorId", t.get)
+	r.GET("/connectors/:id/:connectorId/history", t.history)
+}
+
+func (t *Tracker) list(c *gin.Context) {
+	c.JSON(http.StatusOK, t.Connectors(c.Query("chargePointId")))
+}
+
+func connectorID(c *gin.Context) (int, bool) {
+	connectorID, err := strconv.Atoi(c.Param("connectorId"))
+	if err != nil || connectorID < 0 {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid connectorId(%s)", c.Param("connectorId"))})
+		return 0, false
+	}
+	return connectorID, true
+}
+
+func (t *Tracker) get(c *gin.Context) {
+	connectorID, ok := connectorID(c)
+	if !ok {
+		return
+	}
+	s, ok := t.Connector(c.Param("id"), connectorID)
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("connector(%s/%d) not found", c.Param("id"), connectorID)})
+		return
+	}
+	c.JSON(http.StatusOK, s)
+}
+
+func (t *Tracker) history(c *gin.Context) {
+	connectorID, ok := connectorID(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, t.History(c.Param("id"), connectorID))
+}
@@ -0,0 +1,117 @@
+package connectorstatus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func statuses(ss []Status) string {
+	var s []string
+	for _, st := range ss {
+		s = append(s, st.ChargePoint+"/"+strconv.Itoa(st.Connector)+":"+string(st.Status))
+	}
+	return strings.Join(s, ",")
+}
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker(Config{History: 4})
+	var warnings []string
+	tracker.SetErrorHandler(func(id string, err error) { warnings = append(warnings, id+" "+err.Error()) })
+	plugin := tracker.Wrap(local.NewActionPlugin())
+	notify := func(id string, connectorID int, status protocol.ChargePointStatus, errorCode protocol.ChargePointErrorCode) {
+		handler, _ := plugin.RequestHandler(protocol.StatusNotificationName)
+		if _, err := handler(context.Background(), id, protocol.NewUniqueID(), &protocol.StatusNotificationRequest{
+			ConnectorId: &connectorID,
+			ErrorCode:   errorCode,
+			Status:      status,
+			Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plugin.ChargingPointOnline("CP001")
+	plugin.ChargingPointOnline("CP002")
+	notify("CP001", 0, Available, "NoError")
+	notify("CP001", 1, Available, "NoError")
+	notify("CP001", 2, Available, "NoError")
+	notify("CP002", 1, Charging, "NoError")
+	notify("CP001", 1, Preparing, "NoError")
+	notify("CP001", 1, Charging, "NoError")
+	notify("CP001", 1, Reserved, "NoError")
+	notify("CP001", 0, Charging, "NoError")
+	notify("CP001", 2, Finishing, "NoError")
+	notify("CP001", 2, Faulted, "GroundFailure")
+	if strings.Join(warnings, "\n") != `CP001 StatusNotification rejected, connector(1) transition from Charging to Reserved not allowed
+CP001 StatusNotification rejected, connector(0) reported Charging, only Available, Unavailable or Faulted apply to the charging point
+CP001 StatusNotification rejected, connector(2) transition from Available to Finishing not allowed` {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+	if s := statuses(tracker.Connectors("")); s != "CP001/0:Available,CP001/1:Charging,CP001/2:Faulted,CP002/1:Charging" {
+		t.Fatalf("unexpected statuses %s", s)
+	}
+	if s, ok := tracker.Connector("CP001", 2); !ok || s.ErrorCode != "GroundFailure" {
+		t.Fatalf("unexpected status %+v", s)
+	}
+
+	//offline charging points are Unavailable, any status is accepted once they are back
+	plugin.ChargingPointOffline("CP001")
+	if s := statuses(tracker.Connectors("CP001")); s != "CP001/0:Unavailable,CP001/1:Unavailable,CP001/2:Unavailable" {
+		t.Fatalf("unexpected statuses %s", s)
+	}
+	//and until they report their connectors again
+	plugin.ChargingPointOnline("CP001")
+	if s := statuses(tracker.Connectors("CP001")); s != "CP001/0:Unavailable,CP001/1:Unavailable,CP001/2:Unavailable" {
+		t.Fatalf("unexpected statuses after the connection %s", s)
+	}
+	notify("CP001", 1, Finishing, "NoError")
+	if s := statuses(tracker.Connectors("CP001")); s != "CP001/0:Unavailable,CP001/1:Finishing,CP001/2:Unavailable" {
+		t.Fatalf("unexpected statuses after the first notification %s", s)
+	}
+	notify("CP001", 1, Available, "NoError")
+	if s := statuses(tracker.History("CP001", 1)); s != "CP001/1:Charging,CP001/1:Unavailable,CP001/1:Finishing,CP001/1:Available" {
+		t.Fatalf("unexpected history %s", s)
+	}
+	//the connectors not reported since are recorded offline once
+	plugin.ChargingPointOffline("CP001")
+	if s := statuses(tracker.History("CP001", 2)); s != "CP001/2:Available,CP001/2:Faulted,CP001/2:Unavailable" {
+		t.Fatalf("unexpected history %s", s)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	tracker := NewTracker(Config{})
+	tracker.RegisterAPI(engine)
+	connectorID := 1
+	tracker.notify("CP001", &protocol.StatusNotificationRequest{ConnectorId: &connectorID, ErrorCode: "NoError", Status: Available, VendorErrorCode: "E0"})
+	do := func(url string) (int, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		return w.Code, w.Body.String()
+	}
+	if code, body := do("/connectors?chargePointId=CP001"); code != http.StatusOK || !strings.Contains(body, `"vendorErrorCode":"E0"`) {
+		t.Fatalf("list got %d %s", code, body)
+	}
+	if code, body := do("/connectors/CP001/2"); code != http.StatusNotFound {
+		t.Fatalf("get got %d %s", code, body)
+	}
+	if code, body := do("/connectors/CP001/x/history"); code != http.StatusBadRequest {
+		t.Fatalf("history got %d %s", code, body)
+	}
+	if code, body := do("/connectors/CP001/1/history"); code != http.StatusOK || !strings.Contains(body, `"status":"Available"`) {
+		t.Fatalf("history got %d %s", code, body)
+	}
+}
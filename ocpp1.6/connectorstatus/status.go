@@ -0,0 +1,81 @@
+//Package connectorstatus tracks the status of the connectors of the charging points as reported by their
+//StatusNotifications: the latest status, error code and timestamp of every connector, connector 0 included, and a
+//bounded history. the transitions the state machine of OCPP 1.6 does not allow are rejected, a charging point that
+//disconnects is reported Unavailable until it connects again
+package connectorstatus
+
+import (
+	"fmt"
+	"ocpp16/protocol"
+	"time"
+)
+
+//the statuses of StatusNotification
+const (
+	Available     protocol.ChargePointStatus = "Available"
+	Preparing     protocol.ChargePointStatus = "Preparing"
+	Charging      protocol.ChargePointStatus = "Charging"
+	SuspendedEVSE protocol.ChargePointStatus = "SuspendedEVSE"
+	SuspendedEV   protocol.ChargePointStatus = "SuspendedEV"
+	Finishing     protocol.ChargePointStatus = "Finishing"
+	Reserved      protocol.ChargePointStatus = "Reserved"
+	Unavailable   protocol.ChargePointStatus = "Unavailable"
+	Faulted       protocol.ChargePointStatus = "Faulted"
+)
+
+//transitions are the statuses a connector may go to from a status, see the section 4.9 of the specification. a
+//connector may report the status it is in again, with another error code for instance
+var transitions = map[protocol.ChargePointStatus][]protocol.ChargePointStatus{
+	Available:     {Preparing, Charging, SuspendedEV, SuspendedEVSE, Reserved, Unavailable, Faulted},
+	Preparing:     {Available, Charging, SuspendedEV, SuspendedEVSE, Finishing, Faulted},
+	Charging:      {Available, SuspendedEV, SuspendedEVSE, Finishing, Unavailable, Faulted},
+	SuspendedEV:   {Available, Charging, SuspendedEVSE, Finishing, Unavailable, Faulted},
+	SuspendedEVSE: {Available, Charging, SuspendedEV, Finishing, Unavailable, Faulted},
+	Finishing:     {Available, Preparing, Unavailable, Faulted},
+	Reserved:      {Available, Preparing, Unavailable, Faulted},
+	Unavailable:   {Available, Preparing, Charging, SuspendedEV, SuspendedEVSE, Faulted},
+	Faulted:       {Available, Preparing, Charging, SuspendedEV, SuspendedEVSE, Finishing, Reserved, Unavailable},
+}
+
+//transition checks the move of connector from one status to another, from is empty for the first status reported
+func transition(connector int, from protocol.ChargePointStatus, to protocol.ChargePointStatus) error {
+	if connector == 0 && to != Available && to != Unavailable && to != Faulted {
+		return fmt.Errorf("connector(0) reported %s, only Available, Unavailable or Faulted apply to the charging point", to)
+	}
+	if from == "" || from == to {
+		return nil
+	}
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("connector(%d) transition from %s to %s not allowed", connector, from, to)
+}
+
+//Status is the status of a connector at a time
+type Status struct {
+	ChargePoint     string                        `json:"chargePointId"`
+	Connector       int                           `json:"connectorId"` //0 is the charging point as a whole
+	Status          protocol.ChargePointStatus    `json:"status"`
+	ErrorCode       protocol.ChargePointErrorCode `json:"errorCode"`
+	Info            string                        `json:"info,omitempty"`
+	VendorID        string                        `json:"vendorId,omitempty"`
+	VendorErrorCode string                        `json:"vendorErrorCode,omitempty"`
+	Timestamp       time.Time                     `json:"timestamp"`         //of the notification, the time it was received if it has none
+	Offline         bool                          `json:"offline,omitempty"` //Unavailable as the charging point is disconnected
+}
+
+//connector is what the tracker keeps of a connector
+type connector struct {
+	latest  Status   //reported by the charging point
+	history []Status //oldest first, the disconnections included
+}
+
+//record appends s to the history, dropping the oldest entries beyond max
+func (c *connector) record(s Status, max int) {
+	c.history = append(c.history, s)
+	if n := len(c.history) - max; n > 0 {
+		c.history = append(c.history[:0:0], c.history[n:]...)
+	}
+}
@@ -0,0 +1,204 @@
+package connectorstatus
+
+import (
+	"context"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	History int //entries kept per connector, 100 if 0
+}
+
+type chargePoint struct {
+	connectors map[int]*connector
+}
+
+type Tracker struct {
+	conf    Config
+	mu      sync.Mutex
+	points  map[string]*chargePoint
+	now     func() time.Time
+	onError func(id string, err error)
+}
+
+func NewTracker(conf Config) *Tracker {
+	if conf.History <= 0 {
+		conf.History = 100
+	}
+	return &Tracker{conf: conf, points: make(map[string]*chargePoint), now: time.Now}
+}
+
+//SetErrorHandler gets the warnings on the transitions rejected
+func (t *Tracker) SetErrorHandler(fn func(id string, err error)) {
+	t.onError = fn
+}
+
+func (t *Tracker) handle(id string, err error) {
+	if err != nil && t.onError != nil {
+		t.onError(id, err)
+	}
+}
+
+func (t *Tracker) point(id string) *chargePoint {
+	cp, ok := t.points[id]
+	if !ok {
+		cp = &chargePoint{connectors: make(map[int]*connector)}
+		t.points[id] = cp
+	}
+	return cp
+}
+
+//current returns the status of c, Unavailable from the disconnection of the charging point until it reports c again,
+//the connection alone does not tell the status of the connectors
+func (cp *chargePoint) current(c *connector) Status {
+	if n := len(c.history); n > 0 && c.history[n-1].Offline {
+		return c.history[n-1]
+	}
+	return c.latest
+}
+
+//Connectors returns the latest status of the connectors of id, of all the charging points if id is empty, by charging
+//point and connector
+func (t *Tracker) Connectors(id string) []Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	statuses := []Status{}
+	for cpID, cp := range t.points {
+		if id != "" && cpID != id {
+			continue
+		}
+		for _, c := range cp.connectors {
+			statuses = append(statuses, cp.current(c))
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].ChargePoint != statuses[j].ChargePoint {
+			return statuses[i].ChargePoint < statuses[j].ChargePoint
+		}
+		return statuses[i].Connector < statuses[j].Connector
+	})
+	return statuses
+}
+
+//Connector returns the latest status of the connector connectorID of id
+func (t *Tracker) Connector(id string, connectorID int) (Status, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cp, ok := t.points[id]
+	if !ok {
+		return Status{}, false
+	}
+	c, ok := cp.connectors[connectorID]
+	if !ok {
+		return Status{}, false
+	}
+	return cp.current(c), true
+}
+
+//History returns the statuses of the connector connectorID of id, oldest first
+func (t *Tracker) History(id string, connectorID int) []Status {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	history := []Status{}
+	if cp, ok := t.points[id]; ok {
+		if c, ok := cp.connectors[connectorID]; ok {
+			history = append(history, c.history...)
+		}
+	}
+	return history
+}
+
+//notify records the status of req unless the connector cannot move to it from its latest status. the first status
+//after a disconnection is accepted whatever it is, the transitions in between are unknown
+func (t *Tracker) notify(id string, req *protocol.StatusNotificationRequest) {
+	if req.ConnectorId == nil {
+		return
+	}
+	s := Status{
+		ChargePoint:     id,
+		Connector:       *req.ConnectorId,
+		Status:          req.Status,
+		ErrorCode:       req.ErrorCode,
+		Info:            req.Info,
+		VendorID:        req.VendorId,
+		VendorErrorCode: req.VendorErrorCode,
+		Timestamp:       t.now(),
+	}
+	if req.Timestamp != "" {
+		if ts, err := time.Parse(time.RFC3339Nano, req.Timestamp); err == nil {
+			s.Timestamp = ts
+		}
+	}
+	t.mu.Lock()
+	cp := t.point(id)
+	c, ok := cp.connectors[s.Connector]
+	if !ok {
+		c = &connector{}
+		cp.connectors[s.Connector] = c
+	}
+	from := c.latest.Status
+	if n := len(c.history); n > 0 && c.history[n-1].Offline {
+		from = ""
+	}
+	err := transition(s.Connector, from, s.Status)
+	if err == nil {
+		c.latest = s
+		c.record(s, t.conf.History)
+	}
+	t.mu.Unlock()
+	if err != nil {
+		t.handle(id, fmt.Errorf("StatusNotification rejected, %w", err))
+	}
+}
+
+//offline records the connectors of id Unavailable, but the ones not reported since the last disconnection
+func (t *Tracker) offline(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	for connectorID, c := range t.point(id).connectors {
+		if n := len(c.history); n > 0 && c.history[n-1].Offline {
+			continue
+		}
+		c.record(Status{
+			ChargePoint: id,
+			Connector:   connectorID,
+			Status:      Unavailable,
+			ErrorCode:   "NoError",
+			Timestamp:   now,
+			Offline:     true,
+		}, t.conf.History)
+	}
+}
+
+type trackedPlugin struct {
+	ocpp16server.PassivePlugin
+	t *Tracker
+}
+
+//Wrap returns plugin recording the StatusNotifications and the disconnections, register it on the server in place of
+//plugin. the notifications are forwarded to plugin, the rejected ones too
+func (t *Tracker) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &trackedPlugin{PassivePlugin: plugin, t: t}
+}
+
+func (p *trackedPlugin) ChargingPointOffline(id string) error {
+	p.t.offline(id)
+	return p.PassivePlugin.ChargingPointOffline(id)
+}
+
+func (p *trackedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok || action != protocol.StatusNotificationName {
+		return handler, ok
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		p.t.notify(id, request.(*protocol.StatusNotificationRequest))
+		return handler(ctx, id, uniqueid, request)
+	}, true
+}
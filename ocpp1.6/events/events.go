@@ -0,0 +1,348 @@
+//Package events streams every frame exchanged with the charging points to pluggable sinks, e.g. kafka or local files.
+//
+//the server hands the raw frames to a Stream without blocking, a full queue drops them. a single goroutine parses them
+//into envelopes, resolves the action of the CallResults and CallErrors from the Calls they answer, and hands them in
+//batches to a goroutine per sink, so a slow or failing sink holds back neither the others nor the parsing. delivery is
+//at least once: a batch a sink fails to write is retried as a whole, with a bounded backoff
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ocpp16/protocol"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DirectionIn  = "in"  //received from the charging point
+	DirectionOut = "out" //sent to the charging point
+
+	//MessageTypeTimeout is the type of the envelopes of the active calls the charging point did not reply to
+	MessageTypeTimeout = "Timeout"
+	//MessageTypeInvalid is the type of the frames that are not an ocpp message, the payload is the frame as a string
+	MessageTypeInvalid = "Invalid"
+
+	//a Call not answered in this time is forgotten, its reply gets no action
+	correlationTTL = 10 * time.Minute
+
+	//the first wait before the attempt of a failed batch, doubled for every attempt up to maxBackoff
+	minBackoff = 100 * time.Millisecond
+	maxBackoff = 5 * time.Second
+)
+
+//Envelope is the record written to the sinks for every frame
+type Envelope struct {
+	ID            string          `json:"id"`
+	Node          string          `json:"node,omitempty"`
+	Direction     string          `json:"direction"`
+	MessageType   string          `json:"messageType"`
+	Action        string          `json:"action,omitempty"`
+	UniqueID      string          `json:"uniqueId,omitempty"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	Timestamp     time.Time       `json:"timestamp"`               //when the server read or wrote the frame
+	CallTimestamp *time.Time      `json:"callTimestamp,omitempty"` //of the Call a CallResult, a CallError or a Timeout answers
+}
+
+//Sink writes batches of envelopes, Write is never called concurrently. the batches are shared by the sinks and must not
+//be modified
+type Sink interface {
+	Write(ctx context.Context, batch []*Envelope) error
+	Close() error
+}
+
+type Options struct {
+	Node          string        //the id of this server in the envelopes
+	BufferSize    int           //frames queued before new ones are dropped, 10000 if 0
+	BatchSize     int           //envelopes written at once, 500 if 0
+	FlushInterval time.Duration //of a batch that is not full, 1s if 0
+	Retries       int           //attempts of a failed batch after the first one
+	Timeout       time.Duration //of every write, 10s if 0
+	//OnError is called from the goroutines of the sinks
+	OnError func(err error)
+}
+
+type item struct {
+	id        string
+	direction string
+	frame     []byte
+	action    string //of a timeout, frame is nil
+	uniqueid  string
+	at        time.Time
+}
+
+type call struct {
+	action string
+	at     time.Time
+}
+
+//writer writes the batches of one sink in order, a sink whose batches pile up loses the new ones
+type writer struct {
+	sink    Sink
+	batches chan []*Envelope
+	exited  chan struct{}
+}
+
+type Stream struct {
+	opts      Options
+	writers   []*writer
+	queue     chan *item
+	done      chan struct{}
+	exited    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+	calls     map[string]call //direction, id and unique id of the Calls waiting for their reply
+	dropped   uint64
+	failed    uint64
+}
+
+func NewStream(opts Options, sinks ...Sink) *Stream {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 10000
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 500
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	s := &Stream{
+		opts:   opts,
+		queue:  make(chan *item, opts.BufferSize),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+		calls:  make(map[string]call),
+	}
+	for _, sink := range sinks {
+		//a sink may lag behind by about as many envelopes as the queue holds
+		w := &writer{sink: sink, batches: make(chan []*Envelope, opts.BufferSize/opts.BatchSize+1), exited: make(chan struct{})}
+		s.writers = append(s.writers, w)
+		go s.drain(w)
+	}
+	go s.run()
+	return s
+}
+
+func (s *Stream) enqueue(it *item) {
+	select {
+	case s.queue <- it:
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+	}
+}
+
+//Frame queues a frame read from or written to the charging point id, frame must not be modified afterwards.
+//it never blocks, a nil Stream ignores the frame
+func (s *Stream) Frame(id string, direction string, frame []byte, at time.Time) {
+	if s == nil {
+		return
+	}
+	s.enqueue(&item{id: id, direction: direction, frame: frame, at: at})
+}
+
+//Timeout queues the timeout of the active call uniqueid
+func (s *Stream) Timeout(id string, action string, uniqueid string, at time.Time) {
+	if s == nil {
+		return
+	}
+	s.enqueue(&item{id: id, direction: DirectionOut, action: action, uniqueid: uniqueid, at: at})
+}
+
+//Queued returns the number of frames waiting to be written
+func (s *Stream) Queued() int {
+	return len(s.queue)
+}
+
+//Dropped returns the number of frames dropped because the queue was full
+func (s *Stream) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+//Failed returns the number of envelopes a sink dropped, because it failed to write them or did not keep up
+func (s *Stream) Failed() uint64 {
+	return atomic.LoadUint64(&s.failed)
+}
+
+//Close writes the queued frames and closes the sinks, the frames queued afterwards are ignored. the next calls
+//return the error of the first one
+func (s *Stream) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		<-s.exited
+		for _, w := range s.writers {
+			<-w.exited
+			if err := w.sink.Close(); err != nil && s.closeErr == nil {
+				s.closeErr = err
+			}
+		}
+	})
+	return s.closeErr
+}
+
+func (s *Stream) run() {
+	defer close(s.exited)
+	defer func() {
+		for _, w := range s.writers {
+			close(w.batches)
+		}
+	}()
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]*Envelope, 0, s.opts.BatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			s.dispatch(batch)
+			batch = make([]*Envelope, 0, s.opts.BatchSize)
+		}
+	}
+	for {
+		select {
+		case it := <-s.queue:
+			batch = append(batch, s.envelope(it))
+			if len(batch) >= s.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+			s.forget(time.Now().Add(-correlationTTL))
+		case <-s.done:
+			for {
+				select {
+				case it := <-s.queue:
+					batch = append(batch, s.envelope(it))
+					if len(batch) >= s.opts.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+//dispatch hands batch to every sink without waiting for them
+func (s *Stream) dispatch(batch []*Envelope) {
+	for _, w := range s.writers {
+		select {
+		case w.batches <- batch:
+		default:
+			s.fail(len(batch), fmt.Errorf("sink %T behind", w.sink))
+		}
+	}
+}
+
+func (s *Stream) fail(n int, err error) {
+	atomic.AddUint64(&s.failed, uint64(n))
+	if s.opts.OnError != nil {
+		s.opts.OnError(fmt.Errorf("%d envelopes dropped, %w", n, err))
+	}
+}
+
+//drain writes the batches of w until run returns
+func (s *Stream) drain(w *writer) {
+	defer close(w.exited)
+	for batch := range w.batches {
+		s.write(w.sink, batch)
+	}
+}
+
+func backoff(attempt int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
+
+func (s *Stream) write(sink Sink, batch []*Envelope) {
+	var err error
+	for attempt := 0; attempt <= s.opts.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff(attempt))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.opts.Timeout)
+		err = sink.Write(ctx, batch)
+		cancel()
+		if err == nil {
+			return
+		}
+	}
+	s.fail(len(batch), err)
+}
+
+func callKey(direction string, id string, uniqueid string) string {
+	return direction + "\x00" + id + "\x00" + uniqueid
+}
+
+func opposite(direction string) string {
+	if direction == DirectionIn {
+		return DirectionOut
+	}
+	return DirectionIn
+}
+
+func (s *Stream) forget(before time.Time) {
+	for key, c := range s.calls {
+		if c.at.Before(before) {
+			delete(s.calls, key)
+		}
+	}
+}
+
+//answered returns the Call the reply of uniqueid sent in direction answers
+func (s *Stream) answered(direction string, id string, uniqueid string) (call, bool) {
+	key := callKey(opposite(direction), id, uniqueid)
+	c, ok := s.calls[key]
+	if ok {
+		delete(s.calls, key)
+	}
+	return c, ok
+}
+
+func (s *Stream) envelope(it *item) *Envelope {
+	e := &Envelope{ID: it.id, Node: s.opts.Node, Direction: it.direction, Timestamp: it.at}
+	if it.frame == nil {
+		e.MessageType, e.Action, e.UniqueID = MessageTypeTimeout, it.action, it.uniqueid
+		if c, ok := s.answered(DirectionIn, it.id, it.uniqueid); ok {
+			e.CallTimestamp = &c.at
+		}
+		return e
+	}
+	var fields []json.RawMessage
+	var typ protocol.MessageType
+	if json.Unmarshal(it.frame, &fields) != nil || len(fields) < 3 || json.Unmarshal(fields[0], &typ) != nil ||
+		json.Unmarshal(fields[1], &e.UniqueID) != nil {
+		e.MessageType = MessageTypeInvalid
+		e.Payload, _ = json.Marshal(string(it.frame))
+		return e
+	}
+	switch {
+	case typ == protocol.CALL && len(fields) == 4 && json.Unmarshal(fields[2], &e.Action) == nil:
+		e.MessageType, e.Payload = protocol.CallName, fields[3]
+		s.calls[callKey(it.direction, it.id, e.UniqueID)] = call{action: e.Action, at: it.at}
+		return e
+	case typ == protocol.CALL_RESULT && len(fields) == 3:
+		e.MessageType, e.Payload = protocol.CallResultName, fields[2]
+	case typ == protocol.CALL_ERROR && len(fields) == 5:
+		e.MessageType = protocol.CallErrorName
+		e.Payload, _ = json.Marshal(map[string]json.RawMessage{"errorCode": fields[2], "errorDescription": fields[3], "errorDetails": fields[4]})
+	default:
+		e.MessageType, e.UniqueID = MessageTypeInvalid, ""
+		e.Payload, _ = json.Marshal(string(it.frame))
+		return e
+	}
+	if c, ok := s.answered(it.direction, it.id, e.UniqueID); ok {
+		e.Action, e.CallTimestamp = c.action, &c.at
+	}
+	return e
+}
@@ -0,0 +1,396 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	batches [][]*Envelope
+	fail    int
+}
+
+func (m *memorySink) Write(ctx context.Context, batch []*Envelope) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.fail > 0 {
+		m.fail--
+		return io.ErrClosedPipe
+	}
+	m.batches = append(m.batches, batch)
+	return nil
+}
+
+func (m *memorySink) Close() error {
+	return nil
+}
+
+func TestStream(t *testing.T) {
+	sink := &memorySink{fail: 1}
+	s := NewStream(Options{Node: "node1", BatchSize: 3, FlushInterval: time.Hour, Retries: 1}, sink)
+	t0 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Frame("CP001", DirectionIn, []byte(`[2,"1","Authorize",{"idTag":"tag"}]`), t0)
+	s.Frame("CP001", DirectionOut, []byte(`[3,"1",{"idTagInfo":{"status":"Accepted"}}]`), t0.Add(time.Millisecond))
+	s.Frame("CP001", DirectionOut, []byte(`[2,"2","Reset",{"type":"Soft"}]`), t0.Add(2*time.Millisecond))
+	s.Frame("CP001", DirectionIn, []byte(`[4,"2","NotSupported","no reset",{}]`), t0.Add(3*time.Millisecond))
+	s.Frame("CP001", DirectionOut, []byte(`[2,"3","ClearCache",{}]`), t0.Add(4*time.Millisecond))
+	s.Timeout("CP001", "ClearCache", "3", t0.Add(5*time.Second))
+	s.Frame("CP001", DirectionIn, []byte(`garbage`), t0.Add(6*time.Second))
+	var nilStream *Stream
+	nilStream.Frame("CP001", DirectionIn, []byte(`[2,"1","Heartbeat",{}]`), t0)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sink.batches) != 3 || len(sink.batches[0]) != 3 || len(sink.batches[2]) != 1 {
+		t.Fatalf("unexpected batches %v", sink.batches)
+	}
+	var envelopes []*Envelope
+	for _, batch := range sink.batches {
+		envelopes = append(envelopes, batch...)
+	}
+	tests := []struct {
+		direction, messageType, action, uniqueID, payload string
+		callTimestamp                                     time.Time
+	}{
+		{DirectionIn, "Call", "Authorize", "1", `{"idTag":"tag"}`, time.Time{}},
+		{DirectionOut, "CallResult", "Authorize", "1", `{"idTagInfo":{"status":"Accepted"}}`, t0},
+		{DirectionOut, "Call", "Reset", "2", `{"type":"Soft"}`, time.Time{}},
+		{DirectionIn, "CallError", "Reset", "2", `{"errorCode":"NotSupported","errorDescription":"no reset","errorDetails":{}}`, t0.Add(2 * time.Millisecond)},
+		{DirectionOut, "Call", "ClearCache", "3", `{}`, time.Time{}},
+		{DirectionOut, MessageTypeTimeout, "ClearCache", "3", ``, t0.Add(4 * time.Millisecond)},
+		{DirectionIn, MessageTypeInvalid, "", "", `"garbage"`, time.Time{}},
+	}
+	for i, tt := range tests {
+		e := envelopes[i]
+		if e.ID != "CP001" || e.Node != "node1" || e.Direction != tt.direction || e.MessageType != tt.messageType ||
+			e.Action != tt.action || e.UniqueID != tt.uniqueID || string(e.Payload) != tt.payload {
+			t.Errorf("%d: unexpected envelope %+v, payload %s", i, e, e.Payload)
+		}
+		if (e.CallTimestamp == nil) != tt.callTimestamp.IsZero() || (e.CallTimestamp != nil && !e.CallTimestamp.Equal(tt.callTimestamp)) {
+			t.Errorf("%d: unexpected call timestamp %v", i, e.CallTimestamp)
+		}
+	}
+	if len(s.calls) != 0 {
+		t.Errorf("calls not forgotten %v", s.calls)
+	}
+}
+
+func TestDrop(t *testing.T) {
+	block := make(chan struct{})
+	sink := &blockingSink{block: block}
+	s := NewStream(Options{BufferSize: 2, BatchSize: 1}, sink)
+	for i := 0; i < 10; i++ {
+		s.Frame("CP001", DirectionIn, []byte(`[2,"`+strconv.Itoa(i)+`","Heartbeat",{}]`), time.Now())
+	}
+	//the frames beyond the queue and the backlog of the sink are dropped
+	for deadline := time.Now().Add(5 * time.Second); s.Dropped()+s.Failed() == 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("no frame dropped")
+		}
+	}
+	close(block)
+	s.Close()
+}
+
+//a sink that does not return holds back neither the other sinks nor the queue
+func TestSlowSink(t *testing.T) {
+	block := make(chan struct{})
+	sink := &memorySink{}
+	s := NewStream(Options{BufferSize: 4, BatchSize: 1, Retries: 1}, &blockingSink{block: block}, sink)
+	for i := 0; i < 20; i++ {
+		s.Frame("CP001", DirectionIn, []byte(`[2,"`+strconv.Itoa(i)+`","Heartbeat",{}]`), time.Now())
+		time.Sleep(time.Millisecond)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		sink.mu.Lock()
+		n := len(sink.batches)
+		sink.mu.Unlock()
+		if n == 20 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d of 20 batches written, %d dropped", n, s.Dropped())
+		}
+	}
+	if s.Failed() == 0 {
+		t.Error("the batches of the blocked sink were not dropped")
+	}
+	close(block)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type blockingSink struct {
+	block chan struct{}
+}
+
+func (b *blockingSink) Write(ctx context.Context, batch []*Envelope) error {
+	<-b.block
+	return nil
+}
+
+func (b *blockingSink) Close() error {
+	return nil
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(dir, 0, 0)
+	at := time.Now()
+	if err := sink.Write(context.Background(), []*Envelope{
+		{ID: "CP001", Direction: DirectionIn, MessageType: "Call", Action: "Heartbeat", UniqueID: "1", Payload: json.RawMessage(`{}`), Timestamp: at},
+		{ID: "CP002", Direction: DirectionIn, MessageType: "Call", Action: "Heartbeat", UniqueID: "2", Payload: json.RawMessage(`{}`), Timestamp: at},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sink.Close()
+	data, err := os.ReadFile(filepath.Join(dir, at.Format("events_2006-01-02T15")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], `"id":"CP002"`) {
+		t.Fatalf("unexpected file %s", data)
+	}
+}
+
+//fakeKafka is a broker with two partitions, it fails the first produce to partition 0 with NOT_ENOUGH_REPLICAS
+type fakeKafka struct {
+	t          *testing.T
+	l          net.Listener
+	mu         sync.Mutex
+	failed     bool
+	records    map[int32][]string //partition -> key=value
+	producerID int64
+}
+
+func (f *fakeKafka) serve() {
+	for {
+		conn, err := f.l.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+//versions are the apis the broker supports, the versions the header of the requests and the responses are not flexible
+//in but ApiVersions
+var versions = []kmsg.ApiVersionsResponseApiKey{
+	{ApiKey: kmsg.Produce.Int16(), MinVersion: 3, MaxVersion: 7},
+	{ApiKey: kmsg.Metadata.Int16(), MinVersion: 1, MaxVersion: 7},
+	{ApiKey: kmsg.ApiVersions.Int16(), MinVersion: 0, MaxVersion: 3},
+	{ApiKey: kmsg.InitProducerID.Int16(), MinVersion: 0, MaxVersion: 1},
+}
+
+func (f *fakeKafka) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		var size int32
+		if binary.Read(r, binary.BigEndian, &size) != nil {
+			return
+		}
+		b := make([]byte, size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return
+		}
+		apiKey, version, correlationID := int16(binary.BigEndian.Uint16(b)), int16(binary.BigEndian.Uint16(b[2:])), b[4:8]
+		req := kmsg.RequestForKey(apiKey)
+		if req == nil {
+			f.t.Errorf("unexpected api %d", apiKey)
+			return
+		}
+		req.SetVersion(version)
+		b = b[8:]
+		if clientID := int16(binary.BigEndian.Uint16(b)); clientID > 0 {
+			b = b[clientID:]
+		}
+		b = b[2:]
+		if req.IsFlexible() {
+			b = b[1:] //no tagged fields
+		}
+		if err := req.ReadFrom(b); err != nil {
+			f.t.Errorf("api %d version %d, %v", apiKey, version, err)
+			return
+		}
+		resp := req.ResponseKind()
+		resp.SetVersion(version)
+		switch req := req.(type) {
+		case *kmsg.ApiVersionsRequest:
+			resp.(*kmsg.ApiVersionsResponse).ApiKeys = versions
+		case *kmsg.MetadataRequest:
+			host, port, _ := net.SplitHostPort(f.l.Addr().String())
+			p, _ := strconv.Atoi(port)
+			res := resp.(*kmsg.MetadataResponse)
+			res.Brokers = []kmsg.MetadataResponseBroker{{NodeID: 1, Host: host, Port: int32(p)}}
+			res.ControllerID = 1
+			for _, topic := range req.Topics {
+				t := kmsg.MetadataResponseTopic{Topic: topic.Topic}
+				for partition := int32(0); partition < 2; partition++ {
+					t.Partitions = append(t.Partitions, kmsg.MetadataResponseTopicPartition{Partition: partition, Leader: 1, Replicas: []int32{1}, ISR: []int32{1}})
+				}
+				res.Topics = append(res.Topics, t)
+			}
+		case *kmsg.InitProducerIDRequest:
+			f.mu.Lock()
+			f.producerID = 7
+			f.mu.Unlock()
+			resp.(*kmsg.InitProducerIDResponse).ProducerID = 7
+		case *kmsg.ProduceRequest:
+			if req.Acks != -1 {
+				f.t.Errorf("acks %d", req.Acks)
+			}
+			res := resp.(*kmsg.ProduceResponse)
+			for _, topic := range req.Topics {
+				t := kmsg.ProduceResponseTopic{Topic: topic.Topic}
+				for _, partition := range topic.Partitions {
+					p := kmsg.ProduceResponseTopicPartition{Partition: partition.Partition}
+					f.mu.Lock()
+					if partition.Partition == 0 && !f.failed {
+						f.failed, p.ErrorCode = true, 19
+					} else {
+						f.records[partition.Partition] = append(f.records[partition.Partition], f.decodeBatch(partition.Records)...)
+					}
+					f.mu.Unlock()
+					t.Partitions = append(t.Partitions, p)
+				}
+				res.Topics = append(res.Topics, t)
+			}
+		}
+		out := append(make([]byte, 4), correlationID...)
+		if resp.IsFlexible() && apiKey != kmsg.ApiVersions.Int16() {
+			out = append(out, 0)
+		}
+		out = resp.AppendTo(out)
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+		conn.Write(out)
+	}
+}
+
+//decodeBatch returns the key=uniqueid of the records of an idempotent record batch
+func (f *fakeKafka) decodeBatch(b []byte) []string {
+	var batch kmsg.RecordBatch
+	if err := batch.ReadFrom(b); err != nil {
+		f.t.Error(err)
+		return nil
+	}
+	if batch.ProducerID != f.producerID || batch.ProducerID < 0 {
+		f.t.Errorf("producer id %d, want %d", batch.ProducerID, f.producerID)
+	}
+	raw := batch.Records
+	switch codec := batch.Attributes & 7; codec {
+	case 0:
+	case 2:
+		var err error
+		if raw, err = s2.Decode(nil, raw); err != nil {
+			f.t.Error(err)
+			return nil
+		}
+	default:
+		f.t.Errorf("unexpected compression %d", codec)
+		return nil
+	}
+	var records []string
+	for i := int32(0); i < batch.NumRecords; i++ {
+		var record kmsg.Record
+		if err := record.ReadFrom(raw); err != nil {
+			f.t.Error(err)
+			return records
+		}
+		length, n := binary.Varint(raw)
+		raw = raw[n+int(length):]
+		var e Envelope
+		if err := json.Unmarshal(record.Value, &e); err != nil {
+			f.t.Errorf("invalid value %s", record.Value)
+		}
+		records = append(records, string(record.Key)+"="+e.UniqueID)
+	}
+	return records
+}
+
+func TestKafkaSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	f := &fakeKafka{t: t, l: l, records: make(map[int32][]string)}
+	go f.serve()
+	sink, err := NewKafkaSink(KafkaConfig{Brokers: []string{l.Addr().String()}, Topic: "ocpp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	var batch []*Envelope
+	for i := 0; i < 20; i++ {
+		batch = append(batch, &Envelope{ID: "CP00" + strconv.Itoa(i%4), UniqueID: strconv.Itoa(i), Timestamp: time.Now()})
+	}
+	if err = sink.Write(context.Background(), batch); err != nil {
+		t.Fatal(err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.failed {
+		t.Error("produce not retried")
+	}
+	total := 0
+	partitions := map[string]int32{}
+	for partition, records := range f.records {
+		total += len(records)
+		//the records of a charging point are in one partition and in order
+		last := map[string]int{}
+		for _, record := range records {
+			id, uniqueid, _ := strings.Cut(record, "=")
+			if p, ok := partitions[id]; ok && p != partition {
+				t.Errorf("%s in partitions %d and %d", id, p, partition)
+			}
+			partitions[id] = partition
+			n, _ := strconv.Atoi(uniqueid)
+			if prev, ok := last[id]; ok && n < prev {
+				t.Errorf("partition %d: %s out of order", partition, record)
+			}
+			last[id] = n
+		}
+	}
+	if total != 20 {
+		t.Fatalf("%d records, want 20: %v", total, f.records)
+	}
+}
+
+func TestKafkaConfig(t *testing.T) {
+	for _, conf := range []KafkaConfig{
+		{Topic: "ocpp"},
+		{Brokers: []string{"127.0.0.1:9092"}, Topic: "ocpp", Acks: 2},
+		{Brokers: []string{"127.0.0.1:9092"}, Topic: "ocpp", SASL: "GSSAPI"},
+	} {
+		if _, err := NewKafkaSink(conf); err == nil {
+			t.Errorf("%+v accepted", conf)
+		}
+	}
+	sink, err := NewKafkaSink(KafkaConfig{Brokers: []string{"127.0.0.1:9092"}, Topic: "ocpp", Acks: 1, SASL: "scram-sha-512", Username: "ocpp", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Close()
+}
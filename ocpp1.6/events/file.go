@@ -0,0 +1,49 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"ocpp16/logwriter"
+)
+
+//FileSink writes the envelopes as json lines to hourly files of a directory, events_2006-01-02T15
+type FileSink struct {
+	w io.WriteCloser
+}
+
+//NewFileSink keeps at most maxFiles files and maxDiskUsage bytes in dir, 0 is no limit
+func NewFileSink(dir string, maxFiles int64, maxDiskUsage int64) *FileSink {
+	return &FileSink{w: &logwriter.HourlySplit{
+		Dir:           dir,
+		FileFormat:    "events_2006-01-02T15",
+		MaxFileNumber: maxFiles,
+		MaxDiskUsage:  maxDiskUsage,
+	}}
+}
+
+//NewWriterSink writes the envelopes as json lines to w
+func NewWriterSink(w io.WriteCloser) *FileSink {
+	return &FileSink{w: w}
+}
+
+func (f *FileSink) Write(ctx context.Context, batch []*Envelope) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, e := range batch {
+		if err := encoder.Encode(e); err != nil {
+			return err
+		}
+	}
+	n, err := f.w.Write(buf.Bytes())
+	if err == nil && n < buf.Len() {
+		err = io.ErrShortWrite
+	}
+	return err
+}
+
+func (f *FileSink) Close() error {
+	return f.w.Close()
+}
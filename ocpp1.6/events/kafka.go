@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+type KafkaConfig struct {
+	Brokers  []string //host:port of the bootstrap brokers
+	Topic    string
+	ClientID string        //"ocpp16" if empty
+	Acks     int16         //-1 waits for all the in-sync replicas and makes the producer idempotent, 1 for the leader only, -1 if 0
+	Timeout  time.Duration //of the delivery of a record, its retries included, 10s if 0
+	Retries  int           //attempts of a record the brokers failed before the write fails, 10 if 0
+	TLS      *tls.Config   //nil is plaintext
+	SASL     string        //PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, no authentication if empty
+	Username string
+	Password string
+}
+
+//KafkaSink produces the envelopes to a kafka topic, the charging point id is the key of the record and picks the
+//partition, so the envelopes of a charging point keep their order. the records are retried on the retriable errors
+//of the brokers, without duplicates as the producer is idempotent unless Acks is 1
+type KafkaSink struct {
+	conf   KafkaConfig
+	client *kgo.Client
+}
+
+//NewKafkaSink connects to the brokers at the first write
+func NewKafkaSink(conf KafkaConfig) (*KafkaSink, error) {
+	if len(conf.Brokers) == 0 || conf.Topic == "" {
+		return nil, errors.New("kafka brokers and topic required")
+	}
+	if conf.ClientID == "" {
+		conf.ClientID = "ocpp16"
+	}
+	if conf.Acks == 0 {
+		conf.Acks = -1
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	if conf.Retries <= 0 {
+		conf.Retries = 10
+	}
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(conf.Brokers...),
+		kgo.ClientID(conf.ClientID),
+		kgo.DefaultProduceTopic(conf.Topic),
+		kgo.RecordDeliveryTimeout(conf.Timeout),
+		kgo.RecordRetries(conf.Retries),
+	}
+	switch conf.Acks {
+	case -1:
+		opts = append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	case 1:
+		//without idempotence one request in flight keeps the order of the retried records
+		opts = append(opts, kgo.RequiredAcks(kgo.LeaderAck()), kgo.DisableIdempotentWrite(), kgo.MaxProduceRequestsInflightPerBroker(1))
+	default:
+		return nil, fmt.Errorf("not support kafka acks(%d) current", conf.Acks)
+	}
+	if conf.TLS != nil {
+		opts = append(opts, kgo.DialTLSConfig(conf.TLS))
+	}
+	if conf.SASL != "" {
+		mechanism, err := saslMechanism(conf.SASL, conf.Username, conf.Password)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSink{conf: conf, client: client}, nil
+}
+
+func saslMechanism(name string, username string, password string) (sasl.Mechanism, error) {
+	switch strings.ToUpper(name) {
+	case "PLAIN":
+		return plain.Auth{User: username, Pass: password}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: username, Pass: password}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: username, Pass: password}.AsSha512Mechanism(), nil
+	}
+	return nil, fmt.Errorf("not support kafka sasl mechanism(%s) current", name)
+}
+
+func (k *KafkaSink) Write(ctx context.Context, batch []*Envelope) error {
+	records := make([]*kgo.Record, 0, len(batch))
+	for _, e := range batch {
+		value, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		records = append(records, &kgo.Record{Key: []byte(e.ID), Value: value, Timestamp: e.Timestamp})
+	}
+	if err := k.client.ProduceSync(ctx, records...).FirstErr(); err != nil {
+		return fmt.Errorf("produce to kafka topic(%s) failed, %w", k.conf.Topic, err)
+	}
+	return nil
+}
+
+func (k *KafkaSink) Close() error {
+	k.client.Close()
+	return nil
+}
@@ -0,0 +1,93 @@
+package filetransfer
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+type urlReply struct {
+	URL     string    `json:"url"`
+	Expires time.Time `json:"expires"`
+}
+
+//RegisterAPI serves the files to the operators on r, e.g. the group of the rest api:
+//
+//	GET    /files/firmware                                the images with their size, md5 and sha256
+//	PUT    /files/firmware/:name                          stores the body as the image name
+//	DELETE /files/firmware/:name
+//	GET    /files/firmware/:name/url?scheme=              a signed url of the image, to build a call by hand
+//	GET    /files/diagnostics?chargePointId=              the GetDiagnostics calls with the files uploaded and the last notification
+//	GET    /files/diagnostics/:chargePointId/:name        an uploaded file
+func (s *Service) RegisterAPI(r gin.IRouter) {
+	r.GET("/files/firmware", s.listFirmware)
+	r.PUT("/files/firmware/:name", s.putFirmware)
+	r.DELETE("/files/firmware/:name", s.deleteFirmware)
+	r.GET("/files/firmware/:name/url", s.firmwareURL)
+	r.GET("/files/diagnostics", s.listDiagnostics)
+	r.GET("/files/diagnostics/:chargePointId/:name", s.getDiagnostics)
+}
+
+func (s *Service) listFirmware(c *gin.Context) {
+	images, err := s.Firmware()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, images)
+}
+
+func (s *Service) putFirmware(c *gin.Context) {
+	image, err := s.PutFirmware(c.Param("name"), c.Request.Body)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrInvalidName) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, image)
+}
+
+func (s *Service) deleteFirmware(c *gin.Context) {
+	if err := s.DeleteFirmware(c.Param("name")); err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (s *Service) firmwareURL(c *gin.Context) {
+	scheme := c.DefaultQuery("scheme", s.conf.Scheme)
+	expires := s.now().Add(s.conf.TTL)
+	if _, err := s.base(scheme); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	u, err := s.FirmwareURL(c.Param("name"), scheme, expires)
+	if err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, urlReply{URL: u, Expires: expires.UTC().Truncate(time.Second)})
+}
+
+func (s *Service) listDiagnostics(c *gin.Context) {
+	c.JSON(http.StatusOK, s.Diagnostics(c.Query("chargePointId")))
+}
+
+func (s *Service) getDiagnostics(c *gin.Context) {
+	path, err := s.DiagnosticsFile(c.Param("chargePointId"), c.Param("name"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	c.FileAttachment(path, c.Param("name"))
+}
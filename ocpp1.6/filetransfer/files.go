@@ -0,0 +1,158 @@
+//Package filetransfer serves the firmware images and receives the diagnostics of the charging points over http and ftp,
+//so the locations of UpdateFirmware and GetDiagnostics need no other infrastructure. the locations are signed urls
+//valid for a limited time, issued when the call is built
+package filetransfer
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"ocpp16/internal/atomicfile"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	firmwareDir    = "firmware"
+	diagnosticsDir = "diagnostics"
+)
+
+var (
+	ErrInvalidName = errors.New("invalid file name")
+	ErrForbidden   = errors.New("invalid or expired signature")
+	ErrTooLarge    = errors.New("file too large")
+)
+
+//Firmware is an image of the firmware directory
+type Firmware struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	MD5        string    `json:"md5"`
+	SHA256     string    `json:"sha256"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+}
+
+//File is a diagnostics file uploaded by a charging point
+type File struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	SHA256     string    `json:"sha256"`
+	Protocol   string    `json:"protocol"` //http or ftp
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+//Diagnostics statuses besides the DiagnosticsStatus of the notifications
+const (
+	Requested = "Requested" //GetDiagnostics built, no notification yet
+)
+
+//Diagnostics is a GetDiagnostics call, the files uploaded for it and the DiagnosticsStatusNotifications that followed
+type Diagnostics struct {
+	ChargePoint string    `json:"chargePointId"`
+	UniqueID    string    `json:"uniqueId"` //of the GetDiagnostics call
+	Location    string    `json:"location"`
+	RequestedAt time.Time `json:"requestedAt"`
+	Status      string    `json:"status"` //Requested, Uploading, Uploaded or UploadFailed
+	Files       []File    `json:"files"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+//open reports whether the upload has not been notified Uploaded or UploadFailed yet
+func (d *Diagnostics) open() bool {
+	return d.Status == Requested || d.Status == "Uploading"
+}
+
+//validName accepts the names of a single path element
+func validName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/\\\x00") {
+		return fmt.Errorf("%w(%s)", ErrInvalidName, name)
+	}
+	return nil
+}
+
+//sign returns the signature of scope, e.g. firmware/<name> or diagnostics/<chargePointId>, until expires
+func sign(secret []byte, scope string, expires int64) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(scope + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func verify(secret []byte, scope string, expires string, signature string, now time.Time) error {
+	e, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > e || !hmac.Equal([]byte(sign(secret, scope, e)), []byte(signature)) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+//checksum computes the checksums of the file path
+func checksum(path string) (md5sum string, sha256sum string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+	m, s := md5.New(), sha256.New()
+	if _, err = io.Copy(io.MultiWriter(m, s), f); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(m.Sum(nil)), hex.EncodeToString(s.Sum(nil)), nil
+}
+
+func loadDiagnostics(path string) ([]*Diagnostics, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var diagnostics []*Diagnostics
+	if err = json.Unmarshal(data, &diagnostics); err != nil {
+		return nil, fmt.Errorf("diagnostics file(%s) corrupted, err(%v)", path, err)
+	}
+	return diagnostics, nil
+}
+
+//saveDiagnostics replaces the diagnostics file
+func saveDiagnostics(path string, diagnostics []*Diagnostics) error {
+	data, err := json.Marshal(diagnostics)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
+
+//receive writes r to a new file of dir named after name, a name already taken gets the time as prefix. r longer than
+//max bytes fails with ErrTooLarge
+func receive(dir string, name string, r io.Reader, max int64, now time.Time) (File, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return File{}, err
+	}
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err == nil {
+		name = now.UTC().Format("20060102T150405.000Z") + "-" + name
+		path = filepath.Join(dir, name)
+	}
+	s := sha256.New()
+	var size int64
+	err := atomicfile.Write(path, func(w io.Writer) error {
+		var err error
+		if size, err = io.Copy(io.MultiWriter(w, s), io.LimitReader(r, max+1)); err == nil && size > max {
+			err = fmt.Errorf("%w, more than %d bytes", ErrTooLarge, max)
+		}
+		return err
+	})
+	if err != nil {
+		return File{}, err
+	}
+	return File{Name: name, Size: size, SHA256: hex.EncodeToString(s.Sum(nil)), ReceivedAt: now}, nil
+}
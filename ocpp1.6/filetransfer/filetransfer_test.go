@@ -0,0 +1,250 @@
+package filetransfer
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newService(t *testing.T) (*Service, *httptest.Server, net.Listener) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	web := httptest.NewServer(engine)
+	t.Cleanup(web.Close)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	s, err := NewService(Config{Dir: t.TempDir(), HTTPURL: web.URL, FTPURL: "ftp://" + l.Addr().String(), Secret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.RegisterHandlers(engine)
+	s.RegisterAPI(engine.Group("/api"))
+	go s.ServeFTP(l)
+	return s, web, l
+}
+
+func build(t *testing.T, s *Service, request protocol.Request) (*protocol.Call, error) {
+	call := &protocol.Call{MessageTypeID: protocol.CALL, UniqueID: protocol.NewUniqueID(), Action: request.Action(), Request: request}
+	return call, s.Build("CP001", call)
+}
+
+func TestHTTP(t *testing.T) {
+	s, web, _ := newService(t)
+	if _, err := s.PutFirmware("ac7-2.0.bin", strings.NewReader("firmware")); err != nil {
+		t.Fatal(err)
+	}
+	retrieve := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	if _, err := build(t, s, protocol.UpdateFirmwareRequest{Location: "local:missing.bin", RetrieveDate: retrieve}); err == nil {
+		t.Fatal("url of a missing firmware issued")
+	}
+	call, err := build(t, s, protocol.UpdateFirmwareRequest{Location: "local:ac7-2.0.bin", RetrieveDate: retrieve})
+	if err != nil {
+		t.Fatal(err)
+	}
+	location := call.Request.(protocol.UpdateFirmwareRequest).Location
+	//valid from the retrieveDate on
+	if u, _ := url.Parse(location); u.Query().Get("expires") < fmt.Sprint(time.Now().Add(3*time.Hour-time.Minute).Unix()) {
+		t.Fatalf("url expires before the retrieveDate, %s", location)
+	}
+	res, err := http.Get(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || string(body) != "firmware" || res.Header.Get("Content-MD5") != "dLW16VcO/FwFU7syfNQZQA==" {
+		t.Fatalf("download got %d %s %v", res.StatusCode, body, res.Header)
+	}
+	if res, _ = http.Get(strings.Replace(location, "ac7-2.0.bin", "other.bin", 1)); res.StatusCode != http.StatusForbidden {
+		t.Fatalf("url of another file got %d", res.StatusCode)
+	}
+
+	//the upload is linked to the GetDiagnostics and to the notification that follows
+	if call, err = build(t, s, protocol.GetDiagnosticsRequest{Location: "local:"}); err != nil {
+		t.Fatal(err)
+	}
+	location = call.Request.(protocol.GetDiagnosticsRequest).Location
+	plugin := s.Wrap(local.NewActionPlugin())
+	notify := func(status protocol.DiagnosticsStatus) {
+		handler, _ := plugin.RequestHandler(protocol.DiagnosticsStatusNotificationName)
+		handler(context.Background(), "CP001", protocol.NewUniqueID(), &protocol.DiagnosticsStatusNotificationRequest{Status: status})
+	}
+	notify(protocol.DiagnosticsStatusUploading)
+	u, _ := url.Parse(location)
+	u.Path += "/diag.zip"
+	req, _ := http.NewRequest(http.MethodPut, u.String(), strings.NewReader("logs"))
+	if res, err = http.DefaultClient.Do(req); err != nil || res.StatusCode != http.StatusCreated {
+		t.Fatalf("upload got %v(%v)", res, err)
+	}
+	if res, _ = http.Post(strings.Replace(location, "CP001", "CP002", 1), "text/plain", strings.NewReader("logs")); res.StatusCode != http.StatusForbidden {
+		t.Fatalf("upload to another charging point got %d", res.StatusCode)
+	}
+	notify(protocol.DiagnosticsStatusUploaded)
+	diagnostics := s.Diagnostics("CP001")
+	if len(diagnostics) != 1 || diagnostics[0].UniqueID != call.UniqueID || diagnostics[0].Status != "Uploaded" || len(diagnostics[0].Files) != 1 || diagnostics[0].Files[0].Name != "diag.zip" {
+		t.Fatalf("unexpected diagnostics %+v", diagnostics)
+	}
+	if res, _ = http.Get(web.URL + "/api/files/diagnostics/CP001/diag.zip"); res.StatusCode != http.StatusOK {
+		t.Fatalf("download of the diagnostics got %d", res.StatusCode)
+	}
+
+	//expired
+	s.now = func() time.Time { return time.Now().Add(4 * time.Hour) }
+	if res, _ = http.Post(location, "text/plain", strings.NewReader("logs")); res.StatusCode != http.StatusForbidden {
+		t.Fatalf("expired url got %d", res.StatusCode)
+	}
+	//the diagnostics survive a restart
+	if s, err = NewService(s.conf); err != nil || len(s.Diagnostics("")) != 1 {
+		t.Fatalf("diagnostics not loaded %+v(%v)", s.Diagnostics(""), err)
+	}
+}
+
+//ftpClient is a passive mode client
+type ftpClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (c *ftpClient) cmd(line string, expected string) string {
+	if line != "" {
+		fmt.Fprintf(c.conn, "%s\r\n", line)
+	}
+	reply, err := c.r.ReadString('\n')
+	if err != nil || !strings.HasPrefix(reply, expected) {
+		c.t.Fatalf("%s got %q(%v), expected %s", line, reply, err, expected)
+	}
+	return reply
+}
+
+func (c *ftpClient) data() net.Conn {
+	reply := c.cmd("EPSV", "229")
+	port := strings.Trim(reply[strings.Index(reply, "(")+1:strings.Index(reply, ")")], "|")
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", port))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return conn
+}
+
+func dial(t *testing.T, location string) (*ftpClient, *url.URL) {
+	u, _ := url.Parse(location)
+	conn, err := net.Dial("tcp", u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	c := &ftpClient{t: t, conn: conn, r: bufio.NewReader(conn)}
+	c.cmd("", "220")
+	password, _ := u.User.Password()
+	c.cmd("USER "+u.User.Username(), "331")
+	c.cmd("PASS "+password, "230")
+	c.cmd("TYPE I", "200")
+	return c, u
+}
+
+func TestFTP(t *testing.T) {
+	s, _, _ := newService(t)
+	s.PutFirmware("ac7-2.0.bin", strings.NewReader("firmware"))
+	call, err := build(t, s, protocol.UpdateFirmwareRequest{Location: "local:ac7-2.0.bin?scheme=ftp", RetrieveDate: time.Now().UTC().Format(time.RFC3339)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, u := dial(t, call.Request.(protocol.UpdateFirmwareRequest).Location)
+	c.cmd("SIZE "+u.Path, "213 8")
+	conn := c.data()
+	c.cmd("RETR "+u.Path, "150")
+	data, _ := io.ReadAll(conn)
+	c.cmd("", "226")
+	if string(data) != "firmware" {
+		t.Fatalf("retrieved %q", data)
+	}
+	c.cmd("RETR /diagnostics.json", "550")
+	c.cmd("SIZE /firmware/other.bin", "550")
+
+	if call, err = build(t, s, protocol.GetDiagnosticsRequest{Location: "local:?scheme=ftp"}); err != nil {
+		t.Fatal(err)
+	}
+	c, u = dial(t, call.Request.(protocol.GetDiagnosticsRequest).Location)
+	c.cmd("CWD "+u.Path, "250")
+	c.cmd("STOR ../CP002/diag.zip", "550")
+	conn = c.data()
+	c.cmd("STOR diag.zip", "150")
+	conn.Write([]byte("logs"))
+	conn.Close()
+	c.cmd("", "226")
+	c.cmd("QUIT", "221")
+	diagnostics := s.Diagnostics("CP001")
+	if len(diagnostics) != 1 || len(diagnostics[0].Files) != 1 || diagnostics[0].Files[0].Protocol != "ftp" {
+		t.Fatalf("unexpected diagnostics %+v", diagnostics)
+	}
+	if data, _ = os.ReadFile(filepath.Join(s.conf.Dir, diagnosticsDir, "CP001", "diag.zip")); string(data) != "logs" {
+		t.Fatalf("stored %q", data)
+	}
+}
+
+func TestQuota(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	web := httptest.NewServer(engine)
+	t.Cleanup(web.Close)
+	s, err := NewService(Config{Dir: t.TempDir(), HTTPURL: web.URL, MaxUpload: 8, Quota: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.RegisterHandlers(engine)
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(s.conf.Dir, diagnosticsDir, "CP001", name))
+		return err == nil
+	}
+	call, err := build(t, s, protocol.GetDiagnosticsRequest{Location: "local:"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//a file is no larger than MaxUpload, the files of a diagnostics take no more than the quota
+	req, _ := http.NewRequest(http.MethodPut, call.Request.(protocol.GetDiagnosticsRequest).Location, strings.NewReader("123456789"))
+	if res, err := http.DefaultClient.Do(req); err != nil || res.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("upload beyond the limit got %v(%v)", res, err)
+	}
+	for _, c := range []struct {
+		name string
+		data string
+		ok   bool
+	}{{"a", "123456", true}, {"b", "123456", true}, {"c", "1", false}} {
+		if _, err := s.upload("CP001", c.name, "http", strings.NewReader(c.data)); (err == nil) != c.ok || exists(c.name) != c.ok {
+			t.Fatalf("upload of %s got %v", c.name, err)
+		}
+	}
+	//the files of the older diagnostics are deleted to make room for the last ones
+	build(t, s, protocol.GetDiagnosticsRequest{Location: "local:"})
+	if _, err = s.upload("CP001", "d", "http", strings.NewReader("12345678")); err != nil || exists("a") || exists("b") || !exists("d") {
+		t.Fatalf("upload of d got %v", err)
+	}
+	if diagnostics := s.Diagnostics("CP001"); len(diagnostics) != 1 || diagnostics[0].Files[0].Name != "d" {
+		t.Fatalf("unexpected diagnostics %+v", diagnostics)
+	}
+	//and so are the ones of the diagnostics beyond maxDiagnostics
+	for i := 0; i < maxDiagnostics; i++ {
+		build(t, s, protocol.GetDiagnosticsRequest{Location: "local:"})
+	}
+	if exists("d") || len(s.Diagnostics("CP001")) != maxDiagnostics {
+		t.Fatalf("diagnostics file left, %d diagnostics", len(s.Diagnostics("CP001")))
+	}
+}
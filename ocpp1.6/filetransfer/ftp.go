@@ -0,0 +1,372 @@
+package filetransfer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//ftpUser is the user of the signed ftp urls, the password is <expires>-<signature>
+const ftpUser = "ocpp"
+
+const (
+	ftpIdleTimeout = 5 * time.Minute
+	ftpDataTimeout = 30 * time.Second
+)
+
+//ServeFTP serves the signed ftp urls on l until it is closed. it is a minimal ftp server: a session logs in with the
+//credentials of an url, then retrieves the firmware or stores the diagnostics under the path of the url, over a
+//passive or an active data connection
+func (s *Service) ServeFTP(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go (&ftpSession{s: s, conn: conn, r: bufio.NewReader(conn), dir: "/"}).serve()
+	}
+}
+
+type ftpSession struct {
+	s        *Service
+	conn     net.Conn
+	r        *bufio.Reader
+	password string
+	loggedIn bool
+	dir      string
+	passive  net.Listener
+	active   string
+}
+
+func (f *ftpSession) reply(code int, message string) {
+	fmt.Fprintf(f.conn, "%d %s\r\n", code, message)
+}
+
+func (f *ftpSession) serve() {
+	defer f.conn.Close()
+	defer f.closeData()
+	f.reply(220, "ocpp16 file server ready")
+	for {
+		f.conn.SetReadDeadline(time.Now().Add(ftpIdleTimeout))
+		line, err := f.r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command, arg := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			command, arg = line[:i], line[i+1:]
+		}
+		command = strings.ToUpper(command)
+		if !f.loggedIn {
+			switch command {
+			case "USER", "PASS", "QUIT", "FEAT", "SYST", "OPTS":
+			default:
+				f.reply(530, "Please login with USER and PASS")
+				continue
+			}
+		}
+		if !f.handle(command, arg) {
+			return
+		}
+	}
+}
+
+//handle runs a command, it returns false when the session ends
+func (f *ftpSession) handle(command string, arg string) bool {
+	switch command {
+	case "USER":
+		f.loggedIn = false
+		f.reply(331, "Password required")
+	case "PASS":
+		expires, _, _ := strings.Cut(arg, "-")
+		if e, err := strconv.ParseInt(expires, 10, 64); err != nil || f.s.now().Unix() > e {
+			f.reply(530, "Login incorrect")
+			return true
+		}
+		f.password, f.loggedIn = arg, true
+		f.reply(230, "Logged in")
+	case "QUIT":
+		f.reply(221, "Bye")
+		return false
+	case "SYST":
+		f.reply(215, "UNIX Type: L8")
+	case "FEAT":
+		fmt.Fprintf(f.conn, "211-Features:\r\n SIZE\r\n PASV\r\n EPSV\r\n UTF8\r\n211 End\r\n")
+	case "OPTS", "NOOP", "TYPE":
+		f.reply(200, "OK")
+	case "MODE", "STRU":
+		if arg != "S" && arg != "F" {
+			f.reply(504, "Not implemented for this parameter")
+			return true
+		}
+		f.reply(200, "OK")
+	case "PWD", "XPWD":
+		f.reply(257, fmt.Sprintf("%q is the current directory", f.dir))
+	case "CWD", "XCWD", "CDUP", "MKD", "XMKD":
+		if command == "CDUP" {
+			arg = ".."
+		}
+		dir := f.resolve(arg)
+		if !f.directory(dir) {
+			f.reply(550, "No such directory")
+			return true
+		}
+		if command == "MKD" || command == "XMKD" {
+			f.reply(257, fmt.Sprintf("%q exists", dir))
+			return true
+		}
+		f.dir = dir
+		f.reply(250, "OK")
+	case "PASV", "EPSV":
+		f.listen(command == "EPSV")
+	case "PORT", "EPRT":
+		//only to the charging point itself, no bounce to a third host
+		if addr, ok := activeAddr(command, arg); ok && f.remote(addr) {
+			f.closeData()
+			f.active = addr
+			f.reply(200, "OK")
+		} else {
+			f.reply(501, "Invalid address")
+		}
+	case "SIZE":
+		if image, ok := f.image(arg); ok {
+			f.reply(213, strconv.FormatInt(image.Size, 10))
+		}
+	case "RETR":
+		f.retrieve(arg)
+	case "STOR":
+		f.store(arg)
+	case "LIST", "NLST":
+		//nothing is listed, some charging points list the directory before they store
+		if conn, ok := f.data(); ok {
+			conn.Close()
+			f.reply(226, "Transfer complete")
+		}
+	default:
+		f.reply(502, "Command not implemented")
+	}
+	return true
+}
+
+func (f *ftpSession) resolve(p string) string {
+	if !path.IsAbs(p) {
+		p = path.Join(f.dir, p)
+	}
+	return path.Clean(p)
+}
+
+//directory reports whether dir is /, /firmware, /diagnostics or /diagnostics/<chargePointId>
+func (f *ftpSession) directory(dir string) bool {
+	parts := strings.Split(strings.Trim(dir, "/"), "/")
+	switch {
+	case dir == "/" || (len(parts) == 1 && (parts[0] == firmwareDir || parts[0] == diagnosticsDir)):
+		return true
+	case len(parts) == 2 && parts[0] == diagnosticsDir:
+		return validName(parts[1]) == nil
+	}
+	return false
+}
+
+//authorize checks the password of the session for scope
+func (f *ftpSession) authorize(scope string) bool {
+	expires, signature, _ := strings.Cut(f.password, "-")
+	if err := verify(f.s.secret, scope, expires, signature, f.s.now()); err != nil {
+		f.reply(550, "Permission denied")
+		return false
+	}
+	return true
+}
+
+//image returns the firmware of the path p when the session may retrieve it
+func (f *ftpSession) image(p string) (Firmware, bool) {
+	dir, name := path.Split(f.resolve(p))
+	if path.Clean(dir) != "/"+firmwareDir {
+		f.reply(550, "No such file")
+		return Firmware{}, false
+	}
+	if !f.authorize(firmwareDir + "/" + name) {
+		return Firmware{}, false
+	}
+	image, err := f.s.Image(name)
+	if err != nil {
+		f.reply(550, "No such file")
+		return Firmware{}, false
+	}
+	return image, true
+}
+
+func (f *ftpSession) retrieve(p string) {
+	image, ok := f.image(p)
+	if !ok {
+		return
+	}
+	file, err := os.Open(filepath.Join(f.s.conf.Dir, firmwareDir, image.Name))
+	if err != nil {
+		f.reply(550, "No such file")
+		return
+	}
+	defer file.Close()
+	conn, ok := f.data()
+	if !ok {
+		return
+	}
+	_, err = io.Copy(conn, file)
+	if cerr := conn.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		f.reply(426, "Transfer aborted")
+		return
+	}
+	f.reply(226, "Transfer complete")
+}
+
+func (f *ftpSession) store(p string) {
+	parts := strings.Split(strings.Trim(f.resolve(p), "/"), "/")
+	if len(parts) != 3 || parts[0] != diagnosticsDir || validName(parts[1]) != nil || validName(parts[2]) != nil || parts[2][0] == '.' {
+		f.reply(553, "File name not allowed")
+		return
+	}
+	if !f.authorize(diagnosticsDir + "/" + parts[1]) {
+		return
+	}
+	conn, ok := f.data()
+	if !ok {
+		return
+	}
+	defer conn.Close()
+	_, err := f.s.upload(parts[1], parts[2], "ftp", conn)
+	if errors.Is(err, ErrTooLarge) {
+		f.reply(552, "Exceeded storage allocation")
+		return
+	}
+	if err != nil {
+		f.reply(451, "Local error in processing")
+		return
+	}
+	f.reply(226, "Transfer complete")
+}
+
+//listen opens the passive data listener on the address the charging point reached, or on the host of the ftp url
+//when it is an ip, the server may be behind a nat
+func (f *ftpSession) listen(extended bool) {
+	f.closeData()
+	ip := f.conn.LocalAddr().(*net.TCPAddr).IP
+	if u, err := url.Parse(f.s.conf.FTPURL); err == nil {
+		if public := net.ParseIP(u.Hostname()); public != nil {
+			ip = public
+		}
+	}
+	l, err := f.s.passiveListener(f.conn.LocalAddr().(*net.TCPAddr).IP)
+	if err != nil {
+		f.reply(425, "Can't open data connection")
+		return
+	}
+	f.passive = l
+	port := l.Addr().(*net.TCPAddr).Port
+	if extended {
+		f.reply(229, fmt.Sprintf("Entering Extended Passive Mode (|||%d|)", port))
+		return
+	}
+	ip4 := ip.To4()
+	if ip4 == nil {
+		l.Close()
+		f.passive = nil
+		f.reply(425, "Use EPSV over ipv6")
+		return
+	}
+	f.reply(227, fmt.Sprintf("Entering Passive Mode (%d,%d,%d,%d,%d,%d)", ip4[0], ip4[1], ip4[2], ip4[3], port>>8, port&0xff))
+}
+
+//passiveListener listens on ip at a port of the passive range
+func (s *Service) passiveListener(ip net.IP) (net.Listener, error) {
+	if s.conf.PassiveMin <= 0 || s.conf.PassiveMax < s.conf.PassiveMin {
+		return net.Listen("tcp", net.JoinHostPort(ip.String(), "0"))
+	}
+	n := s.conf.PassiveMax - s.conf.PassiveMin + 1
+	start := rand.Intn(n)
+	var err error
+	for i := 0; i < n; i++ {
+		port := s.conf.PassiveMin + (start+i)%n
+		var l net.Listener
+		if l, err = net.Listen("tcp", net.JoinHostPort(ip.String(), strconv.Itoa(port))); err == nil {
+			return l, nil
+		}
+	}
+	return nil, err
+}
+
+//activeAddr parses the address of PORT h1,h2,h3,h4,p1,p2 or EPRT |proto|ip|port|
+func activeAddr(command string, arg string) (string, bool) {
+	if command == "EPRT" && len(arg) > 1 {
+		fields := strings.Split(arg[1:], arg[:1])
+		if len(fields) < 3 || net.ParseIP(fields[1]) == nil {
+			return "", false
+		}
+		if _, err := strconv.Atoi(fields[2]); err != nil {
+			return "", false
+		}
+		return net.JoinHostPort(fields[1], fields[2]), true
+	}
+	fields := strings.Split(arg, ",")
+	if len(fields) != 6 {
+		return "", false
+	}
+	var b [6]int
+	for i, field := range fields {
+		v, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || v < 0 || v > 255 {
+			return "", false
+		}
+		b[i] = v
+	}
+	return net.JoinHostPort(fmt.Sprintf("%d.%d.%d.%d", b[0], b[1], b[2], b[3]), strconv.Itoa(b[4]<<8|b[5])), true
+}
+
+//data opens the data connection of a transfer after replying 150
+func (f *ftpSession) data() (net.Conn, bool) {
+	defer f.closeData()
+	var conn net.Conn
+	var err error
+	switch {
+	case f.passive != nil:
+		f.reply(150, "Opening data connection")
+		f.passive.(*net.TCPListener).SetDeadline(time.Now().Add(ftpDataTimeout))
+		conn, err = f.passive.Accept()
+	case f.active != "":
+		f.reply(150, "Opening data connection")
+		conn, err = net.DialTimeout("tcp", f.active, ftpDataTimeout)
+	default:
+		f.reply(425, "Use PASV or PORT first")
+		return nil, false
+	}
+	if err != nil {
+		f.reply(425, "Can't open data connection")
+		return nil, false
+	}
+	return conn, true
+}
+
+func (f *ftpSession) remote(addr string) bool {
+	host, _, _ := net.SplitHostPort(addr)
+	return net.ParseIP(host).Equal(f.conn.RemoteAddr().(*net.TCPAddr).IP)
+}
+
+func (f *ftpSession) closeData() {
+	if f.passive != nil {
+		f.passive.Close()
+		f.passive = nil
+	}
+	f.active = ""
+}
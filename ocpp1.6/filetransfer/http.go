@@ -0,0 +1,105 @@
+package filetransfer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+)
+
+//HTTPPath is where RegisterHandlers serves the signed urls
+const HTTPPath = "/files"
+
+//RegisterHandlers serves the signed urls to the charging points on r, the engine of the server:
+//
+//	GET    /files/firmware/:name                           the image, with its checksums in Content-MD5 and Digest
+//	PUT    /files/diagnostics/:chargePointId[/:name]       the body is the file
+//	POST   /files/diagnostics/:chargePointId[/:name]       the body is the file, or a multipart form holding it
+func (s *Service) RegisterHandlers(r gin.IRouter) {
+	r.GET(HTTPPath+"/firmware/:name", s.download)
+	r.HEAD(HTTPPath+"/firmware/:name", s.download)
+	for _, path := range []string{HTTPPath + "/diagnostics/:chargePointId", HTTPPath + "/diagnostics/:chargePointId/:name"} {
+		r.PUT(path, s.receive)
+		r.POST(path, s.receive)
+	}
+}
+
+func (s *Service) authorize(c *gin.Context, scope string) bool {
+	if err := verify(s.secret, scope, c.Query("expires"), c.Query("signature"), s.now()); err != nil {
+		c.JSON(http.StatusForbidden, errorReply{Error: err.Error()})
+		return false
+	}
+	return true
+}
+
+func (s *Service) download(c *gin.Context) {
+	name := c.Param("name")
+	if !s.authorize(c, firmwareDir+"/"+name) {
+		return
+	}
+	f, err := s.Image(name)
+	if err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	file, err := os.Open(filepath.Join(s.conf.Dir, firmwareDir, name))
+	if err != nil {
+		c.JSON(http.StatusNotFound, errorReply{Error: err.Error()})
+		return
+	}
+	defer file.Close()
+	md5sum, _ := hex.DecodeString(f.MD5)
+	sha256sum, _ := hex.DecodeString(f.SHA256)
+	c.Header("Content-MD5", base64.StdEncoding.EncodeToString(md5sum))
+	c.Header("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sha256sum))
+	c.Header("Content-Type", "application/octet-stream")
+	http.ServeContent(c.Writer, c.Request, name, f.ModifiedAt, file)
+}
+
+func (s *Service) receive(c *gin.Context) {
+	id := c.Param("chargePointId")
+	if !s.authorize(c, diagnosticsDir+"/"+id) {
+		return
+	}
+	name, body := c.Param("name"), io.Reader(c.Request.Body)
+	if name == "" {
+		if _, params, err := mime.ParseMediaType(c.GetHeader("Content-Disposition")); err == nil {
+			name = filepath.Base(params["filename"])
+		}
+	}
+	if reader, err := c.Request.MultipartReader(); err == nil {
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				c.JSON(http.StatusBadRequest, errorReply{Error: "no file in the multipart form"})
+				return
+			}
+			if part.FileName() != "" {
+				if name == "" {
+					name = filepath.Base(part.FileName())
+				}
+				body = part
+				break
+			}
+		}
+	}
+	if name == "" {
+		name = "diagnostics-" + s.now().UTC().Format("20060102T150405Z")
+	}
+	f, err := s.upload(id, name, "http", body)
+	if errors.Is(err, ErrTooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, errorReply{Error: err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, f)
+}
@@ -0,0 +1,484 @@
+package filetransfer
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//LocalScheme marks the locations served by the service: local:<firmware name> in UpdateFirmware and local: in
+//GetDiagnostics are replaced by signed urls when the call is built, local:<name>?scheme=ftp picks the protocol
+const LocalScheme = "local"
+
+//maxDiagnostics is the number of diagnostics kept per charging point
+const maxDiagnostics = 20
+
+type Config struct {
+	Dir        string        //firmware/ holds the images, diagnostics/<chargePointId>/ the uploads
+	HTTPURL    string        //the charging points reach RegisterHandlers at, e.g. http://cs.example.com:8090
+	FTPURL     string        //the charging points reach ServeFTP at, e.g. ftp://cs.example.com:2121
+	Scheme     string        //of the urls issued, http or ftp, http if empty
+	Secret     string        //signs the urls, random if empty so the urls die with the process
+	TTL        time.Duration //an url is valid for after the call or the retrieveDate, 1h if 0
+	MaxUpload  int64         //bytes of a diagnostics file, 64MiB if 0
+	Quota      int64         //bytes of the diagnostics files kept per charging point, 512MiB if 0
+	PassiveMin int           //ports of the ftp passive data connections, any if 0
+	PassiveMax int
+}
+
+type Service struct {
+	conf        Config
+	secret      []byte
+	mu          sync.Mutex
+	firmware    map[string]Firmware //checksums by name, while the size and the modification time hold
+	diagnostics []*Diagnostics
+	now         func() time.Time
+	onError     func(id string, err error)
+}
+
+//NewService creates the directories of conf.Dir and loads the diagnostics received before
+func NewService(conf Config) (*Service, error) {
+	if conf.Dir == "" {
+		return nil, errors.New("file server directory missing")
+	}
+	if conf.Scheme == "" {
+		conf.Scheme = "http"
+	}
+	if conf.TTL <= 0 {
+		conf.TTL = time.Hour
+	}
+	if conf.MaxUpload <= 0 {
+		conf.MaxUpload = 64 << 20
+	}
+	if conf.Quota <= 0 {
+		conf.Quota = 512 << 20
+	}
+	s := &Service{conf: conf, secret: []byte(conf.Secret), firmware: make(map[string]Firmware), now: time.Now}
+	if _, err := s.base(conf.Scheme); err != nil {
+		return nil, err
+	}
+	if len(s.secret) == 0 {
+		s.secret = make([]byte, 32)
+		if _, err := rand.Read(s.secret); err != nil {
+			return nil, err
+		}
+	}
+	for _, dir := range []string{firmwareDir, diagnosticsDir} {
+		if err := os.MkdirAll(filepath.Join(conf.Dir, dir), 0755); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if s.diagnostics, err = loadDiagnostics(s.diagnosticsPath()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+//SetErrorHandler gets the errors of saving the diagnostics
+func (s *Service) SetErrorHandler(fn func(id string, err error)) {
+	s.onError = fn
+}
+
+func (s *Service) handle(id string, err error) {
+	if err != nil && s.onError != nil {
+		s.onError(id, err)
+	}
+}
+
+func (s *Service) diagnosticsPath() string {
+	return filepath.Join(s.conf.Dir, "diagnostics.json")
+}
+
+func (s *Service) base(scheme string) (*url.URL, error) {
+	var base string
+	switch scheme {
+	case "http":
+		base = s.conf.HTTPURL
+	case "ftp":
+		base = s.conf.FTPURL
+	default:
+		return nil, fmt.Errorf("not support file server scheme(%s) current", scheme)
+	}
+	u, err := url.Parse(base)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid %s url(%s) of the file server", scheme, base)
+	}
+	return u, nil
+}
+
+//signedURL returns the url of the file or the directory of scope over scheme, valid until expires
+func (s *Service) signedURL(scheme string, scope string, expires time.Time) (string, error) {
+	u, err := s.base(scheme)
+	if err != nil {
+		return "", err
+	}
+	signature := sign(s.secret, scope, expires.Unix())
+	if scheme == "ftp" {
+		//ftp has no query, the credentials carry the signature
+		u.User = url.UserPassword(ftpUser, strconv.FormatInt(expires.Unix(), 10)+"-"+signature)
+		u.Path = "/" + scope
+		if strings.HasPrefix(scope, diagnosticsDir+"/") {
+			u.Path += "/"
+		}
+		return u.String(), nil
+	}
+	u.Path = u.Path + HTTPPath + "/" + scope
+	u.RawQuery = url.Values{"expires": {strconv.FormatInt(expires.Unix(), 10)}, "signature": {signature}}.Encode()
+	return u.String(), nil
+}
+
+//FirmwareURL returns the url the firmware name is downloaded from until expires
+func (s *Service) FirmwareURL(name string, scheme string, expires time.Time) (string, error) {
+	if _, err := s.Image(name); err != nil {
+		return "", err
+	}
+	return s.signedURL(scheme, firmwareDir+"/"+name, expires)
+}
+
+//DiagnosticsURL returns the url id uploads its diagnostics to until expires
+func (s *Service) DiagnosticsURL(id string, scheme string, expires time.Time) (string, error) {
+	if err := validName(id); err != nil {
+		return "", err
+	}
+	return s.signedURL(scheme, diagnosticsDir+"/"+id, expires)
+}
+
+//local returns the name and the scheme of a location of LocalScheme
+func (s *Service) local(location string) (name string, scheme string, ok bool) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme != LocalScheme {
+		return "", "", false
+	}
+	name = u.Opaque
+	if name == "" {
+		name = strings.TrimLeft(u.Path, "/")
+	}
+	if scheme = u.Query().Get("scheme"); scheme == "" {
+		scheme = s.conf.Scheme
+	}
+	return name, scheme, true
+}
+
+//expiry keeps an url valid through the retries of the charging point
+func (s *Service) expiry(from time.Time, retries *int, retryInterval *int) time.Time {
+	expires := from.Add(s.conf.TTL)
+	if retries != nil && retryInterval != nil {
+		expires = expires.Add(time.Duration(*retries**retryInterval) * time.Second)
+	}
+	return expires
+}
+
+//Build replaces the locations of LocalScheme of the UpdateFirmware and GetDiagnostics calls by signed urls and records
+//the GetDiagnostics calls, it is a hook of the server, see SetCallHooks
+func (s *Service) Build(id string, call *protocol.Call) error {
+	switch req := call.Request.(type) {
+	case protocol.UpdateFirmwareRequest:
+		name, scheme, ok := s.local(req.Location)
+		if !ok {
+			return nil
+		}
+		from := s.now()
+		if t, err := time.Parse(time.RFC3339, req.RetrieveDate); err == nil && t.After(from) {
+			from = t
+		}
+		location, err := s.FirmwareURL(name, scheme, s.expiry(from, req.Retries, req.RetryInterval))
+		if err != nil {
+			return err
+		}
+		req.Location = location
+		call.Request = req
+	case protocol.GetDiagnosticsRequest:
+		_, scheme, ok := s.local(req.Location)
+		if !ok {
+			return nil
+		}
+		now := s.now()
+		location, err := s.DiagnosticsURL(id, scheme, s.expiry(now, req.Retries, req.RetryInterval))
+		if err != nil {
+			return err
+		}
+		req.Location = location
+		call.Request = req
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.add(&Diagnostics{ChargePoint: id, UniqueID: call.UniqueID, Location: location, RequestedAt: now, Status: Requested, Files: []File{}, UpdatedAt: now})
+		s.save(id)
+	}
+	return nil
+}
+
+//Image returns the firmware name with its checksums
+func (s *Service) Image(name string) (Firmware, error) {
+	if err := validName(name); err != nil {
+		return Firmware{}, err
+	}
+	path := filepath.Join(s.conf.Dir, firmwareDir, name)
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return Firmware{}, fmt.Errorf("firmware(%s) not found", name)
+	}
+	s.mu.Lock()
+	f, ok := s.firmware[name]
+	s.mu.Unlock()
+	if ok && f.Size == info.Size() && f.ModifiedAt.Equal(info.ModTime()) {
+		return f, nil
+	}
+	f = Firmware{Name: name, Size: info.Size(), ModifiedAt: info.ModTime()}
+	if f.MD5, f.SHA256, err = checksum(path); err != nil {
+		return Firmware{}, err
+	}
+	s.mu.Lock()
+	s.firmware[name] = f
+	s.mu.Unlock()
+	return f, nil
+}
+
+//Firmware returns the images of the firmware directory by name
+func (s *Service) Firmware() ([]Firmware, error) {
+	entries, err := os.ReadDir(filepath.Join(s.conf.Dir, firmwareDir))
+	if err != nil {
+		return nil, err
+	}
+	images := make([]Firmware, 0, len(entries))
+	for _, e := range entries {
+		if !e.Type().IsRegular() || e.Name()[0] == '.' {
+			continue
+		}
+		f, err := s.Image(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, f)
+	}
+	return images, nil
+}
+
+//PutFirmware stores the image name read from r, replacing the image of the same name
+func (s *Service) PutFirmware(name string, r io.Reader) (Firmware, error) {
+	if err := validName(name); err != nil || name[0] == '.' {
+		return Firmware{}, fmt.Errorf("%w(%s)", ErrInvalidName, name)
+	}
+	dir := filepath.Join(s.conf.Dir, firmwareDir)
+	err := atomicfile.Write(filepath.Join(dir, name), func(w io.Writer) error {
+		_, err := io.Copy(w, r)
+		return err
+	})
+	if err != nil {
+		return Firmware{}, err
+	}
+	return s.Image(name)
+}
+
+//DeleteFirmware removes the image name, the urls issued for it fail from now
+func (s *Service) DeleteFirmware(name string) error {
+	if err := validName(name); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.firmware, name)
+	s.mu.Unlock()
+	if err := os.Remove(filepath.Join(s.conf.Dir, firmwareDir, name)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("firmware(%s) not found", name)
+		}
+		return err
+	}
+	return nil
+}
+
+//Diagnostics returns the diagnostics of id from the oldest, of all the charging points if id is empty
+func (s *Service) Diagnostics(id string) []Diagnostics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	diagnostics := []Diagnostics{}
+	for _, d := range s.diagnostics {
+		if id == "" || d.ChargePoint == id {
+			c := *d
+			c.Files = append([]File{}, d.Files...)
+			diagnostics = append(diagnostics, c)
+		}
+	}
+	sort.SliceStable(diagnostics, func(i, j int) bool { return diagnostics[i].RequestedAt.Before(diagnostics[j].RequestedAt) })
+	return diagnostics
+}
+
+//DiagnosticsFile returns the path of the diagnostics file name uploaded by id
+func (s *Service) DiagnosticsFile(id string, name string) (string, error) {
+	if validName(id) != nil || validName(name) != nil || name[0] == '.' {
+		return "", fmt.Errorf("%w(%s/%s)", ErrInvalidName, id, name)
+	}
+	path := filepath.Join(s.conf.Dir, diagnosticsDir, id, name)
+	if info, err := os.Stat(path); err != nil || !info.Mode().IsRegular() {
+		return "", fmt.Errorf("diagnostics(%s/%s) not found", id, name)
+	}
+	return path, nil
+}
+
+//add appends d and drops the oldest diagnostics of its charging point beyond maxDiagnostics, s.mu is held
+func (s *Service) add(d *Diagnostics) {
+	s.diagnostics = append(s.diagnostics, d)
+	count := 0
+	for i := len(s.diagnostics) - 1; i >= 0; i-- {
+		if s.diagnostics[i].ChargePoint != d.ChargePoint {
+			continue
+		}
+		if count++; count > maxDiagnostics {
+			s.drop(i)
+		}
+	}
+}
+
+//drop removes the diagnostics i and deletes its files, s.mu is held
+func (s *Service) drop(i int) {
+	d := s.diagnostics[i]
+	for _, f := range d.Files {
+		if err := os.Remove(filepath.Join(s.conf.Dir, diagnosticsDir, d.ChargePoint, f.Name)); err != nil && !os.IsNotExist(err) {
+			s.handle(d.ChargePoint, err)
+		}
+	}
+	s.diagnostics = append(s.diagnostics[:i], s.diagnostics[i+1:]...)
+}
+
+//used returns the bytes of the diagnostics files of id, but the ones of its last diagnostics, s.mu is held
+func (s *Service) used(id string) (older int64, last int64) {
+	latest := s.latest(id)
+	for _, d := range s.diagnostics {
+		if d.ChargePoint != id {
+			continue
+		}
+		for _, f := range d.Files {
+			if d == latest {
+				last += f.Size
+			} else {
+				older += f.Size
+			}
+		}
+	}
+	return older, last
+}
+
+//fit drops the oldest diagnostics of id until its files take no more than the quota, s.mu is held
+func (s *Service) fit(id string) {
+	older, last := s.used(id)
+	latest := s.latest(id)
+	for i := 0; i < len(s.diagnostics) && older+last > s.conf.Quota; {
+		d := s.diagnostics[i]
+		if d.ChargePoint != id || d == latest {
+			i++
+			continue
+		}
+		for _, f := range d.Files {
+			older -= f.Size
+		}
+		s.drop(i)
+	}
+}
+
+//latest returns the last diagnostics of id, s.mu is held
+func (s *Service) latest(id string) *Diagnostics {
+	for i := len(s.diagnostics) - 1; i >= 0; i-- {
+		if s.diagnostics[i].ChargePoint == id {
+			return s.diagnostics[i]
+		}
+	}
+	return nil
+}
+
+//save writes the diagnostics, s.mu is held
+func (s *Service) save(id string) {
+	s.handle(id, saveDiagnostics(s.diagnosticsPath(), s.diagnostics))
+}
+
+//upload stores the file name read from r in the directory of id and links it to its last diagnostics. the file takes
+//no more than MaxUpload bytes, nor the room the files of the last diagnostics of id leave in the quota; the files of
+//the older diagnostics of id are deleted to make room for it
+func (s *Service) upload(id string, name string, via string, r io.Reader) (File, error) {
+	if err := validName(name); err != nil || name[0] == '.' {
+		return File{}, fmt.Errorf("%w(%s)", ErrInvalidName, name)
+	}
+	s.mu.Lock()
+	_, last := s.used(id)
+	s.mu.Unlock()
+	max := s.conf.MaxUpload
+	if room := s.conf.Quota - last; room < max {
+		max = room
+	}
+	if max <= 0 {
+		return File{}, fmt.Errorf("%w, quota of %d bytes used", ErrTooLarge, s.conf.Quota)
+	}
+	dir := filepath.Join(s.conf.Dir, diagnosticsDir, id)
+	f, err := receive(dir, name, r, max, s.now())
+	if err != nil {
+		return File{}, err
+	}
+	f.Protocol = via
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, last = s.used(id); last+f.Size > s.conf.Quota {
+		//another upload of the same diagnostics took the room meanwhile
+		os.Remove(filepath.Join(dir, f.Name))
+		return File{}, fmt.Errorf("%w, quota of %d bytes used", ErrTooLarge, s.conf.Quota)
+	}
+	d := s.latest(id)
+	if d == nil {
+		//uploaded to an url issued before the diagnostics were lost
+		d = &Diagnostics{ChargePoint: id, RequestedAt: f.ReceivedAt, Status: Requested}
+		s.add(d)
+	}
+	d.Files = append(d.Files, f)
+	d.UpdatedAt = f.ReceivedAt
+	s.fit(id)
+	s.save(id)
+	return f, nil
+}
+
+//notified records the DiagnosticsStatusNotification of id on its last diagnostics
+func (s *Service) notified(id string, status protocol.DiagnosticsStatus) {
+	if status == protocol.DiagnosticsStatusIdle {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := s.latest(id)
+	if d == nil || (!d.open() && status == protocol.DiagnosticsStatusUploading) {
+		return
+	}
+	d.Status = string(status)
+	d.UpdatedAt = s.now()
+	s.save(id)
+}
+
+type servicePlugin struct {
+	ocpp16server.PassivePlugin
+	s *Service
+}
+
+//Wrap links the DiagnosticsStatusNotifications handled by plugin to the diagnostics
+func (s *Service) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &servicePlugin{PassivePlugin: plugin, s: s}
+}
+
+func (p *servicePlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok || action != protocol.DiagnosticsStatusNotificationName {
+		return handler, ok
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		p.s.notified(id, request.(*protocol.DiagnosticsStatusNotificationRequest).Status)
+		return handler(ctx, id, uniqueid, request)
+	}, true
+}
@@ -0,0 +1,77 @@
+package firmware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the campaigns on r, e.g. the group of the rest api:
+//
+//	GET    /firmware/inventory                   the charging points by id with their last BootNotification
+//	GET    /firmware/campaigns                   the campaigns
+//	POST   /firmware/campaigns                   creates the campaign of the body on the charging points of its target
+//	GET    /firmware/campaigns/:campaign         a campaign and the state of its charging points
+//	POST   /firmware/campaigns/:campaign/pause
+//	POST   /firmware/campaigns/:campaign/resume
+//	POST   /firmware/campaigns/:campaign/cancel
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/firmware/inventory", m.inventory)
+	r.GET("/firmware/campaigns", m.list)
+	r.POST("/firmware/campaigns", m.create)
+	r.GET("/firmware/campaigns/:campaign", m.get)
+	r.POST("/firmware/campaigns/:campaign/pause", m.control(m.Pause))
+	r.POST("/firmware/campaigns/:campaign/resume", m.control(m.Resume))
+	r.POST("/firmware/campaigns/:campaign/cancel", m.control(m.Cancel))
+}
+
+func (m *Manager) inventory(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Inventory())
+}
+
+func (m *Manager) list(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Campaigns())
+}
+
+func (m *Manager) create(c *gin.Context) {
+	var campaign Campaign
+	if err := c.ShouldBindJSON(&campaign); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	created, err := m.Create(campaign)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+func (m *Manager) get(c *gin.Context) {
+	campaign, ok := m.Campaign(c.Param("campaign"))
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("campaign(%s) not found", c.Param("campaign"))})
+		return
+	}
+	c.JSON(http.StatusOK, campaign)
+}
+
+func (m *Manager) control(fn func(id string) (*Campaign, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := m.Campaign(c.Param("campaign")); !ok {
+			c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("campaign(%s) not found", c.Param("campaign"))})
+			return
+		}
+		campaign, err := fn(c.Param("campaign"))
+		if err != nil {
+			c.JSON(http.StatusConflict, errorReply{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, campaign)
+	}
+}
@@ -0,0 +1,256 @@
+//Package firmware rolls out firmware updates to the charging points in campaigns: the charging points are targeted by
+//the vendor, the model and the firmwareVersion of their BootNotification, updated in waves within maintenance windows
+//and followed through their FirmwareStatusNotifications
+package firmware
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"ocpp16/internal/atomicfile"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+//DeviceStatus is the state of the update of a charging point
+type DeviceStatus string
+
+const (
+	Pending     DeviceStatus = "Pending" //waiting for its wave, a window and the charging point online
+	Sent        DeviceStatus = "Sent"    //UpdateFirmware accepted
+	Downloading DeviceStatus = "Downloading"
+	Downloaded  DeviceStatus = "Downloaded"
+	Installing  DeviceStatus = "Installing"
+	Installed   DeviceStatus = "Installed"
+	Failed      DeviceStatus = "Failed"
+)
+
+//CampaignStatus is the state of a campaign
+type CampaignStatus string
+
+const (
+	Running   CampaignStatus = "Running"
+	Paused    CampaignStatus = "Paused"
+	Completed CampaignStatus = "Completed"
+	Cancelled CampaignStatus = "Cancelled"
+)
+
+//Info is what a charging point told of itself in its last BootNotification
+type Info struct {
+	Vendor          string    `json:"chargePointVendor"`
+	Model           string    `json:"chargePointModel"`
+	SerialNumber    string    `json:"chargePointSerialNumber,omitempty"`
+	FirmwareVersion string    `json:"firmwareVersion,omitempty"`
+	BootedAt        time.Time `json:"bootedAt"`
+}
+
+//Target selects the charging points of a campaign, an empty field matches all
+type Target struct {
+	Vendor           string   `json:"vendor,omitempty"`
+	Model            string   `json:"model,omitempty"`
+	FirmwareVersions []string `json:"firmwareVersions,omitempty"` //the current versions to update
+	ChargePoints     []string `json:"chargePoints,omitempty"`
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Target) match(id string, info *Info) bool {
+	return (t.Vendor == "" || t.Vendor == info.Vendor) &&
+		(t.Model == "" || t.Model == info.Model) &&
+		(len(t.FirmwareVersions) == 0 || contains(t.FirmwareVersions, info.FirmwareVersion)) &&
+		(len(t.ChargePoints) == 0 || contains(t.ChargePoints, id))
+}
+
+//Window is a daily maintenance window, from Start to End the next day when End is before Start
+type Window struct {
+	Start    string `json:"start"`              //15:04
+	End      string `json:"end"`                //15:04
+	TimeZone string `json:"timeZone,omitempty"` //IANA name, UTC if empty
+}
+
+func minutes(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time(%s) of a window", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w *Window) validate() error {
+	if _, err := minutes(w.Start); err != nil {
+		return err
+	}
+	if _, err := minutes(w.End); err != nil {
+		return err
+	}
+	_, err := time.LoadLocation(w.TimeZone)
+	return err
+}
+
+func (w *Window) contains(t time.Time) bool {
+	start, _ := minutes(w.Start)
+	end, _ := minutes(w.End)
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	now := t.Hour()*60 + t.Minute()
+	if start <= end {
+		return start <= now && now < end
+	}
+	return now >= start || now < end
+}
+
+//Device is a charging point of a campaign
+type Device struct {
+	ChargePoint     string       `json:"chargePointId"`
+	Wave            int          `json:"wave"`
+	Status          DeviceStatus `json:"status"`
+	FirmwareVersion string       `json:"firmwareVersion,omitempty"` //before the update
+	Error           string       `json:"error,omitempty"`
+	SentAt          *time.Time   `json:"sentAt,omitempty"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+}
+
+func (d *Device) updating() bool {
+	switch d.Status {
+	case Sent, Downloading, Downloaded, Installing:
+		return true
+	}
+	return false
+}
+
+func (d *Device) finished() bool {
+	return d.Status == Installed || d.Status == Failed
+}
+
+type Campaign struct {
+	ID               string         `json:"id"`
+	Location         string         `json:"location"`          //of the firmware, sent in UpdateFirmware
+	Version          string         `json:"version,omitempty"` //the firmwareVersion booted after the update, not checked if empty
+	Retries          *int           `json:"retries,omitempty"`
+	RetryInterval    *int           `json:"retryInterval,omitempty"`
+	Target           Target         `json:"target"`
+	WaveSize         int            `json:"waveSize,omitempty"`         //charging points per wave, one wave if 0
+	Concurrency      int            `json:"concurrency,omitempty"`      //charging points updating at once, no limit if 0
+	Windows          []Window       `json:"windows,omitempty"`          //any time if empty
+	FailureThreshold float64        `json:"failureThreshold,omitempty"` //fraction of failed updates pausing the campaign, 0.2 if 0
+	MinFinished      int            `json:"minFinished,omitempty"`      //updates finished before the threshold applies, 3 if 0
+	Status           CampaignStatus `json:"status"`
+	Reason           string         `json:"reason,omitempty"` //of the automatic pause
+	AckFailed        int            `json:"ackFailed,omitempty"`
+	AckFinished      int            `json:"ackFinished,omitempty"` //the updates finished before the last resume
+	CreatedAt        time.Time      `json:"createdAt"`
+	Devices          []*Device      `json:"devices"`
+}
+
+func (c *Campaign) validate() error {
+	if c.ID == "" {
+		return errors.New("campaign id missing")
+	}
+	if u, err := url.Parse(c.Location); err != nil || u.Scheme == "" {
+		return fmt.Errorf("invalid location(%s)", c.Location)
+	}
+	if c.WaveSize < 0 || c.Concurrency < 0 || c.FailureThreshold < 0 || c.FailureThreshold > 1 || c.MinFinished < 0 {
+		return errors.New("invalid waveSize, concurrency, failureThreshold or minFinished")
+	}
+	for i := range c.Windows {
+		if err := c.Windows[i].validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Campaign) clone() *Campaign {
+	clone := *c
+	clone.Devices = make([]*Device, len(c.Devices))
+	for i, d := range c.Devices {
+		device := *d
+		clone.Devices[i] = &device
+	}
+	return &clone
+}
+
+func (c *Campaign) open(t time.Time) bool {
+	if len(c.Windows) == 0 {
+		return true
+	}
+	for i := range c.Windows {
+		if c.Windows[i].contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+//failing returns the failure rate since the last resume when it crosses the threshold
+func (c *Campaign) failing() (float64, bool) {
+	failed, finished := 0, 0
+	for _, d := range c.Devices {
+		if d.finished() {
+			finished++
+		}
+		if d.Status == Failed {
+			failed++
+		}
+	}
+	failed, finished = failed-c.AckFailed, finished-c.AckFinished
+	if finished <= 0 || finished < c.MinFinished {
+		return 0, false
+	}
+	rate := float64(failed) / float64(finished)
+	return rate, rate >= c.FailureThreshold
+}
+
+//state is what the manager saves
+type state struct {
+	Inventory map[string]*Info `json:"inventory"`
+	Campaigns []*Campaign      `json:"campaigns"`
+}
+
+func load(path string) (*state, error) {
+	s := &state{Inventory: make(map[string]*Info)}
+	if path == "" {
+		return s, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("firmware campaign file(%s) corrupted, err(%v)", path, err)
+	}
+	if s.Inventory == nil {
+		s.Inventory = make(map[string]*Info)
+	}
+	return s, nil
+}
+
+//save writes the inventory and the campaigns to path, nothing if path is empty
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
@@ -0,0 +1,172 @@
+package firmware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWindow(t *testing.T) {
+	night := Window{Start: "22:00", End: "05:00", TimeZone: "Asia/Shanghai"}
+	if err := night.validate(); err != nil {
+		t.Fatal(err)
+	}
+	for at, expected := range map[string]bool{
+		"2021-01-01T14:30:00Z": true,  //22:30 in Shanghai
+		"2021-01-01T20:59:00Z": true,  //04:59
+		"2021-01-01T21:00:00Z": false, //05:00
+		"2021-01-01T06:00:00Z": false, //14:00
+	} {
+		tm, _ := time.Parse(time.RFC3339, at)
+		if night.contains(tm) != expected {
+			t.Fatalf("%s in the window %v", at, !expected)
+		}
+	}
+	if (&Window{Start: "25:00", End: "05:00"}).validate() == nil {
+		t.Fatal("invalid window accepted")
+	}
+}
+
+//chargePoints records the UpdateFirmware calls
+type chargePoints struct {
+	mu      sync.Mutex
+	updated []string
+}
+
+func (f *chargePoints) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	if _, ok := call.Request.(protocol.UpdateFirmwareRequest); !ok {
+		return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updated = append(f.updated, id)
+	return &protocol.UpdateFirmwareResponse{}, nil, nil
+}
+
+func (f *chargePoints) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	updated := f.updated
+	f.updated = nil
+	return updated
+}
+
+func status(c *Campaign) string {
+	var s []string
+	for _, d := range c.Devices {
+		s = append(s, d.ChargePoint+":"+string(d.Status))
+	}
+	return string(c.Status) + " " + strings.Join(s, ",")
+}
+
+func TestCampaign(t *testing.T) {
+	f := &chargePoints{}
+	path := filepath.Join(t.TempDir(), "firmware.json")
+	m, err := NewManager(f, Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := m.Wrap(local.NewActionPlugin())
+	handle := func(id string, request protocol.Request) {
+		handler, _ := plugin.RequestHandler(request.Action())
+		if _, err := handler(context.Background(), id, protocol.NewUniqueID(), request); err != nil {
+			t.Fatal(err)
+		}
+	}
+	boot := func(id string, model string, version string) {
+		handle(id, &protocol.BootNotificationRequest{ChargePointVendor: "Tsinglink", ChargePointModel: model, FirmwareVersion: version})
+	}
+	notify := func(id string, statuses ...protocol.FirmwareStatus) {
+		for _, s := range statuses {
+			handle(id, &protocol.FirmwareStatusNotificationRequest{Status: s})
+		}
+	}
+	for _, id := range []string{"CP001", "CP002", "CP003", "CP004"} {
+		boot(id, "AC7", "1.0")
+	}
+	boot("CP005", "DC60", "1.0")
+	boot("CP006", "AC7", "2.0")
+	for _, id := range []string{"CP001", "CP002", "CP003", "CP005"} {
+		plugin.ChargingPointOnline(id)
+	}
+	if _, err = m.Create(Campaign{ID: "ac7", Location: "https://firmware.example.com/ac7-2.0.bin", Version: "2.0", Target: Target{Model: "AC7", FirmwareVersions: []string{"1.0"}}, WaveSize: 2, Concurrency: 1}); err != nil {
+		t.Fatal(err)
+	}
+	step := func(expected ...string) {
+		m.Step(context.Background())
+		if sent := f.sent(); strings.Join(sent, ",") != strings.Join(expected, ",") {
+			t.Fatalf("UpdateFirmware sent to %v, expected %v", sent, expected)
+		}
+	}
+
+	//one at a time, the second wave starts once the first is finished
+	step("CP001")
+	step()
+	notify("CP001", protocol.FirmwareStatusDownloading, protocol.FirmwareStatusDownloaded, protocol.FirmwareStatusInstalling)
+	boot("CP001", "AC7", "2.0")
+	step("CP002")
+	notify("CP002", protocol.FirmwareStatusDownloadFailed)
+	step("CP003")
+	notify("CP003", protocol.FirmwareStatusDownloading, protocol.FirmwareStatusInstallationFailed)
+	//2 failures of 3 updates cross the threshold
+	step()
+	c, _ := m.Campaign("ac7")
+	if status(c) != "Paused CP001:Installed,CP002:Failed,CP003:Failed,CP004:Pending" || c.Reason == "" {
+		t.Fatalf("unexpected campaign %s", status(c))
+	}
+
+	//the campaign goes on where it stopped after a restart
+	if _, err = m.Resume("ac7"); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = NewManager(f, Config{Path: path}); err != nil {
+		t.Fatal(err)
+	}
+	plugin = m.Wrap(local.NewActionPlugin())
+	plugin.ChargingPointOnline("CP004")
+	step("CP004")
+	//no news for too long
+	m.now = func() time.Time { return time.Now().Add(3 * time.Hour) }
+	step()
+	if c, _ = m.Campaign("ac7"); status(c) != "Completed CP001:Installed,CP002:Failed,CP003:Failed,CP004:Failed" {
+		t.Fatalf("unexpected campaign %s", status(c))
+	}
+	if len(m.Inventory()) != 6 {
+		t.Fatalf("unexpected inventory %+v", m.Inventory())
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	m, _ := NewManager(&chargePoints{}, Config{})
+	m.RegisterAPI(engine)
+	handler, _ := m.Wrap(local.NewActionPlugin()).RequestHandler(protocol.BootNotificationName)
+	handler(context.Background(), "CP001", "1", &protocol.BootNotificationRequest{ChargePointVendor: "Tsinglink", ChargePointModel: "AC7", FirmwareVersion: "1.0"})
+	do := func(method string, url string, body string) (int, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w.Code, w.Body.String()
+	}
+	if code, body := do(http.MethodPost, "/firmware/campaigns", `{"id":"dc","location":"ftp://firmware.example.com/dc.bin","target":{"model":"DC60"}}`); code != http.StatusBadRequest {
+		t.Fatalf("campaign without charging point got %d %s", code, body)
+	}
+	if code, body := do(http.MethodPost, "/firmware/campaigns", `{"id":"ac","location":"ftp://firmware.example.com/ac.bin","target":{"model":"AC7"}}`); code != http.StatusCreated || !strings.Contains(body, `"chargePointId":"CP001"`) {
+		t.Fatalf("create got %d %s", code, body)
+	}
+	if code, body := do(http.MethodPost, "/firmware/campaigns/ac/resume", ""); code != http.StatusConflict {
+		t.Fatalf("resume of a running campaign got %d %s", code, body)
+	}
+	if code, body := do(http.MethodPost, "/firmware/campaigns/ac/cancel", ""); code != http.StatusOK || !strings.Contains(body, `"status":"Cancelled"`) {
+		t.Fatalf("cancel got %d %s", code, body)
+	}
+}
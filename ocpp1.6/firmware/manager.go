@@ -0,0 +1,397 @@
+package firmware
+
+import (
+	"context"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sort"
+	"sync"
+	"time"
+)
+
+//Server is the part of *ocpp16server.Server the manager needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Config struct {
+	Path          string        //json file of the inventory and the campaigns, in memory only if empty
+	Timeout       time.Duration //of a call to a charging point, 30s if 0
+	Tick          time.Duration //between two steps of Run, 10s if 0
+	DeviceTimeout time.Duration //an update fails without FirmwareStatusNotification for, 2h if 0
+}
+
+type Manager struct {
+	server  Server
+	conf    Config
+	mu      sync.Mutex
+	state   *state
+	online  map[string]bool
+	now     func() time.Time
+	onError func(id string, err error)
+}
+
+//NewManager loads the inventory and the campaigns of conf.Path
+func NewManager(s Server, conf Config) (*Manager, error) {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 30 * time.Second
+	}
+	if conf.Tick <= 0 {
+		conf.Tick = 10 * time.Second
+	}
+	if conf.DeviceTimeout <= 0 {
+		conf.DeviceTimeout = 2 * time.Hour
+	}
+	st, err := load(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{server: s, conf: conf, state: st, online: make(map[string]bool), now: time.Now}, nil
+}
+
+//SetErrorHandler gets the errors of the steps run in the background and of saving the state
+func (m *Manager) SetErrorHandler(fn func(id string, err error)) {
+	m.onError = fn
+}
+
+func (m *Manager) handle(id string, err error) {
+	if err != nil && m.onError != nil {
+		m.onError(id, err)
+	}
+}
+
+//Inventory returns the charging points seen in a BootNotification
+func (m *Manager) Inventory() map[string]Info {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	inventory := make(map[string]Info, len(m.state.Inventory))
+	for id, info := range m.state.Inventory {
+		inventory[id] = *info
+	}
+	return inventory
+}
+
+//Campaigns returns the campaigns from the oldest
+func (m *Manager) Campaigns() []*Campaign {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	campaigns := make([]*Campaign, 0, len(m.state.Campaigns))
+	for _, c := range m.state.Campaigns {
+		campaigns = append(campaigns, c.clone())
+	}
+	return campaigns
+}
+
+//campaign returns the campaign id, m.mu is held
+func (m *Manager) campaign(id string) (*Campaign, bool) {
+	for _, c := range m.state.Campaigns {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+//Campaign returns the campaign id
+func (m *Manager) Campaign(id string) (*Campaign, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.campaign(id)
+	if !ok {
+		return nil, false
+	}
+	return c.clone(), true
+}
+
+//Create starts c on the charging points of the inventory matching its target, ordered by id and split in waves
+func (m *Manager) Create(c Campaign) (*Campaign, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if c.FailureThreshold == 0 {
+		c.FailureThreshold = 0.2
+	}
+	if c.MinFinished == 0 {
+		c.MinFinished = 3
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.campaign(c.ID); ok {
+		return nil, fmt.Errorf("campaign(%s) exists", c.ID)
+	}
+	var ids []string
+	for id, info := range m.state.Inventory {
+		if c.Target.match(id, info) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no charging point matches the target of campaign(%s)", c.ID)
+	}
+	sort.Strings(ids)
+	now := m.now()
+	c.Status, c.Reason, c.AckFailed, c.AckFinished, c.CreatedAt, c.Devices = Running, "", 0, 0, now, nil
+	for i, id := range ids {
+		wave := 0
+		if c.WaveSize > 0 {
+			wave = i / c.WaveSize
+		}
+		c.Devices = append(c.Devices, &Device{ChargePoint: id, Wave: wave, Status: Pending, FirmwareVersion: m.state.Inventory[id].FirmwareVersion, UpdatedAt: now})
+	}
+	m.state.Campaigns = append(m.state.Campaigns, &c)
+	if err := m.state.save(m.conf.Path); err != nil {
+		m.state.Campaigns = m.state.Campaigns[:len(m.state.Campaigns)-1]
+		return nil, err
+	}
+	return c.clone(), nil
+}
+
+//transition changes the status of the campaign id, from one of from
+func (m *Manager) transition(id string, to CampaignStatus, from ...CampaignStatus) (*Campaign, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.campaign(id)
+	if !ok {
+		return nil, fmt.Errorf("campaign(%s) not found", id)
+	}
+	allowed := false
+	for _, status := range from {
+		allowed = allowed || c.Status == status
+	}
+	if !allowed {
+		return nil, fmt.Errorf("campaign(%s) %s", id, c.Status)
+	}
+	if c.Status == Paused && to == Running {
+		//the failures so far are acknowledged, the threshold applies to the next updates
+		c.AckFailed, c.AckFinished = 0, 0
+		for _, d := range c.Devices {
+			if d.finished() {
+				c.AckFinished++
+			}
+			if d.Status == Failed {
+				c.AckFailed++
+			}
+		}
+	}
+	c.Status, c.Reason = to, ""
+	return c.clone(), m.state.save(m.conf.Path)
+}
+
+//Pause stops sending UpdateFirmware for the campaign id, the updates started go on
+func (m *Manager) Pause(id string) (*Campaign, error) {
+	return m.transition(id, Paused, Running)
+}
+
+//Resume restarts the paused campaign id
+func (m *Manager) Resume(id string) (*Campaign, error) {
+	return m.transition(id, Running, Paused)
+}
+
+//Cancel ends the campaign id, its pending charging points are not updated
+func (m *Manager) Cancel(id string) (*Campaign, error) {
+	return m.transition(id, Cancelled, Running, Paused)
+}
+
+//updating returns the campaign and the device updating the charging point id, m.mu is held
+func (m *Manager) updating(id string) (*Campaign, *Device, bool) {
+	for _, c := range m.state.Campaigns {
+		for _, d := range c.Devices {
+			if d.ChargePoint == id && d.updating() {
+				return c, d, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+type send struct {
+	device *Device
+	req    protocol.UpdateFirmwareRequest
+}
+
+//Step advances the running campaigns once: the updates without news for DeviceTimeout fail, the campaigns crossing
+//their failure threshold are paused, the finished ones completed, and within a window UpdateFirmware is sent to the
+//online pending charging points of the first unfinished wave up to the concurrency
+func (m *Manager) Step(ctx context.Context) {
+	m.mu.Lock()
+	now := m.now()
+	var sends []send
+	changed := false
+	for _, c := range m.state.Campaigns {
+		if c.Status != Running {
+			continue
+		}
+		for _, d := range c.Devices {
+			if d.updating() && now.Sub(d.UpdatedAt) >= m.conf.DeviceTimeout {
+				d.Status, d.Error, d.UpdatedAt = Failed, fmt.Sprintf("no FirmwareStatusNotification for %s", m.conf.DeviceTimeout), now
+				changed = true
+			}
+		}
+		if rate, ok := c.failing(); ok {
+			c.Status, c.Reason = Paused, fmt.Sprintf("failure rate %.0f%% crossed the threshold %.0f%%", rate*100, c.FailureThreshold*100)
+			changed = true
+			continue
+		}
+		wave, updating, finished := -1, 0, true
+		for _, d := range c.Devices {
+			if d.updating() {
+				updating++
+			}
+			if !d.finished() {
+				finished = false
+				if wave < 0 || d.Wave < wave {
+					wave = d.Wave
+				}
+			}
+		}
+		if finished {
+			c.Status, changed = Completed, true
+			continue
+		}
+		if !c.open(now) {
+			continue
+		}
+		for _, d := range c.Devices {
+			if c.Concurrency > 0 && updating >= c.Concurrency {
+				break
+			}
+			if d.Wave != wave || d.Status != Pending || !m.online[d.ChargePoint] {
+				continue
+			}
+			if _, _, busy := m.updating(d.ChargePoint); busy {
+				continue
+			}
+			sent := now
+			d.Status, d.SentAt, d.UpdatedAt = Sent, &sent, now
+			updating, changed = updating+1, true
+			sends = append(sends, send{device: d, req: protocol.UpdateFirmwareRequest{
+				Location:      c.Location,
+				RetrieveDate:  now.UTC().Format(protocol.ISO8601),
+				Retries:       c.Retries,
+				RetryInterval: c.RetryInterval,
+			}})
+		}
+	}
+	if changed {
+		m.handle("", m.state.save(m.conf.Path))
+	}
+	m.mu.Unlock()
+
+	for _, s := range sends {
+		_, err := ocpp16server.Send(ctx, m.server, s.device.ChargePoint, s.req, m.conf.Timeout)
+		if err == nil {
+			continue
+		}
+		m.mu.Lock()
+		if s.device.Status == Sent {
+			s.device.Status, s.device.Error, s.device.UpdatedAt = Failed, err.Error(), m.now()
+		}
+		m.handle(s.device.ChargePoint, m.state.save(m.conf.Path))
+		m.mu.Unlock()
+	}
+}
+
+//Run steps the campaigns every Tick until ctx is done
+func (m *Manager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.conf.Tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Step(ctx)
+		}
+	}
+}
+
+//booted records the BootNotification of the charging point id, it ends the update booting the expected version
+func (m *Manager) booted(id string, req *protocol.BootNotificationRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	m.state.Inventory[id] = &Info{Vendor: req.ChargePointVendor, Model: req.ChargePointModel, SerialNumber: req.ChargePointSerialNumber, FirmwareVersion: req.FirmwareVersion, BootedAt: now}
+	for _, c := range m.state.Campaigns {
+		if c.Version == "" {
+			continue
+		}
+		for _, d := range c.Devices {
+			if d.ChargePoint != id {
+				continue
+			}
+			switch {
+			case (d.Status == Downloaded || d.Status == Installing) && req.FirmwareVersion == c.Version:
+				d.Status, d.UpdatedAt = Installed, now
+			case d.Status == Installed && req.FirmwareVersion != c.Version && now.Sub(d.UpdatedAt) < m.conf.DeviceTimeout:
+				d.Status, d.Error, d.UpdatedAt = Failed, fmt.Sprintf("booted firmwareVersion(%s) after the update", req.FirmwareVersion), now
+			}
+		}
+	}
+	m.handle(id, m.state.save(m.conf.Path))
+}
+
+//notified follows the update of the charging point id with its FirmwareStatusNotification
+func (m *Manager) notified(id string, status protocol.FirmwareStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, d, ok := m.updating(id)
+	if !ok {
+		return
+	}
+	switch status {
+	case protocol.FirmwareStatusDownloading, protocol.FirmwareStatusDownloaded, protocol.FirmwareStatusInstalling, protocol.FirmwareStatusInstalled:
+		d.Status = DeviceStatus(status)
+	case protocol.FirmwareStatusDownloadFailed, protocol.FirmwareStatusInstallationFailed:
+		d.Status, d.Error = Failed, string(status)
+	default:
+		return
+	}
+	d.UpdatedAt = m.now()
+	m.handle(id, m.state.save(m.conf.Path))
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin following the BootNotifications, the FirmwareStatusNotifications and the connections of the
+//charging points, register it on the server in place of plugin
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+func (p *managedPlugin) ChargingPointOnline(id string) error {
+	p.m.mu.Lock()
+	p.m.online[id] = true
+	p.m.mu.Unlock()
+	return p.PassivePlugin.ChargingPointOnline(id)
+}
+
+func (p *managedPlugin) ChargingPointOffline(id string) error {
+	p.m.mu.Lock()
+	delete(p.m.online, id)
+	p.m.mu.Unlock()
+	return p.PassivePlugin.ChargingPointOffline(id)
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return handler, ok
+	}
+	switch action {
+	case protocol.BootNotificationName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			p.m.booted(id, request.(*protocol.BootNotificationRequest))
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	case protocol.FirmwareStatusNotificationName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			p.m.notified(id, request.(*protocol.FirmwareStatusNotificationRequest).Status)
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	}
+	return handler, ok
+}
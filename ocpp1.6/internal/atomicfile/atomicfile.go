@@ -0,0 +1,55 @@
+//Package atomicfile replaces files at once: the content is written and synced to a hidden temporary file of the same
+//directory, renamed over the file, then the directory is synced, so a crash leaves either the old file or the new
+//one, never a half written one
+package atomicfile
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+//Write replaces the file path by what fn writes to w, the file is left untouched when fn fails
+func Write(path string, fn func(w io.Writer) error) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	err = tmp.Chmod(0644)
+	if err == nil {
+		err = fn(tmp)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return syncDir(dir)
+}
+
+//WriteFile replaces the file path by data
+func WriteFile(path string, data []byte) error {
+	return Write(path, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+//syncDir makes the rename durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,35 @@
+package atomicfile
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+	if err := WriteFile(path, []byte("old")); err != nil {
+		t.Fatal(err)
+	}
+	failed := errors.New("failed")
+	if err := Write(path, func(w io.Writer) error {
+		w.Write([]byte("half"))
+		return failed
+	}); !errors.Is(err, failed) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "old" {
+		t.Fatalf("file replaced by a failed write, %q", data)
+	}
+	if err := WriteFile(path, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(dir)
+	info, _ := os.Stat(path)
+	if data, _ := os.ReadFile(path); string(data) != "new" || len(entries) != 1 || info.Mode().Perm() != 0644 {
+		t.Fatalf("unexpected file %q, %d entries, mode %v", data, len(entries), info.Mode())
+	}
+}
@@ -0,0 +1,85 @@
+package loadbalancing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+type siteReply struct {
+	Site
+	Sessions []Session `json:"sessions"`
+}
+
+//RegisterAPI serves the sites on r, e.g. the group of the rest api:
+//
+//	GET    /sites                the sites
+//	GET    /sites/:site          a site and its transactions
+//	PUT    /sites/:site          adds or replaces a site, the body is the site
+//	DELETE /sites/:site          removes a site
+//	POST   /sites/:site/balance  balances a site and returns its transactions
+func (b *Balancer) RegisterAPI(r gin.IRouter) {
+	r.GET("/sites", b.list)
+	r.GET("/sites/:site", b.get)
+	r.PUT("/sites/:site", b.put)
+	r.DELETE("/sites/:site", b.delete)
+	r.POST("/sites/:site/balance", b.balance)
+}
+
+func (b *Balancer) list(c *gin.Context) {
+	c.JSON(http.StatusOK, b.Sites())
+}
+
+func (b *Balancer) get(c *gin.Context) {
+	site, sessions, ok := b.Site(c.Param("site"))
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("site(%s) not found", c.Param("site"))})
+		return
+	}
+	c.JSON(http.StatusOK, siteReply{Site: site, Sessions: sessions})
+}
+
+func (b *Balancer) put(c *gin.Context) {
+	var site Site
+	if err := c.ShouldBindJSON(&site); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	site.ID = c.Param("site")
+	if err := b.PutSite(site); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, site)
+}
+
+func (b *Balancer) delete(c *gin.Context) {
+	ok, err := b.DeleteSite(c.Param("site"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("site(%s) not found", c.Param("site"))})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (b *Balancer) balance(c *gin.Context) {
+	if _, _, ok := b.Site(c.Param("site")); !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("site(%s) not found", c.Param("site"))})
+		return
+	}
+	sessions, err := b.Balance(c.Request.Context(), c.Param("site"))
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error(), "sessions": sessions})
+		return
+	}
+	c.JSON(http.StatusOK, sessions)
+}
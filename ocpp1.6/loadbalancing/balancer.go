@@ -0,0 +1,535 @@
+package loadbalancing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	txProfile        protocol.ChargingProfilePurposeType = "TxProfile"
+	txDefaultProfile protocol.ChargingProfilePurposeType = "TxDefaultProfile"
+	relative         protocol.ChargingProfileKindType    = "Relative"
+	unitA            protocol.ChargingRateUnitType       = "A"
+	unitW            protocol.ChargingRateUnitType       = "W"
+)
+
+//Profiles sends the charging profiles to the charging points, e.g. *smartcharging.Manager
+type Profiles interface {
+	Set(ctx context.Context, id string, connectorID int, profile protocol.ChargingProfile) (protocol.ChargingProfileStatus, error)
+}
+
+type Config struct {
+	Path       string                        //json file of the sites, in memory only if empty
+	Voltage    float64                       //V between a phase and neutral, 230 if 0
+	Phases     int                           //of the charging points, 3 if 0
+	Unit       protocol.ChargingRateUnitType //of the profiles sent, A if empty
+	ProfileID  int                           //of the TxDefaultProfiles, connector n gets ProfileID+n, 100000 if 0
+	StackLevel int                           //of the profiles sent
+	Threshold  float64                       //fraction of its limit a new limit differs by to be sent, 0.05 if 0
+	Delay      time.Duration                 //from a StartTransaction or a MeterValues to the balancing, 1s if 0
+}
+
+type balancing struct {
+	running   bool
+	pending   bool
+	scheduled bool
+}
+
+type Balancer struct {
+	profiles Profiles
+	conf     Config
+	mu       sync.Mutex
+	sites    map[string]*Site
+	members  map[string]string           //charging point id -> site id
+	sessions map[string]map[int]*Session //charging point id -> connector id -> session
+	running  map[string]*balancing       //site id -> balancing
+	now      func() time.Time
+	onError  func(id string, err error)
+}
+
+//NewBalancer loads the sites of conf.Path
+func NewBalancer(p Profiles, conf Config) (*Balancer, error) {
+	if conf.Voltage <= 0 {
+		conf.Voltage = 230
+	}
+	if conf.Phases <= 0 {
+		conf.Phases = 3
+	}
+	if conf.Unit == "" {
+		conf.Unit = unitA
+	}
+	if conf.Unit != unitA && conf.Unit != unitW {
+		return nil, fmt.Errorf("invalid charging rate unit(%s)", conf.Unit)
+	}
+	if conf.ProfileID <= 0 {
+		conf.ProfileID = 100000
+	}
+	if conf.Threshold <= 0 {
+		conf.Threshold = 0.05
+	}
+	if conf.Delay <= 0 {
+		conf.Delay = time.Second
+	}
+	sites, err := loadSites(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+	b := &Balancer{
+		profiles: p,
+		conf:     conf,
+		sites:    sites,
+		members:  make(map[string]string),
+		sessions: make(map[string]map[int]*Session),
+		running:  make(map[string]*balancing),
+		now:      time.Now,
+	}
+	for _, s := range sites {
+		for id := range s.ChargePoints {
+			b.members[id] = s.ID
+		}
+	}
+	return b, nil
+}
+
+//SetErrorHandler gets the errors of the balancings run in the background with the site id, and of the profiles
+//sent to the charging points with their id
+func (b *Balancer) SetErrorHandler(fn func(id string, err error)) {
+	b.onError = fn
+}
+
+func (b *Balancer) handle(id string, err error) {
+	if err != nil && b.onError != nil {
+		b.onError(id, err)
+	}
+}
+
+//minPower is the power a transaction of site gets at least, b.mu is held
+func (b *Balancer) minPower(site *Site) float64 {
+	if site.MinPower > 0 {
+		return site.MinPower
+	}
+	return 6 * b.conf.Voltage * float64(b.conf.Phases)
+}
+
+//sortedSites returns the sites ordered by id, b.mu is held
+func (b *Balancer) sortedSites() []*Site {
+	sites := make([]*Site, 0, len(b.sites))
+	for _, s := range b.sites {
+		sites = append(sites, s)
+	}
+	sort.Slice(sites, func(i, j int) bool { return sites[i].ID < sites[j].ID })
+	return sites
+}
+
+//Sites returns the sites ordered by id
+func (b *Balancer) Sites() []Site {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sites := make([]Site, 0, len(b.sites))
+	for _, s := range b.sortedSites() {
+		sites = append(sites, *s)
+	}
+	return sites
+}
+
+//Site returns the site id and its sessions
+func (b *Balancer) Site(id string) (Site, []Session, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	site, ok := b.sites[id]
+	if !ok {
+		return Site{}, nil, false
+	}
+	return *site, b.siteSessions(site), true
+}
+
+//siteSessions returns a copy of the sessions of site ordered by start, b.mu is held
+func (b *Balancer) siteSessions(site *Site) []Session {
+	sessions := []Session{}
+	for id := range site.ChargePoints {
+		for _, s := range b.sessions[id] {
+			sessions = append(sessions, *s)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].Start.Equal(sessions[j].Start) {
+			return sessions[i].Start.Before(sessions[j].Start)
+		}
+		return sessions[i].ChargePoint < sessions[j].ChargePoint || (sessions[i].ChargePoint == sessions[j].ChargePoint && sessions[i].ConnectorID < sessions[j].ConnectorID)
+	})
+	return sessions
+}
+
+//PutSite adds or replaces site and balances it. A charging point belongs to one site
+func (b *Balancer) PutSite(site Site) error {
+	if err := site.validate(); err != nil {
+		return err
+	}
+	if site.ChargePoints == nil {
+		site.ChargePoints = make(map[string]Member)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for id := range site.ChargePoints {
+		if other, ok := b.members[id]; ok && other != site.ID {
+			return fmt.Errorf("charging point(%s) belongs to site(%s)", id, other)
+		}
+	}
+	sites := b.sortedSites()
+	if _, ok := b.sites[site.ID]; !ok {
+		sites = append(sites, &site)
+	}
+	for i := range sites {
+		if sites[i].ID == site.ID {
+			sites[i] = &site
+		}
+	}
+	if err := saveSites(b.conf.Path, sites); err != nil {
+		return err
+	}
+	if old, ok := b.sites[site.ID]; ok {
+		for id := range old.ChargePoints {
+			delete(b.members, id)
+		}
+	}
+	for id := range site.ChargePoints {
+		b.members[id] = site.ID
+	}
+	b.sites[site.ID] = &site
+	b.trigger(site.ID)
+	return nil
+}
+
+//DeleteSite removes the site id, the profiles already sent to its charging points are kept
+func (b *Balancer) DeleteSite(id string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	site, ok := b.sites[id]
+	if !ok {
+		return false, nil
+	}
+	var sites []*Site
+	for _, s := range b.sortedSites() {
+		if s.ID != id {
+			sites = append(sites, s)
+		}
+	}
+	if err := saveSites(b.conf.Path, sites); err != nil {
+		return false, err
+	}
+	for cp := range site.ChargePoints {
+		delete(b.members, cp)
+	}
+	delete(b.sites, id)
+	return true, nil
+}
+
+//profile returns the profile limiting the connector to power W, a TxDefaultProfile on connector 0
+func (b *Balancer) profile(connectorID int, transactionID *int, power float64) protocol.ChargingProfile {
+	id, stackLevel, phases, start := b.conf.ProfileID+connectorID, b.conf.StackLevel, b.conf.Phases, 0
+	limit := math.Floor(power)
+	if b.conf.Unit == unitA {
+		limit = math.Floor(power/(b.conf.Voltage*float64(phases))*10+1e-9) / 10
+	}
+	purpose := txProfile
+	if connectorID == 0 {
+		purpose = txDefaultProfile
+	}
+	return protocol.ChargingProfile{
+		ChargingProfileId:      &id,
+		TransactionId:          transactionID,
+		StackLevel:             &stackLevel,
+		ChargingProfilePurpose: purpose,
+		ChargingProfileKind:    relative,
+		ChargingSchedule: protocol.ChargingSchedule{
+			ChargingRateUnit:       b.conf.Unit,
+			ChargingSchedulePeriod: []protocol.ChargingSchedulePeriod{{StartPeriod: &start, Limit: &limit, NumberPhases: &phases}},
+		},
+	}
+}
+
+func (b *Balancer) set(ctx context.Context, id string, connectorID int, transactionID *int, power float64) error {
+	status, err := b.profiles.Set(ctx, id, connectorID, b.profile(connectorID, transactionID, power))
+	if err == nil && status != "Accepted" {
+		err = fmt.Errorf("SetChargingProfile %s", status)
+	}
+	return err
+}
+
+//Balance shares the capacity of the site id between its sessions and sends the new limits, the decreases first so
+//the site is never overloaded in between. The changes below the threshold are not sent unless the site would be
+//overloaded by the limits kept
+func (b *Balancer) Balance(ctx context.Context, id string) ([]Session, error) {
+	b.mu.Lock()
+	site, ok := b.sites[id]
+	if !ok {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("site(%s) not found", id)
+	}
+	sessions := b.siteSessions(site)
+	allocation := allocate(site, sessions, b.minPower(site))
+	capacity := site.Capacity
+	for i := range sessions {
+		sessions[i].Allocated = allocation[i]
+		if s, ok := b.sessions[sessions[i].ChargePoint][sessions[i].ConnectorID]; ok {
+			s.Allocated = allocation[i]
+		}
+	}
+	b.mu.Unlock()
+
+	var down, up []int
+	kept := 0.0
+	for i := range sessions {
+		s := &sessions[i]
+		switch {
+		case s.Offline:
+		case s.Limit == nil || s.Allocated < *s.Limit-b.conf.Threshold**s.Limit:
+			down = append(down, i)
+		case s.Allocated > *s.Limit+b.conf.Threshold**s.Limit:
+			up = append(up, i)
+		default:
+			kept += *s.Limit
+			continue
+		}
+		kept += s.Allocated
+	}
+	if kept > capacity {
+		for i := range sessions {
+			if s := &sessions[i]; s.Limit != nil && s.Allocated < *s.Limit && s.Allocated >= *s.Limit-b.conf.Threshold**s.Limit {
+				down = append(down, i)
+			}
+		}
+	}
+	var errs []string
+	for _, i := range append(down, up...) {
+		s := &sessions[i]
+		if err := b.set(ctx, s.ChargePoint, s.ConnectorID, &s.TransactionID, s.Allocated); err != nil {
+			errs = append(errs, fmt.Sprintf("charging point(%s) connector(%d), %v", s.ChargePoint, s.ConnectorID, err))
+			continue
+		}
+		limit := s.Allocated
+		s.Limit = &limit
+		b.mu.Lock()
+		if current, ok := b.sessions[s.ChargePoint][s.ConnectorID]; ok && current.TransactionID == s.TransactionID {
+			current.Limit = &limit
+		}
+		b.mu.Unlock()
+	}
+	if len(errs) > 0 {
+		return sessions, fmt.Errorf("balance site(%s), %s", id, strings.Join(errs, "; "))
+	}
+	return sessions, nil
+}
+
+//balancing returns the state of the balancings of the site id, b.mu is held
+func (b *Balancer) balancing(id string) *balancing {
+	r, ok := b.running[id]
+	if !ok {
+		r = &balancing{}
+		b.running[id] = r
+	}
+	return r
+}
+
+//trigger balances the site id in the background, once more when it is triggered while balancing. b.mu is held
+func (b *Balancer) trigger(id string) {
+	r := b.balancing(id)
+	if r.running {
+		r.pending = true
+		return
+	}
+	r.running = true
+	go func() {
+		for {
+			_, err := b.Balance(context.Background(), id)
+			b.handle(id, err)
+			b.mu.Lock()
+			if _, ok := b.sites[id]; !r.pending || !ok {
+				r.running, r.pending = false, false
+				b.mu.Unlock()
+				return
+			}
+			r.pending = false
+			b.mu.Unlock()
+		}
+	}()
+}
+
+//later triggers the site id after the delay, once for all the calls in between, e.g. once the charging point got the
+//reply of StartTransaction or for the MeterValues of all the transactions of the site. b.mu is held
+func (b *Balancer) later(id string) {
+	r := b.balancing(id)
+	if r.scheduled {
+		return
+	}
+	r.scheduled = true
+	time.AfterFunc(b.conf.Delay, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		r.scheduled = false
+		if _, ok := b.sites[id]; ok {
+			b.trigger(id)
+		}
+	})
+}
+
+//start records the transaction of a charging point of a site, b.mu is held
+func (b *Balancer) start(id string, s *Session) {
+	connectors, ok := b.sessions[id]
+	if !ok {
+		connectors = make(map[int]*Session)
+		b.sessions[id] = connectors
+	}
+	connectors[s.ConnectorID] = s
+}
+
+//stop removes the transaction, it returns the site of its charging point. b.mu is held
+func (b *Balancer) stop(id string, transactionID int) (string, bool) {
+	for connectorID, s := range b.sessions[id] {
+		if s.TransactionID == transactionID {
+			delete(b.sessions[id], connectorID)
+			site, ok := b.members[id]
+			return site, ok
+		}
+	}
+	return "", false
+}
+
+//freeze marks the transactions of the charging point id offline, or back online and balances its site. An offline
+//transaction may go on with the limit it got, it keeps it and is not sent any until the charging point is back
+func (b *Balancer) freeze(id string, offline bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, s := range b.sessions[id] {
+		s.Offline = offline
+	}
+	if site, ok := b.members[id]; ok && !offline && len(b.sessions[id]) > 0 {
+		b.later(site)
+	}
+}
+
+//defaults sends the charging point id a TxDefaultProfile of the minimum power of its site, so its new transactions
+//start low until the site is balanced
+func (b *Balancer) defaults(id string) {
+	time.AfterFunc(b.conf.Delay, func() {
+		b.mu.Lock()
+		site, ok := b.sites[b.members[id]]
+		var power float64
+		if ok {
+			power = b.minPower(site)
+			if m := site.ChargePoints[id].MaxPower; m > 0 && m < power {
+				power = m
+			}
+		}
+		b.mu.Unlock()
+		if ok {
+			b.handle(id, b.set(context.Background(), id, 0, nil, power))
+		}
+	})
+}
+
+type balancedPlugin struct {
+	ocpp16server.PassivePlugin
+	b *Balancer
+}
+
+//Wrap returns plugin following the transactions and the meter values of the charging points of the sites, register
+//it on the server in place of plugin. It is wrapped outside of the smart charging manager, which must know the
+//transactions before their TxProfiles are sent
+func (b *Balancer) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &balancedPlugin{PassivePlugin: plugin, b: b}
+}
+
+func (p *balancedPlugin) ChargingPointOnline(id string) error {
+	p.b.mu.Lock()
+	_, ok := p.b.members[id]
+	p.b.mu.Unlock()
+	if ok {
+		p.b.defaults(id)
+		p.b.freeze(id, false)
+	}
+	return p.PassivePlugin.ChargingPointOnline(id)
+}
+
+func (p *balancedPlugin) ChargingPointOffline(id string) error {
+	p.b.freeze(id, true)
+	return p.PassivePlugin.ChargingPointOffline(id)
+}
+
+func (p *balancedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return handler, ok
+	}
+	b := p.b
+	switch action {
+	case protocol.StartTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			res, err := handler(ctx, id, uniqueid, request)
+			req := request.(*protocol.StartTransactionRequest)
+			start, ok := res.(*protocol.StartTransactionResponse)
+			if !ok || err != nil || start.TransactionId == nil || req.ConnectorId == nil {
+				return res, err
+			}
+			at, parseErr := time.Parse(time.RFC3339Nano, req.Timestamp)
+			if parseErr != nil {
+				at = b.now()
+			}
+			b.mu.Lock()
+			if site, ok := b.members[id]; ok {
+				b.start(id, &Session{ChargePoint: id, ConnectorID: *req.ConnectorId, TransactionID: *start.TransactionId, Start: at})
+				b.later(site)
+			}
+			b.mu.Unlock()
+			return res, err
+		}, true
+	case protocol.StopTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			if req := request.(*protocol.StopTransactionRequest); req.TransactionId != nil {
+				b.mu.Lock()
+				if site, ok := b.stop(id, *req.TransactionId); ok {
+					b.trigger(site)
+				}
+				b.mu.Unlock()
+			}
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	case protocol.MeterValuesName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			req := request.(*protocol.MeterValuesRequest)
+			if req.TransactionId != nil && req.ConnectorId != nil && *req.ConnectorId > 0 {
+				if power, ok := measure(req.MeterValue, b.conf.Voltage, b.conf.Phases); ok {
+					b.metered(id, *req.ConnectorId, *req.TransactionId, power)
+				}
+			}
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	}
+	return handler, ok
+}
+
+//metered records the power drawn by a transaction and balances its site after the delay, the meter values of the
+//transactions of a site are balanced together. The transactions started before the balancer are learnt from them
+func (b *Balancer) metered(id string, connectorID int, transactionID int, power float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	site, ok := b.members[id]
+	if !ok {
+		return
+	}
+	now := b.now()
+	s, ok := b.sessions[id][connectorID]
+	if !ok || s.TransactionID != transactionID {
+		s = &Session{ChargePoint: id, ConnectorID: connectorID, TransactionID: transactionID, Start: now}
+		b.start(id, s)
+	}
+	s.Measured, s.MeasuredAt = power, &now
+	b.later(site)
+}
@@ -0,0 +1,234 @@
+package loadbalancing
+
+import (
+	"context"
+	"fmt"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/smartcharging"
+	"ocpp16/transaction"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func intp(i int) *int {
+	return &i
+}
+
+func equal(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAllocate(t *testing.T) {
+	//32A and 6A on 3 phases
+	site := &Site{Capacity: 22080, ChargePoints: map[string]Member{"A": {Priority: 1}, "B": {Priority: 2}, "C": {}}}
+	t0 := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	sessions := []Session{
+		{ChargePoint: "A", ConnectorID: 1, Start: t0},
+		{ChargePoint: "B", ConnectorID: 1, Start: t0.Add(time.Minute)},
+		{ChargePoint: "C", ConnectorID: 1, Start: t0.Add(2 * time.Minute)},
+	}
+	for _, c := range []struct {
+		strategy Strategy
+		expected []float64
+	}{
+		{EqualShare, []float64{7360, 7360, 7360}},
+		{FirstCome, []float64{13800, 4140, 4140}},
+		{Priority, []float64{4140, 13800, 4140}},
+	} {
+		site.Strategy = c.strategy
+		if got := allocate(site, sessions, 4140); !equal(got, c.expected) {
+			t.Fatalf("%s got %v", c.strategy, got)
+		}
+	}
+
+	//A takes 7360 at most, what it leaves goes to B
+	site.Strategy, site.ChargePoints["A"] = FirstCome, Member{MaxPower: 7360}
+	if got := allocate(site, sessions, 4140); !equal(got, []float64{7360, 10580, 4140}) {
+		t.Fatalf("unexpected allocation with maxPower %v", got)
+	}
+	//A draws 2000W of its 7360W, it keeps the minimum and the others share the rest
+	now, limit := t0, 7360.0
+	sessions[0].Measured, sessions[0].MeasuredAt, sessions[0].Limit = 2000, &now, &limit
+	site.Strategy = EqualShare
+	if got := allocate(site, sessions, 4140); !equal(got, []float64{4140, 8970, 8970}) {
+		t.Fatalf("unexpected allocation with a measured load %v", got)
+	}
+	//B is offline with its 12000W, A and C share what is left
+	held := 12000.0
+	sessions[1].Offline, sessions[1].Limit = true, &held
+	if got := allocate(site, sessions, 4140); !equal(got, []float64{4140, 12000, 5940}) {
+		t.Fatalf("unexpected allocation with an offline session %v", got)
+	}
+	//5 transactions fit with the minimum, the last one is paused
+	sessions = sessions[:0]
+	for i := 0; i < 6; i++ {
+		sessions = append(sessions, Session{ChargePoint: "C", ConnectorID: i + 1, Start: t0.Add(time.Duration(i) * time.Minute)})
+	}
+	if got := allocate(site, sessions, 4140); !equal(got, []float64{4416, 4416, 4416, 4416, 4416, 0}) {
+		t.Fatalf("unexpected allocation over the capacity %v", got)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	for _, c := range []struct {
+		values   []protocol.SampledValue
+		expected float64
+	}{
+		{[]protocol.SampledValue{{Value: "11", Measurand: "Power.Active.Import", Unit: "kW"}, {Value: "16", Measurand: "Current.Import"}}, 11000},
+		{[]protocol.SampledValue{{Value: "10", Measurand: "Current.Import", Phase: "L1"}, {Value: "6", Measurand: "Current.Import", Phase: "L2"}}, 3680},
+		{[]protocol.SampledValue{{Value: "10", Measurand: "Current.Import"}}, 6900},
+	} {
+		if got, ok := measure([]protocol.MeterValue{{SampledValue: c.values}}, 230, 3); !ok || got != c.expected {
+			t.Fatalf("measured %v(%v) of %+v", got, ok, c.values)
+		}
+	}
+	if _, ok := measure([]protocol.MeterValue{{SampledValue: []protocol.SampledValue{{Value: "1200"}}}}, 230, 3); ok {
+		t.Fatal("energy register measured as power")
+	}
+}
+
+//chargePoints accepts the profiles and records the limits in A sent to every connector
+type chargePoints struct {
+	mu     sync.Mutex
+	limits []string
+}
+
+func (f *chargePoints) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	req, ok := call.Request.(protocol.SetChargingProfileRequest)
+	if !ok {
+		return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.limits = append(f.limits, fmt.Sprintf("%s/%d:%v", id, *req.ConnectorId, *req.ChargingProfile.ChargingSchedule.ChargingSchedulePeriod[0].Limit))
+	return &protocol.SetChargingProfileResponse{Status: "Accepted"}, nil, nil
+}
+
+func (f *chargePoints) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	limits := f.limits
+	f.limits = nil
+	return limits
+}
+
+//idle waits for the balancing of the site in the background
+func idle(b *Balancer, site string) {
+	for {
+		b.mu.Lock()
+		r := b.running[site]
+		done := r == nil || !r.running
+		b.mu.Unlock()
+		if done {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBalancer(t *testing.T) {
+	f := &chargePoints{}
+	profiles, _ := smartcharging.NewManager(f, smartcharging.Config{})
+	path := filepath.Join(t.TempDir(), "sites.json")
+	b, err := NewBalancer(profiles, Config{Path: path, Delay: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = b.PutSite(Site{ID: "depot", Capacity: 22080, Strategy: EqualShare, ChargePoints: map[string]Member{"CP001": {}, "CP002": {}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.PutSite(Site{ID: "other", Capacity: 22080, Strategy: EqualShare, ChargePoints: map[string]Member{"CP002": {}}}); err == nil {
+		t.Fatal("charging point added to two sites")
+	}
+	idle(b, "depot")
+	transactions, _ := transaction.NewManager(transaction.NewMemoryRepository())
+	plugin := b.Wrap(profiles.Wrap(transactions.Wrap(local.NewActionPlugin())))
+	handle := func(id string, request protocol.Request) protocol.Response {
+		handler, _ := plugin.RequestHandler(request.Action())
+		res, err := handler(context.Background(), id, protocol.NewUniqueID(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	start := func(id string) int {
+		res := handle(id, &protocol.StartTransactionRequest{ConnectorId: intp(1), IdTag: "tag", MeterStart: intp(0), Timestamp: time.Now().UTC().Format(time.RFC3339)})
+		return *res.(*protocol.StartTransactionResponse).TransactionId
+	}
+	balance := func() {
+		if _, err := b.Balance(context.Background(), "depot"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tx1 := start("CP001")
+	balance()
+	if sent := f.sent(); len(sent) != 1 || sent[0] != "CP001/1:32" || len(profiles.Profiles("CP001")) != 1 {
+		t.Fatalf("unexpected profiles %v", sent)
+	}
+	//the decrease of CP001 is sent before the limit of CP002
+	tx := start("CP002")
+	balance()
+	if sent := f.sent(); len(sent) != 2 || sent[0] != "CP001/1:16" || sent[1] != "CP002/1:16" {
+		t.Fatalf("unexpected profiles %v", sent)
+	}
+
+	//CP002 draws 2A, it keeps 6A and CP001 gets the rest. The meter values are balanced once after the delay
+	for i := 0; i < 3; i++ {
+		handle("CP002", &protocol.MeterValuesRequest{ConnectorId: intp(1), TransactionId: &tx, MeterValue: []protocol.MeterValue{{
+			TimeStamp:    time.Now().UTC().Format(time.RFC3339),
+			SampledValue: []protocol.SampledValue{{Value: "2", Measurand: "Current.Import", Unit: "A"}},
+		}}})
+	}
+	idle(b, "depot")
+	b.mu.Lock()
+	scheduled := b.running["depot"].scheduled
+	b.mu.Unlock()
+	if sent := f.sent(); len(sent) != 0 || !scheduled {
+		t.Fatalf("meter values balanced at once %v, scheduled %v", sent, scheduled)
+	}
+	balance()
+	if sent := f.sent(); len(sent) != 2 || sent[0] != "CP002/1:6" || sent[1] != "CP001/1:26" {
+		t.Fatalf("unexpected profiles %v", sent)
+	}
+	balance()
+	if sent := f.sent(); len(sent) != 0 {
+		t.Fatalf("unchanged limits sent again %v", sent)
+	}
+
+	//CP002 draws its 6A and disconnects, it keeps them, the capacity CP001 leaves is not sent to it until it is back
+	handle("CP002", &protocol.MeterValuesRequest{ConnectorId: intp(1), TransactionId: &tx, MeterValue: []protocol.MeterValue{{
+		TimeStamp:    time.Now().UTC().Format(time.RFC3339),
+		SampledValue: []protocol.SampledValue{{Value: "6", Measurand: "Current.Import", Unit: "A"}},
+	}}})
+	plugin.ChargingPointOffline("CP002")
+	handle("CP001", &protocol.StopTransactionRequest{MeterStop: intp(10), Timestamp: time.Now().UTC().Format(time.RFC3339), TransactionId: &tx1})
+	idle(b, "depot")
+	if sent := f.sent(); len(sent) != 0 {
+		t.Fatalf("profiles sent to an offline charging point %v", sent)
+	}
+	if _, sessions, _ := b.Site("depot"); len(sessions) != 1 || !sessions[0].Offline || sessions[0].Allocated != 4140 {
+		t.Fatalf("unexpected sessions %+v", sessions)
+	}
+	plugin.ChargingPointOnline("CP002")
+	balance()
+	if sent := f.sent(); len(sent) != 1 || sent[0] != "CP002/1:32" {
+		t.Fatalf("unexpected profiles %v", sent)
+	}
+
+	//the sites survive a restart
+	if b, err = NewBalancer(profiles, Config{Path: path}); err != nil || len(b.Sites()) != 1 || len(b.Sites()[0].ChargePoints) != 2 {
+		t.Fatalf("sites not loaded %+v(%v)", b.Sites(), err)
+	}
+}
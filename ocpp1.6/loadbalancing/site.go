@@ -0,0 +1,236 @@
+//Package loadbalancing shares the capacity of the grid connection of a site between the transactions of its charging
+//points, sending each a TxProfile with its part through the smart charging manager
+package loadbalancing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//Strategy decides which transactions get the capacity of a site
+type Strategy string
+
+const (
+	//EqualShare gives every transaction the same limit, the capacity left by the ones drawing less goes to the others
+	EqualShare Strategy = "equal"
+	//Priority gives the capacity to the charging points with the highest priority first
+	Priority Strategy = "priority"
+	//FirstCome gives the capacity to the oldest transactions first
+	FirstCome Strategy = "firstcome"
+)
+
+//Member is a charging point of a site
+type Member struct {
+	Priority int     `json:"priority,omitempty"` //higher first with the priority strategy
+	MaxPower float64 `json:"maxPower,omitempty"` //W a transaction takes at most, the capacity of the site if 0
+}
+
+//Site is a grid connection shared by charging points
+type Site struct {
+	ID           string            `json:"id"`
+	Capacity     float64           `json:"capacity"`           //W
+	MinPower     float64           `json:"minPower,omitempty"` //W a transaction gets at least or it is paused, 6A if 0
+	Strategy     Strategy          `json:"strategy"`
+	ChargePoints map[string]Member `json:"chargePoints"`
+}
+
+func (s *Site) validate() error {
+	if s.ID == "" {
+		return errors.New("site id missing")
+	}
+	if s.Capacity <= 0 || s.MinPower < 0 {
+		return fmt.Errorf("invalid capacity(%v) or minPower(%v)", s.Capacity, s.MinPower)
+	}
+	switch s.Strategy {
+	case EqualShare, Priority, FirstCome:
+	default:
+		return fmt.Errorf("invalid strategy(%s)", s.Strategy)
+	}
+	for id, m := range s.ChargePoints {
+		if m.MaxPower < 0 {
+			return fmt.Errorf("invalid maxPower(%v) of charging point(%s)", m.MaxPower, id)
+		}
+	}
+	return nil
+}
+
+//Session is a transaction running on a charging point of a site
+type Session struct {
+	ChargePoint   string     `json:"chargePointId"`
+	ConnectorID   int        `json:"connectorId"`
+	TransactionID int        `json:"transactionId"`
+	Start         time.Time  `json:"start"`
+	Measured      float64    `json:"measured"` //W of the last MeterValues
+	MeasuredAt    *time.Time `json:"measuredAt,omitempty"`
+	Allocated     float64    `json:"allocated"`         //W of the last balancing
+	Limit         *float64   `json:"limit,omitempty"`   //W of the last profile accepted by the charging point
+	Offline       bool       `json:"offline,omitempty"` //the charging point is disconnected, the transaction keeps its limit
+}
+
+//demand is the power the session may take: the most it may take, unless it draws clearly less than its limit, e.g.
+//when the battery is almost full, then a bit more than it draws
+func (s *Session) demand(max, min float64) float64 {
+	if s.MeasuredAt == nil || s.Limit == nil || s.Measured >= 0.8**s.Limit {
+		return max
+	}
+	return math.Max(min, math.Min(max, s.Measured*1.2))
+}
+
+//held is the power an offline session keeps: its limit, or min it started with from the TxDefaultProfile
+func (s *Session) held(min float64) float64 {
+	if s.Limit == nil {
+		return min
+	}
+	return *s.Limit
+}
+
+//allocate returns the power of every session of site: the offline sessions keep what they hold, the sessions that
+//fit with min in what is left get at least min, in the order of the strategy, the others are paused with 0, the
+//capacity left is shared by the strategy
+func allocate(site *Site, sessions []Session, min float64) []float64 {
+	allocation := make([]float64, len(sessions))
+	demand := make([]float64, len(sessions))
+	capacity := site.Capacity
+	order := []int{}
+	for i := range sessions {
+		max := site.Capacity
+		if m := site.ChargePoints[sessions[i].ChargePoint].MaxPower; m > 0 && m < max {
+			max = m
+		}
+		if sessions[i].Offline {
+			allocation[i] = sessions[i].held(math.Min(min, max))
+			capacity -= allocation[i]
+			continue
+		}
+		demand[i] = sessions[i].demand(max, math.Min(min, max))
+		order = append(order, i)
+	}
+	capacity = math.Max(capacity, 0)
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := &sessions[order[i]], &sessions[order[j]]
+		if site.Strategy == Priority {
+			if pa, pb := site.ChargePoints[a.ChargePoint].Priority, site.ChargePoints[b.ChargePoint].Priority; pa != pb {
+				return pa > pb
+			}
+		}
+		return a.Start.Before(b.Start)
+	})
+	n := len(order)
+	if min > 0 && float64(n)*min > capacity {
+		n = int(capacity / min)
+	}
+	order = order[:n]
+	left := capacity
+	if site.Strategy != EqualShare {
+		for _, i := range order {
+			allocation[i] = math.Min(demand[i], math.Min(min, left))
+			left -= allocation[i]
+		}
+		for _, i := range order {
+			more := math.Min(demand[i]-allocation[i], left)
+			allocation[i] += more
+			left -= more
+		}
+		return allocation
+	}
+	//the smallest demands are served first, what they leave is shared by the next ones
+	sort.SliceStable(order, func(i, j int) bool { return demand[order[i]] < demand[order[j]] })
+	for k, i := range order {
+		allocation[i] = math.Min(demand[i], left/float64(len(order)-k))
+		left -= allocation[i]
+	}
+	return allocation
+}
+
+func loadSites(path string) (map[string]*Site, error) {
+	sites := make(map[string]*Site)
+	if path == "" {
+		return sites, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return sites, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var list []*Site
+	if err = json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("site file(%s) corrupted, err(%v)", path, err)
+	}
+	for _, s := range list {
+		if err = s.validate(); err != nil {
+			return nil, fmt.Errorf("site file(%s), %v", path, err)
+		}
+		sites[s.ID] = s
+	}
+	return sites, nil
+}
+
+//saveSites writes sites to path, nothing if path is empty
+func saveSites(path string, sites []*Site) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(sites)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
+
+//measure returns the power drawn in W from the last meter value with Power.Active.Import or Current.Import, the
+//currents are converted with voltage, on phases when they are not given per phase
+func measure(values []protocol.MeterValue, voltage float64, phases int) (float64, bool) {
+	for i := len(values) - 1; i >= 0; i-- {
+		var power, perPhase, current, perPhaseCurrent float64
+		var hasPower, hasPerPhase, hasCurrent, hasPerPhaseCurrent bool
+		for _, v := range values[i].SampledValue {
+			value, err := strconv.ParseFloat(v.Value, 64)
+			if err != nil {
+				continue
+			}
+			phased := v.Phase == "L1" || v.Phase == "L2" || v.Phase == "L3"
+			switch {
+			case v.Measurand == "Power.Active.Import" && (v.Phase == "" || phased):
+				if v.Unit == "kW" {
+					value *= 1000
+				}
+				if phased {
+					perPhase, hasPerPhase = perPhase+value, true
+				} else {
+					power, hasPower = value, true
+				}
+			case v.Measurand == "Current.Import" && (v.Phase == "" || phased):
+				if phased {
+					perPhaseCurrent, hasPerPhaseCurrent = perPhaseCurrent+value*voltage, true
+				} else {
+					current, hasCurrent = value*voltage*float64(phases), true
+				}
+			}
+		}
+		switch {
+		case hasPower:
+			return power, true
+		case hasPerPhase:
+			return perPhase, true
+		case hasPerPhaseCurrent:
+			return perPhaseCurrent, true
+		case hasCurrent:
+			return current, true
+		}
+	}
+	return 0, false
+}
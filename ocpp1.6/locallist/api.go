@@ -0,0 +1,89 @@
+package locallist
+
+import (
+	"net/http"
+	"ocpp16/protocol"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+type listReply struct {
+	ListVersion            int                          `json:"listVersion"`
+	LocalAuthorizationList []protocol.AuthorizationData `json:"localAuthorizationList"`
+}
+
+//RegisterAPI serves the master list on r, e.g. the group of the rest api:
+//
+//	GET    /locallist                    the version and the entries
+//	PUT    /locallist                    replaces the entries with the localAuthorizationList of the body
+//	PUT    /locallist/entries/:idTag     puts a tag, the body is its idTagInfo
+//	DELETE /locallist/entries/:idTag     removes a tag
+//	GET    /locallist/chargepoints       the sync state of the charging points
+//	POST   /locallist/chargepoints/:id   syncs a charging point and returns its state
+//
+//the changes reply the new version and are pushed to the online charging points in the background
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/locallist", m.get)
+	r.PUT("/locallist", m.replace)
+	r.PUT("/locallist/entries/:idTag", m.put)
+	r.DELETE("/locallist/entries/:idTag", m.delete)
+	r.GET("/locallist/chargepoints", m.statuses)
+	r.POST("/locallist/chargepoints/:id", m.sync)
+}
+
+func (m *Manager) get(c *gin.Context) {
+	version, entries := m.Entries()
+	c.JSON(http.StatusOK, listReply{ListVersion: version, LocalAuthorizationList: entries})
+}
+
+func (m *Manager) reply(c *gin.Context, version int, err error) {
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"listVersion": version})
+}
+
+func (m *Manager) replace(c *gin.Context) {
+	var body listReply
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	version, err := m.Replace(body.LocalAuthorizationList)
+	m.reply(c, version, err)
+}
+
+func (m *Manager) put(c *gin.Context) {
+	info := &protocol.IdTagInfo{}
+	if err := c.ShouldBindJSON(info); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	version, err := m.Update([]protocol.AuthorizationData{{IdTag: c.Param("idTag"), IdTagInfo: info}}, nil)
+	m.reply(c, version, err)
+}
+
+func (m *Manager) delete(c *gin.Context) {
+	version, err := m.Update(nil, []string{c.Param("idTag")})
+	m.reply(c, version, err)
+}
+
+func (m *Manager) statuses(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Statuses())
+}
+
+func (m *Manager) sync(c *gin.Context) {
+	id := c.Param("id")
+	err := m.Sync(c.Request.Context(), id)
+	status := m.Statuses()[id]
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error(), "status": status})
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
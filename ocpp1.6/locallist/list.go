@@ -0,0 +1,171 @@
+//Package locallist keeps the local authorization lists of the charging points in sync with a master list. Every
+//change of the master list bumps its version, the changes are kept so a charging point a few versions behind gets a
+//Differential SendLocalList, the others a Full one, split by its SendLocalListMaxLength
+package locallist
+
+import (
+	"encoding/json"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+)
+
+//change is the new idTagInfo of a tag in a version, nil when the tag is removed
+type change struct {
+	Version   int                 `json:"version"`
+	IdTag     string              `json:"idTag"`
+	IdTagInfo *protocol.IdTagInfo `json:"idTagInfo,omitempty"`
+}
+
+//list is the master list, the json of the list file
+type list struct {
+	Version int                           `json:"version"`
+	Entries map[string]protocol.IdTagInfo `json:"entries"`
+	Changes []change                      `json:"changes"`
+	Since   int                           `json:"since"` //the changes are complete from this version on
+}
+
+func newList() *list {
+	return &list{Entries: make(map[string]protocol.IdTagInfo)}
+}
+
+func loadList(path string) (*list, error) {
+	l := newList()
+	if path == "" {
+		return l, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("local list file(%s) corrupted, err(%v)", path, err)
+	}
+	if l.Entries == nil {
+		l.Entries = make(map[string]protocol.IdTagInfo)
+	}
+	return l, nil
+}
+
+//save writes the list to path, nothing if path is empty
+func (l *list) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
+
+func validate(entry protocol.AuthorizationData) error {
+	if entry.IdTag == "" || len(entry.IdTag) > 20 {
+		return fmt.Errorf("invalid id tag(%s)", entry.IdTag)
+	}
+	if entry.IdTagInfo == nil {
+		return fmt.Errorf("idTagInfo of id tag(%s) missing", entry.IdTag)
+	}
+	if err := protocol.Validate.Struct(entry.IdTagInfo); err != nil {
+		return fmt.Errorf("invalid idTagInfo of id tag(%s), %v", entry.IdTag, err)
+	}
+	return nil
+}
+
+//update puts and removes tags in a new version, it returns false when nothing changed. history bounds the changes kept
+func (l *list) update(put []protocol.AuthorizationData, remove []string, history int) (bool, error) {
+	changes := make(map[string]*protocol.IdTagInfo)
+	for _, idTag := range remove {
+		if _, ok := l.Entries[idTag]; ok {
+			changes[idTag] = nil
+		}
+	}
+	for _, entry := range put {
+		if err := validate(entry); err != nil {
+			return false, err
+		}
+		if info, ok := l.Entries[entry.IdTag]; ok && reflect.DeepEqual(info, *entry.IdTagInfo) {
+			delete(changes, entry.IdTag)
+			continue
+		}
+		info := *entry.IdTagInfo
+		changes[entry.IdTag] = &info
+	}
+	if len(changes) == 0 {
+		return false, nil
+	}
+	l.Version++
+	for idTag, info := range changes {
+		if info == nil {
+			delete(l.Entries, idTag)
+		} else {
+			l.Entries[idTag] = *info
+		}
+		l.Changes = append(l.Changes, change{Version: l.Version, IdTag: idTag, IdTagInfo: info})
+	}
+	//whole versions are dropped from the history, the oldest first
+	for len(l.Changes) > history {
+		l.Since = l.Changes[0].Version
+		i := 0
+		for i < len(l.Changes) && l.Changes[i].Version <= l.Since {
+			i++
+		}
+		l.Changes = l.Changes[i:]
+	}
+	return true, nil
+}
+
+//replace makes entries the whole list
+func (l *list) replace(entries []protocol.AuthorizationData, history int) (bool, error) {
+	keep := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		keep[entry.IdTag] = true
+	}
+	var remove []string
+	for idTag := range l.Entries {
+		if !keep[idTag] {
+			remove = append(remove, idTag)
+		}
+	}
+	return l.update(entries, remove, history)
+}
+
+//full returns the entries ordered by id tag
+func (l *list) full() []protocol.AuthorizationData {
+	entries := make([]protocol.AuthorizationData, 0, len(l.Entries))
+	for idTag, info := range l.Entries {
+		info := info
+		entries = append(entries, protocol.AuthorizationData{IdTag: idTag, IdTagInfo: &info})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].IdTag < entries[j].IdTag })
+	return entries
+}
+
+//diff returns the entries changed after version from, false when the history does not reach back to from
+func (l *list) diff(from int) ([]protocol.AuthorizationData, bool) {
+	if from < l.Since || from > l.Version {
+		return nil, false
+	}
+	latest := make(map[string]*protocol.IdTagInfo)
+	for _, c := range l.Changes {
+		if c.Version > from {
+			latest[c.IdTag] = c.IdTagInfo
+		}
+	}
+	entries := make([]protocol.AuthorizationData, 0, len(latest))
+	for idTag, info := range latest {
+		entries = append(entries, protocol.AuthorizationData{IdTag: idTag, IdTagInfo: info})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].IdTag < entries[j].IdTag })
+	return entries, true
+}
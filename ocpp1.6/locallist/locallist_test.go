@@ -0,0 +1,166 @@
+package locallist
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+//chargePoint keeps a local list like a charging point, rejecting the Differential updates from another version
+type chargePoint struct {
+	mu        sync.Mutex
+	version   int
+	entries   map[string]protocol.IdTagInfo
+	maxLength int
+	mismatch  bool //the next Differential update is VersionMismatch
+	requests  []protocol.SendLocalListRequest
+}
+
+func (cp *chargePoint) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	switch req := call.Request.(type) {
+	case protocol.GetLocalListVersionRequest:
+		version := cp.version
+		return &protocol.GetLocalListVersionResponse{ListVersion: &version}, nil, nil
+	case protocol.GetConfigurationRequest:
+		readonly := true
+		return &protocol.GetConfigurationResponse{ConfigurationKey: []protocol.ConfigurationKey{{Key: MaxLengthKey, Readonly: &readonly, Value: strconv.Itoa(cp.maxLength)}}}, nil, nil
+	case protocol.SendLocalListRequest:
+		cp.requests = append(cp.requests, req)
+		if len(req.LocalAuthorizationList) > cp.maxLength {
+			return &protocol.SendLocalListResponse{Status: protocol.UpdateStatusFailed}, nil, nil
+		}
+		if req.UpdateType == protocol.UpdateTypeFull {
+			cp.entries = make(map[string]protocol.IdTagInfo)
+		} else if cp.mismatch || *req.ListVersion < cp.version {
+			cp.mismatch = false
+			return &protocol.SendLocalListResponse{Status: protocol.UpdateStatusVersionMismatch}, nil, nil
+		}
+		for _, entry := range req.LocalAuthorizationList {
+			if entry.IdTagInfo == nil {
+				delete(cp.entries, entry.IdTag)
+			} else {
+				cp.entries[entry.IdTag] = *entry.IdTagInfo
+			}
+		}
+		cp.version = *req.ListVersion
+		return &protocol.SendLocalListResponse{Status: protocol.UpdateStatusAccepted}, nil, nil
+	}
+	return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+}
+
+func (cp *chargePoint) sent() []protocol.SendLocalListRequest {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	requests := cp.requests
+	cp.requests = nil
+	return requests
+}
+
+func entry(idTag string, status protocol.AuthorizationStatus) protocol.AuthorizationData {
+	return protocol.AuthorizationData{IdTag: idTag, IdTagInfo: &protocol.IdTagInfo{Status: status}}
+}
+
+func TestSync(t *testing.T) {
+	cp := &chargePoint{maxLength: 2, entries: make(map[string]protocol.IdTagInfo)}
+	path := filepath.Join(t.TempDir(), "locallist.json")
+	m, err := NewManager(cp, Config{Path: path, MaxDiff: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.Update([]protocol.AuthorizationData{entry("a", "Accepted"), entry("b", "Accepted"), entry("c", "Blocked")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	//a Differential update from the empty list of version 0, in parts of SendLocalListMaxLength
+	if err = m.Sync(context.Background(), "CP001"); err != nil {
+		t.Fatal(err)
+	}
+	sent := cp.sent()
+	if len(sent) != 2 || sent[0].UpdateType != protocol.UpdateTypeDifferential || len(sent[0].LocalAuthorizationList) != 2 || cp.version != 1 || len(cp.entries) != 3 {
+		t.Fatalf("unexpected updates %+v, version %d", sent, cp.version)
+	}
+
+	if version, _ := m.Update([]protocol.AuthorizationData{entry("a", "Accepted")}, nil); version != 1 {
+		t.Fatalf("unchanged list got version %d", version)
+	}
+	m.Update([]protocol.AuthorizationData{entry("d", "Accepted")}, []string{"a"})
+	m.Sync(context.Background(), "CP001")
+	if sent = cp.sent(); len(sent) != 1 || len(sent[0].LocalAuthorizationList) != 2 || sent[0].LocalAuthorizationList[0].IdTagInfo != nil {
+		t.Fatalf("unexpected differential update %+v", sent)
+	}
+
+	//a rejected Differential update is sent again as a Full one, split into a Full and a Differential part
+	m.Update([]protocol.AuthorizationData{entry("e", "Accepted")}, nil)
+	cp.mismatch = true
+	if err = m.Sync(context.Background(), "CP001"); err != nil {
+		t.Fatal(err)
+	}
+	if sent = cp.sent(); len(sent) != 3 || sent[1].UpdateType != protocol.UpdateTypeFull || sent[2].UpdateType != protocol.UpdateTypeDifferential || len(cp.entries) != 4 {
+		t.Fatalf("unexpected full update %+v", sent)
+	}
+
+	//too far behind for a Differential update
+	cp.version = 0
+	m, _ = NewManager(cp, Config{Path: path, MaxDiff: 2})
+	if err = m.Sync(context.Background(), "CP001"); err != nil {
+		t.Fatal(err)
+	}
+	status := m.Statuses()["CP001"]
+	if sent = cp.sent(); sent[0].UpdateType != protocol.UpdateTypeFull || status.ListVersion != 3 || status.UpdateType != "Full" {
+		t.Fatalf("unexpected update %+v, status %+v", sent, status)
+	}
+	if err = m.Sync(context.Background(), "CP001"); err != nil || len(cp.sent()) != 0 {
+		t.Fatalf("synced list updated again, %v", err)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	l := newList()
+	for _, idTag := range []string{"a", "b", "c", "d"} {
+		if _, err := l.update([]protocol.AuthorizationData{entry(idTag, "Accepted")}, nil, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := l.diff(1); ok {
+		t.Fatal("diff beyond the history")
+	}
+	if diff, ok := l.diff(2); !ok || len(diff) != 2 {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+	if _, err := l.update([]protocol.AuthorizationData{{IdTag: "e"}}, nil, 2); err == nil {
+		t.Fatal("entry without idTagInfo added")
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	m, _ := NewManager(&chargePoint{}, Config{})
+	m.RegisterAPI(engine)
+	do := func(method string, url string, body string) (int, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w.Code, w.Body.String()
+	}
+	if code, body := do(http.MethodPut, "/locallist", `{"localAuthorizationList":[{"idTag":"a","idTagInfo":{"status":"Accepted"}}]}`); code != http.StatusOK || body != `{"listVersion":1}` {
+		t.Fatalf("replace got %d %s", code, body)
+	}
+	if code, _ := do(http.MethodPut, "/locallist/entries/b", `{"status":"Unknown"}`); code != http.StatusBadRequest {
+		t.Fatalf("invalid status got %d", code)
+	}
+	if code, body := do(http.MethodDelete, "/locallist/entries/a", ""); code != http.StatusOK || body != `{"listVersion":2}` {
+		t.Fatalf("delete got %d %s", code, body)
+	}
+	if code, body := do(http.MethodGet, "/locallist", ""); code != http.StatusOK || body != `{"listVersion":2,"localAuthorizationList":[]}` {
+		t.Fatalf("get got %d %s", code, body)
+	}
+}
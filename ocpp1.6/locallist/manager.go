@@ -0,0 +1,350 @@
+package locallist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//MaxLengthKey is the configuration key of the entries a SendLocalList takes at most
+const MaxLengthKey = "SendLocalListMaxLength"
+
+var errNotSupported = errors.New("local authorization list not supported")
+
+//Server is the part of *ocpp16server.Server the manager needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Config struct {
+	Path    string        //json file of the master list, in memory only if empty
+	MaxDiff int           //versions a charging point may be behind to get a Differential update, 100 if 0
+	History int           //changes kept for the Differential updates, 10000 if 0
+	Timeout time.Duration //of a call to a charging point, 30s if 0
+	Delay   time.Duration //from the connection or the BootNotification of a charging point to its sync, 2s if 0
+}
+
+//Status is the sync state of a charging point
+type Status struct {
+	ListVersion int        `json:"listVersion"` //-1 while unknown
+	Supported   bool       `json:"supported"`
+	Online      bool       `json:"online"`
+	UpdateType  string     `json:"updateType,omitempty"` //of the last update sent
+	SyncedAt    *time.Time `json:"syncedAt,omitempty"`
+	Error       string     `json:"error,omitempty"` //of the last sync
+}
+
+type point struct {
+	status  Status
+	syncing bool
+	pending bool
+}
+
+type Manager struct {
+	server  Server
+	conf    Config
+	mu      sync.Mutex
+	list    *list
+	points  map[string]*point
+	onError func(id string, err error)
+}
+
+//NewManager loads the master list of conf.Path
+func NewManager(s Server, conf Config) (*Manager, error) {
+	if conf.MaxDiff <= 0 {
+		conf.MaxDiff = 100
+	}
+	if conf.History <= 0 {
+		conf.History = 10000
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 30 * time.Second
+	}
+	if conf.Delay <= 0 {
+		conf.Delay = 2 * time.Second
+	}
+	l, err := loadList(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{server: s, conf: conf, list: l, points: make(map[string]*point)}, nil
+}
+
+//SetErrorHandler gets the errors of the syncs run in the background
+func (m *Manager) SetErrorHandler(fn func(id string, err error)) {
+	m.onError = fn
+}
+
+//Version returns the version of the master list
+func (m *Manager) Version() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.list.Version
+}
+
+//Entries returns the master list ordered by id tag
+func (m *Manager) Entries() (int, []protocol.AuthorizationData) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.list.Version, m.list.full()
+}
+
+//Update puts and removes tags in a new version of the master list and syncs the online charging points
+func (m *Manager) Update(put []protocol.AuthorizationData, remove []string) (int, error) {
+	return m.change(func(l *list) (bool, error) { return l.update(put, remove, m.conf.History) })
+}
+
+//Replace makes entries the master list and syncs the online charging points
+func (m *Manager) Replace(entries []protocol.AuthorizationData) (int, error) {
+	return m.change(func(l *list) (bool, error) { return l.replace(entries, m.conf.History) })
+}
+
+func (m *Manager) change(fn func(l *list) (bool, error)) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	//the list is changed on a copy, so a failed save leaves it as it was
+	l := *m.list
+	l.Entries = make(map[string]protocol.IdTagInfo, len(m.list.Entries))
+	for idTag, info := range m.list.Entries {
+		l.Entries[idTag] = info
+	}
+	l.Changes = append([]change(nil), m.list.Changes...)
+	changed, err := fn(&l)
+	if err != nil || !changed {
+		return m.list.Version, err
+	}
+	if err = l.save(m.conf.Path); err != nil {
+		return m.list.Version, err
+	}
+	m.list = &l
+	for id, p := range m.points {
+		if p.status.Online {
+			m.trigger(id)
+		}
+	}
+	return l.Version, nil
+}
+
+//Statuses returns the sync state of the charging points seen since the start
+func (m *Manager) Statuses() map[string]Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	statuses := make(map[string]Status, len(m.points))
+	for id, p := range m.points {
+		statuses[id] = p.status
+	}
+	return statuses
+}
+
+func (m *Manager) point(id string) *point {
+	p, ok := m.points[id]
+	if !ok {
+		p = &point{status: Status{ListVersion: -1, Supported: true}}
+		m.points[id] = p
+	}
+	return p
+}
+
+//trigger syncs id in the background, once more after the running sync if there is one. m.mu is held
+func (m *Manager) trigger(id string) {
+	p := m.point(id)
+	if p.syncing {
+		p.pending = true
+		return
+	}
+	p.syncing = true
+	go func() {
+		for {
+			err := m.Sync(context.Background(), id)
+			if err != nil && m.onError != nil {
+				m.onError(id, err)
+			}
+			m.mu.Lock()
+			if !p.pending || !p.status.Online {
+				p.syncing, p.pending = false, false
+				m.mu.Unlock()
+				return
+			}
+			p.pending = false
+			m.mu.Unlock()
+		}
+	}()
+}
+
+//call sends req to the charging point id, a CallError NotImplemented or NotSupported is errNotSupported
+func (m *Manager) call(ctx context.Context, id string, req protocol.Request) (protocol.Response, error) {
+	res, err := ocpp16server.Send(ctx, m.server, id, req, m.conf.Timeout)
+	var reply *ocpp16server.CallErrorReply
+	if errors.As(err, &reply) && (reply.ErrorCode == protocol.NotImplemented || reply.ErrorCode == protocol.NotSupported) {
+		return nil, errNotSupported
+	}
+	return res, err
+}
+
+//maxLength returns the SendLocalListMaxLength of id, 0 when it has none
+func (m *Manager) maxLength(ctx context.Context, id string) int {
+	res, err := m.call(ctx, id, protocol.GetConfigurationRequest{Key: []string{MaxLengthKey}})
+	if err != nil {
+		return 0
+	}
+	if res, ok := res.(*protocol.GetConfigurationResponse); ok {
+		for _, key := range res.ConfigurationKey {
+			if n, err := strconv.Atoi(key.Value); key.Key == MaxLengthKey && err == nil && n > 0 {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+//send updates the list of id to version in parts of maxLength entries. The parts share the version, the first part
+//of a Full update is Full and the following ones are Differential
+func (m *Manager) send(ctx context.Context, id string, version int, updateType protocol.UpdateType, entries []protocol.AuthorizationData, maxLength int) (protocol.UpdateStatus, error) {
+	if maxLength <= 0 {
+		maxLength = len(entries)
+	}
+	for first := true; first || len(entries) > 0; first = false {
+		part := entries
+		if len(part) > maxLength {
+			part = part[:maxLength]
+		}
+		entries = entries[len(part):]
+		typ := updateType
+		if !first {
+			typ = protocol.UpdateTypeDifferential
+		}
+		v := version
+		res, err := m.call(ctx, id, protocol.SendLocalListRequest{ListVersion: &v, LocalAuthorizationList: part, UpdateType: typ})
+		if err != nil {
+			return "", err
+		}
+		reply, ok := res.(*protocol.SendLocalListResponse)
+		if !ok {
+			return "", fmt.Errorf("unexpected SendLocalList reply %+v", res)
+		}
+		if reply.Status != protocol.UpdateStatusAccepted {
+			return reply.Status, nil
+		}
+	}
+	return protocol.UpdateStatusAccepted, nil
+}
+
+//Sync brings the list of the charging point id to the version of the master list: GetLocalListVersion, then a
+//Differential update when id is at most MaxDiff versions behind and the changes reach back to its version, a Full one
+//otherwise or when the Differential one is Failed or VersionMismatch
+func (m *Manager) Sync(ctx context.Context, id string) (err error) {
+	var status Status
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		p := m.point(id)
+		status.Online = p.status.Online
+		if err != nil {
+			status.Error = err.Error()
+		}
+		if errors.Is(err, errNotSupported) {
+			status.Supported, err = false, nil
+		}
+		p.status = status
+	}()
+	status = Status{ListVersion: -1, Supported: true}
+	res, err := m.call(ctx, id, protocol.GetLocalListVersionRequest{})
+	if err != nil {
+		return err
+	}
+	reply, ok := res.(*protocol.GetLocalListVersionResponse)
+	if !ok || reply.ListVersion == nil {
+		return fmt.Errorf("unexpected GetLocalListVersion reply %+v", res)
+	}
+	if *reply.ListVersion < 0 {
+		return errNotSupported
+	}
+	status.ListVersion = *reply.ListVersion
+
+	m.mu.Lock()
+	version, full := m.list.Version, m.list.full()
+	diff, ok := m.list.diff(status.ListVersion)
+	m.mu.Unlock()
+	now := time.Now()
+	if status.ListVersion == version {
+		status.SyncedAt = &now
+		return nil
+	}
+	maxLength := m.maxLength(ctx, id)
+	updated := protocol.UpdateStatus("")
+	if ok && version-status.ListVersion <= m.conf.MaxDiff && len(diff) <= len(full) {
+		status.UpdateType = string(protocol.UpdateTypeDifferential)
+		if updated, err = m.send(ctx, id, version, protocol.UpdateTypeDifferential, diff, maxLength); err != nil {
+			return err
+		}
+	}
+	if updated == "" || updated == protocol.UpdateStatusFailed || updated == protocol.UpdateStatusVersionMismatch {
+		status.UpdateType = string(protocol.UpdateTypeFull)
+		if updated, err = m.send(ctx, id, version, protocol.UpdateTypeFull, full, maxLength); err != nil {
+			return err
+		}
+	}
+	switch updated {
+	case protocol.UpdateStatusAccepted:
+		status.ListVersion, status.SyncedAt = version, &now
+		return nil
+	case protocol.UpdateStatusNotSupported:
+		return errNotSupported
+	}
+	return fmt.Errorf("%s update to version %d %s", status.UpdateType, version, updated)
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin syncing the charging points that connect or boot, register it on the server in place of plugin
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+//later syncs id after the delay, the reply to its request goes out first
+func (m *Manager) later(id string) {
+	time.AfterFunc(m.conf.Delay, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if p := m.point(id); p.status.Online {
+			m.trigger(id)
+		}
+	})
+}
+
+func (p *managedPlugin) ChargingPointOnline(id string) error {
+	p.m.mu.Lock()
+	p.m.point(id).status.Online = true
+	p.m.mu.Unlock()
+	p.m.later(id)
+	return p.PassivePlugin.ChargingPointOnline(id)
+}
+
+func (p *managedPlugin) ChargingPointOffline(id string) error {
+	p.m.mu.Lock()
+	p.m.point(id).status.Online = false
+	p.m.mu.Unlock()
+	return p.PassivePlugin.ChargingPointOffline(id)
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if action != protocol.BootNotificationName || !ok {
+		return handler, ok
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		res, err := handler(ctx, id, uniqueid, request)
+		if boot, ok := res.(*protocol.BootNotificationResponse); ok && err == nil && boot.Status == "Accepted" {
+			p.m.later(id)
+		}
+		return res, err
+	}, true
+}
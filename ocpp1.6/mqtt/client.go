@@ -0,0 +1,203 @@
+//Package mqtt connects the mqtt plugins to a broker with the eclipse paho client: the messages are published and
+//subscribed at QoS 1, the session is persistent unless Options.CleanSession is set and the connection may use tls.
+//mqtttest has an in-process broker for the tests of the plugins
+package mqtt
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+const qos = 1
+
+var (
+	ErrClientClosed        = errors.New("mqtt client closed")
+	ErrAckTimeout          = errors.New("mqtt acknowledgement timeout")
+	ErrSubscriptionRefused = errors.New("mqtt subscription refused")
+)
+
+//Handler receives the messages of a subscription, it runs on the reading goroutine of the client and must not block
+type Handler func(topic string, payload []byte)
+
+type Options struct {
+	Addr              string      //host:port of the broker, or a url tcp://, ssl:// or ws://
+	TLS               *tls.Config //the connection uses tls when set, an Addr without scheme is then ssl://
+	ClientID          string
+	Username          string
+	Password          string
+	CleanSession      bool          //the broker drops the subscriptions and the queued messages of the client when it disconnects
+	KeepAlive         time.Duration //30s if 0
+	Timeout           time.Duration //of the dial and of the acknowledgements, 10s if 0
+	ReconnectInterval time.Duration //the maximal interval between the attempts to reconnect, 30s if 0
+	OnConnectionLost  func(err error)
+	OnReconnect       func()
+}
+
+//Client reconnects and subscribes again when the connection is lost
+type Client struct {
+	opts   Options
+	client paho.Client
+
+	mu            sync.Mutex
+	subscriptions map[string]Handler
+	connected     bool //once, the next connections are reconnections
+	closed        bool
+}
+
+//Connect dials the broker, the first connection must succeed
+func Connect(opts Options) (*Client, error) {
+	if opts.KeepAlive <= 0 {
+		opts.KeepAlive = 30 * time.Second
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.ReconnectInterval <= 0 {
+		opts.ReconnectInterval = 30 * time.Second
+	}
+	c := &Client{opts: opts, subscriptions: make(map[string]Handler)}
+	o := paho.NewClientOptions().
+		AddBroker(brokerURL(opts.Addr, opts.TLS != nil)).
+		SetClientID(opts.ClientID).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetCleanSession(opts.CleanSession).
+		SetKeepAlive(opts.KeepAlive).
+		SetConnectTimeout(opts.Timeout).
+		SetWriteTimeout(opts.Timeout).
+		SetAutoReconnect(true).
+		SetMaxReconnectInterval(opts.ReconnectInterval).
+		SetOnConnectHandler(c.onConnect).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			if opts.OnConnectionLost != nil {
+				opts.OnConnectionLost(err)
+			}
+		})
+	if opts.TLS != nil {
+		o.SetTLSConfig(opts.TLS)
+	}
+	c.client = paho.NewClient(o)
+	token := c.client.Connect()
+	if !token.WaitTimeout(opts.Timeout) {
+		c.client.Disconnect(0)
+		return nil, fmt.Errorf("mqtt connect to %s, %w", opts.Addr, ErrAckTimeout)
+	}
+	if err := token.Error(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+//brokerURL adds the scheme to host:port
+func brokerURL(addr string, secure bool) string {
+	if strings.Contains(addr, "://") {
+		return addr
+	}
+	if secure {
+		return "ssl://" + addr
+	}
+	return "tcp://" + addr
+}
+
+//onConnect subscribes again, the broker may have lost the session
+func (c *Client) onConnect(paho.Client) {
+	c.mu.Lock()
+	reconnected := c.connected
+	c.connected = true
+	subscriptions := make(map[string]Handler, len(c.subscriptions))
+	for filter, handler := range c.subscriptions {
+		subscriptions[filter] = handler
+	}
+	c.mu.Unlock()
+	for filter, handler := range subscriptions {
+		c.subscribe(filter, handler)
+	}
+	if reconnected && c.opts.OnReconnect != nil {
+		c.opts.OnReconnect()
+	}
+}
+
+func (c *Client) wait(token paho.Token) error {
+	if !token.WaitTimeout(c.opts.Timeout) {
+		return ErrAckTimeout
+	}
+	return token.Error()
+}
+
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+//Publish sends payload to topic at QoS 1 and waits for the acknowledgement of the broker
+func (c *Client) Publish(topic string, payload []byte) error {
+	if !ValidTopic(topic) {
+		return errors.New("invalid topic " + topic)
+	}
+	if c.isClosed() {
+		return ErrClientClosed
+	}
+	return c.wait(c.client.Publish(topic, qos, false, payload))
+}
+
+func (c *Client) subscribe(filter string, handler Handler) error {
+	token := c.client.Subscribe(filter, qos, func(_ paho.Client, msg paho.Message) {
+		handler(msg.Topic(), msg.Payload())
+	})
+	if err := c.wait(token); err != nil {
+		return err
+	}
+	if code, ok := token.(*paho.SubscribeToken).Result()[filter]; !ok || code == 0x80 {
+		return fmt.Errorf("%w, filter(%s)", ErrSubscriptionRefused, filter)
+	}
+	return nil
+}
+
+//Subscribe registers handler for the messages matching filter and waits for the acknowledgement of the broker.
+//the subscription is kept across reconnections, it is sent on the next connection while the client is reconnecting
+func (c *Client) Subscribe(filter string, handler Handler) error {
+	if !ValidFilter(filter) {
+		return errors.New("invalid topic filter " + filter)
+	}
+	if c.isClosed() {
+		return ErrClientClosed
+	}
+	c.mu.Lock()
+	c.subscriptions[filter] = handler
+	c.mu.Unlock()
+	if err := c.subscribe(filter, handler); err != nil && !errors.Is(err, paho.ErrNotConnected) {
+		c.mu.Lock()
+		delete(c.subscriptions, filter)
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+//Unsubscribe removes the subscription of filter
+func (c *Client) Unsubscribe(filter string) error {
+	c.mu.Lock()
+	delete(c.subscriptions, filter)
+	c.mu.Unlock()
+	return c.wait(c.client.Unsubscribe(filter))
+}
+
+//Close disconnects from the broker and stops reconnecting
+func (c *Client) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mu.Unlock()
+	c.client.Disconnect(uint(c.opts.Timeout / time.Millisecond))
+	return nil
+}
@@ -0,0 +1,33 @@
+package mqtt
+
+import (
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		filter, topic string
+		match         bool
+	}{
+		{"ocpp/+/in/+", "ocpp/CP001/in/BootNotification", true},
+		{"ocpp/+/in/+", "ocpp/CP001/out/Reset", false},
+		{"ocpp/+/in/+", "ocpp/CP001/in", false},
+		{"ocpp/#", "ocpp/CP001/in/BootNotification", true},
+		{"ocpp/#", "ocpp", true},
+		{"#", "ocpp/CP001", true},
+		{"#", "$SYS/clients", false},
+		{"+/CP001", "$SYS/CP001", false},
+		{"ocpp/CP001", "ocpp/CP001/in", false},
+		{"ocpp/CP001/in", "ocpp/CP001", false},
+	}
+	for _, tt := range tests {
+		if Match(tt.filter, tt.topic) != tt.match {
+			t.Errorf("Match(%s, %s) != %v", tt.filter, tt.topic, tt.match)
+		}
+	}
+	for _, filter := range []string{"a/#/b", "a/b+", "a#", ""} {
+		if ValidFilter(filter) {
+			t.Errorf("%s is a valid filter", filter)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package mqtttest
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"ocpp16/mqtt"
+	"sync"
+	"time"
+)
+
+var ErrBrokerClosed = errors.New("mqtt broker closed")
+
+//Authenticator accepts or refuses the username and password of a CONNECT
+type Authenticator func(clientID string, username string, password string) bool
+
+//Broker is an in-process broker backing the tests of the plugins
+type Broker struct {
+	Auth Authenticator //every client is accepted if nil
+
+	mu        sync.Mutex
+	listeners map[net.Listener]struct{}
+	sessions  map[string]*session //client id -> session
+	closed    bool
+	wg        sync.WaitGroup
+}
+
+type session struct {
+	broker   *Broker
+	conn     net.Conn
+	clientID string
+	wmu      sync.Mutex
+	mu       sync.Mutex
+	filters  map[string]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{listeners: make(map[net.Listener]struct{}), sessions: make(map[string]*session)}
+}
+
+//BasicAuth accepts the clients with the username and password
+func BasicAuth(username string, password string) Authenticator {
+	return func(clientID string, u string, p string) bool {
+		return subtle.ConstantTimeCompare([]byte(u+"\x00"+p), []byte(username+"\x00"+password)) == 1
+	}
+}
+
+//ListenAndServe listens on the tcp address addr, e.g. ":1883", and serves the clients until Close
+func (b *Broker) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return b.Serve(l)
+}
+
+//Serve accepts the connections of l until Close, it always returns a non-nil error
+func (b *Broker) Serve(l net.Listener) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		l.Close()
+		return ErrBrokerClosed
+	}
+	b.listeners[l] = struct{}{}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		delete(b.listeners, l)
+		b.mu.Unlock()
+	}()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			b.mu.Lock()
+			closed := b.closed
+			b.mu.Unlock()
+			if closed {
+				return ErrBrokerClosed
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			b.serveConn(conn)
+		}()
+	}
+}
+
+//Close stops the listeners and disconnects every client
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	for l := range b.listeners {
+		l.Close()
+	}
+	for _, s := range b.sessions {
+		s.conn.Close()
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+	return nil
+}
+
+//Clients returns the number of connected clients
+func (b *Broker) Clients() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.sessions)
+}
+
+//Publish delivers payload to the subscribers of topic, as if a client published it
+func (b *Broker) Publish(topic string, payload []byte) error {
+	if !mqtt.ValidTopic(topic) {
+		return errors.New("invalid topic " + topic)
+	}
+	b.deliver(&publishPacket{topic: topic, payload: payload})
+	return nil
+}
+
+func (b *Broker) deliver(pub *publishPacket) {
+	out := &publishPacket{topic: pub.topic, payload: pub.payload}
+	b.mu.Lock()
+	var subscribers []*session
+	for _, s := range b.sessions {
+		if s.subscribed(pub.topic) {
+			subscribers = append(subscribers, s)
+		}
+	}
+	b.mu.Unlock()
+	for _, s := range subscribers {
+		if err := s.write(out.packet()); err != nil {
+			s.conn.Close()
+		}
+	}
+}
+
+func (b *Broker) serveConn(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	p, err := readPacket(r)
+	if err != nil || p.typ != typeConnect {
+		return
+	}
+	s := &session{broker: b, conn: conn, filters: make(map[string]struct{})}
+	c, code, err := parseConnect(p)
+	if err == nil && c.clientID == "" {
+		code = ConnRefusedIdentifier
+	}
+	if err == nil && code == ConnAccepted && b.Auth != nil && !b.Auth(c.clientID, c.username, c.password) {
+		code = ConnRefusedAuth
+	}
+	if err != nil && code == ConnAccepted {
+		return
+	}
+	s.write(ackPacket(typeConnack, uint16(code)))
+	if code != ConnAccepted {
+		return
+	}
+	s.clientID = c.clientID
+	if !b.register(s) {
+		return
+	}
+	defer b.unregister(s)
+
+	keepAlive := time.Duration(c.keepAlive) * time.Second * 3 / 2
+	for {
+		deadline := time.Time{}
+		if keepAlive > 0 {
+			deadline = time.Now().Add(keepAlive)
+		}
+		conn.SetReadDeadline(deadline)
+		p, err := readPacket(r)
+		if err != nil {
+			return
+		}
+		if err = s.handle(p); err != nil {
+			return
+		}
+	}
+}
+
+//register replaces the session of the same client id, as the spec requires
+func (b *Broker) register(s *session) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return false
+	}
+	if old, ok := b.sessions[s.clientID]; ok {
+		old.conn.Close()
+	}
+	b.sessions[s.clientID] = s
+	return true
+}
+
+func (b *Broker) unregister(s *session) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.sessions[s.clientID] == s {
+		delete(b.sessions, s.clientID)
+	}
+}
+
+//errDisconnect ends the session without an error
+var errDisconnect = errors.New("disconnect")
+
+func (s *session) handle(p *packet) error {
+	switch p.typ {
+	case typePublish:
+		pub, err := parsePublish(p)
+		if err != nil {
+			return err
+		}
+		if !mqtt.ValidTopic(pub.topic) {
+			return errors.New("invalid topic " + pub.topic)
+		}
+		if pub.qos == 1 {
+			if err = s.write(ackPacket(typePuback, pub.packetID)); err != nil {
+				return err
+			}
+		}
+		s.broker.deliver(pub)
+	case typeSubscribe, typeUnsubscribe:
+		sub, err := parseSubscribe(p)
+		if err != nil {
+			return err
+		}
+		codes := make([]byte, 0, len(sub.filters))
+		s.mu.Lock()
+		for _, filter := range sub.filters {
+			switch {
+			case p.typ == typeUnsubscribe:
+				delete(s.filters, filter)
+			case mqtt.ValidFilter(filter):
+				s.filters[filter] = struct{}{}
+				codes = append(codes, 0)
+			default:
+				codes = append(codes, 0x80)
+			}
+		}
+		s.mu.Unlock()
+		if p.typ == typeUnsubscribe {
+			return s.write(ackPacket(typeUnsuback, sub.packetID))
+		}
+		return s.write(ackPacket(typeSuback, sub.packetID, codes...))
+	case typePuback:
+	case typePingreq:
+		return s.write(&packet{typ: typePingresp})
+	case typeDisconnect:
+		return errDisconnect
+	default:
+		return ErrMalformedPacket
+	}
+	return nil
+}
+
+func (s *session) subscribed(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for filter := range s.filters {
+		if mqtt.Match(filter, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *session) write(p *packet) error {
+	b, err := p.bytes()
+	if err != nil {
+		return err
+	}
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	_, err = s.conn.Write(b)
+	return err
+}
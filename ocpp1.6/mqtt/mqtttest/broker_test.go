@@ -0,0 +1,102 @@
+package mqtttest
+
+import (
+	"net"
+	"ocpp16/mqtt"
+	"testing"
+	"time"
+)
+
+func startBroker(t *testing.T, b *Broker) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go b.Serve(l)
+	t.Cleanup(func() { b.Close() })
+	return l.Addr().String()
+}
+
+func receive(t *testing.T, c chan string) string {
+	select {
+	case msg := <-c:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("no message")
+	}
+	return ""
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	b := NewBroker()
+	b.Auth = BasicAuth("user", "secret")
+	addr := startBroker(t, b)
+
+	if _, err := mqtt.Connect(mqtt.Options{Addr: addr, ClientID: "bad", Username: "user", Password: "wrong"}); err == nil {
+		t.Fatal("connected with a wrong password")
+	}
+	reconnected := make(chan struct{}, 1)
+	sub, err := mqtt.Connect(mqtt.Options{
+		Addr:              addr,
+		ClientID:          "sub",
+		Username:          "user",
+		Password:          "secret",
+		ReconnectInterval: 10 * time.Millisecond,
+		OnReconnect:       func() { reconnected <- struct{}{} },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	pub, err := mqtt.Connect(mqtt.Options{Addr: addr, ClientID: "pub", Username: "user", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pub.Close()
+
+	messages := make(chan string, 10)
+	if err = sub.Subscribe("ocpp/+/in/#", func(topic string, payload []byte) {
+		messages <- topic + " " + string(payload)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	pub.Publish("ocpp/CP001/out/Reset", []byte(`{}`))
+	pub.Publish("ocpp/CP001/in/Heartbeat", []byte(`{}`))
+	if msg := receive(t, messages); msg != "ocpp/CP001/in/Heartbeat {}" {
+		t.Fatalf("unexpected message %s", msg)
+	}
+	b.Publish("ocpp/CP002/in/Authorize", []byte(`{"idTag":"1"}`))
+	if msg := receive(t, messages); msg != `ocpp/CP002/in/Authorize {"idTag":"1"}` {
+		t.Fatalf("unexpected message %s", msg)
+	}
+
+	//a client with the same id takes the session over, the first one reconnects and subscribes again
+	dup, err := mqtt.Connect(mqtt.Options{Addr: addr, ClientID: "sub", Username: "user", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dup.Close()
+	select {
+	case <-reconnected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("not reconnected")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		pub.Publish("ocpp/CP001/in/StatusNotification", []byte(`{}`))
+		select {
+		case msg := <-messages:
+			if msg != "ocpp/CP001/in/StatusNotification {}" {
+				t.Fatalf("unexpected message %s", msg)
+			}
+			if err = sub.Unsubscribe("ocpp/+/in/#"); err != nil {
+				t.Fatal(err)
+			}
+			return
+		case <-time.After(20 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("not subscribed again")
+		}
+	}
+}
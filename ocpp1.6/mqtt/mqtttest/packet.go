@@ -0,0 +1,233 @@
+//Package mqtttest is a minimal MQTT 3.1.1 broker for the tests of the mqtt client and plugins, it is not meant for
+//production: QoS 0 delivery (QoS 1 publishes are acknowledged and delivered at QoS 0, QoS 1 subscriptions are granted
+//QoS 0), no retained messages, no wills, no persistent sessions, no tls
+package mqtttest
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	typeConnect     = 1
+	typeConnack     = 2
+	typePublish     = 3
+	typePuback      = 4
+	typeSubscribe   = 8
+	typeSuback      = 9
+	typeUnsubscribe = 10
+	typeUnsuback    = 11
+	typePingreq     = 12
+	typePingresp    = 13
+	typeDisconnect  = 14
+)
+
+//the return codes of CONNACK
+const (
+	ConnAccepted          = 0
+	ConnRefusedProtocol   = 1
+	ConnRefusedIdentifier = 2
+	ConnRefusedAuth       = 4
+)
+
+const maxRemainingLength = 268435455
+
+var ErrMalformedPacket = errors.New("malformed mqtt packet")
+
+type packet struct {
+	typ     byte
+	flags   byte
+	payload []byte //everything after the fixed header
+}
+
+func readPacket(r *bufio.Reader) (*packet, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	length, multiplier := 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return nil, ErrMalformedPacket
+		}
+		digit, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		length += int(digit&0x7f) * multiplier
+		if digit&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+	p := &packet{typ: b >> 4, flags: b & 0x0f, payload: make([]byte, length)}
+	if _, err = io.ReadFull(r, p.payload); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *packet) bytes() ([]byte, error) {
+	length := len(p.payload)
+	if length > maxRemainingLength {
+		return nil, fmt.Errorf("mqtt packet too large, %d bytes", length)
+	}
+	b := make([]byte, 0, 5+length)
+	b = append(b, p.typ<<4|p.flags)
+	for {
+		digit := byte(length % 128)
+		if length /= 128; length > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if length == 0 {
+			break
+		}
+	}
+	return append(b, p.payload...), nil
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+//decoder reads the fields of a packet payload, the first error sticks
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) uint16() uint16 {
+	if d.err != nil || len(d.b) < 2 {
+		d.err = ErrMalformedPacket
+		return 0
+	}
+	v := binary.BigEndian.Uint16(d.b)
+	d.b = d.b[2:]
+	return v
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil || len(d.b) < 1 {
+		d.err = ErrMalformedPacket
+		return 0
+	}
+	v := d.b[0]
+	d.b = d.b[1:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	n := int(d.uint16())
+	if d.err != nil || len(d.b) < n {
+		d.err = ErrMalformedPacket
+		return nil
+	}
+	v := d.b[:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	return string(d.bytes())
+}
+
+type connectPacket struct {
+	clientID  string
+	username  string
+	password  string
+	keepAlive uint16
+}
+
+//parseConnect returns the CONNACK return code when the connection must be refused
+func parseConnect(p *packet) (*connectPacket, byte, error) {
+	d := &decoder{b: p.payload}
+	name, level, flags := d.string(), d.byte(), d.byte()
+	c := &connectPacket{keepAlive: d.uint16()}
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	if name != "MQTT" || level != 4 {
+		return nil, ConnRefusedProtocol, fmt.Errorf("unsupported protocol %s level %d", name, level)
+	}
+	c.clientID = d.string()
+	if flags&0x04 != 0 { //will topic and message are read and ignored
+		d.string()
+		d.bytes()
+	}
+	if flags&0x80 != 0 {
+		c.username = d.string()
+	}
+	if flags&0x40 != 0 {
+		c.password = d.string()
+	}
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	return c, ConnAccepted, nil
+}
+
+type publishPacket struct {
+	topic    string
+	qos      byte
+	packetID uint16
+	payload  []byte
+}
+
+func (pub *publishPacket) packet() *packet {
+	b := appendString(nil, pub.topic)
+	if pub.qos > 0 {
+		b = appendUint16(b, pub.packetID)
+	}
+	return &packet{typ: typePublish, flags: pub.qos << 1, payload: append(b, pub.payload...)}
+}
+
+func parsePublish(p *packet) (*publishPacket, error) {
+	d := &decoder{b: p.payload}
+	pub := &publishPacket{topic: d.string(), qos: (p.flags >> 1) & 0x03}
+	if pub.qos > 0 {
+		pub.packetID = d.uint16()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if pub.qos > 1 {
+		return nil, fmt.Errorf("QoS %d not supported", pub.qos)
+	}
+	pub.payload = d.b
+	return pub, nil
+}
+
+//subscribePacket is a SUBSCRIBE or an UNSUBSCRIBE
+type subscribePacket struct {
+	packetID uint16
+	filters  []string
+}
+
+func parseSubscribe(p *packet) (*subscribePacket, error) {
+	d := &decoder{b: p.payload}
+	s := &subscribePacket{packetID: d.uint16()}
+	for d.err == nil && len(d.b) > 0 {
+		s.filters = append(s.filters, d.string())
+		if p.typ == typeSubscribe {
+			d.byte()
+		}
+	}
+	if d.err != nil || len(s.filters) == 0 {
+		return nil, ErrMalformedPacket
+	}
+	return s, nil
+}
+
+//ackPacket is a PUBACK, SUBACK or UNSUBACK
+func ackPacket(typ byte, packetID uint16, codes ...byte) *packet {
+	return &packet{typ: typ, payload: append(appendUint16(nil, packetID), codes...)}
+}
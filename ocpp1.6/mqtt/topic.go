@@ -0,0 +1,49 @@
+package mqtt
+
+import (
+	"strings"
+)
+
+//ValidTopic reports whether topic can be published to, wildcards are only allowed in filters
+func ValidTopic(topic string) bool {
+	return topic != "" && len(topic) <= 65535 && !strings.ContainsAny(topic, "+#\x00")
+}
+
+//ValidFilter reports whether filter is a valid subscription, "+" matches one level and a trailing "#" the remaining ones
+func ValidFilter(filter string) bool {
+	if filter == "" || len(filter) > 65535 || strings.Contains(filter, "\x00") {
+		return false
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		if strings.Contains(level, "#") && (level != "#" || i != len(levels)-1) {
+			return false
+		}
+		if strings.Contains(level, "+") && level != "+" {
+			return false
+		}
+	}
+	return true
+}
+
+//Match reports whether topic matches filter, the wildcards at the first level do not match the topics starting with "$"
+func Match(filter string, topic string) bool {
+	if strings.HasPrefix(topic, "$") && (strings.HasPrefix(filter, "+") || strings.HasPrefix(filter, "#")) {
+		return false
+	}
+	for {
+		f, fRest, fMore := strings.Cut(filter, "/")
+		if f == "#" {
+			return true
+		}
+		t, tRest, tMore := strings.Cut(topic, "/")
+		if f != "+" && f != t {
+			return false
+		}
+		if !fMore || !tMore {
+			//"a/#" matches "a" as well
+			return fMore == tMore || (fMore && fRest == "#")
+		}
+		filter, topic = fRest, tRest
+	}
+}
@@ -0,0 +1,63 @@
+package ocpp201
+
+type HashAlgorithmEnumType string
+
+const (
+	HashAlgorithmSHA256 HashAlgorithmEnumType = "SHA256"
+	HashAlgorithmSHA384 HashAlgorithmEnumType = "SHA384"
+	HashAlgorithmSHA512 HashAlgorithmEnumType = "SHA512"
+)
+
+type AuthorizeCertificateStatusEnumType string
+
+const (
+	AuthorizeCertificateAccepted               AuthorizeCertificateStatusEnumType = "Accepted"
+	AuthorizeCertificateSignatureError         AuthorizeCertificateStatusEnumType = "SignatureError"
+	AuthorizeCertificateExpired                AuthorizeCertificateStatusEnumType = "CertificateExpired"
+	AuthorizeCertificateRevoked                AuthorizeCertificateStatusEnumType = "CertificateRevoked"
+	AuthorizeCertificateNoCertificateAvailable AuthorizeCertificateStatusEnumType = "NoCertificateAvailable"
+	AuthorizeCertificateCertChainError         AuthorizeCertificateStatusEnumType = "CertChainError"
+	AuthorizeCertificateContractCancelled      AuthorizeCertificateStatusEnumType = "ContractCancelled"
+)
+
+func init() {
+	registerEnum("hashAlgorithm", string(HashAlgorithmSHA256), string(HashAlgorithmSHA384), string(HashAlgorithmSHA512))
+	registerEnum("authorizeCertificateStatus", string(AuthorizeCertificateAccepted), string(AuthorizeCertificateSignatureError),
+		string(AuthorizeCertificateExpired), string(AuthorizeCertificateRevoked), string(AuthorizeCertificateNoCertificateAvailable),
+		string(AuthorizeCertificateCertChainError), string(AuthorizeCertificateContractCancelled))
+}
+
+type OCSPRequestDataType struct {
+	HashAlgorithm  HashAlgorithmEnumType `json:"hashAlgorithm" validate:"required,hashAlgorithm"`
+	IssuerNameHash string                `json:"issuerNameHash" validate:"required,max=128"`
+	IssuerKeyHash  string                `json:"issuerKeyHash" validate:"required,max=128"`
+	SerialNumber   string                `json:"serialNumber" validate:"required,max=40"`
+	ResponderURL   string                `json:"responderURL" validate:"required,max=512"`
+}
+
+type AuthorizeRequest struct {
+	IdToken                     IdTokenType           `json:"idToken" validate:"required"`
+	Certificate                 string                `json:"certificate,omitempty" validate:"omitempty,max=5500"`
+	Iso15118CertificateHashData []OCSPRequestDataType `json:"iso15118CertificateHashData,omitempty" validate:"omitempty,min=1,max=4,dive"`
+}
+
+func (AuthorizeRequest) Action() string {
+	return AuthorizeName
+}
+
+func (r *AuthorizeRequest) Reset() {
+	*r = AuthorizeRequest{}
+}
+
+type AuthorizeResponse struct {
+	IdTokenInfo       IdTokenInfoType                    `json:"idTokenInfo" validate:"required"`
+	CertificateStatus AuthorizeCertificateStatusEnumType `json:"certificateStatus,omitempty" validate:"omitempty,authorizeCertificateStatus"`
+}
+
+func (AuthorizeResponse) Action() string {
+	return AuthorizeName
+}
+
+func (r *AuthorizeResponse) Reset() {
+	*r = AuthorizeResponse{}
+}
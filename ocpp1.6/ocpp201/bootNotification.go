@@ -0,0 +1,71 @@
+package ocpp201
+
+type BootReasonEnumType string
+
+const (
+	BootReasonApplicationReset BootReasonEnumType = "ApplicationReset"
+	BootReasonFirmwareUpdate   BootReasonEnumType = "FirmwareUpdate"
+	BootReasonLocalReset       BootReasonEnumType = "LocalReset"
+	BootReasonPowerUp          BootReasonEnumType = "PowerUp"
+	BootReasonRemoteReset      BootReasonEnumType = "RemoteReset"
+	BootReasonScheduledReset   BootReasonEnumType = "ScheduledReset"
+	BootReasonTriggered        BootReasonEnumType = "Triggered"
+	BootReasonUnknown          BootReasonEnumType = "Unknown"
+	BootReasonWatchdog         BootReasonEnumType = "Watchdog"
+)
+
+type RegistrationStatusEnumType string
+
+const (
+	RegistrationStatusAccepted RegistrationStatusEnumType = "Accepted"
+	RegistrationStatusPending  RegistrationStatusEnumType = "Pending"
+	RegistrationStatusRejected RegistrationStatusEnumType = "Rejected"
+)
+
+func init() {
+	registerEnum("bootReason", string(BootReasonApplicationReset), string(BootReasonFirmwareUpdate), string(BootReasonLocalReset),
+		string(BootReasonPowerUp), string(BootReasonRemoteReset), string(BootReasonScheduledReset), string(BootReasonTriggered),
+		string(BootReasonUnknown), string(BootReasonWatchdog))
+	registerEnum("registrationStatus", string(RegistrationStatusAccepted), string(RegistrationStatusPending), string(RegistrationStatusRejected))
+}
+
+type ModemType struct {
+	Iccid string `json:"iccid,omitempty" validate:"omitempty,max=20"`
+	Imsi  string `json:"imsi,omitempty" validate:"omitempty,max=20"`
+}
+
+type ChargingStationType struct {
+	SerialNumber    string     `json:"serialNumber,omitempty" validate:"omitempty,max=25"`
+	Model           string     `json:"model" validate:"required,max=20"`
+	Modem           *ModemType `json:"modem,omitempty" validate:"omitempty"`
+	VendorName      string     `json:"vendorName" validate:"required,max=50"`
+	FirmwareVersion string     `json:"firmwareVersion,omitempty" validate:"omitempty,max=50"`
+}
+
+type BootNotificationRequest struct {
+	ChargingStation ChargingStationType `json:"chargingStation" validate:"required"`
+	Reason          BootReasonEnumType  `json:"reason" validate:"required,bootReason"`
+}
+
+func (BootNotificationRequest) Action() string {
+	return BootNotificationName
+}
+
+func (r *BootNotificationRequest) Reset() {
+	*r = BootNotificationRequest{}
+}
+
+type BootNotificationResponse struct {
+	CurrentTime string                     `json:"currentTime" validate:"required,dateTime"`
+	Interval    *int                       `json:"interval" validate:"required,gte=0"`
+	Status      RegistrationStatusEnumType `json:"status" validate:"required,registrationStatus"`
+	StatusInfo  *StatusInfoType            `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (BootNotificationResponse) Action() string {
+	return BootNotificationName
+}
+
+func (r *BootNotificationResponse) Reset() {
+	*r = BootNotificationResponse{}
+}
@@ -0,0 +1,47 @@
+package ocpp201
+
+type OperationalStatusEnumType string
+
+const (
+	OperationalStatusInoperative OperationalStatusEnumType = "Inoperative"
+	OperationalStatusOperative   OperationalStatusEnumType = "Operative"
+)
+
+type ChangeAvailabilityStatusEnumType string
+
+const (
+	ChangeAvailabilityAccepted  ChangeAvailabilityStatusEnumType = "Accepted"
+	ChangeAvailabilityRejected  ChangeAvailabilityStatusEnumType = "Rejected"
+	ChangeAvailabilityScheduled ChangeAvailabilityStatusEnumType = "Scheduled"
+)
+
+func init() {
+	registerEnum("operationalStatus", string(OperationalStatusInoperative), string(OperationalStatusOperative))
+	registerEnum("changeAvailabilityStatus", string(ChangeAvailabilityAccepted), string(ChangeAvailabilityRejected), string(ChangeAvailabilityScheduled))
+}
+
+type ChangeAvailabilityRequest struct {
+	OperationalStatus OperationalStatusEnumType `json:"operationalStatus" validate:"required,operationalStatus"`
+	EVSE              *EVSEType                 `json:"evse,omitempty" validate:"omitempty"`
+}
+
+func (ChangeAvailabilityRequest) Action() string {
+	return ChangeAvailabilityName
+}
+
+func (r *ChangeAvailabilityRequest) Reset() {
+	*r = ChangeAvailabilityRequest{}
+}
+
+type ChangeAvailabilityResponse struct {
+	Status     ChangeAvailabilityStatusEnumType `json:"status" validate:"required,changeAvailabilityStatus"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (ChangeAvailabilityResponse) Action() string {
+	return ChangeAvailabilityName
+}
+
+func (r *ChangeAvailabilityResponse) Reset() {
+	*r = ChangeAvailabilityResponse{}
+}
@@ -0,0 +1,33 @@
+package ocpp201
+
+type ClearCacheStatusEnumType string
+
+const (
+	ClearCacheAccepted ClearCacheStatusEnumType = "Accepted"
+	ClearCacheRejected ClearCacheStatusEnumType = "Rejected"
+)
+
+func init() {
+	registerEnum("clearCacheStatus", string(ClearCacheAccepted), string(ClearCacheRejected))
+}
+
+type ClearCacheRequest struct{}
+
+func (ClearCacheRequest) Action() string {
+	return ClearCacheName
+}
+
+func (r *ClearCacheRequest) Reset() {}
+
+type ClearCacheResponse struct {
+	Status     ClearCacheStatusEnumType `json:"status" validate:"required,clearCacheStatus"`
+	StatusInfo *StatusInfoType          `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (ClearCacheResponse) Action() string {
+	return ClearCacheName
+}
+
+func (r *ClearCacheResponse) Reset() {
+	*r = ClearCacheResponse{}
+}
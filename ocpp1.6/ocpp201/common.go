@@ -0,0 +1,243 @@
+package ocpp201
+
+import (
+	"time"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+//Validate has its own registrations because several enumerations share a name with ocpp1.6 but accept other values
+var Validate = validator.New()
+
+const (
+	//currently, the timestamp supports rfc3339,RFC3339Nano, ISO8601
+	RFC3339     = time.RFC3339
+	RFC3339Nano = time.RFC3339Nano
+	ISO8601     = "2006-01-02T15:04:05Z"
+)
+
+func init() {
+	Validate.RegisterValidation("dateTime", func(f validator.FieldLevel) bool {
+		timeString := f.Field().String()
+		timeFormatList := []string{time.RFC3339, ISO8601, RFC3339Nano}
+		for _, v := range timeFormatList {
+			if _, err := time.Parse(v, timeString); err == nil {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+//registerEnum registers tag as a validation that only accepts values, ocpp2.0.1 has too many enumerations to write a switch for each
+func registerEnum(tag string, values ...string) {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	Validate.RegisterValidation(tag, func(f validator.FieldLevel) bool {
+		_, ok := set[f.Field().String()]
+		return ok
+	})
+}
+
+const (
+	BootNotificationName           = "BootNotification"
+	HeartbeatName                  = "Heartbeat"
+	StatusNotificationName         = "StatusNotification"
+	AuthorizeName                  = "Authorize"
+	TransactionEventName           = "TransactionEvent"
+	MeterValuesName                = "MeterValues"
+	NotifyReportName               = "NotifyReport"
+	NotifyEventName                = "NotifyEvent"
+	FirmwareStatusNotificationName = "FirmwareStatusNotification"
+	LogStatusNotificationName      = "LogStatusNotification"
+	SecurityEventNotificationName  = "SecurityEventNotification"
+	DataTransferName               = "DataTransfer"
+	GetVariablesName               = "GetVariables"
+	SetVariablesName               = "SetVariables"
+	GetBaseReportName              = "GetBaseReport"
+	RequestStartTransactionName    = "RequestStartTransaction"
+	RequestStopTransactionName     = "RequestStopTransaction"
+	ResetName                      = "Reset"
+	ChangeAvailabilityName         = "ChangeAvailability"
+	UnlockConnectorName            = "UnlockConnector"
+	TriggerMessageName             = "TriggerMessage"
+	ClearCacheName                 = "ClearCache"
+	GetTransactionStatusName       = "GetTransactionStatus"
+	SetChargingProfileName         = "SetChargingProfile"
+	UpdateFirmwareName             = "UpdateFirmware"
+	GetLogName                     = "GetLog"
+)
+
+type StatusInfoType struct {
+	ReasonCode     string `json:"reasonCode" validate:"required,max=20"`
+	AdditionalInfo string `json:"additionalInfo,omitempty" validate:"omitempty,max=512"`
+}
+
+type EVSEType struct {
+	ID          *int `json:"id" validate:"required,gte=0"`
+	ConnectorID *int `json:"connectorId,omitempty" validate:"omitempty,gte=0"`
+}
+
+type IdTokenEnumType string
+
+const (
+	IdTokenCentral         IdTokenEnumType = "Central"
+	IdTokenEMAID           IdTokenEnumType = "eMAID"
+	IdTokenISO14443        IdTokenEnumType = "ISO14443"
+	IdTokenISO15693        IdTokenEnumType = "ISO15693"
+	IdTokenKeyCode         IdTokenEnumType = "KeyCode"
+	IdTokenLocal           IdTokenEnumType = "Local"
+	IdTokenMacAddress      IdTokenEnumType = "MacAddress"
+	IdTokenNoAuthorization IdTokenEnumType = "NoAuthorization"
+)
+
+type AdditionalInfoType struct {
+	AdditionalIdToken string `json:"additionalIdToken" validate:"required,max=36"`
+	Type              string `json:"type" validate:"required,max=50"`
+}
+
+type IdTokenType struct {
+	IdToken        string               `json:"idToken" validate:"max=36"`
+	Type           IdTokenEnumType      `json:"type" validate:"required,idTokenType"`
+	AdditionalInfo []AdditionalInfoType `json:"additionalInfo,omitempty" validate:"omitempty,dive"`
+}
+
+type AuthorizationStatusEnumType string
+
+const (
+	AuthorizationAccepted           AuthorizationStatusEnumType = "Accepted"
+	AuthorizationBlocked            AuthorizationStatusEnumType = "Blocked"
+	AuthorizationConcurrentTx       AuthorizationStatusEnumType = "ConcurrentTx"
+	AuthorizationExpired            AuthorizationStatusEnumType = "Expired"
+	AuthorizationInvalid            AuthorizationStatusEnumType = "Invalid"
+	AuthorizationNoCredit           AuthorizationStatusEnumType = "NoCredit"
+	AuthorizationNotAllowedTypeEVSE AuthorizationStatusEnumType = "NotAllowedTypeEVSE"
+	AuthorizationNotAtThisLocation  AuthorizationStatusEnumType = "NotAtThisLocation"
+	AuthorizationNotAtThisTime      AuthorizationStatusEnumType = "NotAtThisTime"
+	AuthorizationUnknown            AuthorizationStatusEnumType = "Unknown"
+)
+
+type MessageFormatEnumType string
+
+const (
+	MessageFormatASCII MessageFormatEnumType = "ASCII"
+	MessageFormatHTML  MessageFormatEnumType = "HTML"
+	MessageFormatURI   MessageFormatEnumType = "URI"
+	MessageFormatUTF8  MessageFormatEnumType = "UTF8"
+)
+
+type MessageContentType struct {
+	Format   MessageFormatEnumType `json:"format" validate:"required,messageFormat"`
+	Language string                `json:"language,omitempty" validate:"omitempty,max=8"`
+	Content  string                `json:"content" validate:"required,max=512"`
+}
+
+type IdTokenInfoType struct {
+	Status              AuthorizationStatusEnumType `json:"status" validate:"required,authorizationStatus"`
+	CacheExpiryDateTime string                      `json:"cacheExpiryDateTime,omitempty" validate:"omitempty,dateTime"`
+	ChargingPriority    *int                        `json:"chargingPriority,omitempty" validate:"omitempty,gte=-9,lte=9"`
+	Language1           string                      `json:"language1,omitempty" validate:"omitempty,max=8"`
+	EvseID              []int                       `json:"evseId,omitempty" validate:"omitempty,dive,gte=0"`
+	GroupIdToken        *IdTokenType                `json:"groupIdToken,omitempty" validate:"omitempty"`
+	Language2           string                      `json:"language2,omitempty" validate:"omitempty,max=8"`
+	PersonalMessage     *MessageContentType         `json:"personalMessage,omitempty" validate:"omitempty"`
+}
+
+type ComponentType struct {
+	Name     string    `json:"name" validate:"required,max=50"`
+	Instance string    `json:"instance,omitempty" validate:"omitempty,max=50"`
+	EVSE     *EVSEType `json:"evse,omitempty" validate:"omitempty"`
+}
+
+type VariableType struct {
+	Name     string `json:"name" validate:"required,max=50"`
+	Instance string `json:"instance,omitempty" validate:"omitempty,max=50"`
+}
+
+type AttributeEnumType string
+
+const (
+	AttributeActual AttributeEnumType = "Actual"
+	AttributeTarget AttributeEnumType = "Target"
+	AttributeMinSet AttributeEnumType = "MinSet"
+	AttributeMaxSet AttributeEnumType = "MaxSet"
+)
+
+func init() {
+	registerEnum("idTokenType", string(IdTokenCentral), string(IdTokenEMAID), string(IdTokenISO14443), string(IdTokenISO15693),
+		string(IdTokenKeyCode), string(IdTokenLocal), string(IdTokenMacAddress), string(IdTokenNoAuthorization))
+	registerEnum("authorizationStatus", string(AuthorizationAccepted), string(AuthorizationBlocked), string(AuthorizationConcurrentTx),
+		string(AuthorizationExpired), string(AuthorizationInvalid), string(AuthorizationNoCredit), string(AuthorizationNotAllowedTypeEVSE),
+		string(AuthorizationNotAtThisLocation), string(AuthorizationNotAtThisTime), string(AuthorizationUnknown))
+	registerEnum("messageFormat", string(MessageFormatASCII), string(MessageFormatHTML), string(MessageFormatURI), string(MessageFormatUTF8))
+	registerEnum("attribute", string(AttributeActual), string(AttributeTarget), string(AttributeMinSet), string(AttributeMaxSet))
+}
+
+type ChargingRateUnitEnumType string
+
+const (
+	ChargingRateUnitW ChargingRateUnitEnumType = "W"
+	ChargingRateUnitA ChargingRateUnitEnumType = "A"
+)
+
+type ChargingProfilePurposeEnumType string
+
+const (
+	ChargingStationExternalConstraints ChargingProfilePurposeEnumType = "ChargingStationExternalConstraints"
+	ChargingStationMaxProfile          ChargingProfilePurposeEnumType = "ChargingStationMaxProfile"
+	TxDefaultProfile                   ChargingProfilePurposeEnumType = "TxDefaultProfile"
+	TxProfile                          ChargingProfilePurposeEnumType = "TxProfile"
+)
+
+type ChargingProfileKindEnumType string
+
+const (
+	ChargingProfileKindAbsolute  ChargingProfileKindEnumType = "Absolute"
+	ChargingProfileKindRecurring ChargingProfileKindEnumType = "Recurring"
+	ChargingProfileKindRelative  ChargingProfileKindEnumType = "Relative"
+)
+
+type RecurrencyKindEnumType string
+
+const (
+	RecurrencyKindDaily  RecurrencyKindEnumType = "Daily"
+	RecurrencyKindWeekly RecurrencyKindEnumType = "Weekly"
+)
+
+type ChargingSchedulePeriodType struct {
+	StartPeriod  *int     `json:"startPeriod" validate:"required,gte=0"`
+	Limit        *float64 `json:"limit" validate:"required"`
+	NumberPhases *int     `json:"numberPhases,omitempty" validate:"omitempty,gte=1,lte=3"`
+	PhaseToUse   *int     `json:"phaseToUse,omitempty" validate:"omitempty,gte=1,lte=3"`
+}
+
+type ChargingScheduleType struct {
+	ID                     *int                         `json:"id" validate:"required"`
+	StartSchedule          string                       `json:"startSchedule,omitempty" validate:"omitempty,dateTime"`
+	Duration               *int                         `json:"duration,omitempty" validate:"omitempty"`
+	ChargingRateUnit       ChargingRateUnitEnumType     `json:"chargingRateUnit" validate:"required,chargingRateUnit"`
+	ChargingSchedulePeriod []ChargingSchedulePeriodType `json:"chargingSchedulePeriod" validate:"required,min=1,max=1024,dive"`
+	MinChargingRate        *float64                     `json:"minChargingRate,omitempty" validate:"omitempty"`
+}
+
+type ChargingProfileType struct {
+	ID                     *int                           `json:"id" validate:"required"`
+	StackLevel             *int                           `json:"stackLevel" validate:"required,gte=0"`
+	ChargingProfilePurpose ChargingProfilePurposeEnumType `json:"chargingProfilePurpose" validate:"required,chargingProfilePurpose"`
+	ChargingProfileKind    ChargingProfileKindEnumType    `json:"chargingProfileKind" validate:"required,chargingProfileKind"`
+	RecurrencyKind         RecurrencyKindEnumType         `json:"recurrencyKind,omitempty" validate:"omitempty,recurrencyKind"`
+	ValidFrom              string                         `json:"validFrom,omitempty" validate:"omitempty,dateTime"`
+	ValidTo                string                         `json:"validTo,omitempty" validate:"omitempty,dateTime"`
+	TransactionID          string                         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	ChargingSchedule       []ChargingScheduleType         `json:"chargingSchedule" validate:"required,min=1,max=3,dive"`
+}
+
+func init() {
+	registerEnum("chargingRateUnit", string(ChargingRateUnitW), string(ChargingRateUnitA))
+	registerEnum("chargingProfilePurpose", string(ChargingStationExternalConstraints), string(ChargingStationMaxProfile),
+		string(TxDefaultProfile), string(TxProfile))
+	registerEnum("chargingProfileKind", string(ChargingProfileKindAbsolute), string(ChargingProfileKindRecurring), string(ChargingProfileKindRelative))
+	registerEnum("recurrencyKind", string(RecurrencyKindDaily), string(RecurrencyKindWeekly))
+}
@@ -0,0 +1,44 @@
+package ocpp201
+
+type DataTransferStatusEnumType string
+
+const (
+	DataTransferAccepted         DataTransferStatusEnumType = "Accepted"
+	DataTransferRejected         DataTransferStatusEnumType = "Rejected"
+	DataTransferUnknownMessageId DataTransferStatusEnumType = "UnknownMessageId"
+	DataTransferUnknownVendorId  DataTransferStatusEnumType = "UnknownVendorId"
+)
+
+func init() {
+	registerEnum("dataTransferStatus", string(DataTransferAccepted), string(DataTransferRejected),
+		string(DataTransferUnknownMessageId), string(DataTransferUnknownVendorId))
+}
+
+//DataTransfer can be sent by both sides, unlike ocpp1.6 the data may be any json value
+type DataTransferRequest struct {
+	MessageID string      `json:"messageId,omitempty" validate:"omitempty,max=50"`
+	Data      interface{} `json:"data,omitempty" validate:"omitempty"`
+	VendorID  string      `json:"vendorId" validate:"required,max=255"`
+}
+
+func (DataTransferRequest) Action() string {
+	return DataTransferName
+}
+
+func (r *DataTransferRequest) Reset() {
+	*r = DataTransferRequest{}
+}
+
+type DataTransferResponse struct {
+	Status     DataTransferStatusEnumType `json:"status" validate:"required,dataTransferStatus"`
+	StatusInfo *StatusInfoType            `json:"statusInfo,omitempty" validate:"omitempty"`
+	Data       interface{}                `json:"data,omitempty" validate:"omitempty"`
+}
+
+func (DataTransferResponse) Action() string {
+	return DataTransferName
+}
+
+func (r *DataTransferResponse) Reset() {
+	*r = DataTransferResponse{}
+}
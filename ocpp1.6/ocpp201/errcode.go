@@ -0,0 +1,40 @@
+package ocpp201
+
+import (
+	"ocpp16/protocol"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+//ocpp2.0.1 reuses the rpc framework of ocpp1.6, but renamed two error codes and added three
+const (
+	FormatViolation               protocol.ErrCodeType = "FormatViolation"
+	OccurrenceConstraintViolation protocol.ErrCodeType = "OccurrenceConstraintViolation"
+	MessageTypeNotSupported       protocol.ErrCodeType = "MessageTypeNotSupported"
+	RpcFrameworkError             protocol.ErrCodeType = "RpcFrameworkError"
+)
+
+func init() {
+	Validate.RegisterValidation("errorCode", func(f validator.FieldLevel) bool {
+		errcode := protocol.ErrCodeType(f.Field().String())
+		switch errcode {
+		case protocol.NotImplemented, protocol.NotSupported, protocol.CallInternalError, protocol.ProtocolError,
+			protocol.SecurityError, FormatViolation, protocol.PropertyConstraintViolation, OccurrenceConstraintViolation,
+			protocol.TypeConstraintViolation, protocol.GenericError, MessageTypeNotSupported, RpcFrameworkError:
+			return true
+		}
+		return false
+	})
+}
+
+//ErrorCode converts the ocpp1.6 name of an error code to the ocpp2.0.1 one, other codes are returned unchanged
+func ErrorCode(code protocol.ErrCodeType) protocol.ErrCodeType {
+	switch code {
+	case protocol.FormationViolation:
+		return FormatViolation
+	case protocol.OccurenceConstraintViolation:
+		return OccurrenceConstraintViolation
+	default:
+		return code
+	}
+}
@@ -0,0 +1,49 @@
+package ocpp201
+
+type FirmwareStatusEnumType string
+
+const (
+	FirmwareStatusDownloaded                FirmwareStatusEnumType = "Downloaded"
+	FirmwareStatusDownloadFailed            FirmwareStatusEnumType = "DownloadFailed"
+	FirmwareStatusDownloading               FirmwareStatusEnumType = "Downloading"
+	FirmwareStatusDownloadScheduled         FirmwareStatusEnumType = "DownloadScheduled"
+	FirmwareStatusDownloadPaused            FirmwareStatusEnumType = "DownloadPaused"
+	FirmwareStatusIdle                      FirmwareStatusEnumType = "Idle"
+	FirmwareStatusInstallationFailed        FirmwareStatusEnumType = "InstallationFailed"
+	FirmwareStatusInstalling                FirmwareStatusEnumType = "Installing"
+	FirmwareStatusInstalled                 FirmwareStatusEnumType = "Installed"
+	FirmwareStatusInstallRebooting          FirmwareStatusEnumType = "InstallRebooting"
+	FirmwareStatusInstallScheduled          FirmwareStatusEnumType = "InstallScheduled"
+	FirmwareStatusInstallVerificationFailed FirmwareStatusEnumType = "InstallVerificationFailed"
+	FirmwareStatusInvalidSignature          FirmwareStatusEnumType = "InvalidSignature"
+	FirmwareStatusSignatureVerified         FirmwareStatusEnumType = "SignatureVerified"
+)
+
+func init() {
+	registerEnum("firmwareStatus", string(FirmwareStatusDownloaded), string(FirmwareStatusDownloadFailed), string(FirmwareStatusDownloading),
+		string(FirmwareStatusDownloadScheduled), string(FirmwareStatusDownloadPaused), string(FirmwareStatusIdle),
+		string(FirmwareStatusInstallationFailed), string(FirmwareStatusInstalling), string(FirmwareStatusInstalled),
+		string(FirmwareStatusInstallRebooting), string(FirmwareStatusInstallScheduled), string(FirmwareStatusInstallVerificationFailed),
+		string(FirmwareStatusInvalidSignature), string(FirmwareStatusSignatureVerified))
+}
+
+type FirmwareStatusNotificationRequest struct {
+	Status    FirmwareStatusEnumType `json:"status" validate:"required,firmwareStatus"`
+	RequestID *int                   `json:"requestId,omitempty" validate:"omitempty"`
+}
+
+func (FirmwareStatusNotificationRequest) Action() string {
+	return FirmwareStatusNotificationName
+}
+
+func (r *FirmwareStatusNotificationRequest) Reset() {
+	*r = FirmwareStatusNotificationRequest{}
+}
+
+type FirmwareStatusNotificationResponse struct{}
+
+func (FirmwareStatusNotificationResponse) Action() string {
+	return FirmwareStatusNotificationName
+}
+
+func (r *FirmwareStatusNotificationResponse) Reset() {}
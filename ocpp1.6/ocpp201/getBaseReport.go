@@ -0,0 +1,50 @@
+package ocpp201
+
+type ReportBaseEnumType string
+
+const (
+	ReportBaseConfigurationInventory ReportBaseEnumType = "ConfigurationInventory"
+	ReportBaseFullInventory          ReportBaseEnumType = "FullInventory"
+	ReportBaseSummaryInventory       ReportBaseEnumType = "SummaryInventory"
+)
+
+type GenericDeviceModelStatusEnumType string
+
+const (
+	GenericDeviceModelStatusAccepted       GenericDeviceModelStatusEnumType = "Accepted"
+	GenericDeviceModelStatusRejected       GenericDeviceModelStatusEnumType = "Rejected"
+	GenericDeviceModelStatusNotSupported   GenericDeviceModelStatusEnumType = "NotSupported"
+	GenericDeviceModelStatusEmptyResultSet GenericDeviceModelStatusEnumType = "EmptyResultSet"
+)
+
+func init() {
+	registerEnum("reportBase", string(ReportBaseConfigurationInventory), string(ReportBaseFullInventory), string(ReportBaseSummaryInventory))
+	registerEnum("genericDeviceModelStatus", string(GenericDeviceModelStatusAccepted), string(GenericDeviceModelStatusRejected),
+		string(GenericDeviceModelStatusNotSupported), string(GenericDeviceModelStatusEmptyResultSet))
+}
+
+type GetBaseReportRequest struct {
+	RequestID  *int               `json:"requestId" validate:"required"`
+	ReportBase ReportBaseEnumType `json:"reportBase" validate:"required,reportBase"`
+}
+
+func (GetBaseReportRequest) Action() string {
+	return GetBaseReportName
+}
+
+func (r *GetBaseReportRequest) Reset() {
+	*r = GetBaseReportRequest{}
+}
+
+type GetBaseReportResponse struct {
+	Status     GenericDeviceModelStatusEnumType `json:"status" validate:"required,genericDeviceModelStatus"`
+	StatusInfo *StatusInfoType                  `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (GetBaseReportResponse) Action() string {
+	return GetBaseReportName
+}
+
+func (r *GetBaseReportResponse) Reset() {
+	*r = GetBaseReportResponse{}
+}
@@ -0,0 +1,57 @@
+package ocpp201
+
+type LogEnumType string
+
+const (
+	LogDiagnostics LogEnumType = "DiagnosticsLog"
+	LogSecurity    LogEnumType = "SecurityLog"
+)
+
+type LogStatusEnumType string
+
+const (
+	LogStatusAccepted         LogStatusEnumType = "Accepted"
+	LogStatusRejected         LogStatusEnumType = "Rejected"
+	LogStatusAcceptedCanceled LogStatusEnumType = "AcceptedCanceled"
+)
+
+func init() {
+	registerEnum("log", string(LogDiagnostics), string(LogSecurity))
+	registerEnum("logStatus", string(LogStatusAccepted), string(LogStatusRejected), string(LogStatusAcceptedCanceled))
+}
+
+type LogParametersType struct {
+	RemoteLocation  string `json:"remoteLocation" validate:"required,max=512"`
+	OldestTimestamp string `json:"oldestTimestamp,omitempty" validate:"omitempty,dateTime"`
+	LatestTimestamp string `json:"latestTimestamp,omitempty" validate:"omitempty,dateTime"`
+}
+
+type GetLogRequest struct {
+	Log           LogParametersType `json:"log" validate:"required"`
+	LogType       LogEnumType       `json:"logType" validate:"required,log"`
+	RequestID     *int              `json:"requestId" validate:"required"`
+	Retries       *int              `json:"retries,omitempty" validate:"omitempty,gte=0"`
+	RetryInterval *int              `json:"retryInterval,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (GetLogRequest) Action() string {
+	return GetLogName
+}
+
+func (r *GetLogRequest) Reset() {
+	*r = GetLogRequest{}
+}
+
+type GetLogResponse struct {
+	Status     LogStatusEnumType `json:"status" validate:"required,logStatus"`
+	StatusInfo *StatusInfoType   `json:"statusInfo,omitempty" validate:"omitempty"`
+	Filename   string            `json:"filename,omitempty" validate:"omitempty,max=255"`
+}
+
+func (GetLogResponse) Action() string {
+	return GetLogName
+}
+
+func (r *GetLogResponse) Reset() {
+	*r = GetLogResponse{}
+}
@@ -0,0 +1,26 @@
+package ocpp201
+
+type GetTransactionStatusRequest struct {
+	TransactionID string `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+}
+
+func (GetTransactionStatusRequest) Action() string {
+	return GetTransactionStatusName
+}
+
+func (r *GetTransactionStatusRequest) Reset() {
+	r.TransactionID = ""
+}
+
+type GetTransactionStatusResponse struct {
+	OngoingIndicator *bool `json:"ongoingIndicator,omitempty" validate:"omitempty"`
+	MessagesInQueue  *bool `json:"messagesInQueue" validate:"required"`
+}
+
+func (GetTransactionStatusResponse) Action() string {
+	return GetTransactionStatusName
+}
+
+func (r *GetTransactionStatusResponse) Reset() {
+	*r = GetTransactionStatusResponse{}
+}
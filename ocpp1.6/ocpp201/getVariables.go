@@ -0,0 +1,55 @@
+package ocpp201
+
+type GetVariableStatusEnumType string
+
+const (
+	GetVariableStatusAccepted                  GetVariableStatusEnumType = "Accepted"
+	GetVariableStatusRejected                  GetVariableStatusEnumType = "Rejected"
+	GetVariableStatusUnknownComponent          GetVariableStatusEnumType = "UnknownComponent"
+	GetVariableStatusUnknownVariable           GetVariableStatusEnumType = "UnknownVariable"
+	GetVariableStatusNotSupportedAttributeType GetVariableStatusEnumType = "NotSupportedAttributeType"
+)
+
+func init() {
+	registerEnum("getVariableStatus", string(GetVariableStatusAccepted), string(GetVariableStatusRejected),
+		string(GetVariableStatusUnknownComponent), string(GetVariableStatusUnknownVariable), string(GetVariableStatusNotSupportedAttributeType))
+}
+
+type GetVariableDataType struct {
+	AttributeType AttributeEnumType `json:"attributeType,omitempty" validate:"omitempty,attribute"`
+	Component     ComponentType     `json:"component" validate:"required"`
+	Variable      VariableType      `json:"variable" validate:"required"`
+}
+
+type GetVariablesRequest struct {
+	GetVariableData []GetVariableDataType `json:"getVariableData" validate:"required,min=1,dive"`
+}
+
+func (GetVariablesRequest) Action() string {
+	return GetVariablesName
+}
+
+func (r *GetVariablesRequest) Reset() {
+	*r = GetVariablesRequest{}
+}
+
+type GetVariableResultType struct {
+	AttributeStatus     GetVariableStatusEnumType `json:"attributeStatus" validate:"required,getVariableStatus"`
+	AttributeStatusInfo *StatusInfoType           `json:"attributeStatusInfo,omitempty" validate:"omitempty"`
+	AttributeType       AttributeEnumType         `json:"attributeType,omitempty" validate:"omitempty,attribute"`
+	AttributeValue      string                    `json:"attributeValue,omitempty" validate:"omitempty,max=2500"`
+	Component           ComponentType             `json:"component" validate:"required"`
+	Variable            VariableType              `json:"variable" validate:"required"`
+}
+
+type GetVariablesResponse struct {
+	GetVariableResult []GetVariableResultType `json:"getVariableResult" validate:"required,min=1,dive"`
+}
+
+func (GetVariablesResponse) Action() string {
+	return GetVariablesName
+}
+
+func (r *GetVariablesResponse) Reset() {
+	*r = GetVariablesResponse{}
+}
@@ -0,0 +1,21 @@
+package ocpp201
+
+type HeartbeatRequest struct{}
+
+func (HeartbeatRequest) Action() string {
+	return HeartbeatName
+}
+
+func (r *HeartbeatRequest) Reset() {}
+
+type HeartbeatResponse struct {
+	CurrentTime string `json:"currentTime" validate:"required,dateTime"`
+}
+
+func (HeartbeatResponse) Action() string {
+	return HeartbeatName
+}
+
+func (r *HeartbeatResponse) Reset() {
+	r.CurrentTime = ""
+}
@@ -0,0 +1,41 @@
+package ocpp201
+
+type UploadLogStatusEnumType string
+
+const (
+	UploadLogStatusBadMessage            UploadLogStatusEnumType = "BadMessage"
+	UploadLogStatusIdle                  UploadLogStatusEnumType = "Idle"
+	UploadLogStatusNotSupportedOperation UploadLogStatusEnumType = "NotSupportedOperation"
+	UploadLogStatusPermissionDenied      UploadLogStatusEnumType = "PermissionDenied"
+	UploadLogStatusUploaded              UploadLogStatusEnumType = "Uploaded"
+	UploadLogStatusUploadFailure         UploadLogStatusEnumType = "UploadFailure"
+	UploadLogStatusUploading             UploadLogStatusEnumType = "Uploading"
+	UploadLogStatusAcceptedCanceled      UploadLogStatusEnumType = "AcceptedCanceled"
+)
+
+func init() {
+	registerEnum("uploadLogStatus", string(UploadLogStatusBadMessage), string(UploadLogStatusIdle), string(UploadLogStatusNotSupportedOperation),
+		string(UploadLogStatusPermissionDenied), string(UploadLogStatusUploaded), string(UploadLogStatusUploadFailure),
+		string(UploadLogStatusUploading), string(UploadLogStatusAcceptedCanceled))
+}
+
+type LogStatusNotificationRequest struct {
+	Status    UploadLogStatusEnumType `json:"status" validate:"required,uploadLogStatus"`
+	RequestID *int                    `json:"requestId,omitempty" validate:"omitempty"`
+}
+
+func (LogStatusNotificationRequest) Action() string {
+	return LogStatusNotificationName
+}
+
+func (r *LogStatusNotificationRequest) Reset() {
+	*r = LogStatusNotificationRequest{}
+}
+
+type LogStatusNotificationResponse struct{}
+
+func (LogStatusNotificationResponse) Action() string {
+	return LogStatusNotificationName
+}
+
+func (r *LogStatusNotificationResponse) Reset() {}
@@ -0,0 +1,134 @@
+package ocpp201
+
+type ReadingContextEnumType string
+
+const (
+	ReadingContextInterruptionBegin ReadingContextEnumType = "Interruption.Begin"
+	ReadingContextInterruptionEnd   ReadingContextEnumType = "Interruption.End"
+	ReadingContextOther             ReadingContextEnumType = "Other"
+	ReadingContextSampleClock       ReadingContextEnumType = "Sample.Clock"
+	ReadingContextSamplePeriodic    ReadingContextEnumType = "Sample.Periodic"
+	ReadingContextTransactionBegin  ReadingContextEnumType = "Transaction.Begin"
+	ReadingContextTransactionEnd    ReadingContextEnumType = "Transaction.End"
+	ReadingContextTrigger           ReadingContextEnumType = "Trigger"
+)
+
+type MeasurandEnumType string
+
+const (
+	MeasurandCurrentExport                MeasurandEnumType = "Current.Export"
+	MeasurandCurrentImport                MeasurandEnumType = "Current.Import"
+	MeasurandCurrentOffered               MeasurandEnumType = "Current.Offered"
+	MeasurandEnergyActiveExportRegister   MeasurandEnumType = "Energy.Active.Export.Register"
+	MeasurandEnergyActiveImportRegister   MeasurandEnumType = "Energy.Active.Import.Register"
+	MeasurandEnergyReactiveExportRegister MeasurandEnumType = "Energy.Reactive.Export.Register"
+	MeasurandEnergyReactiveImportRegister MeasurandEnumType = "Energy.Reactive.Import.Register"
+	MeasurandEnergyActiveExportInterval   MeasurandEnumType = "Energy.Active.Export.Interval"
+	MeasurandEnergyActiveImportInterval   MeasurandEnumType = "Energy.Active.Import.Interval"
+	MeasurandEnergyActiveNet              MeasurandEnumType = "Energy.Active.Net"
+	MeasurandEnergyReactiveExportInterval MeasurandEnumType = "Energy.Reactive.Export.Interval"
+	MeasurandEnergyReactiveImportInterval MeasurandEnumType = "Energy.Reactive.Import.Interval"
+	MeasurandEnergyReactiveNet            MeasurandEnumType = "Energy.Reactive.Net"
+	MeasurandEnergyApparentNet            MeasurandEnumType = "Energy.Apparent.Net"
+	MeasurandEnergyApparentImport         MeasurandEnumType = "Energy.Apparent.Import"
+	MeasurandEnergyApparentExport         MeasurandEnumType = "Energy.Apparent.Export"
+	MeasurandFrequency                    MeasurandEnumType = "Frequency"
+	MeasurandPowerActiveExport            MeasurandEnumType = "Power.Active.Export"
+	MeasurandPowerActiveImport            MeasurandEnumType = "Power.Active.Import"
+	MeasurandPowerFactor                  MeasurandEnumType = "Power.Factor"
+	MeasurandPowerOffered                 MeasurandEnumType = "Power.Offered"
+	MeasurandPowerReactiveExport          MeasurandEnumType = "Power.Reactive.Export"
+	MeasurandPowerReactiveImport          MeasurandEnumType = "Power.Reactive.Import"
+	MeasurandSoC                          MeasurandEnumType = "SoC"
+	MeasurandVoltage                      MeasurandEnumType = "Voltage"
+)
+
+type PhaseEnumType string
+
+const (
+	PhaseL1   PhaseEnumType = "L1"
+	PhaseL2   PhaseEnumType = "L2"
+	PhaseL3   PhaseEnumType = "L3"
+	PhaseN    PhaseEnumType = "N"
+	PhaseL1N  PhaseEnumType = "L1-N"
+	PhaseL2N  PhaseEnumType = "L2-N"
+	PhaseL3N  PhaseEnumType = "L3-N"
+	PhaseL1L2 PhaseEnumType = "L1-L2"
+	PhaseL2L3 PhaseEnumType = "L2-L3"
+	PhaseL3L1 PhaseEnumType = "L3-L1"
+)
+
+type LocationEnumType string
+
+const (
+	LocationBody   LocationEnumType = "Body"
+	LocationCable  LocationEnumType = "Cable"
+	LocationEV     LocationEnumType = "EV"
+	LocationInlet  LocationEnumType = "Inlet"
+	LocationOutlet LocationEnumType = "Outlet"
+)
+
+func init() {
+	registerEnum("readingContext", string(ReadingContextInterruptionBegin), string(ReadingContextInterruptionEnd), string(ReadingContextOther),
+		string(ReadingContextSampleClock), string(ReadingContextSamplePeriodic), string(ReadingContextTransactionBegin),
+		string(ReadingContextTransactionEnd), string(ReadingContextTrigger))
+	registerEnum("measurand", string(MeasurandCurrentExport), string(MeasurandCurrentImport), string(MeasurandCurrentOffered),
+		string(MeasurandEnergyActiveExportRegister), string(MeasurandEnergyActiveImportRegister), string(MeasurandEnergyReactiveExportRegister),
+		string(MeasurandEnergyReactiveImportRegister), string(MeasurandEnergyActiveExportInterval), string(MeasurandEnergyActiveImportInterval),
+		string(MeasurandEnergyActiveNet), string(MeasurandEnergyReactiveExportInterval), string(MeasurandEnergyReactiveImportInterval),
+		string(MeasurandEnergyReactiveNet), string(MeasurandEnergyApparentNet), string(MeasurandEnergyApparentImport),
+		string(MeasurandEnergyApparentExport), string(MeasurandFrequency), string(MeasurandPowerActiveExport), string(MeasurandPowerActiveImport),
+		string(MeasurandPowerFactor), string(MeasurandPowerOffered), string(MeasurandPowerReactiveExport), string(MeasurandPowerReactiveImport),
+		string(MeasurandSoC), string(MeasurandVoltage))
+	registerEnum("phase", string(PhaseL1), string(PhaseL2), string(PhaseL3), string(PhaseN), string(PhaseL1N), string(PhaseL2N),
+		string(PhaseL3N), string(PhaseL1L2), string(PhaseL2L3), string(PhaseL3L1))
+	registerEnum("location", string(LocationBody), string(LocationCable), string(LocationEV), string(LocationInlet), string(LocationOutlet))
+}
+
+type UnitOfMeasureType struct {
+	Unit       string `json:"unit,omitempty" validate:"omitempty,max=20"`
+	Multiplier *int   `json:"multiplier,omitempty" validate:"omitempty"`
+}
+
+type SignedMeterValueType struct {
+	SignedMeterData string `json:"signedMeterData" validate:"required,max=2500"`
+	SigningMethod   string `json:"signingMethod" validate:"required,max=50"`
+	EncodingMethod  string `json:"encodingMethod" validate:"required,max=50"`
+	PublicKey       string `json:"publicKey" validate:"required,max=2500"`
+}
+
+type SampledValueType struct {
+	Value            *float64               `json:"value" validate:"required"`
+	Context          ReadingContextEnumType `json:"context,omitempty" validate:"omitempty,readingContext"`
+	Measurand        MeasurandEnumType      `json:"measurand,omitempty" validate:"omitempty,measurand"`
+	Phase            PhaseEnumType          `json:"phase,omitempty" validate:"omitempty,phase"`
+	Location         LocationEnumType       `json:"location,omitempty" validate:"omitempty,location"`
+	SignedMeterValue *SignedMeterValueType  `json:"signedMeterValue,omitempty" validate:"omitempty"`
+	UnitOfMeasure    *UnitOfMeasureType     `json:"unitOfMeasure,omitempty" validate:"omitempty"`
+}
+
+type MeterValueType struct {
+	Timestamp    string             `json:"timestamp" validate:"required,dateTime"`
+	SampledValue []SampledValueType `json:"sampledValue" validate:"required,min=1,dive"`
+}
+
+type MeterValuesRequest struct {
+	EvseID     *int             `json:"evseId" validate:"required,gte=0"`
+	MeterValue []MeterValueType `json:"meterValue" validate:"required,min=1,dive"`
+}
+
+func (MeterValuesRequest) Action() string {
+	return MeterValuesName
+}
+
+func (r *MeterValuesRequest) Reset() {
+	*r = MeterValuesRequest{}
+}
+
+type MeterValuesResponse struct{}
+
+func (MeterValuesResponse) Action() string {
+	return MeterValuesName
+}
+
+func (r *MeterValuesResponse) Reset() {}
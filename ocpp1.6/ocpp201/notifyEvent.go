@@ -0,0 +1,63 @@
+package ocpp201
+
+type EventTriggerEnumType string
+
+const (
+	EventTriggerAlerting EventTriggerEnumType = "Alerting"
+	EventTriggerDelta    EventTriggerEnumType = "Delta"
+	EventTriggerPeriodic EventTriggerEnumType = "Periodic"
+)
+
+type EventNotificationEnumType string
+
+const (
+	EventNotificationHardWiredNotification EventNotificationEnumType = "HardWiredNotification"
+	EventNotificationHardWiredMonitor      EventNotificationEnumType = "HardWiredMonitor"
+	EventNotificationPreconfiguredMonitor  EventNotificationEnumType = "PreconfiguredMonitor"
+	EventNotificationCustomMonitor         EventNotificationEnumType = "CustomMonitor"
+)
+
+func init() {
+	registerEnum("eventTrigger", string(EventTriggerAlerting), string(EventTriggerDelta), string(EventTriggerPeriodic))
+	registerEnum("eventNotification", string(EventNotificationHardWiredNotification), string(EventNotificationHardWiredMonitor),
+		string(EventNotificationPreconfiguredMonitor), string(EventNotificationCustomMonitor))
+}
+
+type EventDataType struct {
+	EventID               *int                      `json:"eventId" validate:"required"`
+	Timestamp             string                    `json:"timestamp" validate:"required,dateTime"`
+	Trigger               EventTriggerEnumType      `json:"trigger" validate:"required,eventTrigger"`
+	Cause                 *int                      `json:"cause,omitempty" validate:"omitempty"`
+	ActualValue           string                    `json:"actualValue" validate:"max=2500"`
+	TechCode              string                    `json:"techCode,omitempty" validate:"omitempty,max=50"`
+	TechInfo              string                    `json:"techInfo,omitempty" validate:"omitempty,max=500"`
+	Cleared               *bool                     `json:"cleared,omitempty" validate:"omitempty"`
+	TransactionID         string                    `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+	VariableMonitoringID  *int                      `json:"variableMonitoringId,omitempty" validate:"omitempty"`
+	EventNotificationType EventNotificationEnumType `json:"eventNotificationType" validate:"required,eventNotification"`
+	Component             ComponentType             `json:"component" validate:"required"`
+	Variable              VariableType              `json:"variable" validate:"required"`
+}
+
+type NotifyEventRequest struct {
+	GeneratedAt string          `json:"generatedAt" validate:"required,dateTime"`
+	Tbc         *bool           `json:"tbc,omitempty" validate:"omitempty"`
+	SeqNo       *int            `json:"seqNo" validate:"required,gte=0"`
+	EventData   []EventDataType `json:"eventData" validate:"required,min=1,dive"`
+}
+
+func (NotifyEventRequest) Action() string {
+	return NotifyEventName
+}
+
+func (r *NotifyEventRequest) Reset() {
+	*r = NotifyEventRequest{}
+}
+
+type NotifyEventResponse struct{}
+
+func (NotifyEventResponse) Action() string {
+	return NotifyEventName
+}
+
+func (r *NotifyEventResponse) Reset() {}
@@ -0,0 +1,76 @@
+package ocpp201
+
+type MutabilityEnumType string
+
+const (
+	MutabilityReadOnly  MutabilityEnumType = "ReadOnly"
+	MutabilityWriteOnly MutabilityEnumType = "WriteOnly"
+	MutabilityReadWrite MutabilityEnumType = "ReadWrite"
+)
+
+type DataEnumType string
+
+const (
+	DataString       DataEnumType = "string"
+	DataDecimal      DataEnumType = "decimal"
+	DataInteger      DataEnumType = "integer"
+	DataDateTime     DataEnumType = "dateTime"
+	DataBoolean      DataEnumType = "boolean"
+	DataOptionList   DataEnumType = "OptionList"
+	DataSequenceList DataEnumType = "SequenceList"
+	DataMemberList   DataEnumType = "MemberList"
+)
+
+func init() {
+	registerEnum("mutability", string(MutabilityReadOnly), string(MutabilityWriteOnly), string(MutabilityReadWrite))
+	registerEnum("data", string(DataString), string(DataDecimal), string(DataInteger), string(DataDateTime), string(DataBoolean),
+		string(DataOptionList), string(DataSequenceList), string(DataMemberList))
+}
+
+type VariableAttributeType struct {
+	Type       AttributeEnumType  `json:"type,omitempty" validate:"omitempty,attribute"`
+	Value      string             `json:"value,omitempty" validate:"omitempty,max=2500"`
+	Mutability MutabilityEnumType `json:"mutability,omitempty" validate:"omitempty,mutability"`
+	Persistent *bool              `json:"persistent,omitempty" validate:"omitempty"`
+	Constant   *bool              `json:"constant,omitempty" validate:"omitempty"`
+}
+
+type VariableCharacteristicsType struct {
+	Unit               string       `json:"unit,omitempty" validate:"omitempty,max=16"`
+	DataType           DataEnumType `json:"dataType" validate:"required,data"`
+	MinLimit           *float64     `json:"minLimit,omitempty" validate:"omitempty"`
+	MaxLimit           *float64     `json:"maxLimit,omitempty" validate:"omitempty"`
+	ValuesList         string       `json:"valuesList,omitempty" validate:"omitempty,max=1000"`
+	SupportsMonitoring *bool        `json:"supportsMonitoring" validate:"required"`
+}
+
+type ReportDataType struct {
+	Component               ComponentType                `json:"component" validate:"required"`
+	Variable                VariableType                 `json:"variable" validate:"required"`
+	VariableAttribute       []VariableAttributeType      `json:"variableAttribute" validate:"required,min=1,max=4,dive"`
+	VariableCharacteristics *VariableCharacteristicsType `json:"variableCharacteristics,omitempty" validate:"omitempty"`
+}
+
+type NotifyReportRequest struct {
+	RequestID   *int             `json:"requestId" validate:"required"`
+	GeneratedAt string           `json:"generatedAt" validate:"required,dateTime"`
+	ReportData  []ReportDataType `json:"reportData,omitempty" validate:"omitempty,min=1,dive"`
+	Tbc         *bool            `json:"tbc,omitempty" validate:"omitempty"`
+	SeqNo       *int             `json:"seqNo" validate:"required,gte=0"`
+}
+
+func (NotifyReportRequest) Action() string {
+	return NotifyReportName
+}
+
+func (r *NotifyReportRequest) Reset() {
+	*r = NotifyReportRequest{}
+}
+
+type NotifyReportResponse struct{}
+
+func (NotifyReportResponse) Action() string {
+	return NotifyReportName
+}
+
+func (r *NotifyReportResponse) Reset() {}
@@ -0,0 +1,129 @@
+package ocpp201
+
+import (
+	"encoding/json"
+	"ocpp16/protocol"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+/*****************BootNotification***************/
+func TestBootNotification(t *testing.T) {
+	req := &BootNotificationRequest{
+		ChargingStation: ChargingStationType{Model: "SingleSocket", VendorName: "tsinglink"},
+		Reason:          BootReasonPowerUp,
+	}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	req.Reason = "Reboot"
+	if err := Validate.Struct(req); err == nil {
+		t.Error("invalid reason must be rejected")
+	}
+	req.Reason = BootReasonPowerUp
+	req.ChargingStation.Model = strings.Repeat("m", 21)
+	if err := Validate.Struct(req); err == nil {
+		t.Error("model longer than 20 must be rejected")
+	}
+	res := &BootNotificationResponse{
+		CurrentTime: time.Now().Format(RFC3339),
+		Interval:    intPtr(300),
+		Status:      RegistrationStatusAccepted,
+	}
+	if err := Validate.Struct(res); err != nil {
+		t.Error(err)
+	}
+}
+
+/*****************TransactionEvent***************/
+func TestTransactionEvent(t *testing.T) {
+	value := 1520.5
+	req := &TransactionEventRequest{
+		EventType:       TransactionEventStarted,
+		Timestamp:       time.Now().Format(RFC3339),
+		TriggerReason:   TriggerReasonAuthorized,
+		SeqNo:           intPtr(0),
+		TransactionInfo: TransactionType{TransactionID: "f7c4e3d1"},
+		IdToken:         &IdTokenType{IdToken: "04A2B3C4", Type: IdTokenISO14443},
+		EVSE:            &EVSEType{ID: intPtr(1), ConnectorID: intPtr(1)},
+		MeterValue: []MeterValueType{{
+			Timestamp:    time.Now().Format(RFC3339),
+			SampledValue: []SampledValueType{{Value: &value, Measurand: MeasurandEnergyActiveImportRegister}},
+		}},
+	}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	req.MeterValue[0].SampledValue[0].Measurand = "Energy"
+	if err := Validate.Struct(req); err == nil {
+		t.Error("invalid measurand must be rejected")
+	}
+	req.MeterValue[0].SampledValue[0].Measurand = ""
+	req.TransactionInfo.TransactionID = ""
+	if err := Validate.Struct(req); err == nil {
+		t.Error("missing transactionId must be rejected")
+	}
+}
+
+/*****************GetVariables***************/
+func TestGetVariables(t *testing.T) {
+	req := &GetVariablesRequest{}
+	if err := Validate.Struct(req); err == nil {
+		t.Error("empty getVariableData must be rejected")
+	}
+	req.GetVariableData = []GetVariableDataType{{
+		Component: ComponentType{Name: "OCPPCommCtrlr"},
+		Variable:  VariableType{Name: "HeartbeatInterval"},
+	}}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+}
+
+/*****************CallError***************/
+func TestCallError(t *testing.T) {
+	callError := &protocol.CallError{
+		MessageTypeID:    protocol.CALL_ERROR,
+		UniqueID:         "uniqueid",
+		ErrorCode:        FormatViolation,
+		ErrorDescription: "ErrorDescription",
+	}
+	if err := Validate.Struct(callError); err != nil {
+		t.Error(err)
+	}
+	callError.ErrorCode = ErrorCode(protocol.OccurenceConstraintViolation)
+	if callError.ErrorCode != OccurrenceConstraintViolation {
+		t.Errorf("expect %s, got %s", OccurrenceConstraintViolation, callError.ErrorCode)
+	}
+	callError.ErrorCode = protocol.FormationViolation
+	if err := Validate.Struct(callError); err == nil {
+		t.Error("ocpp1.6 error code FormationViolation must be rejected")
+	}
+}
+
+/*****************trait***************/
+func TestTraits(t *testing.T) {
+	for _, action := range OCPP201M.SupportActions() {
+		trait, ok := OCPP201M.GetTraitAction(action)
+		if !ok {
+			t.Fatalf("action(%s) not registered", action)
+		}
+		req := reflect.New(trait.RequestType()).Interface().(protocol.Request)
+		res := reflect.New(trait.ResponseType()).Interface().(protocol.Response)
+		if req.Action() != action || res.Action() != action {
+			t.Errorf("trait of action(%s) returns request(%s), response(%s)", action, req.Action(), res.Action())
+		}
+		if _, err := json.Marshal(req); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, ok := OCPP201M.GetTraitAction("StartTransaction"); ok {
+		t.Error("StartTransaction does not exist in ocpp2.0.1")
+	}
+}
@@ -0,0 +1,42 @@
+package ocpp201
+
+type RequestStartStopStatusEnumType string
+
+const (
+	RequestStartStopAccepted RequestStartStopStatusEnumType = "Accepted"
+	RequestStartStopRejected RequestStartStopStatusEnumType = "Rejected"
+)
+
+func init() {
+	registerEnum("requestStartStopStatus", string(RequestStartStopAccepted), string(RequestStartStopRejected))
+}
+
+type RequestStartTransactionRequest struct {
+	EvseID          *int                 `json:"evseId,omitempty" validate:"omitempty,gt=0"`
+	RemoteStartID   *int                 `json:"remoteStartId" validate:"required"`
+	IdToken         IdTokenType          `json:"idToken" validate:"required"`
+	ChargingProfile *ChargingProfileType `json:"chargingProfile,omitempty" validate:"omitempty"`
+	GroupIdToken    *IdTokenType         `json:"groupIdToken,omitempty" validate:"omitempty"`
+}
+
+func (RequestStartTransactionRequest) Action() string {
+	return RequestStartTransactionName
+}
+
+func (r *RequestStartTransactionRequest) Reset() {
+	*r = RequestStartTransactionRequest{}
+}
+
+type RequestStartTransactionResponse struct {
+	Status        RequestStartStopStatusEnumType `json:"status" validate:"required,requestStartStopStatus"`
+	StatusInfo    *StatusInfoType                `json:"statusInfo,omitempty" validate:"omitempty"`
+	TransactionID string                         `json:"transactionId,omitempty" validate:"omitempty,max=36"`
+}
+
+func (RequestStartTransactionResponse) Action() string {
+	return RequestStartTransactionName
+}
+
+func (r *RequestStartTransactionResponse) Reset() {
+	*r = RequestStartTransactionResponse{}
+}
@@ -0,0 +1,26 @@
+package ocpp201
+
+type RequestStopTransactionRequest struct {
+	TransactionID string `json:"transactionId" validate:"required,max=36"`
+}
+
+func (RequestStopTransactionRequest) Action() string {
+	return RequestStopTransactionName
+}
+
+func (r *RequestStopTransactionRequest) Reset() {
+	r.TransactionID = ""
+}
+
+type RequestStopTransactionResponse struct {
+	Status     RequestStartStopStatusEnumType `json:"status" validate:"required,requestStartStopStatus"`
+	StatusInfo *StatusInfoType                `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (RequestStopTransactionResponse) Action() string {
+	return RequestStopTransactionName
+}
+
+func (r *RequestStopTransactionResponse) Reset() {
+	*r = RequestStopTransactionResponse{}
+}
@@ -0,0 +1,47 @@
+package ocpp201
+
+type ResetEnumType string
+
+const (
+	ResetImmediate ResetEnumType = "Immediate"
+	ResetOnIdle    ResetEnumType = "OnIdle"
+)
+
+type ResetStatusEnumType string
+
+const (
+	ResetStatusAccepted  ResetStatusEnumType = "Accepted"
+	ResetStatusRejected  ResetStatusEnumType = "Rejected"
+	ResetStatusScheduled ResetStatusEnumType = "Scheduled"
+)
+
+func init() {
+	registerEnum("reset", string(ResetImmediate), string(ResetOnIdle))
+	registerEnum("resetStatus", string(ResetStatusAccepted), string(ResetStatusRejected), string(ResetStatusScheduled))
+}
+
+type ResetRequest struct {
+	Type   ResetEnumType `json:"type" validate:"required,reset"`
+	EvseID *int          `json:"evseId,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (ResetRequest) Action() string {
+	return ResetName
+}
+
+func (r *ResetRequest) Reset() {
+	*r = ResetRequest{}
+}
+
+type ResetResponse struct {
+	Status     ResetStatusEnumType `json:"status" validate:"required,resetStatus"`
+	StatusInfo *StatusInfoType     `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (ResetResponse) Action() string {
+	return ResetName
+}
+
+func (r *ResetResponse) Reset() {
+	*r = ResetResponse{}
+}
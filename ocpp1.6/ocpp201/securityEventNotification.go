@@ -0,0 +1,23 @@
+package ocpp201
+
+type SecurityEventNotificationRequest struct {
+	Type      string `json:"type" validate:"required,max=50"`
+	Timestamp string `json:"timestamp" validate:"required,dateTime"`
+	TechInfo  string `json:"techInfo,omitempty" validate:"omitempty,max=255"`
+}
+
+func (SecurityEventNotificationRequest) Action() string {
+	return SecurityEventNotificationName
+}
+
+func (r *SecurityEventNotificationRequest) Reset() {
+	*r = SecurityEventNotificationRequest{}
+}
+
+type SecurityEventNotificationResponse struct{}
+
+func (SecurityEventNotificationResponse) Action() string {
+	return SecurityEventNotificationName
+}
+
+func (r *SecurityEventNotificationResponse) Reset() {}
@@ -0,0 +1,38 @@
+package ocpp201
+
+type ChargingProfileStatusEnumType string
+
+const (
+	ChargingProfileStatusAccepted ChargingProfileStatusEnumType = "Accepted"
+	ChargingProfileStatusRejected ChargingProfileStatusEnumType = "Rejected"
+)
+
+func init() {
+	registerEnum("chargingProfileStatus", string(ChargingProfileStatusAccepted), string(ChargingProfileStatusRejected))
+}
+
+type SetChargingProfileRequest struct {
+	EvseID          *int                `json:"evseId" validate:"required,gte=0"`
+	ChargingProfile ChargingProfileType `json:"chargingProfile" validate:"required"`
+}
+
+func (SetChargingProfileRequest) Action() string {
+	return SetChargingProfileName
+}
+
+func (r *SetChargingProfileRequest) Reset() {
+	*r = SetChargingProfileRequest{}
+}
+
+type SetChargingProfileResponse struct {
+	Status     ChargingProfileStatusEnumType `json:"status" validate:"required,chargingProfileStatus"`
+	StatusInfo *StatusInfoType               `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (SetChargingProfileResponse) Action() string {
+	return SetChargingProfileName
+}
+
+func (r *SetChargingProfileResponse) Reset() {
+	*r = SetChargingProfileResponse{}
+}
@@ -0,0 +1,57 @@
+package ocpp201
+
+type SetVariableStatusEnumType string
+
+const (
+	SetVariableStatusAccepted                  SetVariableStatusEnumType = "Accepted"
+	SetVariableStatusRejected                  SetVariableStatusEnumType = "Rejected"
+	SetVariableStatusUnknownComponent          SetVariableStatusEnumType = "UnknownComponent"
+	SetVariableStatusUnknownVariable           SetVariableStatusEnumType = "UnknownVariable"
+	SetVariableStatusNotSupportedAttributeType SetVariableStatusEnumType = "NotSupportedAttributeType"
+	SetVariableStatusRebootRequired            SetVariableStatusEnumType = "RebootRequired"
+)
+
+func init() {
+	registerEnum("setVariableStatus", string(SetVariableStatusAccepted), string(SetVariableStatusRejected),
+		string(SetVariableStatusUnknownComponent), string(SetVariableStatusUnknownVariable),
+		string(SetVariableStatusNotSupportedAttributeType), string(SetVariableStatusRebootRequired))
+}
+
+type SetVariableDataType struct {
+	AttributeType  AttributeEnumType `json:"attributeType,omitempty" validate:"omitempty,attribute"`
+	AttributeValue string            `json:"attributeValue" validate:"max=1000"`
+	Component      ComponentType     `json:"component" validate:"required"`
+	Variable       VariableType      `json:"variable" validate:"required"`
+}
+
+type SetVariablesRequest struct {
+	SetVariableData []SetVariableDataType `json:"setVariableData" validate:"required,min=1,dive"`
+}
+
+func (SetVariablesRequest) Action() string {
+	return SetVariablesName
+}
+
+func (r *SetVariablesRequest) Reset() {
+	*r = SetVariablesRequest{}
+}
+
+type SetVariableResultType struct {
+	AttributeType       AttributeEnumType         `json:"attributeType,omitempty" validate:"omitempty,attribute"`
+	AttributeStatus     SetVariableStatusEnumType `json:"attributeStatus" validate:"required,setVariableStatus"`
+	AttributeStatusInfo *StatusInfoType           `json:"attributeStatusInfo,omitempty" validate:"omitempty"`
+	Component           ComponentType             `json:"component" validate:"required"`
+	Variable            VariableType              `json:"variable" validate:"required"`
+}
+
+type SetVariablesResponse struct {
+	SetVariableResult []SetVariableResultType `json:"setVariableResult" validate:"required,min=1,dive"`
+}
+
+func (SetVariablesResponse) Action() string {
+	return SetVariablesName
+}
+
+func (r *SetVariablesResponse) Reset() {
+	*r = SetVariablesResponse{}
+}
@@ -0,0 +1,39 @@
+package ocpp201
+
+type ConnectorStatusEnumType string
+
+const (
+	ConnectorStatusAvailable   ConnectorStatusEnumType = "Available"
+	ConnectorStatusOccupied    ConnectorStatusEnumType = "Occupied"
+	ConnectorStatusReserved    ConnectorStatusEnumType = "Reserved"
+	ConnectorStatusUnavailable ConnectorStatusEnumType = "Unavailable"
+	ConnectorStatusFaulted     ConnectorStatusEnumType = "Faulted"
+)
+
+func init() {
+	registerEnum("connectorStatus", string(ConnectorStatusAvailable), string(ConnectorStatusOccupied), string(ConnectorStatusReserved),
+		string(ConnectorStatusUnavailable), string(ConnectorStatusFaulted))
+}
+
+type StatusNotificationRequest struct {
+	Timestamp       string                  `json:"timestamp" validate:"required,dateTime"`
+	ConnectorStatus ConnectorStatusEnumType `json:"connectorStatus" validate:"required,connectorStatus"`
+	EvseID          *int                    `json:"evseId" validate:"required,gte=0"`
+	ConnectorID     *int                    `json:"connectorId" validate:"required,gte=0"`
+}
+
+func (StatusNotificationRequest) Action() string {
+	return StatusNotificationName
+}
+
+func (r *StatusNotificationRequest) Reset() {
+	*r = StatusNotificationRequest{}
+}
+
+type StatusNotificationResponse struct{}
+
+func (StatusNotificationResponse) Action() string {
+	return StatusNotificationName
+}
+
+func (r *StatusNotificationResponse) Reset() {}
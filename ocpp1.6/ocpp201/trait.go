@@ -0,0 +1,413 @@
+package ocpp201
+
+import (
+	"ocpp16/protocol"
+	"reflect"
+	"sync"
+)
+
+func init() {
+	OCPP201M.register(
+		BootNotificationTrait{},
+		HeartbeatTrait{},
+		StatusNotificationTrait{},
+		AuthorizeTrait{},
+		TransactionEventTrait{},
+		MeterValuesTrait{},
+		NotifyReportTrait{},
+		NotifyEventTrait{},
+		FirmwareStatusNotificationTrait{},
+		LogStatusNotificationTrait{},
+		SecurityEventNotificationTrait{},
+		DataTransferTrait{},
+		GetVariablesTrait{},
+		SetVariablesTrait{},
+		GetBaseReportTrait{},
+		RequestStartTransactionTrait{},
+		RequestStopTransactionTrait{},
+		ResetTrait{},
+		ChangeAvailabilityTrait{},
+		UnlockConnectorTrait{},
+		TriggerMessageTrait{},
+		ClearCacheTrait{},
+		GetTransactionStatusTrait{},
+		SetChargingProfileTrait{},
+		UpdateFirmwareTrait{},
+		GetLogTrait{},
+	)
+}
+
+//OCPP201M is the trait map of ocpp2.0.1, the message framing and the Request/Response interfaces are shared with ocpp1.6
+var OCPP201M = &OCPP201Map{
+	traitMap: make(map[string]protocol.Trait),
+}
+
+type OCPP201Map struct {
+	sync.RWMutex
+	traitMap map[string]protocol.Trait
+}
+
+func (m *OCPP201Map) register(traits ...protocol.Trait) {
+	m.Lock()
+	defer m.Unlock()
+	for _, trait := range traits {
+		m.traitMap[trait.Action()] = trait
+	}
+}
+
+func (m *OCPP201Map) SupportActions() []string {
+	m.RLock()
+	defer m.RUnlock()
+	var actions []string
+	for action := range m.traitMap {
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+func (m *OCPP201Map) GetTraitAction(action string) (protocol.Trait, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	if v, ok := m.traitMap[action]; ok {
+		return v, ok
+	}
+	return nil, false
+}
+
+//BootNotification
+type BootNotificationTrait struct{}
+
+func (BootNotificationTrait) Action() string {
+	return BootNotificationName
+}
+func (BootNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(BootNotificationRequest{})
+}
+func (BootNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(BootNotificationResponse{})
+}
+
+//Heartbeat
+type HeartbeatTrait struct{}
+
+func (HeartbeatTrait) Action() string {
+	return HeartbeatName
+}
+func (HeartbeatTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(HeartbeatRequest{})
+}
+func (HeartbeatTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(HeartbeatResponse{})
+}
+
+//StatusNotification
+type StatusNotificationTrait struct{}
+
+func (StatusNotificationTrait) Action() string {
+	return StatusNotificationName
+}
+func (StatusNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(StatusNotificationRequest{})
+}
+func (StatusNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(StatusNotificationResponse{})
+}
+
+//Authorize
+type AuthorizeTrait struct{}
+
+func (AuthorizeTrait) Action() string {
+	return AuthorizeName
+}
+func (AuthorizeTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(AuthorizeRequest{})
+}
+func (AuthorizeTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(AuthorizeResponse{})
+}
+
+//TransactionEvent
+type TransactionEventTrait struct{}
+
+func (TransactionEventTrait) Action() string {
+	return TransactionEventName
+}
+func (TransactionEventTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(TransactionEventRequest{})
+}
+func (TransactionEventTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(TransactionEventResponse{})
+}
+
+//MeterValues
+type MeterValuesTrait struct{}
+
+func (MeterValuesTrait) Action() string {
+	return MeterValuesName
+}
+func (MeterValuesTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(MeterValuesRequest{})
+}
+func (MeterValuesTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(MeterValuesResponse{})
+}
+
+//NotifyReport
+type NotifyReportTrait struct{}
+
+func (NotifyReportTrait) Action() string {
+	return NotifyReportName
+}
+func (NotifyReportTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(NotifyReportRequest{})
+}
+func (NotifyReportTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(NotifyReportResponse{})
+}
+
+//NotifyEvent
+type NotifyEventTrait struct{}
+
+func (NotifyEventTrait) Action() string {
+	return NotifyEventName
+}
+func (NotifyEventTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(NotifyEventRequest{})
+}
+func (NotifyEventTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(NotifyEventResponse{})
+}
+
+//FirmwareStatusNotification
+type FirmwareStatusNotificationTrait struct{}
+
+func (FirmwareStatusNotificationTrait) Action() string {
+	return FirmwareStatusNotificationName
+}
+func (FirmwareStatusNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(FirmwareStatusNotificationRequest{})
+}
+func (FirmwareStatusNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(FirmwareStatusNotificationResponse{})
+}
+
+//LogStatusNotification
+type LogStatusNotificationTrait struct{}
+
+func (LogStatusNotificationTrait) Action() string {
+	return LogStatusNotificationName
+}
+func (LogStatusNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(LogStatusNotificationRequest{})
+}
+func (LogStatusNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(LogStatusNotificationResponse{})
+}
+
+//SecurityEventNotification
+type SecurityEventNotificationTrait struct{}
+
+func (SecurityEventNotificationTrait) Action() string {
+	return SecurityEventNotificationName
+}
+func (SecurityEventNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SecurityEventNotificationRequest{})
+}
+func (SecurityEventNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SecurityEventNotificationResponse{})
+}
+
+//DataTransfer
+type DataTransferTrait struct{}
+
+func (DataTransferTrait) Action() string {
+	return DataTransferName
+}
+func (DataTransferTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(DataTransferRequest{})
+}
+func (DataTransferTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(DataTransferResponse{})
+}
+
+//GetVariables
+type GetVariablesTrait struct{}
+
+func (GetVariablesTrait) Action() string {
+	return GetVariablesName
+}
+func (GetVariablesTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetVariablesRequest{})
+}
+func (GetVariablesTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetVariablesResponse{})
+}
+
+//SetVariables
+type SetVariablesTrait struct{}
+
+func (SetVariablesTrait) Action() string {
+	return SetVariablesName
+}
+func (SetVariablesTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SetVariablesRequest{})
+}
+func (SetVariablesTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SetVariablesResponse{})
+}
+
+//GetBaseReport
+type GetBaseReportTrait struct{}
+
+func (GetBaseReportTrait) Action() string {
+	return GetBaseReportName
+}
+func (GetBaseReportTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetBaseReportRequest{})
+}
+func (GetBaseReportTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetBaseReportResponse{})
+}
+
+//RequestStartTransaction
+type RequestStartTransactionTrait struct{}
+
+func (RequestStartTransactionTrait) Action() string {
+	return RequestStartTransactionName
+}
+func (RequestStartTransactionTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(RequestStartTransactionRequest{})
+}
+func (RequestStartTransactionTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(RequestStartTransactionResponse{})
+}
+
+//RequestStopTransaction
+type RequestStopTransactionTrait struct{}
+
+func (RequestStopTransactionTrait) Action() string {
+	return RequestStopTransactionName
+}
+func (RequestStopTransactionTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(RequestStopTransactionRequest{})
+}
+func (RequestStopTransactionTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(RequestStopTransactionResponse{})
+}
+
+//Reset
+type ResetTrait struct{}
+
+func (ResetTrait) Action() string {
+	return ResetName
+}
+func (ResetTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(ResetRequest{})
+}
+func (ResetTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(ResetResponse{})
+}
+
+//ChangeAvailability
+type ChangeAvailabilityTrait struct{}
+
+func (ChangeAvailabilityTrait) Action() string {
+	return ChangeAvailabilityName
+}
+func (ChangeAvailabilityTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(ChangeAvailabilityRequest{})
+}
+func (ChangeAvailabilityTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(ChangeAvailabilityResponse{})
+}
+
+//UnlockConnector
+type UnlockConnectorTrait struct{}
+
+func (UnlockConnectorTrait) Action() string {
+	return UnlockConnectorName
+}
+func (UnlockConnectorTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(UnlockConnectorRequest{})
+}
+func (UnlockConnectorTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(UnlockConnectorResponse{})
+}
+
+//TriggerMessage
+type TriggerMessageTrait struct{}
+
+func (TriggerMessageTrait) Action() string {
+	return TriggerMessageName
+}
+func (TriggerMessageTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(TriggerMessageRequest{})
+}
+func (TriggerMessageTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(TriggerMessageResponse{})
+}
+
+//ClearCache
+type ClearCacheTrait struct{}
+
+func (ClearCacheTrait) Action() string {
+	return ClearCacheName
+}
+func (ClearCacheTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(ClearCacheRequest{})
+}
+func (ClearCacheTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(ClearCacheResponse{})
+}
+
+//GetTransactionStatus
+type GetTransactionStatusTrait struct{}
+
+func (GetTransactionStatusTrait) Action() string {
+	return GetTransactionStatusName
+}
+func (GetTransactionStatusTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetTransactionStatusRequest{})
+}
+func (GetTransactionStatusTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetTransactionStatusResponse{})
+}
+
+//SetChargingProfile
+type SetChargingProfileTrait struct{}
+
+func (SetChargingProfileTrait) Action() string {
+	return SetChargingProfileName
+}
+func (SetChargingProfileTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SetChargingProfileRequest{})
+}
+func (SetChargingProfileTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SetChargingProfileResponse{})
+}
+
+//UpdateFirmware
+type UpdateFirmwareTrait struct{}
+
+func (UpdateFirmwareTrait) Action() string {
+	return UpdateFirmwareName
+}
+func (UpdateFirmwareTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(UpdateFirmwareRequest{})
+}
+func (UpdateFirmwareTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(UpdateFirmwareResponse{})
+}
+
+//GetLog
+type GetLogTrait struct{}
+
+func (GetLogTrait) Action() string {
+	return GetLogName
+}
+func (GetLogTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetLogRequest{})
+}
+func (GetLogTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetLogResponse{})
+}
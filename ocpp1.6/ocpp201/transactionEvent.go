@@ -0,0 +1,133 @@
+package ocpp201
+
+type TransactionEventEnumType string
+
+const (
+	TransactionEventEnded   TransactionEventEnumType = "Ended"
+	TransactionEventStarted TransactionEventEnumType = "Started"
+	TransactionEventUpdated TransactionEventEnumType = "Updated"
+)
+
+type TriggerReasonEnumType string
+
+const (
+	TriggerReasonAuthorized           TriggerReasonEnumType = "Authorized"
+	TriggerReasonCablePluggedIn       TriggerReasonEnumType = "CablePluggedIn"
+	TriggerReasonChargingRateChanged  TriggerReasonEnumType = "ChargingRateChanged"
+	TriggerReasonChargingStateChanged TriggerReasonEnumType = "ChargingStateChanged"
+	TriggerReasonDeauthorized         TriggerReasonEnumType = "Deauthorized"
+	TriggerReasonEnergyLimitReached   TriggerReasonEnumType = "EnergyLimitReached"
+	TriggerReasonEVCommunicationLost  TriggerReasonEnumType = "EVCommunicationLost"
+	TriggerReasonEVConnectTimeout     TriggerReasonEnumType = "EVConnectTimeout"
+	TriggerReasonMeterValueClock      TriggerReasonEnumType = "MeterValueClock"
+	TriggerReasonMeterValuePeriodic   TriggerReasonEnumType = "MeterValuePeriodic"
+	TriggerReasonTimeLimitReached     TriggerReasonEnumType = "TimeLimitReached"
+	TriggerReasonTrigger              TriggerReasonEnumType = "Trigger"
+	TriggerReasonUnlockCommand        TriggerReasonEnumType = "UnlockCommand"
+	TriggerReasonStopAuthorized       TriggerReasonEnumType = "StopAuthorized"
+	TriggerReasonEVDeparted           TriggerReasonEnumType = "EVDeparted"
+	TriggerReasonEVDetected           TriggerReasonEnumType = "EVDetected"
+	TriggerReasonRemoteStop           TriggerReasonEnumType = "RemoteStop"
+	TriggerReasonRemoteStart          TriggerReasonEnumType = "RemoteStart"
+	TriggerReasonAbnormalCondition    TriggerReasonEnumType = "AbnormalCondition"
+	TriggerReasonSignedDataReceived   TriggerReasonEnumType = "SignedDataReceived"
+	TriggerReasonResetCommand         TriggerReasonEnumType = "ResetCommand"
+)
+
+type ChargingStateEnumType string
+
+const (
+	ChargingStateCharging      ChargingStateEnumType = "Charging"
+	ChargingStateEVConnected   ChargingStateEnumType = "EVConnected"
+	ChargingStateSuspendedEV   ChargingStateEnumType = "SuspendedEV"
+	ChargingStateSuspendedEVSE ChargingStateEnumType = "SuspendedEVSE"
+	ChargingStateIdle          ChargingStateEnumType = "Idle"
+)
+
+type ReasonEnumType string
+
+const (
+	ReasonDeAuthorized       ReasonEnumType = "DeAuthorized"
+	ReasonEmergencyStop      ReasonEnumType = "EmergencyStop"
+	ReasonEnergyLimitReached ReasonEnumType = "EnergyLimitReached"
+	ReasonEVDisconnected     ReasonEnumType = "EVDisconnected"
+	ReasonGroundFault        ReasonEnumType = "GroundFault"
+	ReasonImmediateReset     ReasonEnumType = "ImmediateReset"
+	ReasonLocal              ReasonEnumType = "Local"
+	ReasonLocalOutOfCredit   ReasonEnumType = "LocalOutOfCredit"
+	ReasonMasterPass         ReasonEnumType = "MasterPass"
+	ReasonOther              ReasonEnumType = "Other"
+	ReasonOvercurrentFault   ReasonEnumType = "OvercurrentFault"
+	ReasonPowerLoss          ReasonEnumType = "PowerLoss"
+	ReasonPowerQuality       ReasonEnumType = "PowerQuality"
+	ReasonReboot             ReasonEnumType = "Reboot"
+	ReasonRemote             ReasonEnumType = "Remote"
+	ReasonSOCLimitReached    ReasonEnumType = "SOCLimitReached"
+	ReasonStoppedByEV        ReasonEnumType = "StoppedByEV"
+	ReasonTimeLimitReached   ReasonEnumType = "TimeLimitReached"
+	ReasonTimeout            ReasonEnumType = "Timeout"
+)
+
+func init() {
+	registerEnum("transactionEvent", string(TransactionEventEnded), string(TransactionEventStarted), string(TransactionEventUpdated))
+	registerEnum("triggerReason", string(TriggerReasonAuthorized), string(TriggerReasonCablePluggedIn), string(TriggerReasonChargingRateChanged),
+		string(TriggerReasonChargingStateChanged), string(TriggerReasonDeauthorized), string(TriggerReasonEnergyLimitReached),
+		string(TriggerReasonEVCommunicationLost), string(TriggerReasonEVConnectTimeout), string(TriggerReasonMeterValueClock),
+		string(TriggerReasonMeterValuePeriodic), string(TriggerReasonTimeLimitReached), string(TriggerReasonTrigger),
+		string(TriggerReasonUnlockCommand), string(TriggerReasonStopAuthorized), string(TriggerReasonEVDeparted),
+		string(TriggerReasonEVDetected), string(TriggerReasonRemoteStop), string(TriggerReasonRemoteStart),
+		string(TriggerReasonAbnormalCondition), string(TriggerReasonSignedDataReceived), string(TriggerReasonResetCommand))
+	registerEnum("chargingState", string(ChargingStateCharging), string(ChargingStateEVConnected), string(ChargingStateSuspendedEV),
+		string(ChargingStateSuspendedEVSE), string(ChargingStateIdle))
+	registerEnum("reason", string(ReasonDeAuthorized), string(ReasonEmergencyStop), string(ReasonEnergyLimitReached),
+		string(ReasonEVDisconnected), string(ReasonGroundFault), string(ReasonImmediateReset), string(ReasonLocal),
+		string(ReasonLocalOutOfCredit), string(ReasonMasterPass), string(ReasonOther), string(ReasonOvercurrentFault),
+		string(ReasonPowerLoss), string(ReasonPowerQuality), string(ReasonReboot), string(ReasonRemote), string(ReasonSOCLimitReached),
+		string(ReasonStoppedByEV), string(ReasonTimeLimitReached), string(ReasonTimeout))
+}
+
+type TransactionType struct {
+	TransactionID     string                `json:"transactionId" validate:"required,max=36"`
+	ChargingState     ChargingStateEnumType `json:"chargingState,omitempty" validate:"omitempty,chargingState"`
+	TimeSpentCharging *int                  `json:"timeSpentCharging,omitempty" validate:"omitempty"`
+	StoppedReason     ReasonEnumType        `json:"stoppedReason,omitempty" validate:"omitempty,reason"`
+	RemoteStartID     *int                  `json:"remoteStartId,omitempty" validate:"omitempty"`
+}
+
+type TransactionEventRequest struct {
+	EventType          TransactionEventEnumType `json:"eventType" validate:"required,transactionEvent"`
+	Timestamp          string                   `json:"timestamp" validate:"required,dateTime"`
+	TriggerReason      TriggerReasonEnumType    `json:"triggerReason" validate:"required,triggerReason"`
+	SeqNo              *int                     `json:"seqNo" validate:"required,gte=0"`
+	Offline            *bool                    `json:"offline,omitempty" validate:"omitempty"`
+	NumberOfPhasesUsed *int                     `json:"numberOfPhasesUsed,omitempty" validate:"omitempty"`
+	CableMaxCurrent    *int                     `json:"cableMaxCurrent,omitempty" validate:"omitempty"`
+	ReservationID      *int                     `json:"reservationId,omitempty" validate:"omitempty"`
+	TransactionInfo    TransactionType          `json:"transactionInfo" validate:"required"`
+	IdToken            *IdTokenType             `json:"idToken,omitempty" validate:"omitempty"`
+	EVSE               *EVSEType                `json:"evse,omitempty" validate:"omitempty"`
+	MeterValue         []MeterValueType         `json:"meterValue,omitempty" validate:"omitempty,min=1,dive"`
+}
+
+func (TransactionEventRequest) Action() string {
+	return TransactionEventName
+}
+
+func (r *TransactionEventRequest) Reset() {
+	*r = TransactionEventRequest{}
+}
+
+type TransactionEventResponse struct {
+	TotalCost              *float64            `json:"totalCost,omitempty" validate:"omitempty"`
+	ChargingPriority       *int                `json:"chargingPriority,omitempty" validate:"omitempty,gte=-9,lte=9"`
+	IdTokenInfo            *IdTokenInfoType    `json:"idTokenInfo,omitempty" validate:"omitempty"`
+	UpdatedPersonalMessage *MessageContentType `json:"updatedPersonalMessage,omitempty" validate:"omitempty"`
+}
+
+func (TransactionEventResponse) Action() string {
+	return TransactionEventName
+}
+
+func (r *TransactionEventResponse) Reset() {
+	*r = TransactionEventResponse{}
+}
@@ -0,0 +1,60 @@
+package ocpp201
+
+type MessageTriggerEnumType string
+
+const (
+	MessageTriggerBootNotification                  MessageTriggerEnumType = "BootNotification"
+	MessageTriggerLogStatusNotification             MessageTriggerEnumType = "LogStatusNotification"
+	MessageTriggerFirmwareStatusNotification        MessageTriggerEnumType = "FirmwareStatusNotification"
+	MessageTriggerHeartbeat                         MessageTriggerEnumType = "Heartbeat"
+	MessageTriggerMeterValues                       MessageTriggerEnumType = "MeterValues"
+	MessageTriggerSignChargingStationCertificate    MessageTriggerEnumType = "SignChargingStationCertificate"
+	MessageTriggerSignV2GCertificate                MessageTriggerEnumType = "SignV2GCertificate"
+	MessageTriggerStatusNotification                MessageTriggerEnumType = "StatusNotification"
+	MessageTriggerTransactionEvent                  MessageTriggerEnumType = "TransactionEvent"
+	MessageTriggerSignCombinedCertificate           MessageTriggerEnumType = "SignCombinedCertificate"
+	MessageTriggerPublishFirmwareStatusNotification MessageTriggerEnumType = "PublishFirmwareStatusNotification"
+)
+
+type TriggerMessageStatusEnumType string
+
+const (
+	TriggerMessageAccepted       TriggerMessageStatusEnumType = "Accepted"
+	TriggerMessageRejected       TriggerMessageStatusEnumType = "Rejected"
+	TriggerMessageNotImplemented TriggerMessageStatusEnumType = "NotImplemented"
+)
+
+func init() {
+	registerEnum("messageTrigger", string(MessageTriggerBootNotification), string(MessageTriggerLogStatusNotification),
+		string(MessageTriggerFirmwareStatusNotification), string(MessageTriggerHeartbeat), string(MessageTriggerMeterValues),
+		string(MessageTriggerSignChargingStationCertificate), string(MessageTriggerSignV2GCertificate),
+		string(MessageTriggerStatusNotification), string(MessageTriggerTransactionEvent), string(MessageTriggerSignCombinedCertificate),
+		string(MessageTriggerPublishFirmwareStatusNotification))
+	registerEnum("triggerMessageStatus", string(TriggerMessageAccepted), string(TriggerMessageRejected), string(TriggerMessageNotImplemented))
+}
+
+type TriggerMessageRequest struct {
+	RequestedMessage MessageTriggerEnumType `json:"requestedMessage" validate:"required,messageTrigger"`
+	EVSE             *EVSEType              `json:"evse,omitempty" validate:"omitempty"`
+}
+
+func (TriggerMessageRequest) Action() string {
+	return TriggerMessageName
+}
+
+func (r *TriggerMessageRequest) Reset() {
+	*r = TriggerMessageRequest{}
+}
+
+type TriggerMessageResponse struct {
+	Status     TriggerMessageStatusEnumType `json:"status" validate:"required,triggerMessageStatus"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (TriggerMessageResponse) Action() string {
+	return TriggerMessageName
+}
+
+func (r *TriggerMessageResponse) Reset() {
+	*r = TriggerMessageResponse{}
+}
@@ -0,0 +1,41 @@
+package ocpp201
+
+type UnlockStatusEnumType string
+
+const (
+	UnlockStatusUnlocked                     UnlockStatusEnumType = "Unlocked"
+	UnlockStatusUnlockFailed                 UnlockStatusEnumType = "UnlockFailed"
+	UnlockStatusOngoingAuthorizedTransaction UnlockStatusEnumType = "OngoingAuthorizedTransaction"
+	UnlockStatusUnknownConnector             UnlockStatusEnumType = "UnknownConnector"
+)
+
+func init() {
+	registerEnum("unlockStatus", string(UnlockStatusUnlocked), string(UnlockStatusUnlockFailed),
+		string(UnlockStatusOngoingAuthorizedTransaction), string(UnlockStatusUnknownConnector))
+}
+
+type UnlockConnectorRequest struct {
+	EvseID      *int `json:"evseId" validate:"required,gte=0"`
+	ConnectorID *int `json:"connectorId" validate:"required,gte=0"`
+}
+
+func (UnlockConnectorRequest) Action() string {
+	return UnlockConnectorName
+}
+
+func (r *UnlockConnectorRequest) Reset() {
+	*r = UnlockConnectorRequest{}
+}
+
+type UnlockConnectorResponse struct {
+	Status     UnlockStatusEnumType `json:"status" validate:"required,unlockStatus"`
+	StatusInfo *StatusInfoType      `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (UnlockConnectorResponse) Action() string {
+	return UnlockConnectorName
+}
+
+func (r *UnlockConnectorResponse) Reset() {
+	*r = UnlockConnectorResponse{}
+}
@@ -0,0 +1,52 @@
+package ocpp201
+
+type UpdateFirmwareStatusEnumType string
+
+const (
+	UpdateFirmwareAccepted           UpdateFirmwareStatusEnumType = "Accepted"
+	UpdateFirmwareRejected           UpdateFirmwareStatusEnumType = "Rejected"
+	UpdateFirmwareAcceptedCanceled   UpdateFirmwareStatusEnumType = "AcceptedCanceled"
+	UpdateFirmwareInvalidCertificate UpdateFirmwareStatusEnumType = "InvalidCertificate"
+	UpdateFirmwareRevokedCertificate UpdateFirmwareStatusEnumType = "RevokedCertificate"
+)
+
+func init() {
+	registerEnum("updateFirmwareStatus", string(UpdateFirmwareAccepted), string(UpdateFirmwareRejected), string(UpdateFirmwareAcceptedCanceled),
+		string(UpdateFirmwareInvalidCertificate), string(UpdateFirmwareRevokedCertificate))
+}
+
+type FirmwareType struct {
+	Location           string `json:"location" validate:"required,max=512"`
+	RetrieveDateTime   string `json:"retrieveDateTime" validate:"required,dateTime"`
+	InstallDateTime    string `json:"installDateTime,omitempty" validate:"omitempty,dateTime"`
+	SigningCertificate string `json:"signingCertificate,omitempty" validate:"omitempty,max=5500"`
+	Signature          string `json:"signature,omitempty" validate:"omitempty,max=800"`
+}
+
+type UpdateFirmwareRequest struct {
+	Retries       *int         `json:"retries,omitempty" validate:"omitempty,gte=0"`
+	RetryInterval *int         `json:"retryInterval,omitempty" validate:"omitempty,gte=0"`
+	RequestID     *int         `json:"requestId" validate:"required"`
+	Firmware      FirmwareType `json:"firmware" validate:"required"`
+}
+
+func (UpdateFirmwareRequest) Action() string {
+	return UpdateFirmwareName
+}
+
+func (r *UpdateFirmwareRequest) Reset() {
+	*r = UpdateFirmwareRequest{}
+}
+
+type UpdateFirmwareResponse struct {
+	Status     UpdateFirmwareStatusEnumType `json:"status" validate:"required,updateFirmwareStatus"`
+	StatusInfo *StatusInfoType              `json:"statusInfo,omitempty" validate:"omitempty"`
+}
+
+func (UpdateFirmwareResponse) Action() string {
+	return UpdateFirmwareName
+}
+
+func (r *UpdateFirmwareResponse) Reset() {
+	*r = UpdateFirmwareResponse{}
+}
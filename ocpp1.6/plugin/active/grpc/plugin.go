@@ -0,0 +1,413 @@
+//Package grpc serves ChargePointService of protobuf/ocpp16.proto on a grpc server: one rpc per call of the central
+//system, answered with the reply of the charging point, and the Connect stream, on which a backend subscribes to
+//the requests, connections and disconnections of charging points and sends calls whose replies come back as events.
+//
+//the requests reach the streams through the passive plugin returned by Wrap
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"ocpp16/protobuf"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//events queued for a stream before it is closed as too slow
+const streamBuffer = 1024
+
+var errSlowStream = status.Error(codes.ResourceExhausted, "events not consumed in time")
+
+//Server is the part of *ocpp16server.Server the plugin needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Plugin struct {
+	protobuf.UnimplementedChargePointServiceServer
+	server  Server
+	mu      sync.RWMutex
+	streams map[*stream]struct{}
+}
+
+type stream struct {
+	mu     sync.Mutex
+	all    bool
+	ids    map[string]bool
+	events chan *protobuf.Event
+	ctx    context.Context
+	cancel context.CancelFunc
+	err    error
+}
+
+//NewActiveCallPlugin registers ChargePointService on g, which the caller serves
+func NewActiveCallPlugin(s Server, g *grpc.Server) *Plugin {
+	p := &Plugin{server: s, streams: make(map[*stream]struct{})}
+	protobuf.RegisterChargePointServiceServer(g, p)
+	return p
+}
+
+//newCall converts the request of a backend, the server handles the requests of the active calls as values
+func newCall(uniqueid string, request protocol.Request) *protocol.Call {
+	if uniqueid == "" {
+		uniqueid = protocol.NewUniqueID()
+	}
+	return &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        request.Action(),
+		Request:       reflect.Indirect(reflect.ValueOf(request)).Interface().(protocol.Request),
+	}
+}
+
+//callMessage is the <Action>Call of an rpc
+type callMessage interface {
+	proto.Message
+	GetId() string
+	GetUniqueId() string
+}
+
+//call sends the request of in to its charging point and sets out, the <Action>Result of in, to the reply
+func (p *Plugin) call(ctx context.Context, in callMessage, out proto.Message) error {
+	request, err := protobuf.GetPayload(in)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.GetId() == "" || request == nil {
+		return status.Error(codes.InvalidArgument, "id or request missing")
+	}
+	response, callError, err := p.server.Call(ctx, in.GetId(), newCall(in.GetUniqueId(), request.(protocol.Request)))
+	if err != nil {
+		return status.Error(code(err), err.Error())
+	}
+	if callError != nil {
+		protobuf.SetError(out, protobuf.NewCallError(callError))
+		return nil
+	}
+	if err = protobuf.SetPayload(out, response); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (p *Plugin) CancelReservation(ctx context.Context, in *protobuf.CancelReservationCall) (*protobuf.CancelReservationResult, error) {
+	out := &protobuf.CancelReservationResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ChangeAvailability(ctx context.Context, in *protobuf.ChangeAvailabilityCall) (*protobuf.ChangeAvailabilityResult, error) {
+	out := &protobuf.ChangeAvailabilityResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ChangeConfiguration(ctx context.Context, in *protobuf.ChangeConfigurationCall) (*protobuf.ChangeConfigurationResult, error) {
+	out := &protobuf.ChangeConfigurationResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ClearCache(ctx context.Context, in *protobuf.ClearCacheCall) (*protobuf.ClearCacheResult, error) {
+	out := &protobuf.ClearCacheResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ClearChargingProfile(ctx context.Context, in *protobuf.ClearChargingProfileCall) (*protobuf.ClearChargingProfileResult, error) {
+	out := &protobuf.ClearChargingProfileResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) DataTransfer(ctx context.Context, in *protobuf.DataTransferCall) (*protobuf.DataTransferResult, error) {
+	out := &protobuf.DataTransferResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetCompositeSchedule(ctx context.Context, in *protobuf.GetCompositeScheduleCall) (*protobuf.GetCompositeScheduleResult, error) {
+	out := &protobuf.GetCompositeScheduleResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetConfiguration(ctx context.Context, in *protobuf.GetConfigurationCall) (*protobuf.GetConfigurationResult, error) {
+	out := &protobuf.GetConfigurationResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetDiagnostics(ctx context.Context, in *protobuf.GetDiagnosticsCall) (*protobuf.GetDiagnosticsResult, error) {
+	out := &protobuf.GetDiagnosticsResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetLocalListVersion(ctx context.Context, in *protobuf.GetLocalListVersionCall) (*protobuf.GetLocalListVersionResult, error) {
+	out := &protobuf.GetLocalListVersionResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) RemoteStartTransaction(ctx context.Context, in *protobuf.RemoteStartTransactionCall) (*protobuf.RemoteStartTransactionResult, error) {
+	out := &protobuf.RemoteStartTransactionResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) RemoteStopTransaction(ctx context.Context, in *protobuf.RemoteStopTransactionCall) (*protobuf.RemoteStopTransactionResult, error) {
+	out := &protobuf.RemoteStopTransactionResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ReserveNow(ctx context.Context, in *protobuf.ReserveNowCall) (*protobuf.ReserveNowResult, error) {
+	out := &protobuf.ReserveNowResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) Reset(ctx context.Context, in *protobuf.ResetCall) (*protobuf.ResetResult, error) {
+	out := &protobuf.ResetResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) SendLocalList(ctx context.Context, in *protobuf.SendLocalListCall) (*protobuf.SendLocalListResult, error) {
+	out := &protobuf.SendLocalListResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) SetChargingProfile(ctx context.Context, in *protobuf.SetChargingProfileCall) (*protobuf.SetChargingProfileResult, error) {
+	out := &protobuf.SetChargingProfileResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) TriggerMessage(ctx context.Context, in *protobuf.TriggerMessageCall) (*protobuf.TriggerMessageResult, error) {
+	out := &protobuf.TriggerMessageResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) UnlockConnector(ctx context.Context, in *protobuf.UnlockConnectorCall) (*protobuf.UnlockConnectorResult, error) {
+	out := &protobuf.UnlockConnectorResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) UpdateFirmware(ctx context.Context, in *protobuf.UpdateFirmwareCall) (*protobuf.UpdateFirmwareResult, error) {
+	out := &protobuf.UpdateFirmwareResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) CertificateSigned(ctx context.Context, in *protobuf.CertificateSignedCall) (*protobuf.CertificateSignedResult, error) {
+	out := &protobuf.CertificateSignedResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) DeleteCertificate(ctx context.Context, in *protobuf.DeleteCertificateCall) (*protobuf.DeleteCertificateResult, error) {
+	out := &protobuf.DeleteCertificateResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) ExtendedTriggerMessage(ctx context.Context, in *protobuf.ExtendedTriggerMessageCall) (*protobuf.ExtendedTriggerMessageResult, error) {
+	out := &protobuf.ExtendedTriggerMessageResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetInstalledCertificateIds(ctx context.Context, in *protobuf.GetInstalledCertificateIdsCall) (*protobuf.GetInstalledCertificateIdsResult, error) {
+	out := &protobuf.GetInstalledCertificateIdsResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) GetLog(ctx context.Context, in *protobuf.GetLogCall) (*protobuf.GetLogResult, error) {
+	out := &protobuf.GetLogResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) InstallCertificate(ctx context.Context, in *protobuf.InstallCertificateCall) (*protobuf.InstallCertificateResult, error) {
+	out := &protobuf.InstallCertificateResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func (p *Plugin) SignedUpdateFirmware(ctx context.Context, in *protobuf.SignedUpdateFirmwareCall) (*protobuf.SignedUpdateFirmwareResult, error) {
+	out := &protobuf.SignedUpdateFirmwareResult{}
+	return out, p.call(ctx, in, out)
+}
+
+func code(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, ocpp16server.ErrResponseTimeout):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, ocpp16server.ErrConnClosed):
+		return codes.Unavailable
+	}
+	return codes.FailedPrecondition
+}
+
+//Connect serves the stream of a backend
+func (p *Plugin) Connect(ss protobuf.ChargePointService_ConnectServer) error {
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	s := &stream{ids: make(map[string]bool), events: make(chan *protobuf.Event, streamBuffer), ctx: ctx, cancel: cancel}
+	p.mu.Lock()
+	p.streams[s] = struct{}{}
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.streams, s)
+		p.mu.Unlock()
+	}()
+	errC := make(chan error, 1)
+	go func() {
+		for {
+			cmd, err := ss.Recv()
+			if err != nil {
+				errC <- err
+				return
+			}
+			p.command(s, cmd)
+		}
+	}()
+	for {
+		select {
+		case e := <-s.events:
+			if err := ss.Send(e); err != nil {
+				return err
+			}
+		case err := <-errC:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.err != nil {
+				return s.err
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+func (p *Plugin) command(s *stream, cmd *protobuf.Command) {
+	switch c := cmd.Command.(type) {
+	case *protobuf.Command_Subscribe:
+		s.mu.Lock()
+		if len(c.Subscribe.GetIds()) == 0 {
+			s.all = true
+		}
+		for _, id := range c.Subscribe.GetIds() {
+			s.ids[id] = true
+		}
+		s.mu.Unlock()
+	case *protobuf.Command_Unsubscribe:
+		s.mu.Lock()
+		if len(c.Unsubscribe.GetIds()) == 0 {
+			s.all, s.ids = false, make(map[string]bool)
+		}
+		for _, id := range c.Unsubscribe.GetIds() {
+			delete(s.ids, id)
+		}
+		s.mu.Unlock()
+	case *protobuf.Command_Call:
+		request, err := protobuf.GetPayload(c.Call)
+		if err != nil {
+			e := &protobuf.Event{Id: c.Call.GetId(), UniqueId: c.Call.GetUniqueId()}
+			protobuf.SetError(e, &protobuf.CallError{ErrorCode: string(protocol.FormationViolation), ErrorDescription: err.Error()})
+			s.deliver(e)
+			return
+		}
+		if request == nil {
+			return
+		}
+		call := newCall(c.Call.GetUniqueId(), request.(protocol.Request))
+		go func() {
+			e := &protobuf.Event{Id: c.Call.GetId(), UniqueId: call.UniqueID, Action: call.Action}
+			response, callError, err := p.server.Call(s.ctx, c.Call.GetId(), call)
+			switch {
+			case err != nil:
+				protobuf.SetError(e, &protobuf.CallError{ErrorCode: string(protocol.GenericError), ErrorDescription: err.Error()})
+			case callError != nil:
+				protobuf.SetError(e, protobuf.NewCallError(callError))
+			default:
+				if err = protobuf.SetPayload(e, response); err != nil {
+					protobuf.SetError(e, &protobuf.CallError{ErrorCode: string(protocol.CallInternalError), ErrorDescription: err.Error()})
+				}
+			}
+			s.deliver(e)
+		}()
+	}
+}
+
+func (s *stream) subscribed(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.all || s.ids[id]
+}
+
+//deliver queues e without blocking, a stream that does not keep up is closed
+func (s *stream) deliver(e *protobuf.Event) {
+	select {
+	case s.events <- e:
+	case <-s.ctx.Done():
+	default:
+		s.mu.Lock()
+		if s.err == nil {
+			s.err = errSlowStream
+		}
+		s.mu.Unlock()
+		s.cancel()
+	}
+}
+
+//publish sends e to the streams subscribed to its charging point
+func (p *Plugin) publish(e *protobuf.Event) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for s := range p.streams {
+		if s.subscribed(e.Id) {
+			s.deliver(e)
+		}
+	}
+}
+
+type observedPlugin struct {
+	ocpp16server.PassivePlugin
+	p *Plugin
+}
+
+//Wrap returns plugin publishing the requests it handles and the connections and disconnections to the streams,
+//register it on the server in place of plugin
+func (p *Plugin) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &observedPlugin{PassivePlugin: plugin, p: p}
+}
+
+func (o *observedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := o.PassivePlugin.RequestHandler(action)
+	if !ok || !contains(protobuf.ChargePointActions, action) {
+		return handler, ok
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		e := &protobuf.Event{Id: id, UniqueId: uniqueid, Action: action}
+		if err := protobuf.SetPayload(e, request); err == nil {
+			o.p.publish(e)
+		}
+		return handler(ctx, id, uniqueid, request)
+	}, true
+}
+
+func (o *observedPlugin) ChargingPointOnline(id string) error {
+	o.p.publish(&protobuf.Event{Id: id, Event: &protobuf.Event_Online{Online: &protobuf.Empty{}}})
+	return o.PassivePlugin.ChargingPointOnline(id)
+}
+
+func (o *observedPlugin) ChargingPointOffline(id string) error {
+	o.p.publish(&protobuf.Event{Id: id, Event: &protobuf.Event_Offline{Offline: &protobuf.Empty{}}})
+	return o.PassivePlugin.ChargingPointOffline(id)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protobuf"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServer struct {
+	calls chan *protocol.Call
+}
+
+func (s *fakeServer) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	if id != "CP001" {
+		return nil, nil, fmt.Errorf("%w, id(%s)", ocpp16server.ErrConnClosed, id)
+	}
+	s.calls <- call
+	req := call.Request.(protocol.ResetRequest)
+	if req.Type == "Hard" {
+		return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: "no hard reset"}, nil
+	}
+	return &protocol.ResetResponse{Status: "Accepted"}, nil, nil
+}
+
+func serve(t *testing.T, s Server) (*Plugin, *grpc.ClientConn) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := grpc.NewServer()
+	p := NewActiveCallPlugin(s, g)
+	go g.Serve(l)
+	t.Cleanup(g.Stop)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return p, conn
+}
+
+func TestCall(t *testing.T) {
+	s := &fakeServer{calls: make(chan *protocol.Call, 10)}
+	_, conn := serve(t, s)
+	client := protobuf.NewChargePointServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	out, err := client.Reset(ctx, &protobuf.ResetCall{Id: "CP001", UniqueId: "1", Request: &protobuf.ResetRequest{Type: "Soft"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.GetResponse().GetStatus() != "Accepted" {
+		t.Fatalf("unexpected result %s", out)
+	}
+	if call := <-s.calls; call.UniqueID != "1" || call.Action != protocol.ResetName || call.Request != (protocol.ResetRequest{Type: "Soft"}) {
+		t.Fatalf("unexpected call %+v", call)
+	}
+
+	if out, err = client.Reset(ctx, &protobuf.ResetCall{Id: "CP001", Request: &protobuf.ResetRequest{Type: "Hard"}}); err != nil {
+		t.Fatal(err)
+	}
+	if out.GetError().GetErrorCode() != string(protocol.NotSupported) {
+		t.Fatalf("unexpected result %s", out)
+	}
+	if call := <-s.calls; call.UniqueID == "" {
+		t.Fatal("unique id not generated")
+	}
+
+	_, err = client.Reset(ctx, &protobuf.ResetCall{Id: "CP002", Request: &protobuf.ResetRequest{Type: "Soft"}})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("unexpected error %v", err)
+	}
+	_, err = client.Reset(ctx, &protobuf.ResetCall{Id: "CP001"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	s := &fakeServer{calls: make(chan *protocol.Call, 10)}
+	p, conn := serve(t, s)
+	plugin := p.Wrap(local.NewActionPlugin())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := protobuf.NewChargePointServiceClient(conn).Connect(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subscribe := &protobuf.Command_Subscribe{Subscribe: &protobuf.Subscription{Ids: []string{"CP001"}}}
+	if err = stream.Send(&protobuf.Command{Command: subscribe}); err != nil {
+		t.Fatal(err)
+	}
+	//the subscription is handled asynchronously, publish until the stream receives it
+	events := make(chan *protobuf.Event, 10)
+	go func() {
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				close(events)
+				return
+			}
+			events <- e
+		}
+	}()
+	online := func() *protobuf.Event {
+		for {
+			plugin.ChargingPointOnline("CP001")
+			select {
+			case e := <-events:
+				return e
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	if e := online(); e.Id != "CP001" || e.GetOnline() == nil {
+		t.Fatalf("unexpected event %s", e)
+	}
+	for len(events) > 0 {
+		<-events
+	}
+
+	plugin.ChargingPointOnline("CP002")
+	handler, _ := plugin.RequestHandler(protocol.HeartbeatName)
+	if _, err = handler(ctx, "CP001", "7", &protocol.HeartbeatRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if e := <-events; e.Id != "CP001" || e.UniqueId != "7" || e.Action != protocol.HeartbeatName || e.GetHeartbeatRequest() == nil {
+		t.Fatalf("unexpected event %s", e)
+	}
+
+	call := &protobuf.Call{Id: "CP001", UniqueId: "8", Request: &protobuf.Call_ResetRequest{ResetRequest: &protobuf.ResetRequest{Type: "Soft"}}}
+	if err = stream.Send(&protobuf.Command{Command: &protobuf.Command_Call{Call: call}}); err != nil {
+		t.Fatal(err)
+	}
+	e := <-events
+	if e.UniqueId != "8" || e.Action != protocol.ResetName || e.GetResetResponse().GetStatus() != "Accepted" {
+		t.Fatalf("unexpected event %s", e)
+	}
+	call = &protobuf.Call{Id: "CP002", UniqueId: "9", Request: &protobuf.Call_ResetRequest{ResetRequest: &protobuf.ResetRequest{Type: "Soft"}}}
+	if err = stream.Send(&protobuf.Command{Command: &protobuf.Command_Call{Call: call}}); err != nil {
+		t.Fatal(err)
+	}
+	if e = <-events; e.UniqueId != "9" || e.GetError().GetErrorCode() != string(protocol.GenericError) {
+		t.Fatalf("unexpected event %s", e)
+	}
+}
@@ -10,13 +10,17 @@ import (
 var activeCallHandler ActiveCallHandler
 
 func NewActiveCallPlugin(handler ocpp16server.ActiveCallHandler) {
-	activeCallHandler = ActiveCallHandler{
-		handler: handler,
-	}
+	activeCallHandler.handler = handler
+}
+
+//NewSyncActiveCallPlugin enables the Call* functions, which wait for the reply of the charging point
+func NewSyncActiveCallPlugin(handler ocpp16server.SyncActiveCallHandler) {
+	activeCallHandler.syncHandler = handler
 }
 
 type ActiveCallHandler struct {
-	handler ocpp16server.ActiveCallHandler
+	handler     ocpp16server.ActiveCallHandler
+	syncHandler ocpp16server.SyncActiveCallHandler
 }
 
 func ActiveChangeConfiguration(ctx context.Context, id string, uniqueid string, req *protocol.ChangeConfigurationRequest) error {
@@ -78,6 +82,35 @@ func ActiveCancelReservation(ctx context.Context, id string, uniqueid string, re
 
 }
 
+//Security
+func ActiveCertificateSigned(ctx context.Context, id string, uniqueid string, req *protocol.CertificateSignedRequest) error {
+	return activeCallHandler.activeCertificateSigned(ctx, id, uniqueid, req)
+}
+
+func ActiveInstallCertificate(ctx context.Context, id string, uniqueid string, req *protocol.InstallCertificateRequest) error {
+	return activeCallHandler.activeInstallCertificate(ctx, id, uniqueid, req)
+}
+
+func ActiveDeleteCertificate(ctx context.Context, id string, uniqueid string, req *protocol.DeleteCertificateRequest) error {
+	return activeCallHandler.activeDeleteCertificate(ctx, id, uniqueid, req)
+}
+
+func ActiveGetInstalledCertificateIds(ctx context.Context, id string, uniqueid string, req *protocol.GetInstalledCertificateIdsRequest) error {
+	return activeCallHandler.activeGetInstalledCertificateIds(ctx, id, uniqueid, req)
+}
+
+func ActiveGetLog(ctx context.Context, id string, uniqueid string, req *protocol.GetLogRequest) error {
+	return activeCallHandler.activeGetLog(ctx, id, uniqueid, req)
+}
+
+func ActiveSignedUpdateFirmware(ctx context.Context, id string, uniqueid string, req *protocol.SignedUpdateFirmwareRequest) error {
+	return activeCallHandler.activeSignedUpdateFirmware(ctx, id, uniqueid, req)
+}
+
+func ActiveExtendedTriggerMessage(ctx context.Context, id string, uniqueid string, req *protocol.ExtendedTriggerMessageRequest) error {
+	return activeCallHandler.activeExtendedTriggerMessage(ctx, id, uniqueid, req)
+}
+
 //ChargingCore
 func (s *ActiveCallHandler) activeChangeConfiguration(ctx context.Context, id string, uniqueid string, req *protocol.ChangeConfigurationRequest) error {
 	if req == nil {
@@ -284,3 +317,118 @@ func (s *ActiveCallHandler) activeSendLocalList(ctx context.Context, id string,
 	}
 	return s.handler(ctx, id, &call)
 }
+
+//Security
+func (s *ActiveCallHandler) activeCertificateSigned(ctx context.Context, id string, uniqueid string, req *protocol.CertificateSignedRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveCertificateSigned error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.CertificateSignedName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeInstallCertificate(ctx context.Context, id string, uniqueid string, req *protocol.InstallCertificateRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveInstallCertificate error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.InstallCertificateName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeDeleteCertificate(ctx context.Context, id string, uniqueid string, req *protocol.DeleteCertificateRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveDeleteCertificate error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.DeleteCertificateName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeGetInstalledCertificateIds(ctx context.Context, id string, uniqueid string, req *protocol.GetInstalledCertificateIdsRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveGetInstalledCertificateIds error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.GetInstalledCertificateIdsName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeGetLog(ctx context.Context, id string, uniqueid string, req *protocol.GetLogRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveGetLog error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.GetLogName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeSignedUpdateFirmware(ctx context.Context, id string, uniqueid string, req *protocol.SignedUpdateFirmwareRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveSignedUpdateFirmware error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.SignedUpdateFirmwareName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+func (s *ActiveCallHandler) activeExtendedTriggerMessage(ctx context.Context, id string, uniqueid string, req *protocol.ExtendedTriggerMessageRequest) error {
+	if req == nil {
+		return fmt.Errorf("ActiveExtendedTriggerMessage error: req nil, req(%+v)", req)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.ExtendedTriggerMessageName,
+		Request:       *req,
+	}
+	return s.handler(ctx, id, &call)
+}
+
+//Call sends req and waits for the reply of the charging point, it requires NewSyncActiveCallPlugin to be registered.
+//exactly one of the returned values is non-nil, the response is the pointer type of the action, e.g.
+//*protocol.ResetResponse for a protocol.ResetRequest
+func Call(ctx context.Context, id string, uniqueid string, req protocol.Request) (protocol.Response, *protocol.CallError, error) {
+	if req == nil {
+		return nil, nil, fmt.Errorf("Call error: req nil, req(%+v)", req)
+	}
+	return activeCallHandler.call(ctx, id, uniqueid, req.Action(), req)
+}
+
+func (s *ActiveCallHandler) call(ctx context.Context, id string, uniqueid string, action string, req protocol.Request) (protocol.Response, *protocol.CallError, error) {
+	if s.syncHandler == nil {
+		return nil, nil, fmt.Errorf("Call%s error: sync active call handler not registered", action)
+	}
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        action,
+		Request:       req,
+	}
+	return s.syncHandler(ctx, id, &call)
+}
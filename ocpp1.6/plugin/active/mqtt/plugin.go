@@ -0,0 +1,107 @@
+//Package mqtt subscribes to {prefix}/{id}/out/{action} on an mqtt broker and sends the messages as active calls.
+//
+//the message is {"uniqueId":...,"payload":{...}}, e.g. {"payload":{"type":"Soft"}} published to ocpp/{id}/out/Reset,
+//a random uuid is the unique id when it is empty. the reply of the charging point is published by the mqtt passive plugin,
+//a call that can not be sent is reported on {prefix}/{id}/error as {"uniqueId":...,"action":...,"error":...}
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	mqttclient "ocpp16/mqtt"
+	"ocpp16/protocol"
+	"strings"
+	"time"
+)
+
+//Server is the part of *ocpp16server.Server the plugin needs
+type Server interface {
+	HandleActiveCall(ctx context.Context, id string, call *protocol.Call) error
+	NewRequest(id string, action string, payload []byte) (protocol.Request, error)
+}
+
+type Config struct {
+	Prefix  string        //of the topics, "ocpp" if empty
+	Timeout time.Duration //of the queueing of a call, 10s if 0
+}
+
+//Message is the payload of the messages on {prefix}/{id}/out/{action}
+type Message struct {
+	UniqueID string          `json:"uniqueId,omitempty"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+//ErrorMessage is published on {prefix}/{id}/error when a call can not be sent
+type ErrorMessage struct {
+	UniqueID string `json:"uniqueId,omitempty"`
+	Action   string `json:"action"`
+	Error    string `json:"error"`
+}
+
+type plugin struct {
+	conf   Config
+	server Server
+	client *mqttclient.Client
+}
+
+//NewActiveCallPlugin subscribes client to the topics of the active calls
+func NewActiveCallPlugin(s Server, client *mqttclient.Client, conf Config) error {
+	if conf.Prefix == "" {
+		conf.Prefix = "ocpp"
+	}
+	if !mqttclient.ValidTopic(conf.Prefix) {
+		return fmt.Errorf("invalid topic prefix(%s)", conf.Prefix)
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	p := &plugin{conf: conf, server: s, client: client}
+	return client.Subscribe(conf.Prefix+"/+/out/+", func(topic string, payload []byte) {
+		//the handler runs on the reading goroutine of the client, queueing a call may block
+		go p.handle(topic, payload)
+	})
+}
+
+func (p *plugin) handle(topic string, payload []byte) {
+	levels := strings.Split(strings.TrimPrefix(topic, p.conf.Prefix+"/"), "/")
+	if len(levels) != 3 {
+		return
+	}
+	id, action := levels[0], levels[2]
+	var msg Message
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		p.reportError(id, &ErrorMessage{Action: action, Error: fmt.Sprintf("invalid message, %v", err)})
+		return
+	}
+	if len(msg.Payload) == 0 {
+		msg.Payload = json.RawMessage("{}")
+	}
+	if msg.UniqueID == "" {
+		msg.UniqueID = protocol.NewUniqueID()
+	}
+	req, err := p.server.NewRequest(id, action, msg.Payload)
+	if err != nil {
+		p.reportError(id, &ErrorMessage{UniqueID: msg.UniqueID, Action: action, Error: err.Error()})
+		return
+	}
+	call := &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      msg.UniqueID,
+		Action:        action,
+		Request:       req,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.conf.Timeout)
+	defer cancel()
+	if err = p.server.HandleActiveCall(ctx, id, call); err != nil {
+		p.reportError(id, &ErrorMessage{UniqueID: msg.UniqueID, Action: action, Error: err.Error()})
+	}
+}
+
+func (p *plugin) reportError(id string, msg *ErrorMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	p.client.Publish(p.conf.Prefix+"/"+id+"/error", data)
+}
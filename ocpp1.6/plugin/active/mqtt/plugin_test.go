@@ -0,0 +1,103 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	mqttclient "ocpp16/mqtt"
+	"ocpp16/mqtt/mqtttest"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeServer struct {
+	calls chan *protocol.Call
+}
+
+func (s *fakeServer) HandleActiveCall(ctx context.Context, id string, call *protocol.Call) error {
+	if id != "cs-CP001" {
+		return fmt.Errorf("%w, id(%s)", ocpp16server.ErrConnClosed, id)
+	}
+	s.calls <- call
+	return nil
+}
+
+func (s *fakeServer) NewRequest(id string, action string, payload []byte) (protocol.Request, error) {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil, fmt.Errorf("%w, action(%s)", ocpp16server.ErrActionNotSupported, action)
+	}
+	req := reflect.New(ocpptrait.RequestType())
+	if err := json.Unmarshal(payload, req.Interface()); err != nil {
+		return nil, err
+	}
+	return req.Elem().Interface().(protocol.Request), nil
+}
+
+func TestActiveCall(t *testing.T) {
+	broker := mqtttest.NewBroker()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go broker.Serve(l)
+	defer broker.Close()
+	client, err := mqttclient.Connect(mqttclient.Options{Addr: l.Addr().String(), ClientID: "central"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	s := &fakeServer{calls: make(chan *protocol.Call, 10)}
+	if err = NewActiveCallPlugin(s, client, Config{}); err != nil {
+		t.Fatal(err)
+	}
+	errs := make(chan *ErrorMessage, 10)
+	client.Subscribe("ocpp/+/error", func(topic string, payload []byte) {
+		var msg ErrorMessage
+		json.Unmarshal(payload, &msg)
+		errs <- &msg
+	})
+
+	broker.Publish("ocpp/cs-CP001/out/Reset", []byte(`{"uniqueId":"42","payload":{"type":"Soft"}}`))
+	select {
+	case call := <-s.calls:
+		if call.UniqueID != "42" || call.Action != protocol.ResetName || call.Request.(protocol.ResetRequest).Type != "Soft" {
+			t.Fatalf("unexpected call %+v", call)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no call")
+	}
+	broker.Publish("ocpp/cs-CP001/out/ClearCache", []byte(`{}`))
+	select {
+	case call := <-s.calls:
+		if len(call.UniqueID) != 36 || call.Action != protocol.ClearCacheName {
+			t.Fatalf("unexpected call %+v", call)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no call")
+	}
+
+	tests := []struct {
+		topic, payload string
+		err            error
+	}{
+		{"ocpp/cs-CP001/out/Jump", `{"uniqueId":"1"}`, ocpp16server.ErrActionNotSupported},
+		{"ocpp/cs-CP002/out/Reset", `{"uniqueId":"2","payload":{"type":"Hard"}}`, ocpp16server.ErrConnClosed},
+	}
+	for _, tt := range tests {
+		broker.Publish(tt.topic, []byte(tt.payload))
+		select {
+		case msg := <-errs:
+			if !strings.HasPrefix(msg.Error, tt.err.Error()) {
+				t.Errorf("%s: unexpected error %+v", tt.topic, msg)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%s: no error", tt.topic)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+//Package rest serves an http api on the gin engine of the server to send active calls and inspect the connections:
+//
+//	POST /api/v1/chargepoints/:id/actions/:action  the body is the json payload of the request, e.g. {"type":"Soft"} for Reset.
+//	     ?wait=true waits for the reply of the charging point, ?timeout=30s bounds the wait, ?uniqueId= sets the message id
+//	GET  /api/v1/chargepoints                      the connected charging points
+//	GET  /api/v1/chargepoints/:id                  one charging point, with the call waiting for its reply
+//
+//the id is the one of the server, i.e. the name and the id of the service uri joined by "-"
+package rest
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const BasePath = "/api/v1"
+
+//Server is the part of *ocpp16server.Server the api needs
+type Server interface {
+	Router() gin.IRouter
+	HandleActiveCall(ctx context.Context, id string, call *protocol.Call) error
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+	NewRequest(id string, action string, payload []byte) (protocol.Request, error)
+	ChargePoints() []ocpp16server.ChargePointState
+	ChargePoint(id string) (ocpp16server.ChargePointState, bool)
+}
+
+//CallReply is the body answering a call, Response or CallError is only set when the call waited for the reply
+type CallReply struct {
+	UniqueID  string            `json:"uniqueId"`
+	Response  protocol.Response `json:"response,omitempty"`
+	CallError *CallError        `json:"callError,omitempty"`
+}
+
+type CallError struct {
+	ErrorCode        protocol.ErrCodeType  `json:"errorCode"`
+	ErrorDescription string                `json:"errorDescription"`
+	ErrorDetails     protocol.ErrorDetails `json:"errorDetails"`
+}
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+type plugin struct {
+	server Server
+}
+
+//NewActiveCallPlugin registers the api on the router of s, handlers run before every endpoint, e.g. TokenAuth
+func NewActiveCallPlugin(s Server, handlers ...gin.HandlerFunc) {
+	p := &plugin{server: s}
+	group := s.Router().Group(BasePath, handlers...)
+	group.GET("/chargepoints", p.listChargePoints)
+	group.GET("/chargepoints/:id", p.getChargePoint)
+	group.POST("/chargepoints/:id/actions/:action", p.call)
+}
+
+//TokenAuth rejects the requests without the header "Authorization: Bearer token", an empty token rejects every request
+func TokenAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" || subtle.ConstantTimeCompare([]byte(c.GetHeader("Authorization")), []byte("Bearer "+token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, errorReply{Error: "invalid token"})
+		}
+	}
+}
+
+func (p *plugin) listChargePoints(c *gin.Context) {
+	c.JSON(http.StatusOK, p.server.ChargePoints())
+}
+
+func (p *plugin) getChargePoint(c *gin.Context) {
+	state, ok := p.server.ChargePoint(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("charging point(%s) not connected", c.Param("id"))})
+		return
+	}
+	c.JSON(http.StatusOK, state)
+}
+
+func (p *plugin) call(c *gin.Context) {
+	id, action := c.Param("id"), c.Param("action")
+	payload, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	}
+	req, err := p.server.NewRequest(id, action, payload)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ocpp16server.ErrActionNotSupported) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, errorReply{Error: err.Error()})
+		return
+	}
+	uniqueid := c.Query("uniqueId")
+	if uniqueid == "" {
+		uniqueid = protocol.NewUniqueID()
+	}
+	call := &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        action,
+		Request:       req,
+	}
+	wait, _ := strconv.ParseBool(c.Query("wait"))
+	if !wait {
+		if err = p.server.HandleActiveCall(c.Request.Context(), id, call); err != nil {
+			c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusAccepted, CallReply{UniqueID: uniqueid})
+		return
+	}
+	ctx := c.Request.Context()
+	if timeout := c.Query("timeout"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid timeout(%s), %v", timeout, err)})
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+	res, callError, err := p.server.Call(ctx, id, call)
+	switch {
+	case errors.Is(err, ocpp16server.ErrResponseTimeout), errors.Is(err, context.DeadlineExceeded):
+		c.JSON(http.StatusGatewayTimeout, errorReply{Error: err.Error()})
+	case errors.Is(err, ocpp16server.ErrConnClosed):
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+	case err != nil:
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+	case callError != nil:
+		c.JSON(http.StatusOK, CallReply{UniqueID: uniqueid, CallError: &CallError{
+			ErrorCode:        callError.ErrorCode,
+			ErrorDescription: callError.ErrorDescription,
+			ErrorDetails:     callError.ErrorDetails,
+		}})
+	default:
+		c.JSON(http.StatusOK, CallReply{UniqueID: uniqueid, Response: res})
+	}
+}
@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+type fakeServer struct {
+	router *gin.Engine
+	calls  []*protocol.Call
+}
+
+func (s *fakeServer) Router() gin.IRouter {
+	return s.router
+}
+
+func (s *fakeServer) HandleActiveCall(ctx context.Context, id string, call *protocol.Call) error {
+	s.calls = append(s.calls, call)
+	return nil
+}
+
+func (s *fakeServer) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	s.calls = append(s.calls, call)
+	switch call.Action {
+	case protocol.ResetName:
+		return &protocol.ResetResponse{Status: "Accepted"}, nil, nil
+	case protocol.UnlockConnectorName:
+		return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: "no lock"}, nil
+	}
+	return nil, nil, fmt.Errorf("%w, id(%s)", ocpp16server.ErrResponseTimeout, id)
+}
+
+func (s *fakeServer) NewRequest(id string, action string, payload []byte) (protocol.Request, error) {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil, fmt.Errorf("%w, action(%s)", ocpp16server.ErrActionNotSupported, action)
+	}
+	req := reflect.New(ocpptrait.RequestType())
+	if err := json.Unmarshal(payload, req.Interface()); err != nil {
+		return nil, err
+	}
+	return req.Elem().Interface().(protocol.Request), nil
+}
+
+func (s *fakeServer) ChargePoints() []ocpp16server.ChargePointState {
+	return []ocpp16server.ChargePointState{{ID: "cs-CP001", Subprotocol: "ocpp1.6"}}
+}
+
+func (s *fakeServer) ChargePoint(id string) (ocpp16server.ChargePointState, bool) {
+	if id != "cs-CP001" {
+		return ocpp16server.ChargePointState{}, false
+	}
+	return s.ChargePoints()[0], true
+}
+
+func do(h http.Handler, method string, path string, body string, token string) (int, string) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	s := &fakeServer{router: gin.New()}
+	NewActiveCallPlugin(s, TokenAuth("secret"))
+	tests := []struct {
+		method, path, body, token string
+		code                      int
+		contains                  string
+	}{
+		{"GET", "/api/v1/chargepoints", "", "", http.StatusUnauthorized, "invalid token"},
+		{"GET", "/api/v1/chargepoints", "", "secret", http.StatusOK, `"id":"cs-CP001"`},
+		{"GET", "/api/v1/chargepoints/cs-CP001", "", "secret", http.StatusOK, `"subprotocol":"ocpp1.6"`},
+		{"GET", "/api/v1/chargepoints/cs-CP002", "", "secret", http.StatusNotFound, "not connected"},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/Reset?uniqueId=42", `{"type":"Soft"}`, "secret", http.StatusAccepted, `{"uniqueId":"42"}`},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/Reset?wait=true", `{"type":"Hard"}`, "secret", http.StatusOK, `"response":{"status":"Accepted"}`},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/UnlockConnector?wait=1", `{"connectorId":1}`, "secret", http.StatusOK, `"callError":{"errorCode":"NotSupported"`},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/ClearCache?wait=true&timeout=1s", ``, "secret", http.StatusGatewayTimeout, "timeout"},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/Jump", `{}`, "secret", http.StatusNotFound, "action not supported"},
+		{"POST", "/api/v1/chargepoints/cs-CP001/actions/Reset", `{"type":`, "secret", http.StatusBadRequest, "error"},
+	}
+	for _, tt := range tests {
+		code, body := do(s.router, tt.method, tt.path, tt.body, tt.token)
+		if code != tt.code || !strings.Contains(body, tt.contains) {
+			t.Errorf("%s %s: got %d %s, want %d %s", tt.method, tt.path, code, body, tt.code, tt.contains)
+		}
+	}
+	if len(s.calls) != 4 {
+		t.Fatalf("%d calls, want 4", len(s.calls))
+	}
+	if call := s.calls[0]; call.UniqueID != "42" || call.Action != protocol.ResetName || call.Request.(protocol.ResetRequest).Type != "Soft" {
+		t.Errorf("unexpected call %+v", call)
+	}
+	if len(s.calls[1].UniqueID) != 36 {
+		t.Errorf("unexpected uniqueid %s", s.calls[1].UniqueID)
+	}
+}
+
+func TestEmptyToken(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	s := &fakeServer{router: gin.New()}
+	NewActiveCallPlugin(s, TokenAuth(""))
+	for _, header := range []string{"", "Bearer "} {
+		req := httptest.NewRequest("GET", "/api/v1/chargepoints", nil)
+		req.Header.Set("Authorization", header)
+		rec := httptest.NewRecorder()
+		s.router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("header(%q): got %d %s, want %d", header, rec.Code, rec.Body.String(), http.StatusUnauthorized)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package rpcx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/rpcxio/rpcx-etcd/serverplugin"
@@ -10,6 +11,7 @@ import (
 	"ocpp16/config"
 	"ocpp16/protocol"
 	ocpp16server "ocpp16/server"
+	"sync"
 	"time"
 )
 
@@ -20,42 +22,70 @@ type ActiveCallServer struct {
 	Reservation             *ReservationServer
 	RemoteTrigger           *RemoteTriggerServer
 	FirmwareManagement      *FirmwareManagementServer
+	Security                *SecurityServer
 }
 
 type ChargingCoreServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 
 type SmartChargingServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 
 type LocalAuthListManagementServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 type ReservationServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 
 type RemoteTriggerServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 
 type FirmwareManagementServer struct {
-	handler ocpp16server.ActiveCallHandler
+	*callHandlers
 }
 
+//SecurityServer serves the messages of the ocpp1.6 security extension
+type SecurityServer struct {
+	*callHandlers
+}
+
+//callHandlers are shared by the services, the sync handler is optional
+type callHandlers struct {
+	handler     ocpp16server.ActiveCallHandler
+	syncHandler ocpp16server.SyncActiveCallHandler
+	sync.RWMutex
+}
+
+var handlers = &callHandlers{}
+
 func NewActiveCallPlugin(handler ocpp16server.ActiveCallHandler) {
+	handlers.Lock()
+	handlers.handler = handler
+	handlers.Unlock()
 	s := &ActiveCallServer{
-		ChargingCore:            &ChargingCoreServer{handler: handler},
-		SmartCharging:           &SmartChargingServer{handler: handler},
-		LocalAuthListManagement: &LocalAuthListManagementServer{handler: handler},
-		Reservation:             &ReservationServer{handler: handler},
-		RemoteTrigger:           &RemoteTriggerServer{handler: handler},
-		FirmwareManagement:      &FirmwareManagementServer{handler: handler},
+		ChargingCore:            &ChargingCoreServer{handlers},
+		SmartCharging:           &SmartChargingServer{handlers},
+		LocalAuthListManagement: &LocalAuthListManagementServer{handlers},
+		Reservation:             &ReservationServer{handlers},
+		RemoteTrigger:           &RemoteTriggerServer{handlers},
+		FirmwareManagement:      &FirmwareManagementServer{handlers},
+		Security:                &SecurityServer{handlers},
 	}
 	go s.run()
 }
+
+//NewSyncActiveCallPlugin lets the services started by NewActiveCallPlugin wait for the reply of the charging point,
+//returned in Reply.Response or Reply.CallError, when the metadata of the call has "sync": "true"
+func NewSyncActiveCallPlugin(handler ocpp16server.SyncActiveCallHandler) {
+	handlers.Lock()
+	handlers.syncHandler = handler
+	handlers.Unlock()
+}
+
 func (s *ActiveCallServer) run() {
 	conf := config.GCONF
 	r := &serverplugin.EtcdV3RegisterPlugin{
@@ -71,17 +101,47 @@ func (s *ActiveCallServer) run() {
 	}
 	rpcxServer := server.NewServer()
 	rpcxServer.Plugins.Add(r)
+	s.register(rpcxServer)
+	rpcxServer.Serve("tcp", conf.RPCAddress)
+}
+
+func (s *ActiveCallServer) register(rpcxServer *server.Server) {
 	rpcxServer.RegisterName("ChargingCoreServer", s.ChargingCore, "")
 	rpcxServer.RegisterName("SmartChargingServer", s.SmartCharging, "")
 	rpcxServer.RegisterName("LocalAuthListManagementServer", s.LocalAuthListManagement, "")
 	rpcxServer.RegisterName("ReservationServer", s.Reservation, "")
 	rpcxServer.RegisterName("RemoteTriggerServer", s.RemoteTrigger, "")
 	rpcxServer.RegisterName("FirmwareManagementServer", s.FirmwareManagement, "")
-	rpcxServer.Serve("tcp", conf.RPCAddress)
+	rpcxServer.RegisterName("SecurityServer", s.Security, "")
 }
 
+//Reply.Response holds the json of the response of the charging point, a client decodes it into the response type of
+//the action it called, e.g. protocol.ResetResponse for ActiveReset
 type Reply struct {
-	Err error
+	Err       error
+	Response  json.RawMessage
+	CallError *protocol.CallError
+}
+
+func (h *callHandlers) activeCall(ctx context.Context, id string, call *protocol.Call, res *Reply) error {
+	h.RLock()
+	handler, syncHandler := h.handler, h.syncHandler
+	h.RUnlock()
+	if m, _ := ctx.Value(share.ReqMetaDataKey).(map[string]string); m["sync"] != "true" {
+		err := handler(ctx, id, call)
+		res.Err = err
+		return err
+	}
+	if syncHandler == nil {
+		res.Err = fmt.Errorf("Active%s error: sync active call handler not registered", call.Action)
+		return res.Err
+	}
+	response, callError, err := syncHandler(ctx, id, call)
+	if err == nil && response != nil {
+		res.Response, err = json.Marshal(response)
+	}
+	res.CallError, res.Err = callError, err
+	return err
 }
 
 //ChargingCore
@@ -98,9 +158,7 @@ func (o *ChargingCoreServer) ActiveChangeConfiguration(ctx context.Context, req
 		Action:        protocol.ChangeConfigurationName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveDataTransfer(ctx context.Context, req *protocol.DataTransferRequest, res *Reply) error {
@@ -117,9 +175,7 @@ func (o *ChargingCoreServer) ActiveDataTransfer(ctx context.Context, req *protoc
 		Action:        protocol.DataTransferName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveRemoteStartTransaction(ctx context.Context, req *protocol.RemoteStartTransactionRequest, res *Reply) error {
@@ -136,9 +192,7 @@ func (o *ChargingCoreServer) ActiveRemoteStartTransaction(ctx context.Context, r
 		Action:        protocol.RemoteStartTransactionName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 
 }
 
@@ -156,9 +210,7 @@ func (o *ChargingCoreServer) ActiveRemoteStopTransaction(ctx context.Context, re
 		Action:        protocol.RemoteStopTransactionName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveUnlockConnector(ctx context.Context, req *protocol.UnlockConnectorRequest, res *Reply) error {
@@ -175,9 +227,7 @@ func (o *ChargingCoreServer) ActiveUnlockConnector(ctx context.Context, req *pro
 		Action:        protocol.UnlockConnectorName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveReset(ctx context.Context, req *protocol.ResetRequest, res *Reply) error {
@@ -193,9 +243,7 @@ func (o *ChargingCoreServer) ActiveReset(ctx context.Context, req *protocol.Rese
 		Action:        protocol.ResetName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 
 }
 
@@ -212,9 +260,7 @@ func (o *ChargingCoreServer) ActiveGetConfiguration(ctx context.Context, req *pr
 		Action:        protocol.GetConfigurationName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveChangeAvailability(ctx context.Context, req *protocol.ChangeAvailabilityRequest, res *Reply) error {
@@ -230,9 +276,7 @@ func (o *ChargingCoreServer) ActiveChangeAvailability(ctx context.Context, req *
 		Action:        protocol.ChangeAvailabilityName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 func (o *ChargingCoreServer) ActiveClearCache(ctx context.Context, req *protocol.ClearCacheRequest, res *Reply) error {
@@ -248,9 +292,7 @@ func (o *ChargingCoreServer) ActiveClearCache(ctx context.Context, req *protocol
 		Action:        protocol.ClearCacheName,
 		Request:       *req,
 	}
-	err := o.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return o.activeCall(ctx, id, &call, res)
 }
 
 //SmartCharging
@@ -267,9 +309,7 @@ func (s *SmartChargingServer) ActiveSetChargingProfile(ctx context.Context, req
 		Action:        protocol.SetChargingProfileName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 func (s *SmartChargingServer) ActiveGetCompositeSchedule(ctx context.Context, req *protocol.GetCompositeScheduleRequest, res *Reply) error {
@@ -285,9 +325,7 @@ func (s *SmartChargingServer) ActiveGetCompositeSchedule(ctx context.Context, re
 		Action:        protocol.GetCompositeScheduleName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 func (s *SmartChargingServer) ActiveClearChargingProfile(ctx context.Context, req *protocol.ClearChargingProfileRequest, res *Reply) error {
@@ -303,9 +341,7 @@ func (s *SmartChargingServer) ActiveClearChargingProfile(ctx context.Context, re
 		Action:        protocol.ClearChargingProfileName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 //Reservation
@@ -322,9 +358,7 @@ func (s *ReservationServer) ActiveCancelReservation(ctx context.Context, req *pr
 		Action:        protocol.CancelReservationName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 func (s *ReservationServer) ActiveReserveNow(ctx context.Context, req *protocol.ReserveNowRequest, res *Reply) error {
@@ -340,9 +374,7 @@ func (s *ReservationServer) ActiveReserveNow(ctx context.Context, req *protocol.
 		Action:        protocol.ReserveNowName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 //LocalAuthListManagement
@@ -360,9 +392,7 @@ func (s *LocalAuthListManagementServer) ActiveGetLocalListVersion(ctx context.Co
 		Action:        protocol.GetLocalListVersionName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 func (s *LocalAuthListManagementServer) ActiveSendLocalList(ctx context.Context, req *protocol.SendLocalListRequest, res *Reply) error {
@@ -378,9 +408,7 @@ func (s *LocalAuthListManagementServer) ActiveSendLocalList(ctx context.Context,
 		Action:        protocol.SendLocalListName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 //RemoteTrigger
@@ -397,9 +425,7 @@ func (s *RemoteTriggerServer) ActiveTriggerMessage(ctx context.Context, req *pro
 		Action:        protocol.TriggerMessageName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 //FirmwareManagement
@@ -416,9 +442,7 @@ func (s *FirmwareManagementServer) ActiveUpdateFirmware(ctx context.Context, req
 		Action:        protocol.UpdateFirmwareName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
 }
 
 func (s *FirmwareManagementServer) ActiveGetDiagnostics(ctx context.Context, req *protocol.GetDiagnosticsRequest, res *Reply) error {
@@ -434,7 +458,118 @@ func (s *FirmwareManagementServer) ActiveGetDiagnostics(ctx context.Context, req
 		Action:        protocol.GetDiagnosticsName,
 		Request:       *req,
 	}
-	err := s.handler(ctx, id, &call)
-	res.Err = err
-	return err
+	return s.activeCall(ctx, id, &call, res)
+}
+
+//Security
+func (s *SecurityServer) ActiveCertificateSigned(ctx context.Context, req *protocol.CertificateSignedRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveCertificateSigned error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.CertificateSignedName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveInstallCertificate(ctx context.Context, req *protocol.InstallCertificateRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveInstallCertificate error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.InstallCertificateName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveDeleteCertificate(ctx context.Context, req *protocol.DeleteCertificateRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveDeleteCertificate error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.DeleteCertificateName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveGetInstalledCertificateIds(ctx context.Context, req *protocol.GetInstalledCertificateIdsRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveGetInstalledCertificateIds error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.GetInstalledCertificateIdsName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveGetLog(ctx context.Context, req *protocol.GetLogRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveGetLog error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.GetLogName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveSignedUpdateFirmware(ctx context.Context, req *protocol.SignedUpdateFirmwareRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveSignedUpdateFirmware error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.SignedUpdateFirmwareName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
+}
+
+func (s *SecurityServer) ActiveExtendedTriggerMessage(ctx context.Context, req *protocol.ExtendedTriggerMessageRequest, res *Reply) error {
+	if req == nil || res == nil {
+		return fmt.Errorf("ActiveExtendedTriggerMessage error: req  or res nil, req(%+v), res(%+v)", req, res)
+	}
+	m := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	var uniqueid, id string
+	id, uniqueid = m["chargingPointIdentify"], m["messageId"]
+	call := protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        protocol.ExtendedTriggerMessageName,
+		Request:       *req,
+	}
+	return s.activeCall(ctx, id, &call, res)
 }
@@ -0,0 +1,56 @@
+package rpcx
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"ocpp16/protocol"
+	"testing"
+
+	"github.com/smallnest/rpcx/client"
+	"github.com/smallnest/rpcx/server"
+	"github.com/smallnest/rpcx/share"
+)
+
+func TestSyncActiveCall(t *testing.T) {
+	NewSyncActiveCallPlugin(func(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+		if id == "CP002" {
+			return nil, &protocol.CallError{MessageTypeID: protocol.CALL_ERROR, UniqueID: call.UniqueID, ErrorCode: "NotSupported", ErrorDescription: "reset"}, nil
+		}
+		return &protocol.ResetResponse{Status: "Accepted"}, nil, nil
+	})
+	s := &ActiveCallServer{ChargingCore: &ChargingCoreServer{handlers}}
+	rpcxServer := server.NewServer()
+	rpcxServer.RegisterName("ChargingCoreServer", s.ChargingCore, "")
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go rpcxServer.ServeListener("tcp", ln)
+	defer rpcxServer.Close()
+
+	d, err := client.NewPeer2PeerDiscovery("tcp@"+ln.Addr().String(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xclient := client.NewXClient("ChargingCoreServer", client.Failfast, client.RandomSelect, d, client.DefaultOption)
+	defer xclient.Close()
+	call := func(id string) *Reply {
+		ctx := context.WithValue(context.Background(), share.ReqMetaDataKey, map[string]string{"chargingPointIdentify": id, "messageId": "1", "sync": "true"})
+		reply := &Reply{}
+		if err := xclient.Call(ctx, "ActiveReset", &protocol.ResetRequest{Type: "Hard"}, reply); err != nil {
+			t.Fatal(err)
+		}
+		return reply
+	}
+
+	reply := call("CP001")
+	var res protocol.ResetResponse
+	if err := json.Unmarshal(reply.Response, &res); err != nil || res.Status != "Accepted" || reply.CallError != nil {
+		t.Fatalf("unexpected reply %+v, %s, %v", reply, reply.Response, err)
+	}
+	reply = call("CP002")
+	if reply.Response != nil || reply.CallError == nil || reply.CallError.ErrorCode != "NotSupported" {
+		t.Fatalf("unexpected reply %+v", reply)
+	}
+}
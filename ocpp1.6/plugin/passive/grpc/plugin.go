@@ -0,0 +1,186 @@
+//Package grpc is a passive plugin calling CentralSystemService of protobuf/ocpp16.proto on a backend: every request
+//of a charging point is an rpc answered with its response, or with a CallError the charging point receives as is.
+//the replies to the active calls go to Reply and the connections and disconnections to ChargingPointOnline and
+//ChargingPointOffline
+package grpc
+
+import (
+	"context"
+	"fmt"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protobuf"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	FallbackCallError = "callerror" //answer with an InternalError CallError
+	FallbackDefault   = "default"   //answer with the default response of the action, a CallError for the actions without one
+	FallbackNone      = "none"      //leave the call unanswered, the charging point times out
+)
+
+type Config struct {
+	Timeout  time.Duration //of every rpc, 10s if 0
+	Fallback string        //the answer when an rpc fails, callerror if empty
+	Defaults map[string]protocol.Response
+}
+
+type GRPCPlugin struct {
+	conf   Config
+	client protobuf.CentralSystemServiceClient
+}
+
+//NewActionPlugin calls the backend on conn, e.g. a *grpc.ClientConn
+func NewActionPlugin(conn grpc.ClientConnInterface, conf Config) *GRPCPlugin {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	if conf.Fallback == "" {
+		conf.Fallback = FallbackCallError
+	}
+	return &GRPCPlugin{conf: conf, client: protobuf.NewCentralSystemServiceClient(conn)}
+}
+
+//result is the <Action>Result of an rpc
+type result interface {
+	proto.Message
+	GetError() *protobuf.CallError
+}
+
+//invoke calls the rpc of the <Action>Call in
+func (p *GRPCPlugin) invoke(ctx context.Context, in proto.Message) (result, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+	defer cancel()
+	switch in := in.(type) {
+	case *protobuf.AuthorizeCall:
+		return p.client.Authorize(ctx, in)
+	case *protobuf.BootNotificationCall:
+		return p.client.BootNotification(ctx, in)
+	case *protobuf.DataTransferCall:
+		return p.client.DataTransfer(ctx, in)
+	case *protobuf.DiagnosticsStatusNotificationCall:
+		return p.client.DiagnosticsStatusNotification(ctx, in)
+	case *protobuf.FirmwareStatusNotificationCall:
+		return p.client.FirmwareStatusNotification(ctx, in)
+	case *protobuf.HeartbeatCall:
+		return p.client.Heartbeat(ctx, in)
+	case *protobuf.MeterValuesCall:
+		return p.client.MeterValues(ctx, in)
+	case *protobuf.StartTransactionCall:
+		return p.client.StartTransaction(ctx, in)
+	case *protobuf.StatusNotificationCall:
+		return p.client.StatusNotification(ctx, in)
+	case *protobuf.StopTransactionCall:
+		return p.client.StopTransaction(ctx, in)
+	case *protobuf.SecurityEventNotificationCall:
+		return p.client.SecurityEventNotification(ctx, in)
+	case *protobuf.SignCertificateCall:
+		return p.client.SignCertificate(ctx, in)
+	case *protobuf.LogStatusNotificationCall:
+		return p.client.LogStatusNotification(ctx, in)
+	case *protobuf.SignedFirmwareStatusNotificationCall:
+		return p.client.SignedFirmwareStatusNotification(ctx, in)
+	}
+	return nil, fmt.Errorf("no rpc for %T", in)
+}
+
+func (p *GRPCPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	if !contains(protobuf.ChargePointActions, action) {
+		return nil, false
+	}
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		in, err := protobuf.NewCall(id, uniqueid, request)
+		if err != nil {
+			return nil, err
+		}
+		out, err := p.invoke(ctx, in)
+		if err != nil {
+			return p.fallback(action, err)
+		}
+		if e := out.GetError(); e != nil {
+			return nil, &ocpp16server.Error{
+				ErrorCode:        protocol.ErrCodeType(e.ErrorCode),
+				ErrorDescription: e.ErrorDescription,
+				ErrorDetails:     protocol.ErrorDetails{},
+			}
+		}
+		response, err := protobuf.GetPayload(out)
+		if err == nil && response == nil {
+			err = fmt.Errorf("%s result without response", action)
+		}
+		if err != nil {
+			return p.fallback(action, err)
+		}
+		return response.(protocol.Response), nil
+	}, true
+}
+
+func (p *GRPCPlugin) defaultResponse(action string) protocol.Response {
+	if res, ok := p.conf.Defaults[action]; ok {
+		return res
+	}
+	res, _ := local.FallbackResponse(action)
+	return res
+}
+
+func (p *GRPCPlugin) fallback(action string, err error) (protocol.Response, error) {
+	switch p.conf.Fallback {
+	case FallbackNone:
+		return nil, err
+	case FallbackDefault:
+		if res := p.defaultResponse(action); res != nil {
+			return res, nil
+		}
+	}
+	return nil, &ocpp16server.Error{
+		ErrorCode:        protocol.CallInternalError,
+		ErrorDescription: fmt.Sprintf("backend unavailable, %v", err),
+		ErrorDetails:     protocol.ErrorDetails{},
+	}
+}
+
+//ResponseHandler sends the replies to the active calls to Reply
+func (p *GRPCPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	if action != protocol.CallErrorName && !contains(protobuf.CentralSystemActions, action) {
+		return nil, false
+	}
+	return func(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+		reply := &protobuf.Reply{Id: id, UniqueId: uniqueid, Action: action}
+		if e, ok := res.(*protocol.CallError); ok {
+			protobuf.SetError(reply, protobuf.NewCallError(e))
+		} else if err := protobuf.SetPayload(reply, res); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+		defer cancel()
+		_, err := p.client.Reply(ctx, reply)
+		return err
+	}, true
+}
+
+func (p *GRPCPlugin) ChargingPointOnline(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.conf.Timeout)
+	defer cancel()
+	_, err := p.client.ChargingPointOnline(ctx, &protobuf.ChargePoint{Id: id})
+	return err
+}
+
+func (p *GRPCPlugin) ChargingPointOffline(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.conf.Timeout)
+	defer cancel()
+	_, err := p.client.ChargingPointOffline(ctx, &protobuf.ChargePoint{Id: id})
+	return err
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
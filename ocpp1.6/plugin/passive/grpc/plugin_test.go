@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"ocpp16/protobuf"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type backend struct {
+	protobuf.UnimplementedCentralSystemServiceServer
+	replies chan *protobuf.Reply
+	online  chan string
+}
+
+func (b *backend) Authorize(ctx context.Context, in *protobuf.AuthorizeCall) (*protobuf.AuthorizeResult, error) {
+	if in.GetRequest().GetIdTag() != "tag" {
+		e := &protobuf.CallError{ErrorCode: string(protocol.SecurityError), ErrorDescription: "unknown tag"}
+		return &protobuf.AuthorizeResult{Result: &protobuf.AuthorizeResult_Error{Error: e}}, nil
+	}
+	res := &protobuf.AuthorizeResponse{IdTagInfo: &protobuf.IdTagInfo{Status: "Accepted"}}
+	return &protobuf.AuthorizeResult{Result: &protobuf.AuthorizeResult_Response{Response: res}}, nil
+}
+
+//Heartbeat answers without response
+func (b *backend) Heartbeat(ctx context.Context, in *protobuf.HeartbeatCall) (*protobuf.HeartbeatResult, error) {
+	return &protobuf.HeartbeatResult{}, nil
+}
+
+func (b *backend) Reply(ctx context.Context, in *protobuf.Reply) (*protobuf.Empty, error) {
+	b.replies <- in
+	return &protobuf.Empty{}, nil
+}
+
+func (b *backend) ChargingPointOnline(ctx context.Context, in *protobuf.ChargePoint) (*protobuf.Empty, error) {
+	b.online <- in.Id
+	return &protobuf.Empty{}, nil
+}
+
+func (b *backend) serve(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := grpc.NewServer()
+	protobuf.RegisterCentralSystemServiceServer(g, b)
+	go g.Serve(l)
+	t.Cleanup(g.Stop)
+	return l.Addr().String()
+}
+
+func dial(t *testing.T, addr string) *grpc.ClientConn {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestPlugin(t *testing.T) {
+	b := &backend{replies: make(chan *protobuf.Reply, 10), online: make(chan string, 10)}
+	p := NewActionPlugin(dial(t, b.serve(t)), Config{Timeout: 5 * time.Second})
+
+	handler, ok := p.RequestHandler(protocol.AuthorizeName)
+	if !ok {
+		t.Fatal("Authorize not supported")
+	}
+	res, err := handler(context.Background(), "CP001", "1", &protocol.AuthorizeRequest{IdTag: "tag"})
+	if auth, ok := res.(*protocol.AuthorizeResponse); err != nil || !ok || auth.IdTagInfo.Status != "Accepted" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	_, err = handler(context.Background(), "CP001", "2", &protocol.AuthorizeRequest{IdTag: "other"})
+	var e *ocpp16server.Error
+	if !errors.As(err, &e) || e.ErrorCode != protocol.SecurityError || e.ErrorDescription != "unknown tag" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	//a result without response is answered by the fallback
+	heartbeat, _ := p.RequestHandler(protocol.HeartbeatName)
+	if _, err = heartbeat(context.Background(), "CP001", "5", &protocol.HeartbeatRequest{}); !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, ok = p.RequestHandler(protocol.ResetName); ok {
+		t.Fatal("Reset handled as a request of the charging points")
+	}
+
+	reply, _ := p.ResponseHandler(protocol.ResetName)
+	if err = reply(context.Background(), "CP001", "3", &protocol.ResetResponse{Status: "Accepted"}); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-b.replies; r.Id != "CP001" || r.UniqueId != "3" || r.Action != protocol.ResetName || r.GetResetResponse().GetStatus() != "Accepted" {
+		t.Fatalf("unexpected reply %s", r)
+	}
+	reply, _ = p.ResponseHandler(protocol.CallErrorName)
+	if err = reply(context.Background(), "CP001", "4", &protocol.CallError{ErrorCode: protocol.NotSupported}); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-b.replies; r.UniqueId != "4" || r.GetError().GetErrorCode() != string(protocol.NotSupported) {
+		t.Fatalf("unexpected reply %s", r)
+	}
+
+	if err = p.ChargingPointOnline("CP001"); err != nil || <-b.online != "CP001" {
+		t.Fatalf("online not sent, %v", err)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	conn := dial(t, addr)
+	request := func(fallback string, action string, req protocol.Request) (protocol.Response, error) {
+		handler, _ := NewActionPlugin(conn, Config{Timeout: time.Second, Fallback: fallback}).RequestHandler(action)
+		return handler(context.Background(), "CP001", "1", req)
+	}
+	if res, err := request(FallbackDefault, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); err != nil || res.(*protocol.HeartbeatResponse).CurrentTime == "" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	var e *ocpp16server.Error
+	if _, err := request(FallbackCallError, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := request(FallbackNone, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); err == nil || errors.As(err, &e) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
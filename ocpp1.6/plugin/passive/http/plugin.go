@@ -0,0 +1,281 @@
+//Package http is a passive plugin that forwards the requests of the charging points to an http backend.
+//
+//every request is posted as {"id": ..., "uniqueId": ..., "action": ..., "payload": {...}}, a 2xx reply is the payload
+//of the response, e.g. {"status":"Accepted","currentTime":...,"interval":300} for BootNotification. a 4xx reply
+//{"errorCode": ..., "errorDescription": ...} answers with that CallError. 5xx replies and transport errors are retried,
+//then the fallback answers
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"ocpp16/billing"
+	"ocpp16/config"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/reservation"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FallbackCallError = "callerror" //answer with an InternalError CallError
+	FallbackDefault   = "default"   //answer with the default response of the action, a CallError for the actions without one
+	FallbackNone      = "none"      //leave the call unanswered, the charging point times out
+
+	SignatureHeader = "X-OCPP-Signature"
+	TimestampHeader = "X-OCPP-Timestamp"
+
+	//the actions of the notices sent by ChargingPointOnline, ChargingPointOffline, ChargeDetailRecord and ReservationEvent
+	OnlineAction      = "ChargingPointOnline"
+	OfflineAction     = "ChargingPointOffline"
+	CDRAction         = "ChargeDetailRecord"
+	ReservationAction = "Reservation"
+)
+
+type Config struct {
+	URL           string            //the url of the actions without their own
+	URLs          map[string]string //action -> url
+	Timeout       time.Duration     //of every attempt, 10s if 0
+	Retries       int               //attempts after the first one
+	RetryInterval time.Duration     //multiplied by the attempt number, 200ms if 0
+	Secret        string            //key of the hmac signature, requests are not signed if empty
+	Fallback      string
+	Defaults      map[string]protocol.Response //overrides the default responses of FallbackDefault
+	Client        *nethttp.Client
+}
+
+//Message is the body posted to the backend
+type Message struct {
+	ID       string      `json:"id"`
+	UniqueID string      `json:"uniqueId"`
+	Action   string      `json:"action"`
+	Payload  interface{} `json:"payload,omitempty"`
+}
+
+type callError struct {
+	ErrorCode        protocol.ErrCodeType `json:"errorCode"`
+	ErrorDescription string               `json:"errorDescription"`
+}
+
+type HTTPPlugin struct {
+	conf   Config
+	client *nethttp.Client
+}
+
+func NewActionPlugin(conf Config) *HTTPPlugin {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	if conf.RetryInterval <= 0 {
+		conf.RetryInterval = 200 * time.Millisecond
+	}
+	if conf.Fallback == "" {
+		conf.Fallback = FallbackCallError
+	}
+	client := conf.Client
+	if client == nil {
+		client = &nethttp.Client{}
+	}
+	return &HTTPPlugin{conf: conf, client: client}
+}
+
+//NewActionPluginFromConfig creates the plugin from the webhook_* items of the configuration file
+func NewActionPluginFromConfig() *HTTPPlugin {
+	conf := config.GCONF
+	urls := make(map[string]string)
+	for _, item := range conf.WebhookActionURLs {
+		if action, url, ok := strings.Cut(item, "="); ok {
+			urls[strings.TrimSpace(action)] = strings.TrimSpace(url)
+		}
+	}
+	return NewActionPlugin(Config{
+		URL:      conf.WebhookURL,
+		URLs:     urls,
+		Timeout:  time.Duration(conf.WebhookTimeout) * time.Second,
+		Retries:  conf.WebhookRetries,
+		Secret:   conf.WebhookSecret,
+		Fallback: conf.WebhookFallback,
+	})
+}
+
+func (p *HTTPPlugin) url(action string) string {
+	if url, ok := p.conf.URLs[action]; ok {
+		return url
+	}
+	return p.conf.URL
+}
+
+//RequestHandler supports the actions of ocpp1.6 that have a url
+func (p *HTTPPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok || p.url(action) == "" {
+		return nil, false
+	}
+	resType := ocpptrait.ResponseType()
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		res := reflect.New(resType).Interface().(protocol.Response)
+		err := p.post(ctx, p.url(action), &Message{ID: id, UniqueID: uniqueid, Action: action, Payload: request}, res)
+		if err == nil {
+			return res, nil
+		}
+		var e *ocpp16server.Error
+		if errors.As(err, &e) {
+			return nil, e
+		}
+		return p.fallback(action, err)
+	}, true
+}
+
+//ResponseHandler accepts the replies to the active calls without forwarding them, the active plugins return them to the caller
+func (p *HTTPPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	return func(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+		return nil
+	}, true
+}
+
+func (p *HTTPPlugin) fallback(action string, err error) (protocol.Response, error) {
+	switch p.conf.Fallback {
+	case FallbackNone:
+		return nil, err
+	case FallbackDefault:
+		if res, ok := p.conf.Defaults[action]; ok {
+			return res, nil
+		}
+		if res, ok := local.FallbackResponse(action); ok {
+			return res, nil
+		}
+	}
+	return nil, &ocpp16server.Error{
+		ErrorCode:        protocol.CallInternalError,
+		ErrorDescription: fmt.Sprintf("backend unavailable, %v", err),
+		ErrorDetails:     protocol.ErrorDetails{},
+	}
+}
+
+//ChargingPointOnline posts an OnlineAction message without payload
+func (p *HTTPPlugin) ChargingPointOnline(id string) error {
+	if url := p.url(OnlineAction); url != "" {
+		return p.post(context.Background(), url, &Message{ID: id, Action: OnlineAction}, nil)
+	}
+	return nil
+}
+
+//ChargingPointOffline posts an OfflineAction message without payload
+func (p *HTTPPlugin) ChargingPointOffline(id string) error {
+	if url := p.url(OfflineAction); url != "" {
+		return p.post(context.Background(), url, &Message{ID: id, Action: OfflineAction}, nil)
+	}
+	return nil
+}
+
+//ChargeDetailRecord posts a CDRAction message with the record of a closed transaction as payload
+func (p *HTTPPlugin) ChargeDetailRecord(cdr *billing.ChargeDetailRecord) error {
+	if url := p.url(CDRAction); url != "" {
+		return p.post(context.Background(), url, &Message{ID: cdr.ChargePointID, Action: CDRAction, Payload: cdr}, nil)
+	}
+	return nil
+}
+
+//permanentError is not retried
+type permanentError struct {
+	error
+}
+
+//post sends msg to url and unmarshals the reply into res, the 4xx replies with an errorCode are returned as *ocpp16server.Error
+func (p *HTTPPlugin) post(ctx context.Context, url string, msg *Message, res interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	for attempt := 0; ; attempt++ {
+		err = p.attempt(ctx, url, body, res)
+		var permanent permanentError
+		if err == nil || errors.As(err, &permanent) || attempt >= p.conf.Retries {
+			if errors.As(err, &permanent) {
+				err = permanent.error
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(p.conf.RetryInterval * time.Duration(attempt+1)):
+		}
+	}
+}
+
+func (p *HTTPPlugin) attempt(ctx context.Context, url string, body []byte, res interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+	defer cancel()
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.conf.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(p.conf.Secret, timestamp, body))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("status(%d), body(%s)", resp.StatusCode, data)
+	case resp.StatusCode >= 400:
+		var e callError
+		if json.Unmarshal(data, &e) == nil && e.ErrorCode != "" {
+			return permanentError{&ocpp16server.Error{ErrorCode: e.ErrorCode, ErrorDescription: e.ErrorDescription, ErrorDetails: protocol.ErrorDetails{}}}
+		}
+		return permanentError{fmt.Errorf("status(%d), body(%s)", resp.StatusCode, data)}
+	case resp.StatusCode >= 300:
+		return permanentError{fmt.Errorf("status(%d), body(%s)", resp.StatusCode, data)}
+	}
+	if res == nil {
+		return nil
+	}
+	//an empty reply would answer with the zero response, the fallback answers instead
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return permanentError{fmt.Errorf("status(%d), empty reply", resp.StatusCode)}
+	}
+	if err = json.Unmarshal(data, res); err != nil {
+		return permanentError{fmt.Errorf("invalid reply(%s), %v", data, err)}
+	}
+	return nil
+}
+
+//Sign returns the signature of a request, "sha256=" followed by the hex hmac-sha256 of timestamp + "." + body
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+//ReservationEvent posts a ReservationAction message with the event of a reservation as payload
+func (p *HTTPPlugin) ReservationEvent(e *reservation.Event) error {
+	if url := p.url(ReservationAction); url != "" {
+		return p.post(context.Background(), url, &Message{ID: e.Reservation.ChargePoint, Action: ReservationAction, Payload: e}, nil)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestHandler(t *testing.T) {
+	var failures int32 = 2
+	backend := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != Sign("secret", r.Header.Get(TimestampHeader), body) {
+			w.WriteHeader(nethttp.StatusUnauthorized)
+			return
+		}
+		var msg struct {
+			Message
+			Payload json.RawMessage `json:"payload"`
+		}
+		json.Unmarshal(body, &msg)
+		switch msg.Action {
+		case protocol.BootNotificationName:
+			if msg.ID != "CP001" || msg.UniqueID != "1" || string(msg.Payload) != `{"chargePointVendor":"v","chargePointModel":"m"}` {
+				t.Errorf("unexpected message %s", body)
+			}
+			w.Write([]byte(`{"status":"Accepted","currentTime":"2021-01-01T00:00:00Z","interval":300}`))
+		case protocol.AuthorizeName:
+			w.WriteHeader(nethttp.StatusForbidden)
+			w.Write([]byte(`{"errorCode":"SecurityError","errorDescription":"unknown tag"}`))
+		case protocol.HeartbeatName:
+			if atomic.AddInt32(&failures, -1) >= 0 {
+				w.WriteHeader(nethttp.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"currentTime":"2021-01-01T00:00:00Z"}`))
+		}
+	}))
+	defer backend.Close()
+	p := NewActionPlugin(Config{URL: backend.URL, Secret: "secret", Retries: 2, RetryInterval: time.Millisecond})
+
+	handler, ok := p.RequestHandler(protocol.BootNotificationName)
+	if !ok {
+		t.Fatal("BootNotification not supported")
+	}
+	res, err := handler(context.Background(), "CP001", "1", &protocol.BootNotificationRequest{ChargePointVendor: "v", ChargePointModel: "m"})
+	if boot, ok := res.(*protocol.BootNotificationResponse); err != nil || !ok || boot.Status != "Accepted" || *boot.Interval != 300 {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+
+	handler, _ = p.RequestHandler(protocol.AuthorizeName)
+	_, err = handler(context.Background(), "CP001", "2", &protocol.AuthorizeRequest{IdTag: "tag"})
+	var e *ocpp16server.Error
+	if !errors.As(err, &e) || e.ErrorCode != protocol.SecurityError || e.ErrorDescription != "unknown tag" {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	handler, _ = p.RequestHandler(protocol.HeartbeatName)
+	res, err = handler(context.Background(), "CP001", "3", &protocol.HeartbeatRequest{})
+	if heartbeat, ok := res.(*protocol.HeartbeatResponse); err != nil || !ok || heartbeat.CurrentTime != "2021-01-01T00:00:00Z" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+
+	if _, ok = NewActionPlugin(Config{URLs: map[string]string{protocol.AuthorizeName: backend.URL}}).RequestHandler(protocol.HeartbeatName); ok {
+		t.Fatal("Heartbeat supported without url")
+	}
+}
+
+func TestFallback(t *testing.T) {
+	backend := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {}))
+	url := backend.URL
+	backend.Close()
+	request := func(fallback string, action string, req protocol.Request) (protocol.Response, error) {
+		handler, _ := NewActionPlugin(Config{URL: url, Fallback: fallback, RetryInterval: time.Millisecond}).RequestHandler(action)
+		return handler(context.Background(), "CP001", "1", req)
+	}
+	if res, err := request(FallbackDefault, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); err != nil || res.(*protocol.HeartbeatResponse).CurrentTime == "" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	var e *ocpp16server.Error
+	if _, err := request(FallbackDefault, protocol.StartTransactionName, &protocol.StartTransactionRequest{}); !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := request(FallbackCallError, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := request(FallbackNone, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); err == nil || errors.As(err, &e) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	//a 2xx reply without body is answered by the fallback instead of an empty response
+	empty := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {}))
+	defer empty.Close()
+	url = empty.URL
+	if res, err := request(FallbackDefault, protocol.HeartbeatName, &protocol.HeartbeatRequest{}); err != nil || res.(*protocol.HeartbeatResponse).CurrentTime == "" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	if _, err := request(FallbackCallError, protocol.AuthorizeName, &protocol.AuthorizeRequest{IdTag: "tag"}); !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
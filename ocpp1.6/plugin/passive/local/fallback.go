@@ -0,0 +1,37 @@
+package local
+
+import (
+	"ocpp16/protocol"
+	"time"
+)
+
+var fallbackResponses = map[string]func() protocol.Response{
+	protocol.HeartbeatName: func() protocol.Response {
+		return &protocol.HeartbeatResponse{CurrentTime: time.Now().UTC().Format(protocol.ISO8601)}
+	},
+	protocol.BootNotificationName: func() protocol.Response {
+		interval := 60
+		return &protocol.BootNotificationResponse{Status: "Pending", CurrentTime: time.Now().UTC().Format(protocol.ISO8601), Interval: &interval}
+	},
+	protocol.StatusNotificationName:               func() protocol.Response { return &protocol.StatusNotificationResponse{} },
+	protocol.MeterValuesName:                      func() protocol.Response { return &protocol.MeterValuesResponse{} },
+	protocol.StopTransactionName:                  func() protocol.Response { return &protocol.StopTransactionResponse{} },
+	protocol.DataTransferName:                     func() protocol.Response { return &protocol.DataTransferResponse{Status: "Rejected"} },
+	protocol.FirmwareStatusNotificationName:       func() protocol.Response { return &protocol.FirmwareStatusNotificationResponse{} },
+	protocol.DiagnosticsStatusNotificationName:    func() protocol.Response { return &protocol.DiagnosticsStatusNotificationResponse{} },
+	protocol.SecurityEventNotificationName:        func() protocol.Response { return &protocol.SecurityEventNotificationResponse{} },
+	protocol.SignCertificateName:                  func() protocol.Response { return &protocol.SignCertificateResponse{Status: "Rejected"} },
+	protocol.LogStatusNotificationName:            func() protocol.Response { return &protocol.LogStatusNotificationResponse{} },
+	protocol.SignedFirmwareStatusNotificationName: func() protocol.Response { return &protocol.SignedFirmwareStatusNotificationResponse{} },
+}
+
+//FallbackResponse is the response of an ocpp1.6 request that does not grant anything, for the plugins forwarding
+//the requests to answer when their backend is unavailable: BootNotification is Pending, DataTransfer and
+//SignCertificate are Rejected. Authorize and StartTransaction have none
+func FallbackResponse(action string) (protocol.Response, bool) {
+	res, ok := fallbackResponses[action]
+	if !ok {
+		return nil, false
+	}
+	return res(), true
+}
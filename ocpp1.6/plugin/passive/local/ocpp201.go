@@ -0,0 +1,137 @@
+package local
+
+import (
+	"context"
+	"ocpp16/ocpp201"
+	"ocpp16/protocol"
+	"time"
+)
+
+//OCPP201ActionPlugin is a sample passive plugin of the connections that negotiated ocpp2.0.1: it accepts every charging
+//point and knows no id token. the center system registers it with ocpp201_passive_plugin local
+type OCPP201ActionPlugin struct {
+	requestHandlerMap  map[string]protocol.RequestHandler
+	responseHandlerMap map[string]protocol.ResponseHandler
+}
+
+func NewOCPP201ActionPlugin() *OCPP201ActionPlugin {
+	plugin := &OCPP201ActionPlugin{}
+	plugin.registerRequestHandler()
+	plugin.registerResponseHandler()
+	return plugin
+}
+
+func (l *OCPP201ActionPlugin) BootNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	interval := 300
+	return &ocpp201.BootNotificationResponse{
+		CurrentTime: time.Now().Format(ocpp201.ISO8601),
+		Interval:    &interval,
+		Status:      ocpp201.RegistrationStatusAccepted,
+	}, nil
+}
+
+func (l *OCPP201ActionPlugin) Heartbeat(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.HeartbeatResponse{
+		CurrentTime: time.Now().Format(ocpp201.ISO8601),
+	}, nil
+}
+
+func (l *OCPP201ActionPlugin) StatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.StatusNotificationResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) Authorize(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.AuthorizeResponse{
+		IdTokenInfo: ocpp201.IdTokenInfoType{Status: ocpp201.AuthorizationUnknown},
+	}, nil
+}
+
+func (l *OCPP201ActionPlugin) TransactionEvent(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.TransactionEventResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) MeterValues(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.MeterValuesResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) NotifyReport(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.NotifyReportResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) NotifyEvent(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.NotifyEventResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) FirmwareStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.FirmwareStatusNotificationResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) LogStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.LogStatusNotificationResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) SecurityEventNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.SecurityEventNotificationResponse{}, nil
+}
+
+func (l *OCPP201ActionPlugin) DataTransfer(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return &ocpp201.DataTransferResponse{Status: ocpp201.DataTransferUnknownVendorId}, nil
+}
+
+func (l *OCPP201ActionPlugin) registerRequestHandler() {
+	l.requestHandlerMap = map[string]protocol.RequestHandler{
+		ocpp201.BootNotificationName:           protocol.RequestHandler(l.BootNotification),
+		ocpp201.HeartbeatName:                  protocol.RequestHandler(l.Heartbeat),
+		ocpp201.StatusNotificationName:         protocol.RequestHandler(l.StatusNotification),
+		ocpp201.AuthorizeName:                  protocol.RequestHandler(l.Authorize),
+		ocpp201.TransactionEventName:           protocol.RequestHandler(l.TransactionEvent),
+		ocpp201.MeterValuesName:                protocol.RequestHandler(l.MeterValues),
+		ocpp201.NotifyReportName:               protocol.RequestHandler(l.NotifyReport),
+		ocpp201.NotifyEventName:                protocol.RequestHandler(l.NotifyEvent),
+		ocpp201.FirmwareStatusNotificationName: protocol.RequestHandler(l.FirmwareStatusNotification),
+		ocpp201.LogStatusNotificationName:      protocol.RequestHandler(l.LogStatusNotification),
+		ocpp201.SecurityEventNotificationName:  protocol.RequestHandler(l.SecurityEventNotification),
+		ocpp201.DataTransferName:               protocol.RequestHandler(l.DataTransfer),
+	}
+}
+
+//RequestHandler represent device active request Center
+func (l *OCPP201ActionPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := l.requestHandlerMap[action]
+	return handler, ok
+}
+
+//Response is the handler of every reply to the csms, the local plugin only discards them
+func (l *OCPP201ActionPlugin) Response(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+//ResponseHandler represent The device reply to the center request
+func (l *OCPP201ActionPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	handler, ok := l.responseHandlerMap[action]
+	return handler, ok
+}
+
+func (l *OCPP201ActionPlugin) registerResponseHandler() {
+	l.responseHandlerMap = make(map[string]protocol.ResponseHandler)
+	for _, action := range []string{
+		ocpp201.GetVariablesName,
+		ocpp201.SetVariablesName,
+		ocpp201.GetBaseReportName,
+		ocpp201.RequestStartTransactionName,
+		ocpp201.RequestStopTransactionName,
+		ocpp201.ResetName,
+		ocpp201.ChangeAvailabilityName,
+		ocpp201.UnlockConnectorName,
+		ocpp201.TriggerMessageName,
+		ocpp201.ClearCacheName,
+		ocpp201.GetTransactionStatusName,
+		ocpp201.SetChargingProfileName,
+		ocpp201.UpdateFirmwareName,
+		ocpp201.GetLogName,
+		ocpp201.DataTransferName,
+		protocol.CallErrorName,
+	} {
+		l.responseHandlerMap[action] = protocol.ResponseHandler(l.Response)
+	}
+}
@@ -72,17 +72,40 @@ func (l *LocalActionPlugin) DiagnosticsStatusNotification(ctx context.Context, i
 	return nil, nil
 }
 
+// security - request
+func (l *LocalActionPlugin) SecurityEventNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return nil, nil
+}
+
+func (l *LocalActionPlugin) SignCertificate(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return nil, nil
+}
+
+func (l *LocalActionPlugin) LogStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return nil, nil
+}
+
+func (l *LocalActionPlugin) SignedFirmwareStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	return nil, nil
+}
+
 func (l *LocalActionPlugin) registerRequestHandler() {
 	l.requestHandlerMap = map[string]protocol.RequestHandler{
-		protocol.HeartbeatName:                  protocol.RequestHandler(l.Heartbeat),
-		protocol.BootNotificationName:           protocol.RequestHandler(l.BootNotification),
-		protocol.StatusNotificationName:         protocol.RequestHandler(l.StatusNotification),
-		protocol.MeterValuesName:                protocol.RequestHandler(l.MeterValues),
-		protocol.AuthorizeName:                  protocol.RequestHandler(l.Authorize),
-		protocol.StartTransactionName:           protocol.RequestHandler(l.StartTransaction),
-		protocol.StopTransactionName:            protocol.RequestHandler(l.StopTransaction),
-		protocol.FirmwareStatusNotificationName: protocol.RequestHandler(l.FirmwareStatusNotification),
-		protocol.DataTransferName:               protocol.RequestHandler(l.DataTransfer),
+		protocol.HeartbeatName:                     protocol.RequestHandler(l.Heartbeat),
+		protocol.BootNotificationName:              protocol.RequestHandler(l.BootNotification),
+		protocol.StatusNotificationName:            protocol.RequestHandler(l.StatusNotification),
+		protocol.MeterValuesName:                   protocol.RequestHandler(l.MeterValues),
+		protocol.AuthorizeName:                     protocol.RequestHandler(l.Authorize),
+		protocol.StartTransactionName:              protocol.RequestHandler(l.StartTransaction),
+		protocol.StopTransactionName:               protocol.RequestHandler(l.StopTransaction),
+		protocol.FirmwareStatusNotificationName:    protocol.RequestHandler(l.FirmwareStatusNotification),
+		protocol.DiagnosticsStatusNotificationName: protocol.RequestHandler(l.DiagnosticsStatusNotification),
+		protocol.DataTransferName:                  protocol.RequestHandler(l.DataTransfer),
+
+		protocol.SecurityEventNotificationName:        protocol.RequestHandler(l.SecurityEventNotification),
+		protocol.SignCertificateName:                  protocol.RequestHandler(l.SignCertificate),
+		protocol.LogStatusNotificationName:            protocol.RequestHandler(l.LogStatusNotification),
+		protocol.SignedFirmwareStatusNotificationName: protocol.RequestHandler(l.SignedFirmwareStatusNotification),
 	}
 }
 
@@ -176,6 +199,35 @@ func (l *LocalActionPlugin) GetLocalListVersionResponse(ctx context.Context, id
 	return nil
 }
 
+//Security -response
+func (l *LocalActionPlugin) CertificateSignedResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) InstallCertificateResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) DeleteCertificateResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) GetInstalledCertificateIdsResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) GetLogResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) SignedUpdateFirmwareResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
+func (l *LocalActionPlugin) ExtendedTriggerMessageResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	return nil
+}
+
 //ResponseHandler represent The device reply to the center request
 func (l *LocalActionPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
 	handler, ok := l.responseHandlerMap[action]
@@ -202,5 +254,13 @@ func (l *LocalActionPlugin) registerResponseHandler() {
 		protocol.GetDiagnosticsName:         protocol.ResponseHandler(l.GetDiagnosticsResponse),
 		protocol.UpdateFirmwareName:         protocol.ResponseHandler(l.UpdateFirmWareResponse),
 		protocol.CallErrorName:              protocol.ResponseHandler(l.CallError),
+
+		protocol.CertificateSignedName:          protocol.ResponseHandler(l.CertificateSignedResponse),
+		protocol.InstallCertificateName:         protocol.ResponseHandler(l.InstallCertificateResponse),
+		protocol.DeleteCertificateName:          protocol.ResponseHandler(l.DeleteCertificateResponse),
+		protocol.GetInstalledCertificateIdsName: protocol.ResponseHandler(l.GetInstalledCertificateIdsResponse),
+		protocol.GetLogName:                     protocol.ResponseHandler(l.GetLogResponse),
+		protocol.SignedUpdateFirmwareName:       protocol.ResponseHandler(l.SignedUpdateFirmwareResponse),
+		protocol.ExtendedTriggerMessageName:     protocol.ResponseHandler(l.ExtendedTriggerMessageResponse),
 	}
 }
@@ -0,0 +1,266 @@
+//Package mqtt is a passive plugin that publishes the messages of the charging points to an mqtt broker.
+//
+//every request is published to {prefix}/{id}/in/{action} as {"type":"Call","id":...,"uniqueId":...,"action":...,"payload":{...}}.
+//the actions that wait for a decision, e.g. Authorize, carry "replyTo":"{prefix}/{id}/reply/{uniqueId}" and are answered
+//by the first message published there, the payload of the response or {"errorCode":...,"errorDescription":...} for a
+//CallError. the other actions are answered at once with the response that does not grant anything, see
+//local.FallbackResponse. the replies to the active calls are published to the same topics with the type CallResult,
+//or CallError to {prefix}/{id}/in/CallError
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ocpp16/billing"
+	mqttclient "ocpp16/mqtt"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/reservation"
+	ocpp16server "ocpp16/server"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	FallbackCallError = "callerror" //answer with an InternalError CallError
+	FallbackDefault   = "default"   //answer with the default response of the action, a CallError for the actions without one
+	FallbackNone      = "none"      //leave the call unanswered, the charging point times out
+
+	//the types of the published messages
+	TypeCall       = "Call"
+	TypeCallResult = "CallResult"
+	TypeCallError  = "CallError"
+	TypeEvent      = "Event"
+
+	//the actions of the events published by ChargingPointOnline, ChargingPointOffline, ChargeDetailRecord and ReservationEvent
+	OnlineAction      = "ChargingPointOnline"
+	OfflineAction     = "ChargingPointOffline"
+	CDRAction         = "ChargeDetailRecord"
+	ReservationAction = "Reservation"
+)
+
+//DefaultWait are the actions waiting for a reply when Config.Wait is nil
+var DefaultWait = []string{
+	protocol.AuthorizeName,
+	protocol.BootNotificationName,
+	protocol.StartTransactionName,
+	protocol.DataTransferName,
+	protocol.SignCertificateName,
+}
+
+type Config struct {
+	Prefix   string        //of the topics, "ocpp" if empty
+	Wait     []string      //the actions waiting for a reply, the actions without a default response always wait
+	Timeout  time.Duration //of the wait for a reply, 10s if 0
+	Fallback string        //the answer when the publish fails or no reply comes in time
+	Defaults map[string]protocol.Response
+}
+
+//Message is the payload of the published messages
+type Message struct {
+	Type     string      `json:"type"`
+	ID       string      `json:"id"`
+	UniqueID string      `json:"uniqueId,omitempty"`
+	Action   string      `json:"action"`
+	Payload  interface{} `json:"payload,omitempty"`
+	ReplyTo  string      `json:"replyTo,omitempty"`
+}
+
+type callError struct {
+	ErrorCode        protocol.ErrCodeType  `json:"errorCode"`
+	ErrorDescription string                `json:"errorDescription"`
+	ErrorDetails     protocol.ErrorDetails `json:"errorDetails"`
+}
+
+type MQTTPlugin struct {
+	conf    Config
+	client  *mqttclient.Client
+	wait    map[string]bool
+	mu      sync.Mutex
+	replies map[string]chan []byte //reply topic -> waiting request
+}
+
+//NewActionPlugin subscribes client to the reply topics
+func NewActionPlugin(client *mqttclient.Client, conf Config) (*MQTTPlugin, error) {
+	if conf.Prefix == "" {
+		conf.Prefix = "ocpp"
+	}
+	if !mqttclient.ValidTopic(conf.Prefix) {
+		return nil, fmt.Errorf("invalid topic prefix(%s)", conf.Prefix)
+	}
+	if conf.Wait == nil {
+		conf.Wait = DefaultWait
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	if conf.Fallback == "" {
+		conf.Fallback = FallbackCallError
+	}
+	p := &MQTTPlugin{conf: conf, client: client, wait: make(map[string]bool), replies: make(map[string]chan []byte)}
+	for _, action := range conf.Wait {
+		p.wait[action] = true
+	}
+	if err := client.Subscribe(conf.Prefix+"/+/reply/+", p.reply); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+//Topic returns {prefix}/{levels...}, the levels must not contain "/" or a wildcard
+func Topic(prefix string, levels ...string) (string, error) {
+	for _, level := range levels {
+		if level == "" || strings.ContainsAny(level, "/+#") {
+			return "", fmt.Errorf("invalid topic level(%s)", level)
+		}
+	}
+	return prefix + "/" + strings.Join(levels, "/"), nil
+}
+
+func (p *MQTTPlugin) reply(topic string, payload []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if c, ok := p.replies[topic]; ok {
+		c <- payload
+		delete(p.replies, topic)
+	}
+}
+
+func (p *MQTTPlugin) publish(id string, msg *Message) error {
+	topic, err := Topic(p.conf.Prefix, id, "in", msg.Action)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return p.client.Publish(topic, data)
+}
+
+//RequestHandler supports the actions of ocpp1.6
+func (p *MQTTPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil, false
+	}
+	resType := ocpptrait.ResponseType()
+	return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+		msg := &Message{Type: TypeCall, ID: id, UniqueID: uniqueid, Action: action, Payload: request}
+		if res := p.defaultResponse(action); res != nil && !p.wait[action] {
+			if err := p.publish(id, msg); err != nil {
+				return p.fallback(action, err)
+			}
+			return res, nil
+		}
+		res := reflect.New(resType).Interface().(protocol.Response)
+		err := p.request(ctx, msg, res)
+		if err == nil {
+			return res, nil
+		}
+		var e *ocpp16server.Error
+		if errors.As(err, &e) {
+			return nil, e
+		}
+		return p.fallback(action, err)
+	}, true
+}
+
+//request publishes msg and unmarshals the reply into res, a reply with an errorCode is returned as *ocpp16server.Error
+func (p *MQTTPlugin) request(ctx context.Context, msg *Message, res protocol.Response) error {
+	replyTo, err := Topic(p.conf.Prefix, msg.ID, "reply", msg.UniqueID)
+	if err != nil {
+		return err
+	}
+	msg.ReplyTo = replyTo
+	c := make(chan []byte, 1)
+	p.mu.Lock()
+	p.replies[replyTo] = c
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.replies, replyTo)
+		p.mu.Unlock()
+	}()
+	if err = p.publish(msg.ID, msg); err != nil {
+		return err
+	}
+	timer := time.NewTimer(p.conf.Timeout)
+	defer timer.Stop()
+	var data []byte
+	select {
+	case data = <-c:
+	case <-timer.C:
+		return fmt.Errorf("no reply on %s in %s", replyTo, p.conf.Timeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	var e callError
+	if json.Unmarshal(data, &e) == nil && e.ErrorCode != "" {
+		return &ocpp16server.Error{ErrorCode: e.ErrorCode, ErrorDescription: e.ErrorDescription, ErrorDetails: protocol.ErrorDetails{}}
+	}
+	if err = json.Unmarshal(data, res); err != nil {
+		return fmt.Errorf("invalid reply(%s), %v", data, err)
+	}
+	return nil
+}
+
+func (p *MQTTPlugin) defaultResponse(action string) protocol.Response {
+	if res, ok := p.conf.Defaults[action]; ok {
+		return res
+	}
+	res, _ := local.FallbackResponse(action)
+	return res
+}
+
+func (p *MQTTPlugin) fallback(action string, err error) (protocol.Response, error) {
+	switch p.conf.Fallback {
+	case FallbackNone:
+		return nil, err
+	case FallbackDefault:
+		if res := p.defaultResponse(action); res != nil {
+			return res, nil
+		}
+	}
+	return nil, &ocpp16server.Error{
+		ErrorCode:        protocol.CallInternalError,
+		ErrorDescription: fmt.Sprintf("backend unavailable, %v", err),
+		ErrorDetails:     protocol.ErrorDetails{},
+	}
+}
+
+//ResponseHandler publishes the replies to the active calls
+func (p *MQTTPlugin) ResponseHandler(action string) (protocol.ResponseHandler, bool) {
+	return func(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+		msg := &Message{Type: TypeCallResult, ID: id, UniqueID: uniqueid, Action: action, Payload: res}
+		if e, ok := res.(*protocol.CallError); ok {
+			msg.Type = TypeCallError
+			msg.Payload = &callError{ErrorCode: e.ErrorCode, ErrorDescription: e.ErrorDescription, ErrorDetails: e.ErrorDetails}
+		}
+		return p.publish(id, msg)
+	}, true
+}
+
+//ChargingPointOnline publishes an OnlineAction event without payload
+func (p *MQTTPlugin) ChargingPointOnline(id string) error {
+	return p.publish(id, &Message{Type: TypeEvent, ID: id, Action: OnlineAction})
+}
+
+//ChargingPointOffline publishes an OfflineAction event without payload
+func (p *MQTTPlugin) ChargingPointOffline(id string) error {
+	return p.publish(id, &Message{Type: TypeEvent, ID: id, Action: OfflineAction})
+}
+
+//ChargeDetailRecord publishes the record of a closed transaction as an event
+func (p *MQTTPlugin) ChargeDetailRecord(cdr *billing.ChargeDetailRecord) error {
+	return p.publish(cdr.ChargePointID, &Message{Type: TypeEvent, ID: cdr.ChargePointID, Action: CDRAction, Payload: cdr})
+}
+
+//ReservationEvent publishes the event of a reservation
+func (p *MQTTPlugin) ReservationEvent(e *reservation.Event) error {
+	return p.publish(e.Reservation.ChargePoint, &Message{Type: TypeEvent, ID: e.Reservation.ChargePoint, Action: ReservationAction, Payload: e})
+}
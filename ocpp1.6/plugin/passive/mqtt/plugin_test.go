@@ -0,0 +1,139 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"ocpp16/billing"
+	mqttclient "ocpp16/mqtt"
+	"ocpp16/mqtt/mqtttest"
+	"ocpp16/protocol"
+	"ocpp16/reservation"
+	ocpp16server "ocpp16/server"
+	"testing"
+	"time"
+)
+
+func connect(t *testing.T, clientID string) (*mqttclient.Client, *mqtttest.Broker) {
+	broker := mqtttest.NewBroker()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go broker.Serve(l)
+	t.Cleanup(func() { broker.Close() })
+	client, err := mqttclient.Connect(mqttclient.Options{Addr: l.Addr().String(), ClientID: clientID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client, broker
+}
+
+func TestRequestHandler(t *testing.T) {
+	client, broker := connect(t, "central")
+	p, err := NewActionPlugin(client, Config{Timeout: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//the backend authorizes the tag "good" and publishes every message it sees
+	messages := make(chan *Message, 10)
+	if err = client.Subscribe("ocpp/+/in/+", func(topic string, payload []byte) {
+		var msg Message
+		json.Unmarshal(payload, &msg)
+		messages <- &msg
+		if msg.Action != protocol.AuthorizeName || msg.ReplyTo == "" {
+			return
+		}
+		idTag := msg.Payload.(map[string]interface{})["idTag"]
+		switch idTag {
+		case "good":
+			broker.Publish(msg.ReplyTo, []byte(`{"idTagInfo":{"status":"Accepted"}}`))
+		case "bad":
+			broker.Publish(msg.ReplyTo, []byte(`{"errorCode":"SecurityError","errorDescription":"blocked"}`))
+		}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	handler, _ := p.RequestHandler(protocol.AuthorizeName)
+	res, err := handler(context.Background(), "cs-CP001", "1", &protocol.AuthorizeRequest{IdTag: "good"})
+	if auth, ok := res.(*protocol.AuthorizeResponse); err != nil || !ok || auth.IdTagInfo.Status != "Accepted" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	if msg := <-messages; msg.Type != TypeCall || msg.ID != "cs-CP001" || msg.UniqueID != "1" || msg.ReplyTo != "ocpp/cs-CP001/reply/1" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	_, err = handler(context.Background(), "cs-CP001", "2", &protocol.AuthorizeRequest{IdTag: "bad"})
+	var e *ocpp16server.Error
+	if !errors.As(err, &e) || e.ErrorCode != protocol.SecurityError || e.ErrorDescription != "blocked" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	<-messages
+	//no reply in time
+	_, err = handler(context.Background(), "cs-CP001", "3", &protocol.AuthorizeRequest{IdTag: "unknown"})
+	if !errors.As(err, &e) || e.ErrorCode != protocol.CallInternalError {
+		t.Fatalf("unexpected error %v", err)
+	}
+	<-messages
+
+	//the actions with a default response do not wait
+	handler, _ = p.RequestHandler(protocol.MeterValuesName)
+	res, err = handler(context.Background(), "cs-CP001", "4", &protocol.MeterValuesRequest{})
+	if _, ok := res.(*protocol.MeterValuesResponse); err != nil || !ok {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	if msg := <-messages; msg.Action != protocol.MeterValuesName || msg.ReplyTo != "" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+
+	responseHandler, _ := p.ResponseHandler(protocol.ResetName)
+	if err = responseHandler(context.Background(), "cs-CP001", "5", &protocol.ResetResponse{Status: "Accepted"}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := <-messages; msg.Type != TypeCallResult || msg.Action != protocol.ResetName || msg.UniqueID != "5" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	responseHandler, _ = p.ResponseHandler(protocol.CallErrorName)
+	responseHandler(context.Background(), "cs-CP001", "6", &protocol.CallError{ErrorCode: protocol.NotSupported})
+	if msg := <-messages; msg.Type != TypeCallError || msg.Payload.(map[string]interface{})["errorCode"] != "NotSupported" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+
+	p.ChargingPointOffline("cs-CP001")
+	if msg := <-messages; msg.Type != TypeEvent || msg.Action != OfflineAction {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	p.ChargeDetailRecord(&billing.ChargeDetailRecord{TransactionID: 7, ChargePointID: "cs-CP001", Total: 1.5})
+	if msg := <-messages; msg.Type != TypeEvent || msg.Action != CDRAction || msg.Payload.(map[string]interface{})["total"] != 1.5 {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	p.ReservationEvent(&reservation.Event{Type: reservation.Expired, Reservation: reservation.Reservation{ID: 3, ChargePoint: "cs-CP001"}})
+	if msg := <-messages; msg.Type != TypeEvent || msg.Action != ReservationAction || msg.Payload.(map[string]interface{})["type"] != "Expired" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	client, _ := connect(t, "central")
+	p, err := NewActionPlugin(client, Config{Timeout: 10 * time.Millisecond, Fallback: FallbackDefault})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, _ := p.RequestHandler(protocol.BootNotificationName)
+	res, err := handler(context.Background(), "cs-CP001", "1", &protocol.BootNotificationRequest{})
+	if boot, ok := res.(*protocol.BootNotificationResponse); err != nil || !ok || boot.Status != "Pending" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+	handler, _ = p.RequestHandler(protocol.StartTransactionName)
+	var e *ocpp16server.Error
+	if _, err = handler(context.Background(), "cs-CP001", "2", &protocol.StartTransactionRequest{}); !errors.As(err, &e) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	client.Close()
+	handler, _ = p.RequestHandler(protocol.HeartbeatName)
+	if res, err = handler(context.Background(), "cs-CP001", "3", &protocol.HeartbeatRequest{}); err != nil || res.(*protocol.HeartbeatResponse).CurrentTime == "" {
+		t.Fatalf("unexpected response %+v, err %v", res, err)
+	}
+}
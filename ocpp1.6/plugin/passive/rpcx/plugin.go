@@ -19,6 +19,7 @@ type RPCXPlugin struct {
 	Reservation             client.XClient
 	RemoteTrigger           client.XClient
 	LocalAuthListManagement client.XClient
+	Security                client.XClient
 	requestHandlerMap       map[string]protocol.RequestHandler
 	responseHandlerMap      map[string]protocol.ResponseHandler
 }
@@ -48,6 +49,8 @@ func (c *RPCXPlugin) init() {
 	c.RemoteTrigger = client.NewXClient("RemoteTriggerClient", client.Failtry, client.RandomSelect, d5, client.DefaultOption)
 	d6, _ := clientPlugin.NewEtcdV3Discovery(c.basePath, "LocalAuthListManagementClient", c.etcdAddr, false, nil)
 	c.LocalAuthListManagement = client.NewXClient("LocalAuthListManagementClient", client.Failtry, client.RandomSelect, d6, client.DefaultOption)
+	d7, _ := clientPlugin.NewEtcdV3Discovery(c.basePath, "SecurityClient", c.etcdAddr, false, nil)
+	c.Security = client.NewXClient("SecurityClient", client.Failtry, client.RandomSelect, d7, client.DefaultOption)
 }
 func (c *RPCXPlugin) Heartbeat(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
 	reply := &protocol.HeartbeatResponse{
@@ -173,6 +176,47 @@ func (c *RPCXPlugin) DiagnosticsStatusNotification(ctx context.Context, id strin
 	return reply, err
 }
 
+// security - request
+func (c *RPCXPlugin) SecurityEventNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	reply := &protocol.SecurityEventNotificationResponse{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "SecurityEventNotification", request.(*protocol.SecurityEventNotificationRequest), reply)
+	return reply, err
+}
+
+func (c *RPCXPlugin) SignCertificate(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	reply := &protocol.SignCertificateResponse{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "SignCertificate", request.(*protocol.SignCertificateRequest), reply)
+	return reply, err
+}
+
+func (c *RPCXPlugin) LogStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	reply := &protocol.LogStatusNotificationResponse{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "LogStatusNotification", request.(*protocol.LogStatusNotificationRequest), reply)
+	return reply, err
+}
+
+func (c *RPCXPlugin) SignedFirmwareStatusNotification(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	reply := &protocol.SignedFirmwareStatusNotificationResponse{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "SignedFirmwareStatusNotification", request.(*protocol.SignedFirmwareStatusNotificationRequest), reply)
+	return reply, err
+}
+
 func (c *RPCXPlugin) registerRequestHandler() {
 	c.requestHandlerMap = map[string]protocol.RequestHandler{
 		protocol.BootNotificationName:           protocol.RequestHandler(c.BootNotification),
@@ -184,6 +228,11 @@ func (c *RPCXPlugin) registerRequestHandler() {
 		protocol.FirmwareStatusNotificationName: protocol.RequestHandler(c.FirmwareStatusNotification),
 		protocol.HeartbeatName:                  protocol.RequestHandler(c.Heartbeat),
 		protocol.DataTransferName:               protocol.RequestHandler(c.DataTransfer),
+
+		protocol.SecurityEventNotificationName:        protocol.RequestHandler(c.SecurityEventNotification),
+		protocol.SignCertificateName:                  protocol.RequestHandler(c.SignCertificate),
+		protocol.LogStatusNotificationName:            protocol.RequestHandler(c.LogStatusNotification),
+		protocol.SignedFirmwareStatusNotificationName: protocol.RequestHandler(c.SignedFirmwareStatusNotification),
 	}
 }
 
@@ -380,6 +429,77 @@ func (c *RPCXPlugin) GetLocalListVersionResponse(ctx context.Context, id string,
 	return err
 }
 
+//Security -response
+func (c *RPCXPlugin) CertificateSignedResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "CertificateSignedResponse", res.(*protocol.CertificateSignedResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) InstallCertificateResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "InstallCertificateResponse", res.(*protocol.InstallCertificateResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) DeleteCertificateResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "DeleteCertificateResponse", res.(*protocol.DeleteCertificateResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) GetInstalledCertificateIdsResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "GetInstalledCertificateIdsResponse", res.(*protocol.GetInstalledCertificateIdsResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) GetLogResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "GetLogResponse", res.(*protocol.GetLogResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) SignedUpdateFirmwareResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "SignedUpdateFirmwareResponse", res.(*protocol.SignedUpdateFirmwareResponse), reply)
+	return err
+}
+
+func (c *RPCXPlugin) ExtendedTriggerMessageResponse(ctx context.Context, id string, uniqueid string, res protocol.Response) error {
+	reply := &Reply{}
+	ctx = context.WithValue(ctx, share.ReqMetaDataKey, map[string]string{
+		"chargingPointIdentify": id,
+		"messageId":             uniqueid,
+	})
+	err := c.Security.Call(ctx, "ExtendedTriggerMessageResponse", res.(*protocol.ExtendedTriggerMessageResponse), reply)
+	return err
+}
+
 func (c *RPCXPlugin) registerResponseHandler() {
 	c.responseHandlerMap = map[string]protocol.ResponseHandler{
 		protocol.ChangeConfigurationName:    protocol.ResponseHandler(c.ChangeConfigurationResponse),
@@ -400,6 +520,14 @@ func (c *RPCXPlugin) registerResponseHandler() {
 		protocol.GetDiagnosticsName:         protocol.ResponseHandler(c.GetDiagnosticsResponse),
 		protocol.UpdateFirmwareName:         protocol.ResponseHandler(c.UpdateFirmWareResponse),
 		protocol.CallErrorName:              protocol.ResponseHandler(c.CallError),
+
+		protocol.CertificateSignedName:          protocol.ResponseHandler(c.CertificateSignedResponse),
+		protocol.InstallCertificateName:         protocol.ResponseHandler(c.InstallCertificateResponse),
+		protocol.DeleteCertificateName:          protocol.ResponseHandler(c.DeleteCertificateResponse),
+		protocol.GetInstalledCertificateIdsName: protocol.ResponseHandler(c.GetInstalledCertificateIdsResponse),
+		protocol.GetLogName:                     protocol.ResponseHandler(c.GetLogResponse),
+		protocol.SignedUpdateFirmwareName:       protocol.ResponseHandler(c.SignedUpdateFirmwareResponse),
+		protocol.ExtendedTriggerMessageName:     protocol.ResponseHandler(c.ExtendedTriggerMessageResponse),
 	}
 }
 
@@ -0,0 +1,17505 @@
+//ocpp16.proto is the api of the grpc plugins, the go code is generated from it by protoc-gen-go and protoc-gen-go-grpc,
+//see the go:generate line of protobuf.go.
+//
+//The requests and responses mirror the types of the ocpp16/protocol package: the fields keep the json names of the
+//ocpp payloads, the enumerations are strings and the date-times are RFC 3339 strings. The optional fields are the ones
+//a payload may omit while 0, false or "" are valid values. The number of a field never changes, the number of a
+//removed field is reserved. TestPayloads fails on a field of the protocol package that is missing here
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        (unknown)
+// source: ocpp16.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{0}
+}
+
+type ChargePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChargePoint) Reset() {
+	*x = ChargePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargePoint) ProtoMessage() {}
+
+func (x *ChargePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargePoint.ProtoReflect.Descriptor instead.
+func (*ChargePoint) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChargePoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CallError is the CallError of a charging point, or the error a backend answers a request with
+type CallError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode        string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorDescription string `protobuf:"bytes,2,opt,name=error_description,json=errorDescription,proto3" json:"error_description,omitempty"`
+}
+
+func (x *CallError) Reset() {
+	*x = CallError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallError) ProtoMessage() {}
+
+func (x *CallError) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallError.ProtoReflect.Descriptor instead.
+func (*CallError) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CallError) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *CallError) GetErrorDescription() string {
+	if x != nil {
+		return x.ErrorDescription
+	}
+	return ""
+}
+
+// Subscription is the charging points of a Command, no id is every charging point
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Subscription) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type Command struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Command:
+	//	*Command_Subscribe
+	//	*Command_Unsubscribe
+	//	*Command_Call
+	Command isCommand_Command `protobuf_oneof:"command"`
+}
+
+func (x *Command) Reset() {
+	*x = Command{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command) ProtoMessage() {}
+
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *Command) GetCommand() isCommand_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *Command) GetSubscribe() *Subscription {
+	if x, ok := x.GetCommand().(*Command_Subscribe); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *Command) GetUnsubscribe() *Subscription {
+	if x, ok := x.GetCommand().(*Command_Unsubscribe); ok {
+		return x.Unsubscribe
+	}
+	return nil
+}
+
+func (x *Command) GetCall() *Call {
+	if x, ok := x.GetCommand().(*Command_Call); ok {
+		return x.Call
+	}
+	return nil
+}
+
+type isCommand_Command interface {
+	isCommand_Command()
+}
+
+type Command_Subscribe struct {
+	Subscribe *Subscription `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+}
+
+type Command_Unsubscribe struct {
+	Unsubscribe *Subscription `protobuf:"bytes,2,opt,name=unsubscribe,proto3,oneof"`
+}
+
+type Command_Call struct {
+	Call *Call `protobuf:"bytes,3,opt,name=call,proto3,oneof"`
+}
+
+func (*Command_Subscribe) isCommand_Command() {}
+
+func (*Command_Unsubscribe) isCommand_Command() {}
+
+func (*Command_Call) isCommand_Command() {}
+
+// Call is a call of a Command, a random uuid is used without unique_id
+type Call struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	// Types that are assignable to Request:
+	//	*Call_CancelReservationRequest
+	//	*Call_ChangeAvailabilityRequest
+	//	*Call_ChangeConfigurationRequest
+	//	*Call_ClearCacheRequest
+	//	*Call_ClearChargingProfileRequest
+	//	*Call_DataTransferRequest
+	//	*Call_GetCompositeScheduleRequest
+	//	*Call_GetConfigurationRequest
+	//	*Call_GetDiagnosticsRequest
+	//	*Call_GetLocalListVersionRequest
+	//	*Call_RemoteStartTransactionRequest
+	//	*Call_RemoteStopTransactionRequest
+	//	*Call_ReserveNowRequest
+	//	*Call_ResetRequest
+	//	*Call_SendLocalListRequest
+	//	*Call_SetChargingProfileRequest
+	//	*Call_TriggerMessageRequest
+	//	*Call_UnlockConnectorRequest
+	//	*Call_UpdateFirmwareRequest
+	//	*Call_CertificateSignedRequest
+	//	*Call_DeleteCertificateRequest
+	//	*Call_ExtendedTriggerMessageRequest
+	//	*Call_GetInstalledCertificateIdsRequest
+	//	*Call_GetLogRequest
+	//	*Call_InstallCertificateRequest
+	//	*Call_SignedUpdateFirmwareRequest
+	Request isCall_Request `protobuf_oneof:"request"`
+}
+
+func (x *Call) Reset() {
+	*x = Call{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Call) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Call) ProtoMessage() {}
+
+func (x *Call) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Call.ProtoReflect.Descriptor instead.
+func (*Call) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Call) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Call) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (m *Call) GetRequest() isCall_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *Call) GetCancelReservationRequest() *CancelReservationRequest {
+	if x, ok := x.GetRequest().(*Call_CancelReservationRequest); ok {
+		return x.CancelReservationRequest
+	}
+	return nil
+}
+
+func (x *Call) GetChangeAvailabilityRequest() *ChangeAvailabilityRequest {
+	if x, ok := x.GetRequest().(*Call_ChangeAvailabilityRequest); ok {
+		return x.ChangeAvailabilityRequest
+	}
+	return nil
+}
+
+func (x *Call) GetChangeConfigurationRequest() *ChangeConfigurationRequest {
+	if x, ok := x.GetRequest().(*Call_ChangeConfigurationRequest); ok {
+		return x.ChangeConfigurationRequest
+	}
+	return nil
+}
+
+func (x *Call) GetClearCacheRequest() *ClearCacheRequest {
+	if x, ok := x.GetRequest().(*Call_ClearCacheRequest); ok {
+		return x.ClearCacheRequest
+	}
+	return nil
+}
+
+func (x *Call) GetClearChargingProfileRequest() *ClearChargingProfileRequest {
+	if x, ok := x.GetRequest().(*Call_ClearChargingProfileRequest); ok {
+		return x.ClearChargingProfileRequest
+	}
+	return nil
+}
+
+func (x *Call) GetDataTransferRequest() *DataTransferRequest {
+	if x, ok := x.GetRequest().(*Call_DataTransferRequest); ok {
+		return x.DataTransferRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetCompositeScheduleRequest() *GetCompositeScheduleRequest {
+	if x, ok := x.GetRequest().(*Call_GetCompositeScheduleRequest); ok {
+		return x.GetCompositeScheduleRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetConfigurationRequest() *GetConfigurationRequest {
+	if x, ok := x.GetRequest().(*Call_GetConfigurationRequest); ok {
+		return x.GetConfigurationRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetDiagnosticsRequest() *GetDiagnosticsRequest {
+	if x, ok := x.GetRequest().(*Call_GetDiagnosticsRequest); ok {
+		return x.GetDiagnosticsRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetLocalListVersionRequest() *GetLocalListVersionRequest {
+	if x, ok := x.GetRequest().(*Call_GetLocalListVersionRequest); ok {
+		return x.GetLocalListVersionRequest
+	}
+	return nil
+}
+
+func (x *Call) GetRemoteStartTransactionRequest() *RemoteStartTransactionRequest {
+	if x, ok := x.GetRequest().(*Call_RemoteStartTransactionRequest); ok {
+		return x.RemoteStartTransactionRequest
+	}
+	return nil
+}
+
+func (x *Call) GetRemoteStopTransactionRequest() *RemoteStopTransactionRequest {
+	if x, ok := x.GetRequest().(*Call_RemoteStopTransactionRequest); ok {
+		return x.RemoteStopTransactionRequest
+	}
+	return nil
+}
+
+func (x *Call) GetReserveNowRequest() *ReserveNowRequest {
+	if x, ok := x.GetRequest().(*Call_ReserveNowRequest); ok {
+		return x.ReserveNowRequest
+	}
+	return nil
+}
+
+func (x *Call) GetResetRequest() *ResetRequest {
+	if x, ok := x.GetRequest().(*Call_ResetRequest); ok {
+		return x.ResetRequest
+	}
+	return nil
+}
+
+func (x *Call) GetSendLocalListRequest() *SendLocalListRequest {
+	if x, ok := x.GetRequest().(*Call_SendLocalListRequest); ok {
+		return x.SendLocalListRequest
+	}
+	return nil
+}
+
+func (x *Call) GetSetChargingProfileRequest() *SetChargingProfileRequest {
+	if x, ok := x.GetRequest().(*Call_SetChargingProfileRequest); ok {
+		return x.SetChargingProfileRequest
+	}
+	return nil
+}
+
+func (x *Call) GetTriggerMessageRequest() *TriggerMessageRequest {
+	if x, ok := x.GetRequest().(*Call_TriggerMessageRequest); ok {
+		return x.TriggerMessageRequest
+	}
+	return nil
+}
+
+func (x *Call) GetUnlockConnectorRequest() *UnlockConnectorRequest {
+	if x, ok := x.GetRequest().(*Call_UnlockConnectorRequest); ok {
+		return x.UnlockConnectorRequest
+	}
+	return nil
+}
+
+func (x *Call) GetUpdateFirmwareRequest() *UpdateFirmwareRequest {
+	if x, ok := x.GetRequest().(*Call_UpdateFirmwareRequest); ok {
+		return x.UpdateFirmwareRequest
+	}
+	return nil
+}
+
+func (x *Call) GetCertificateSignedRequest() *CertificateSignedRequest {
+	if x, ok := x.GetRequest().(*Call_CertificateSignedRequest); ok {
+		return x.CertificateSignedRequest
+	}
+	return nil
+}
+
+func (x *Call) GetDeleteCertificateRequest() *DeleteCertificateRequest {
+	if x, ok := x.GetRequest().(*Call_DeleteCertificateRequest); ok {
+		return x.DeleteCertificateRequest
+	}
+	return nil
+}
+
+func (x *Call) GetExtendedTriggerMessageRequest() *ExtendedTriggerMessageRequest {
+	if x, ok := x.GetRequest().(*Call_ExtendedTriggerMessageRequest); ok {
+		return x.ExtendedTriggerMessageRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetInstalledCertificateIdsRequest() *GetInstalledCertificateIdsRequest {
+	if x, ok := x.GetRequest().(*Call_GetInstalledCertificateIdsRequest); ok {
+		return x.GetInstalledCertificateIdsRequest
+	}
+	return nil
+}
+
+func (x *Call) GetGetLogRequest() *GetLogRequest {
+	if x, ok := x.GetRequest().(*Call_GetLogRequest); ok {
+		return x.GetLogRequest
+	}
+	return nil
+}
+
+func (x *Call) GetInstallCertificateRequest() *InstallCertificateRequest {
+	if x, ok := x.GetRequest().(*Call_InstallCertificateRequest); ok {
+		return x.InstallCertificateRequest
+	}
+	return nil
+}
+
+func (x *Call) GetSignedUpdateFirmwareRequest() *SignedUpdateFirmwareRequest {
+	if x, ok := x.GetRequest().(*Call_SignedUpdateFirmwareRequest); ok {
+		return x.SignedUpdateFirmwareRequest
+	}
+	return nil
+}
+
+type isCall_Request interface {
+	isCall_Request()
+}
+
+type Call_CancelReservationRequest struct {
+	CancelReservationRequest *CancelReservationRequest `protobuf:"bytes,16,opt,name=cancel_reservation_request,json=cancelReservationRequest,proto3,oneof"`
+}
+
+type Call_ChangeAvailabilityRequest struct {
+	ChangeAvailabilityRequest *ChangeAvailabilityRequest `protobuf:"bytes,17,opt,name=change_availability_request,json=changeAvailabilityRequest,proto3,oneof"`
+}
+
+type Call_ChangeConfigurationRequest struct {
+	ChangeConfigurationRequest *ChangeConfigurationRequest `protobuf:"bytes,18,opt,name=change_configuration_request,json=changeConfigurationRequest,proto3,oneof"`
+}
+
+type Call_ClearCacheRequest struct {
+	ClearCacheRequest *ClearCacheRequest `protobuf:"bytes,19,opt,name=clear_cache_request,json=clearCacheRequest,proto3,oneof"`
+}
+
+type Call_ClearChargingProfileRequest struct {
+	ClearChargingProfileRequest *ClearChargingProfileRequest `protobuf:"bytes,20,opt,name=clear_charging_profile_request,json=clearChargingProfileRequest,proto3,oneof"`
+}
+
+type Call_DataTransferRequest struct {
+	DataTransferRequest *DataTransferRequest `protobuf:"bytes,21,opt,name=data_transfer_request,json=dataTransferRequest,proto3,oneof"`
+}
+
+type Call_GetCompositeScheduleRequest struct {
+	GetCompositeScheduleRequest *GetCompositeScheduleRequest `protobuf:"bytes,22,opt,name=get_composite_schedule_request,json=getCompositeScheduleRequest,proto3,oneof"`
+}
+
+type Call_GetConfigurationRequest struct {
+	GetConfigurationRequest *GetConfigurationRequest `protobuf:"bytes,23,opt,name=get_configuration_request,json=getConfigurationRequest,proto3,oneof"`
+}
+
+type Call_GetDiagnosticsRequest struct {
+	GetDiagnosticsRequest *GetDiagnosticsRequest `protobuf:"bytes,24,opt,name=get_diagnostics_request,json=getDiagnosticsRequest,proto3,oneof"`
+}
+
+type Call_GetLocalListVersionRequest struct {
+	GetLocalListVersionRequest *GetLocalListVersionRequest `protobuf:"bytes,25,opt,name=get_local_list_version_request,json=getLocalListVersionRequest,proto3,oneof"`
+}
+
+type Call_RemoteStartTransactionRequest struct {
+	RemoteStartTransactionRequest *RemoteStartTransactionRequest `protobuf:"bytes,26,opt,name=remote_start_transaction_request,json=remoteStartTransactionRequest,proto3,oneof"`
+}
+
+type Call_RemoteStopTransactionRequest struct {
+	RemoteStopTransactionRequest *RemoteStopTransactionRequest `protobuf:"bytes,27,opt,name=remote_stop_transaction_request,json=remoteStopTransactionRequest,proto3,oneof"`
+}
+
+type Call_ReserveNowRequest struct {
+	ReserveNowRequest *ReserveNowRequest `protobuf:"bytes,28,opt,name=reserve_now_request,json=reserveNowRequest,proto3,oneof"`
+}
+
+type Call_ResetRequest struct {
+	ResetRequest *ResetRequest `protobuf:"bytes,29,opt,name=reset_request,json=resetRequest,proto3,oneof"`
+}
+
+type Call_SendLocalListRequest struct {
+	SendLocalListRequest *SendLocalListRequest `protobuf:"bytes,30,opt,name=send_local_list_request,json=sendLocalListRequest,proto3,oneof"`
+}
+
+type Call_SetChargingProfileRequest struct {
+	SetChargingProfileRequest *SetChargingProfileRequest `protobuf:"bytes,31,opt,name=set_charging_profile_request,json=setChargingProfileRequest,proto3,oneof"`
+}
+
+type Call_TriggerMessageRequest struct {
+	TriggerMessageRequest *TriggerMessageRequest `protobuf:"bytes,32,opt,name=trigger_message_request,json=triggerMessageRequest,proto3,oneof"`
+}
+
+type Call_UnlockConnectorRequest struct {
+	UnlockConnectorRequest *UnlockConnectorRequest `protobuf:"bytes,33,opt,name=unlock_connector_request,json=unlockConnectorRequest,proto3,oneof"`
+}
+
+type Call_UpdateFirmwareRequest struct {
+	UpdateFirmwareRequest *UpdateFirmwareRequest `protobuf:"bytes,34,opt,name=update_firmware_request,json=updateFirmwareRequest,proto3,oneof"`
+}
+
+type Call_CertificateSignedRequest struct {
+	CertificateSignedRequest *CertificateSignedRequest `protobuf:"bytes,35,opt,name=certificate_signed_request,json=certificateSignedRequest,proto3,oneof"`
+}
+
+type Call_DeleteCertificateRequest struct {
+	DeleteCertificateRequest *DeleteCertificateRequest `protobuf:"bytes,36,opt,name=delete_certificate_request,json=deleteCertificateRequest,proto3,oneof"`
+}
+
+type Call_ExtendedTriggerMessageRequest struct {
+	ExtendedTriggerMessageRequest *ExtendedTriggerMessageRequest `protobuf:"bytes,37,opt,name=extended_trigger_message_request,json=extendedTriggerMessageRequest,proto3,oneof"`
+}
+
+type Call_GetInstalledCertificateIdsRequest struct {
+	GetInstalledCertificateIdsRequest *GetInstalledCertificateIdsRequest `protobuf:"bytes,38,opt,name=get_installed_certificate_ids_request,json=getInstalledCertificateIdsRequest,proto3,oneof"`
+}
+
+type Call_GetLogRequest struct {
+	GetLogRequest *GetLogRequest `protobuf:"bytes,39,opt,name=get_log_request,json=getLogRequest,proto3,oneof"`
+}
+
+type Call_InstallCertificateRequest struct {
+	InstallCertificateRequest *InstallCertificateRequest `protobuf:"bytes,40,opt,name=install_certificate_request,json=installCertificateRequest,proto3,oneof"`
+}
+
+type Call_SignedUpdateFirmwareRequest struct {
+	SignedUpdateFirmwareRequest *SignedUpdateFirmwareRequest `protobuf:"bytes,41,opt,name=signed_update_firmware_request,json=signedUpdateFirmwareRequest,proto3,oneof"`
+}
+
+func (*Call_CancelReservationRequest) isCall_Request() {}
+
+func (*Call_ChangeAvailabilityRequest) isCall_Request() {}
+
+func (*Call_ChangeConfigurationRequest) isCall_Request() {}
+
+func (*Call_ClearCacheRequest) isCall_Request() {}
+
+func (*Call_ClearChargingProfileRequest) isCall_Request() {}
+
+func (*Call_DataTransferRequest) isCall_Request() {}
+
+func (*Call_GetCompositeScheduleRequest) isCall_Request() {}
+
+func (*Call_GetConfigurationRequest) isCall_Request() {}
+
+func (*Call_GetDiagnosticsRequest) isCall_Request() {}
+
+func (*Call_GetLocalListVersionRequest) isCall_Request() {}
+
+func (*Call_RemoteStartTransactionRequest) isCall_Request() {}
+
+func (*Call_RemoteStopTransactionRequest) isCall_Request() {}
+
+func (*Call_ReserveNowRequest) isCall_Request() {}
+
+func (*Call_ResetRequest) isCall_Request() {}
+
+func (*Call_SendLocalListRequest) isCall_Request() {}
+
+func (*Call_SetChargingProfileRequest) isCall_Request() {}
+
+func (*Call_TriggerMessageRequest) isCall_Request() {}
+
+func (*Call_UnlockConnectorRequest) isCall_Request() {}
+
+func (*Call_UpdateFirmwareRequest) isCall_Request() {}
+
+func (*Call_CertificateSignedRequest) isCall_Request() {}
+
+func (*Call_DeleteCertificateRequest) isCall_Request() {}
+
+func (*Call_ExtendedTriggerMessageRequest) isCall_Request() {}
+
+func (*Call_GetInstalledCertificateIdsRequest) isCall_Request() {}
+
+func (*Call_GetLogRequest) isCall_Request() {}
+
+func (*Call_InstallCertificateRequest) isCall_Request() {}
+
+func (*Call_SignedUpdateFirmwareRequest) isCall_Request() {}
+
+type Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Types that are assignable to Result:
+	//	*Reply_Error
+	//	*Reply_CancelReservationResponse
+	//	*Reply_ChangeAvailabilityResponse
+	//	*Reply_ChangeConfigurationResponse
+	//	*Reply_ClearCacheResponse
+	//	*Reply_ClearChargingProfileResponse
+	//	*Reply_DataTransferResponse
+	//	*Reply_GetCompositeScheduleResponse
+	//	*Reply_GetConfigurationResponse
+	//	*Reply_GetDiagnosticsResponse
+	//	*Reply_GetLocalListVersionResponse
+	//	*Reply_RemoteStartTransactionResponse
+	//	*Reply_RemoteStopTransactionResponse
+	//	*Reply_ReserveNowResponse
+	//	*Reply_ResetResponse
+	//	*Reply_SendLocalListResponse
+	//	*Reply_SetChargingProfileResponse
+	//	*Reply_TriggerMessageResponse
+	//	*Reply_UnlockConnectorResponse
+	//	*Reply_UpdateFirmwareResponse
+	//	*Reply_CertificateSignedResponse
+	//	*Reply_DeleteCertificateResponse
+	//	*Reply_ExtendedTriggerMessageResponse
+	//	*Reply_GetInstalledCertificateIdsResponse
+	//	*Reply_GetLogResponse
+	//	*Reply_InstallCertificateResponse
+	//	*Reply_SignedUpdateFirmwareResponse
+	Result isReply_Result `protobuf_oneof:"result"`
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Reply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reply) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *Reply) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (m *Reply) GetResult() isReply_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *Reply) GetError() *CallError {
+	if x, ok := x.GetResult().(*Reply_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Reply) GetCancelReservationResponse() *CancelReservationResponse {
+	if x, ok := x.GetResult().(*Reply_CancelReservationResponse); ok {
+		return x.CancelReservationResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetChangeAvailabilityResponse() *ChangeAvailabilityResponse {
+	if x, ok := x.GetResult().(*Reply_ChangeAvailabilityResponse); ok {
+		return x.ChangeAvailabilityResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetChangeConfigurationResponse() *ChangeConfigurationResponse {
+	if x, ok := x.GetResult().(*Reply_ChangeConfigurationResponse); ok {
+		return x.ChangeConfigurationResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetClearCacheResponse() *ClearCacheResponse {
+	if x, ok := x.GetResult().(*Reply_ClearCacheResponse); ok {
+		return x.ClearCacheResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetClearChargingProfileResponse() *ClearChargingProfileResponse {
+	if x, ok := x.GetResult().(*Reply_ClearChargingProfileResponse); ok {
+		return x.ClearChargingProfileResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetDataTransferResponse() *DataTransferResponse {
+	if x, ok := x.GetResult().(*Reply_DataTransferResponse); ok {
+		return x.DataTransferResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetCompositeScheduleResponse() *GetCompositeScheduleResponse {
+	if x, ok := x.GetResult().(*Reply_GetCompositeScheduleResponse); ok {
+		return x.GetCompositeScheduleResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetConfigurationResponse() *GetConfigurationResponse {
+	if x, ok := x.GetResult().(*Reply_GetConfigurationResponse); ok {
+		return x.GetConfigurationResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetDiagnosticsResponse() *GetDiagnosticsResponse {
+	if x, ok := x.GetResult().(*Reply_GetDiagnosticsResponse); ok {
+		return x.GetDiagnosticsResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetLocalListVersionResponse() *GetLocalListVersionResponse {
+	if x, ok := x.GetResult().(*Reply_GetLocalListVersionResponse); ok {
+		return x.GetLocalListVersionResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetRemoteStartTransactionResponse() *RemoteStartTransactionResponse {
+	if x, ok := x.GetResult().(*Reply_RemoteStartTransactionResponse); ok {
+		return x.RemoteStartTransactionResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetRemoteStopTransactionResponse() *RemoteStopTransactionResponse {
+	if x, ok := x.GetResult().(*Reply_RemoteStopTransactionResponse); ok {
+		return x.RemoteStopTransactionResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetReserveNowResponse() *ReserveNowResponse {
+	if x, ok := x.GetResult().(*Reply_ReserveNowResponse); ok {
+		return x.ReserveNowResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetResetResponse() *ResetResponse {
+	if x, ok := x.GetResult().(*Reply_ResetResponse); ok {
+		return x.ResetResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetSendLocalListResponse() *SendLocalListResponse {
+	if x, ok := x.GetResult().(*Reply_SendLocalListResponse); ok {
+		return x.SendLocalListResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetSetChargingProfileResponse() *SetChargingProfileResponse {
+	if x, ok := x.GetResult().(*Reply_SetChargingProfileResponse); ok {
+		return x.SetChargingProfileResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetTriggerMessageResponse() *TriggerMessageResponse {
+	if x, ok := x.GetResult().(*Reply_TriggerMessageResponse); ok {
+		return x.TriggerMessageResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetUnlockConnectorResponse() *UnlockConnectorResponse {
+	if x, ok := x.GetResult().(*Reply_UnlockConnectorResponse); ok {
+		return x.UnlockConnectorResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetUpdateFirmwareResponse() *UpdateFirmwareResponse {
+	if x, ok := x.GetResult().(*Reply_UpdateFirmwareResponse); ok {
+		return x.UpdateFirmwareResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetCertificateSignedResponse() *CertificateSignedResponse {
+	if x, ok := x.GetResult().(*Reply_CertificateSignedResponse); ok {
+		return x.CertificateSignedResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetDeleteCertificateResponse() *DeleteCertificateResponse {
+	if x, ok := x.GetResult().(*Reply_DeleteCertificateResponse); ok {
+		return x.DeleteCertificateResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetExtendedTriggerMessageResponse() *ExtendedTriggerMessageResponse {
+	if x, ok := x.GetResult().(*Reply_ExtendedTriggerMessageResponse); ok {
+		return x.ExtendedTriggerMessageResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetInstalledCertificateIdsResponse() *GetInstalledCertificateIdsResponse {
+	if x, ok := x.GetResult().(*Reply_GetInstalledCertificateIdsResponse); ok {
+		return x.GetInstalledCertificateIdsResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetGetLogResponse() *GetLogResponse {
+	if x, ok := x.GetResult().(*Reply_GetLogResponse); ok {
+		return x.GetLogResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetInstallCertificateResponse() *InstallCertificateResponse {
+	if x, ok := x.GetResult().(*Reply_InstallCertificateResponse); ok {
+		return x.InstallCertificateResponse
+	}
+	return nil
+}
+
+func (x *Reply) GetSignedUpdateFirmwareResponse() *SignedUpdateFirmwareResponse {
+	if x, ok := x.GetResult().(*Reply_SignedUpdateFirmwareResponse); ok {
+		return x.SignedUpdateFirmwareResponse
+	}
+	return nil
+}
+
+type isReply_Result interface {
+	isReply_Result()
+}
+
+type Reply_Error struct {
+	Error *CallError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+type Reply_CancelReservationResponse struct {
+	CancelReservationResponse *CancelReservationResponse `protobuf:"bytes,64,opt,name=cancel_reservation_response,json=cancelReservationResponse,proto3,oneof"`
+}
+
+type Reply_ChangeAvailabilityResponse struct {
+	ChangeAvailabilityResponse *ChangeAvailabilityResponse `protobuf:"bytes,65,opt,name=change_availability_response,json=changeAvailabilityResponse,proto3,oneof"`
+}
+
+type Reply_ChangeConfigurationResponse struct {
+	ChangeConfigurationResponse *ChangeConfigurationResponse `protobuf:"bytes,66,opt,name=change_configuration_response,json=changeConfigurationResponse,proto3,oneof"`
+}
+
+type Reply_ClearCacheResponse struct {
+	ClearCacheResponse *ClearCacheResponse `protobuf:"bytes,67,opt,name=clear_cache_response,json=clearCacheResponse,proto3,oneof"`
+}
+
+type Reply_ClearChargingProfileResponse struct {
+	ClearChargingProfileResponse *ClearChargingProfileResponse `protobuf:"bytes,68,opt,name=clear_charging_profile_response,json=clearChargingProfileResponse,proto3,oneof"`
+}
+
+type Reply_DataTransferResponse struct {
+	DataTransferResponse *DataTransferResponse `protobuf:"bytes,69,opt,name=data_transfer_response,json=dataTransferResponse,proto3,oneof"`
+}
+
+type Reply_GetCompositeScheduleResponse struct {
+	GetCompositeScheduleResponse *GetCompositeScheduleResponse `protobuf:"bytes,70,opt,name=get_composite_schedule_response,json=getCompositeScheduleResponse,proto3,oneof"`
+}
+
+type Reply_GetConfigurationResponse struct {
+	GetConfigurationResponse *GetConfigurationResponse `protobuf:"bytes,71,opt,name=get_configuration_response,json=getConfigurationResponse,proto3,oneof"`
+}
+
+type Reply_GetDiagnosticsResponse struct {
+	GetDiagnosticsResponse *GetDiagnosticsResponse `protobuf:"bytes,72,opt,name=get_diagnostics_response,json=getDiagnosticsResponse,proto3,oneof"`
+}
+
+type Reply_GetLocalListVersionResponse struct {
+	GetLocalListVersionResponse *GetLocalListVersionResponse `protobuf:"bytes,73,opt,name=get_local_list_version_response,json=getLocalListVersionResponse,proto3,oneof"`
+}
+
+type Reply_RemoteStartTransactionResponse struct {
+	RemoteStartTransactionResponse *RemoteStartTransactionResponse `protobuf:"bytes,74,opt,name=remote_start_transaction_response,json=remoteStartTransactionResponse,proto3,oneof"`
+}
+
+type Reply_RemoteStopTransactionResponse struct {
+	RemoteStopTransactionResponse *RemoteStopTransactionResponse `protobuf:"bytes,75,opt,name=remote_stop_transaction_response,json=remoteStopTransactionResponse,proto3,oneof"`
+}
+
+type Reply_ReserveNowResponse struct {
+	ReserveNowResponse *ReserveNowResponse `protobuf:"bytes,76,opt,name=reserve_now_response,json=reserveNowResponse,proto3,oneof"`
+}
+
+type Reply_ResetResponse struct {
+	ResetResponse *ResetResponse `protobuf:"bytes,77,opt,name=reset_response,json=resetResponse,proto3,oneof"`
+}
+
+type Reply_SendLocalListResponse struct {
+	SendLocalListResponse *SendLocalListResponse `protobuf:"bytes,78,opt,name=send_local_list_response,json=sendLocalListResponse,proto3,oneof"`
+}
+
+type Reply_SetChargingProfileResponse struct {
+	SetChargingProfileResponse *SetChargingProfileResponse `protobuf:"bytes,79,opt,name=set_charging_profile_response,json=setChargingProfileResponse,proto3,oneof"`
+}
+
+type Reply_TriggerMessageResponse struct {
+	TriggerMessageResponse *TriggerMessageResponse `protobuf:"bytes,80,opt,name=trigger_message_response,json=triggerMessageResponse,proto3,oneof"`
+}
+
+type Reply_UnlockConnectorResponse struct {
+	UnlockConnectorResponse *UnlockConnectorResponse `protobuf:"bytes,81,opt,name=unlock_connector_response,json=unlockConnectorResponse,proto3,oneof"`
+}
+
+type Reply_UpdateFirmwareResponse struct {
+	UpdateFirmwareResponse *UpdateFirmwareResponse `protobuf:"bytes,82,opt,name=update_firmware_response,json=updateFirmwareResponse,proto3,oneof"`
+}
+
+type Reply_CertificateSignedResponse struct {
+	CertificateSignedResponse *CertificateSignedResponse `protobuf:"bytes,83,opt,name=certificate_signed_response,json=certificateSignedResponse,proto3,oneof"`
+}
+
+type Reply_DeleteCertificateResponse struct {
+	DeleteCertificateResponse *DeleteCertificateResponse `protobuf:"bytes,84,opt,name=delete_certificate_response,json=deleteCertificateResponse,proto3,oneof"`
+}
+
+type Reply_ExtendedTriggerMessageResponse struct {
+	ExtendedTriggerMessageResponse *ExtendedTriggerMessageResponse `protobuf:"bytes,85,opt,name=extended_trigger_message_response,json=extendedTriggerMessageResponse,proto3,oneof"`
+}
+
+type Reply_GetInstalledCertificateIdsResponse struct {
+	GetInstalledCertificateIdsResponse *GetInstalledCertificateIdsResponse `protobuf:"bytes,86,opt,name=get_installed_certificate_ids_response,json=getInstalledCertificateIdsResponse,proto3,oneof"`
+}
+
+type Reply_GetLogResponse struct {
+	GetLogResponse *GetLogResponse `protobuf:"bytes,87,opt,name=get_log_response,json=getLogResponse,proto3,oneof"`
+}
+
+type Reply_InstallCertificateResponse struct {
+	InstallCertificateResponse *InstallCertificateResponse `protobuf:"bytes,88,opt,name=install_certificate_response,json=installCertificateResponse,proto3,oneof"`
+}
+
+type Reply_SignedUpdateFirmwareResponse struct {
+	SignedUpdateFirmwareResponse *SignedUpdateFirmwareResponse `protobuf:"bytes,89,opt,name=signed_update_firmware_response,json=signedUpdateFirmwareResponse,proto3,oneof"`
+}
+
+func (*Reply_Error) isReply_Result() {}
+
+func (*Reply_CancelReservationResponse) isReply_Result() {}
+
+func (*Reply_ChangeAvailabilityResponse) isReply_Result() {}
+
+func (*Reply_ChangeConfigurationResponse) isReply_Result() {}
+
+func (*Reply_ClearCacheResponse) isReply_Result() {}
+
+func (*Reply_ClearChargingProfileResponse) isReply_Result() {}
+
+func (*Reply_DataTransferResponse) isReply_Result() {}
+
+func (*Reply_GetCompositeScheduleResponse) isReply_Result() {}
+
+func (*Reply_GetConfigurationResponse) isReply_Result() {}
+
+func (*Reply_GetDiagnosticsResponse) isReply_Result() {}
+
+func (*Reply_GetLocalListVersionResponse) isReply_Result() {}
+
+func (*Reply_RemoteStartTransactionResponse) isReply_Result() {}
+
+func (*Reply_RemoteStopTransactionResponse) isReply_Result() {}
+
+func (*Reply_ReserveNowResponse) isReply_Result() {}
+
+func (*Reply_ResetResponse) isReply_Result() {}
+
+func (*Reply_SendLocalListResponse) isReply_Result() {}
+
+func (*Reply_SetChargingProfileResponse) isReply_Result() {}
+
+func (*Reply_TriggerMessageResponse) isReply_Result() {}
+
+func (*Reply_UnlockConnectorResponse) isReply_Result() {}
+
+func (*Reply_UpdateFirmwareResponse) isReply_Result() {}
+
+func (*Reply_CertificateSignedResponse) isReply_Result() {}
+
+func (*Reply_DeleteCertificateResponse) isReply_Result() {}
+
+func (*Reply_ExtendedTriggerMessageResponse) isReply_Result() {}
+
+func (*Reply_GetInstalledCertificateIdsResponse) isReply_Result() {}
+
+func (*Reply_GetLogResponse) isReply_Result() {}
+
+func (*Reply_InstallCertificateResponse) isReply_Result() {}
+
+func (*Reply_SignedUpdateFirmwareResponse) isReply_Result() {}
+
+// Event is a request of a subscribed charging point, its connection or disconnection, or the reply to a call of the stream
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Types that are assignable to Event:
+	//	*Event_Online
+	//	*Event_Offline
+	//	*Event_Error
+	//	*Event_AuthorizeRequest
+	//	*Event_BootNotificationRequest
+	//	*Event_DataTransferRequest
+	//	*Event_DiagnosticsStatusNotificationRequest
+	//	*Event_FirmwareStatusNotificationRequest
+	//	*Event_HeartbeatRequest
+	//	*Event_MeterValuesRequest
+	//	*Event_StartTransactionRequest
+	//	*Event_StatusNotificationRequest
+	//	*Event_StopTransactionRequest
+	//	*Event_SecurityEventNotificationRequest
+	//	*Event_SignCertificateRequest
+	//	*Event_LogStatusNotificationRequest
+	//	*Event_SignedFirmwareStatusNotificationRequest
+	//	*Event_CancelReservationResponse
+	//	*Event_ChangeAvailabilityResponse
+	//	*Event_ChangeConfigurationResponse
+	//	*Event_ClearCacheResponse
+	//	*Event_ClearChargingProfileResponse
+	//	*Event_DataTransferResponse
+	//	*Event_GetCompositeScheduleResponse
+	//	*Event_GetConfigurationResponse
+	//	*Event_GetDiagnosticsResponse
+	//	*Event_GetLocalListVersionResponse
+	//	*Event_RemoteStartTransactionResponse
+	//	*Event_RemoteStopTransactionResponse
+	//	*Event_ReserveNowResponse
+	//	*Event_ResetResponse
+	//	*Event_SendLocalListResponse
+	//	*Event_SetChargingProfileResponse
+	//	*Event_TriggerMessageResponse
+	//	*Event_UnlockConnectorResponse
+	//	*Event_UpdateFirmwareResponse
+	//	*Event_CertificateSignedResponse
+	//	*Event_DeleteCertificateResponse
+	//	*Event_ExtendedTriggerMessageResponse
+	//	*Event_GetInstalledCertificateIdsResponse
+	//	*Event_GetLogResponse
+	//	*Event_InstallCertificateResponse
+	//	*Event_SignedUpdateFirmwareResponse
+	Event isEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *Event) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *Event) GetOnline() *Empty {
+	if x, ok := x.GetEvent().(*Event_Online); ok {
+		return x.Online
+	}
+	return nil
+}
+
+func (x *Event) GetOffline() *Empty {
+	if x, ok := x.GetEvent().(*Event_Offline); ok {
+		return x.Offline
+	}
+	return nil
+}
+
+func (x *Event) GetError() *CallError {
+	if x, ok := x.GetEvent().(*Event_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Event) GetAuthorizeRequest() *AuthorizeRequest {
+	if x, ok := x.GetEvent().(*Event_AuthorizeRequest); ok {
+		return x.AuthorizeRequest
+	}
+	return nil
+}
+
+func (x *Event) GetBootNotificationRequest() *BootNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_BootNotificationRequest); ok {
+		return x.BootNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetDataTransferRequest() *DataTransferRequest {
+	if x, ok := x.GetEvent().(*Event_DataTransferRequest); ok {
+		return x.DataTransferRequest
+	}
+	return nil
+}
+
+func (x *Event) GetDiagnosticsStatusNotificationRequest() *DiagnosticsStatusNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_DiagnosticsStatusNotificationRequest); ok {
+		return x.DiagnosticsStatusNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetFirmwareStatusNotificationRequest() *FirmwareStatusNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_FirmwareStatusNotificationRequest); ok {
+		return x.FirmwareStatusNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetHeartbeatRequest() *HeartbeatRequest {
+	if x, ok := x.GetEvent().(*Event_HeartbeatRequest); ok {
+		return x.HeartbeatRequest
+	}
+	return nil
+}
+
+func (x *Event) GetMeterValuesRequest() *MeterValuesRequest {
+	if x, ok := x.GetEvent().(*Event_MeterValuesRequest); ok {
+		return x.MeterValuesRequest
+	}
+	return nil
+}
+
+func (x *Event) GetStartTransactionRequest() *StartTransactionRequest {
+	if x, ok := x.GetEvent().(*Event_StartTransactionRequest); ok {
+		return x.StartTransactionRequest
+	}
+	return nil
+}
+
+func (x *Event) GetStatusNotificationRequest() *StatusNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_StatusNotificationRequest); ok {
+		return x.StatusNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetStopTransactionRequest() *StopTransactionRequest {
+	if x, ok := x.GetEvent().(*Event_StopTransactionRequest); ok {
+		return x.StopTransactionRequest
+	}
+	return nil
+}
+
+func (x *Event) GetSecurityEventNotificationRequest() *SecurityEventNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_SecurityEventNotificationRequest); ok {
+		return x.SecurityEventNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetSignCertificateRequest() *SignCertificateRequest {
+	if x, ok := x.GetEvent().(*Event_SignCertificateRequest); ok {
+		return x.SignCertificateRequest
+	}
+	return nil
+}
+
+func (x *Event) GetLogStatusNotificationRequest() *LogStatusNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_LogStatusNotificationRequest); ok {
+		return x.LogStatusNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetSignedFirmwareStatusNotificationRequest() *SignedFirmwareStatusNotificationRequest {
+	if x, ok := x.GetEvent().(*Event_SignedFirmwareStatusNotificationRequest); ok {
+		return x.SignedFirmwareStatusNotificationRequest
+	}
+	return nil
+}
+
+func (x *Event) GetCancelReservationResponse() *CancelReservationResponse {
+	if x, ok := x.GetEvent().(*Event_CancelReservationResponse); ok {
+		return x.CancelReservationResponse
+	}
+	return nil
+}
+
+func (x *Event) GetChangeAvailabilityResponse() *ChangeAvailabilityResponse {
+	if x, ok := x.GetEvent().(*Event_ChangeAvailabilityResponse); ok {
+		return x.ChangeAvailabilityResponse
+	}
+	return nil
+}
+
+func (x *Event) GetChangeConfigurationResponse() *ChangeConfigurationResponse {
+	if x, ok := x.GetEvent().(*Event_ChangeConfigurationResponse); ok {
+		return x.ChangeConfigurationResponse
+	}
+	return nil
+}
+
+func (x *Event) GetClearCacheResponse() *ClearCacheResponse {
+	if x, ok := x.GetEvent().(*Event_ClearCacheResponse); ok {
+		return x.ClearCacheResponse
+	}
+	return nil
+}
+
+func (x *Event) GetClearChargingProfileResponse() *ClearChargingProfileResponse {
+	if x, ok := x.GetEvent().(*Event_ClearChargingProfileResponse); ok {
+		return x.ClearChargingProfileResponse
+	}
+	return nil
+}
+
+func (x *Event) GetDataTransferResponse() *DataTransferResponse {
+	if x, ok := x.GetEvent().(*Event_DataTransferResponse); ok {
+		return x.DataTransferResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetCompositeScheduleResponse() *GetCompositeScheduleResponse {
+	if x, ok := x.GetEvent().(*Event_GetCompositeScheduleResponse); ok {
+		return x.GetCompositeScheduleResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetConfigurationResponse() *GetConfigurationResponse {
+	if x, ok := x.GetEvent().(*Event_GetConfigurationResponse); ok {
+		return x.GetConfigurationResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetDiagnosticsResponse() *GetDiagnosticsResponse {
+	if x, ok := x.GetEvent().(*Event_GetDiagnosticsResponse); ok {
+		return x.GetDiagnosticsResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetLocalListVersionResponse() *GetLocalListVersionResponse {
+	if x, ok := x.GetEvent().(*Event_GetLocalListVersionResponse); ok {
+		return x.GetLocalListVersionResponse
+	}
+	return nil
+}
+
+func (x *Event) GetRemoteStartTransactionResponse() *RemoteStartTransactionResponse {
+	if x, ok := x.GetEvent().(*Event_RemoteStartTransactionResponse); ok {
+		return x.RemoteStartTransactionResponse
+	}
+	return nil
+}
+
+func (x *Event) GetRemoteStopTransactionResponse() *RemoteStopTransactionResponse {
+	if x, ok := x.GetEvent().(*Event_RemoteStopTransactionResponse); ok {
+		return x.RemoteStopTransactionResponse
+	}
+	return nil
+}
+
+func (x *Event) GetReserveNowResponse() *ReserveNowResponse {
+	if x, ok := x.GetEvent().(*Event_ReserveNowResponse); ok {
+		return x.ReserveNowResponse
+	}
+	return nil
+}
+
+func (x *Event) GetResetResponse() *ResetResponse {
+	if x, ok := x.GetEvent().(*Event_ResetResponse); ok {
+		return x.ResetResponse
+	}
+	return nil
+}
+
+func (x *Event) GetSendLocalListResponse() *SendLocalListResponse {
+	if x, ok := x.GetEvent().(*Event_SendLocalListResponse); ok {
+		return x.SendLocalListResponse
+	}
+	return nil
+}
+
+func (x *Event) GetSetChargingProfileResponse() *SetChargingProfileResponse {
+	if x, ok := x.GetEvent().(*Event_SetChargingProfileResponse); ok {
+		return x.SetChargingProfileResponse
+	}
+	return nil
+}
+
+func (x *Event) GetTriggerMessageResponse() *TriggerMessageResponse {
+	if x, ok := x.GetEvent().(*Event_TriggerMessageResponse); ok {
+		return x.TriggerMessageResponse
+	}
+	return nil
+}
+
+func (x *Event) GetUnlockConnectorResponse() *UnlockConnectorResponse {
+	if x, ok := x.GetEvent().(*Event_UnlockConnectorResponse); ok {
+		return x.UnlockConnectorResponse
+	}
+	return nil
+}
+
+func (x *Event) GetUpdateFirmwareResponse() *UpdateFirmwareResponse {
+	if x, ok := x.GetEvent().(*Event_UpdateFirmwareResponse); ok {
+		return x.UpdateFirmwareResponse
+	}
+	return nil
+}
+
+func (x *Event) GetCertificateSignedResponse() *CertificateSignedResponse {
+	if x, ok := x.GetEvent().(*Event_CertificateSignedResponse); ok {
+		return x.CertificateSignedResponse
+	}
+	return nil
+}
+
+func (x *Event) GetDeleteCertificateResponse() *DeleteCertificateResponse {
+	if x, ok := x.GetEvent().(*Event_DeleteCertificateResponse); ok {
+		return x.DeleteCertificateResponse
+	}
+	return nil
+}
+
+func (x *Event) GetExtendedTriggerMessageResponse() *ExtendedTriggerMessageResponse {
+	if x, ok := x.GetEvent().(*Event_ExtendedTriggerMessageResponse); ok {
+		return x.ExtendedTriggerMessageResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetInstalledCertificateIdsResponse() *GetInstalledCertificateIdsResponse {
+	if x, ok := x.GetEvent().(*Event_GetInstalledCertificateIdsResponse); ok {
+		return x.GetInstalledCertificateIdsResponse
+	}
+	return nil
+}
+
+func (x *Event) GetGetLogResponse() *GetLogResponse {
+	if x, ok := x.GetEvent().(*Event_GetLogResponse); ok {
+		return x.GetLogResponse
+	}
+	return nil
+}
+
+func (x *Event) GetInstallCertificateResponse() *InstallCertificateResponse {
+	if x, ok := x.GetEvent().(*Event_InstallCertificateResponse); ok {
+		return x.InstallCertificateResponse
+	}
+	return nil
+}
+
+func (x *Event) GetSignedUpdateFirmwareResponse() *SignedUpdateFirmwareResponse {
+	if x, ok := x.GetEvent().(*Event_SignedUpdateFirmwareResponse); ok {
+		return x.SignedUpdateFirmwareResponse
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_Online struct {
+	Online *Empty `protobuf:"bytes,4,opt,name=online,proto3,oneof"`
+}
+
+type Event_Offline struct {
+	Offline *Empty `protobuf:"bytes,5,opt,name=offline,proto3,oneof"`
+}
+
+type Event_Error struct {
+	Error *CallError `protobuf:"bytes,6,opt,name=error,proto3,oneof"`
+}
+
+type Event_AuthorizeRequest struct {
+	AuthorizeRequest *AuthorizeRequest `protobuf:"bytes,16,opt,name=authorize_request,json=authorizeRequest,proto3,oneof"`
+}
+
+type Event_BootNotificationRequest struct {
+	BootNotificationRequest *BootNotificationRequest `protobuf:"bytes,17,opt,name=boot_notification_request,json=bootNotificationRequest,proto3,oneof"`
+}
+
+type Event_DataTransferRequest struct {
+	DataTransferRequest *DataTransferRequest `protobuf:"bytes,18,opt,name=data_transfer_request,json=dataTransferRequest,proto3,oneof"`
+}
+
+type Event_DiagnosticsStatusNotificationRequest struct {
+	DiagnosticsStatusNotificationRequest *DiagnosticsStatusNotificationRequest `protobuf:"bytes,19,opt,name=diagnostics_status_notification_request,json=diagnosticsStatusNotificationRequest,proto3,oneof"`
+}
+
+type Event_FirmwareStatusNotificationRequest struct {
+	FirmwareStatusNotificationRequest *FirmwareStatusNotificationRequest `protobuf:"bytes,20,opt,name=firmware_status_notification_request,json=firmwareStatusNotificationRequest,proto3,oneof"`
+}
+
+type Event_HeartbeatRequest struct {
+	HeartbeatRequest *HeartbeatRequest `protobuf:"bytes,21,opt,name=heartbeat_request,json=heartbeatRequest,proto3,oneof"`
+}
+
+type Event_MeterValuesRequest struct {
+	MeterValuesRequest *MeterValuesRequest `protobuf:"bytes,22,opt,name=meter_values_request,json=meterValuesRequest,proto3,oneof"`
+}
+
+type Event_StartTransactionRequest struct {
+	StartTransactionRequest *StartTransactionRequest `protobuf:"bytes,23,opt,name=start_transaction_request,json=startTransactionRequest,proto3,oneof"`
+}
+
+type Event_StatusNotificationRequest struct {
+	StatusNotificationRequest *StatusNotificationRequest `protobuf:"bytes,24,opt,name=status_notification_request,json=statusNotificationRequest,proto3,oneof"`
+}
+
+type Event_StopTransactionRequest struct {
+	StopTransactionRequest *StopTransactionRequest `protobuf:"bytes,25,opt,name=stop_transaction_request,json=stopTransactionRequest,proto3,oneof"`
+}
+
+type Event_SecurityEventNotificationRequest struct {
+	SecurityEventNotificationRequest *SecurityEventNotificationRequest `protobuf:"bytes,26,opt,name=security_event_notification_request,json=securityEventNotificationRequest,proto3,oneof"`
+}
+
+type Event_SignCertificateRequest struct {
+	SignCertificateRequest *SignCertificateRequest `protobuf:"bytes,27,opt,name=sign_certificate_request,json=signCertificateRequest,proto3,oneof"`
+}
+
+type Event_LogStatusNotificationRequest struct {
+	LogStatusNotificationRequest *LogStatusNotificationRequest `protobuf:"bytes,28,opt,name=log_status_notification_request,json=logStatusNotificationRequest,proto3,oneof"`
+}
+
+type Event_SignedFirmwareStatusNotificationRequest struct {
+	SignedFirmwareStatusNotificationRequest *SignedFirmwareStatusNotificationRequest `protobuf:"bytes,29,opt,name=signed_firmware_status_notification_request,json=signedFirmwareStatusNotificationRequest,proto3,oneof"`
+}
+
+type Event_CancelReservationResponse struct {
+	CancelReservationResponse *CancelReservationResponse `protobuf:"bytes,64,opt,name=cancel_reservation_response,json=cancelReservationResponse,proto3,oneof"`
+}
+
+type Event_ChangeAvailabilityResponse struct {
+	ChangeAvailabilityResponse *ChangeAvailabilityResponse `protobuf:"bytes,65,opt,name=change_availability_response,json=changeAvailabilityResponse,proto3,oneof"`
+}
+
+type Event_ChangeConfigurationResponse struct {
+	ChangeConfigurationResponse *ChangeConfigurationResponse `protobuf:"bytes,66,opt,name=change_configuration_response,json=changeConfigurationResponse,proto3,oneof"`
+}
+
+type Event_ClearCacheResponse struct {
+	ClearCacheResponse *ClearCacheResponse `protobuf:"bytes,67,opt,name=clear_cache_response,json=clearCacheResponse,proto3,oneof"`
+}
+
+type Event_ClearChargingProfileResponse struct {
+	ClearChargingProfileResponse *ClearChargingProfileResponse `protobuf:"bytes,68,opt,name=clear_charging_profile_response,json=clearChargingProfileResponse,proto3,oneof"`
+}
+
+type Event_DataTransferResponse struct {
+	DataTransferResponse *DataTransferResponse `protobuf:"bytes,69,opt,name=data_transfer_response,json=dataTransferResponse,proto3,oneof"`
+}
+
+type Event_GetCompositeScheduleResponse struct {
+	GetCompositeScheduleResponse *GetCompositeScheduleResponse `protobuf:"bytes,70,opt,name=get_composite_schedule_response,json=getCompositeScheduleResponse,proto3,oneof"`
+}
+
+type Event_GetConfigurationResponse struct {
+	GetConfigurationResponse *GetConfigurationResponse `protobuf:"bytes,71,opt,name=get_configuration_response,json=getConfigurationResponse,proto3,oneof"`
+}
+
+type Event_GetDiagnosticsResponse struct {
+	GetDiagnosticsResponse *GetDiagnosticsResponse `protobuf:"bytes,72,opt,name=get_diagnostics_response,json=getDiagnosticsResponse,proto3,oneof"`
+}
+
+type Event_GetLocalListVersionResponse struct {
+	GetLocalListVersionResponse *GetLocalListVersionResponse `protobuf:"bytes,73,opt,name=get_local_list_version_response,json=getLocalListVersionResponse,proto3,oneof"`
+}
+
+type Event_RemoteStartTransactionResponse struct {
+	RemoteStartTransactionResponse *RemoteStartTransactionResponse `protobuf:"bytes,74,opt,name=remote_start_transaction_response,json=remoteStartTransactionResponse,proto3,oneof"`
+}
+
+type Event_RemoteStopTransactionResponse struct {
+	RemoteStopTransactionResponse *RemoteStopTransactionResponse `protobuf:"bytes,75,opt,name=remote_stop_transaction_response,json=remoteStopTransactionResponse,proto3,oneof"`
+}
+
+type Event_ReserveNowResponse struct {
+	ReserveNowResponse *ReserveNowResponse `protobuf:"bytes,76,opt,name=reserve_now_response,json=reserveNowResponse,proto3,oneof"`
+}
+
+type Event_ResetResponse struct {
+	ResetResponse *ResetResponse `protobuf:"bytes,77,opt,name=reset_response,json=resetResponse,proto3,oneof"`
+}
+
+type Event_SendLocalListResponse struct {
+	SendLocalListResponse *SendLocalListResponse `protobuf:"bytes,78,opt,name=send_local_list_response,json=sendLocalListResponse,proto3,oneof"`
+}
+
+type Event_SetChargingProfileResponse struct {
+	SetChargingProfileResponse *SetChargingProfileResponse `protobuf:"bytes,79,opt,name=set_charging_profile_response,json=setChargingProfileResponse,proto3,oneof"`
+}
+
+type Event_TriggerMessageResponse struct {
+	TriggerMessageResponse *TriggerMessageResponse `protobuf:"bytes,80,opt,name=trigger_message_response,json=triggerMessageResponse,proto3,oneof"`
+}
+
+type Event_UnlockConnectorResponse struct {
+	UnlockConnectorResponse *UnlockConnectorResponse `protobuf:"bytes,81,opt,name=unlock_connector_response,json=unlockConnectorResponse,proto3,oneof"`
+}
+
+type Event_UpdateFirmwareResponse struct {
+	UpdateFirmwareResponse *UpdateFirmwareResponse `protobuf:"bytes,82,opt,name=update_firmware_response,json=updateFirmwareResponse,proto3,oneof"`
+}
+
+type Event_CertificateSignedResponse struct {
+	CertificateSignedResponse *CertificateSignedResponse `protobuf:"bytes,83,opt,name=certificate_signed_response,json=certificateSignedResponse,proto3,oneof"`
+}
+
+type Event_DeleteCertificateResponse struct {
+	DeleteCertificateResponse *DeleteCertificateResponse `protobuf:"bytes,84,opt,name=delete_certificate_response,json=deleteCertificateResponse,proto3,oneof"`
+}
+
+type Event_ExtendedTriggerMessageResponse struct {
+	ExtendedTriggerMessageResponse *ExtendedTriggerMessageResponse `protobuf:"bytes,85,opt,name=extended_trigger_message_response,json=extendedTriggerMessageResponse,proto3,oneof"`
+}
+
+type Event_GetInstalledCertificateIdsResponse struct {
+	GetInstalledCertificateIdsResponse *GetInstalledCertificateIdsResponse `protobuf:"bytes,86,opt,name=get_installed_certificate_ids_response,json=getInstalledCertificateIdsResponse,proto3,oneof"`
+}
+
+type Event_GetLogResponse struct {
+	GetLogResponse *GetLogResponse `protobuf:"bytes,87,opt,name=get_log_response,json=getLogResponse,proto3,oneof"`
+}
+
+type Event_InstallCertificateResponse struct {
+	InstallCertificateResponse *InstallCertificateResponse `protobuf:"bytes,88,opt,name=install_certificate_response,json=installCertificateResponse,proto3,oneof"`
+}
+
+type Event_SignedUpdateFirmwareResponse struct {
+	SignedUpdateFirmwareResponse *SignedUpdateFirmwareResponse `protobuf:"bytes,89,opt,name=signed_update_firmware_response,json=signedUpdateFirmwareResponse,proto3,oneof"`
+}
+
+func (*Event_Online) isEvent_Event() {}
+
+func (*Event_Offline) isEvent_Event() {}
+
+func (*Event_Error) isEvent_Event() {}
+
+func (*Event_AuthorizeRequest) isEvent_Event() {}
+
+func (*Event_BootNotificationRequest) isEvent_Event() {}
+
+func (*Event_DataTransferRequest) isEvent_Event() {}
+
+func (*Event_DiagnosticsStatusNotificationRequest) isEvent_Event() {}
+
+func (*Event_FirmwareStatusNotificationRequest) isEvent_Event() {}
+
+func (*Event_HeartbeatRequest) isEvent_Event() {}
+
+func (*Event_MeterValuesRequest) isEvent_Event() {}
+
+func (*Event_StartTransactionRequest) isEvent_Event() {}
+
+func (*Event_StatusNotificationRequest) isEvent_Event() {}
+
+func (*Event_StopTransactionRequest) isEvent_Event() {}
+
+func (*Event_SecurityEventNotificationRequest) isEvent_Event() {}
+
+func (*Event_SignCertificateRequest) isEvent_Event() {}
+
+func (*Event_LogStatusNotificationRequest) isEvent_Event() {}
+
+func (*Event_SignedFirmwareStatusNotificationRequest) isEvent_Event() {}
+
+func (*Event_CancelReservationResponse) isEvent_Event() {}
+
+func (*Event_ChangeAvailabilityResponse) isEvent_Event() {}
+
+func (*Event_ChangeConfigurationResponse) isEvent_Event() {}
+
+func (*Event_ClearCacheResponse) isEvent_Event() {}
+
+func (*Event_ClearChargingProfileResponse) isEvent_Event() {}
+
+func (*Event_DataTransferResponse) isEvent_Event() {}
+
+func (*Event_GetCompositeScheduleResponse) isEvent_Event() {}
+
+func (*Event_GetConfigurationResponse) isEvent_Event() {}
+
+func (*Event_GetDiagnosticsResponse) isEvent_Event() {}
+
+func (*Event_GetLocalListVersionResponse) isEvent_Event() {}
+
+func (*Event_RemoteStartTransactionResponse) isEvent_Event() {}
+
+func (*Event_RemoteStopTransactionResponse) isEvent_Event() {}
+
+func (*Event_ReserveNowResponse) isEvent_Event() {}
+
+func (*Event_ResetResponse) isEvent_Event() {}
+
+func (*Event_SendLocalListResponse) isEvent_Event() {}
+
+func (*Event_SetChargingProfileResponse) isEvent_Event() {}
+
+func (*Event_TriggerMessageResponse) isEvent_Event() {}
+
+func (*Event_UnlockConnectorResponse) isEvent_Event() {}
+
+func (*Event_UpdateFirmwareResponse) isEvent_Event() {}
+
+func (*Event_CertificateSignedResponse) isEvent_Event() {}
+
+func (*Event_DeleteCertificateResponse) isEvent_Event() {}
+
+func (*Event_ExtendedTriggerMessageResponse) isEvent_Event() {}
+
+func (*Event_GetInstalledCertificateIdsResponse) isEvent_Event() {}
+
+func (*Event_GetLogResponse) isEvent_Event() {}
+
+func (*Event_InstallCertificateResponse) isEvent_Event() {}
+
+func (*Event_SignedUpdateFirmwareResponse) isEvent_Event() {}
+
+type AuthorizeCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string            `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *AuthorizeRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *AuthorizeCall) Reset() {
+	*x = AuthorizeCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeCall) ProtoMessage() {}
+
+func (x *AuthorizeCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeCall.ProtoReflect.Descriptor instead.
+func (*AuthorizeCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuthorizeCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthorizeCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *AuthorizeCall) GetRequest() *AuthorizeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type AuthorizeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*AuthorizeResult_Response
+	//	*AuthorizeResult_Error
+	Result isAuthorizeResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *AuthorizeResult) Reset() {
+	*x = AuthorizeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResult) ProtoMessage() {}
+
+func (x *AuthorizeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResult.ProtoReflect.Descriptor instead.
+func (*AuthorizeResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *AuthorizeResult) GetResult() isAuthorizeResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AuthorizeResult) GetResponse() *AuthorizeResponse {
+	if x, ok := x.GetResult().(*AuthorizeResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AuthorizeResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*AuthorizeResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isAuthorizeResult_Result interface {
+	isAuthorizeResult_Result()
+}
+
+type AuthorizeResult_Response struct {
+	Response *AuthorizeResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type AuthorizeResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*AuthorizeResult_Response) isAuthorizeResult_Result() {}
+
+func (*AuthorizeResult_Error) isAuthorizeResult_Result() {}
+
+type BootNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                   `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *BootNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *BootNotificationCall) Reset() {
+	*x = BootNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootNotificationCall) ProtoMessage() {}
+
+func (x *BootNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootNotificationCall.ProtoReflect.Descriptor instead.
+func (*BootNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BootNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BootNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *BootNotificationCall) GetRequest() *BootNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type BootNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*BootNotificationResult_Response
+	//	*BootNotificationResult_Error
+	Result isBootNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *BootNotificationResult) Reset() {
+	*x = BootNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootNotificationResult) ProtoMessage() {}
+
+func (x *BootNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootNotificationResult.ProtoReflect.Descriptor instead.
+func (*BootNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *BootNotificationResult) GetResult() isBootNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *BootNotificationResult) GetResponse() *BootNotificationResponse {
+	if x, ok := x.GetResult().(*BootNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BootNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*BootNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isBootNotificationResult_Result interface {
+	isBootNotificationResult_Result()
+}
+
+type BootNotificationResult_Response struct {
+	Response *BootNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type BootNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*BootNotificationResult_Response) isBootNotificationResult_Result() {}
+
+func (*BootNotificationResult_Error) isBootNotificationResult_Result() {}
+
+type CancelReservationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                    `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *CancelReservationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *CancelReservationCall) Reset() {
+	*x = CancelReservationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationCall) ProtoMessage() {}
+
+func (x *CancelReservationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationCall.ProtoReflect.Descriptor instead.
+func (*CancelReservationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelReservationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelReservationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *CancelReservationCall) GetRequest() *CancelReservationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CancelReservationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*CancelReservationResult_Response
+	//	*CancelReservationResult_Error
+	Result isCancelReservationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *CancelReservationResult) Reset() {
+	*x = CancelReservationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResult) ProtoMessage() {}
+
+func (x *CancelReservationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResult.ProtoReflect.Descriptor instead.
+func (*CancelReservationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *CancelReservationResult) GetResult() isCancelReservationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *CancelReservationResult) GetResponse() *CancelReservationResponse {
+	if x, ok := x.GetResult().(*CancelReservationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CancelReservationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*CancelReservationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCancelReservationResult_Result interface {
+	isCancelReservationResult_Result()
+}
+
+type CancelReservationResult_Response struct {
+	Response *CancelReservationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type CancelReservationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CancelReservationResult_Response) isCancelReservationResult_Result() {}
+
+func (*CancelReservationResult_Error) isCancelReservationResult_Result() {}
+
+type CertificateSignedCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                    `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *CertificateSignedRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *CertificateSignedCall) Reset() {
+	*x = CertificateSignedCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateSignedCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSignedCall) ProtoMessage() {}
+
+func (x *CertificateSignedCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSignedCall.ProtoReflect.Descriptor instead.
+func (*CertificateSignedCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CertificateSignedCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertificateSignedCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *CertificateSignedCall) GetRequest() *CertificateSignedRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CertificateSignedResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*CertificateSignedResult_Response
+	//	*CertificateSignedResult_Error
+	Result isCertificateSignedResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *CertificateSignedResult) Reset() {
+	*x = CertificateSignedResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateSignedResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSignedResult) ProtoMessage() {}
+
+func (x *CertificateSignedResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSignedResult.ProtoReflect.Descriptor instead.
+func (*CertificateSignedResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *CertificateSignedResult) GetResult() isCertificateSignedResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *CertificateSignedResult) GetResponse() *CertificateSignedResponse {
+	if x, ok := x.GetResult().(*CertificateSignedResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CertificateSignedResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*CertificateSignedResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCertificateSignedResult_Result interface {
+	isCertificateSignedResult_Result()
+}
+
+type CertificateSignedResult_Response struct {
+	Response *CertificateSignedResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type CertificateSignedResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CertificateSignedResult_Response) isCertificateSignedResult_Result() {}
+
+func (*CertificateSignedResult_Error) isCertificateSignedResult_Result() {}
+
+type ChangeAvailabilityCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                     `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ChangeAvailabilityRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ChangeAvailabilityCall) Reset() {
+	*x = ChangeAvailabilityCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAvailabilityCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAvailabilityCall) ProtoMessage() {}
+
+func (x *ChangeAvailabilityCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAvailabilityCall.ProtoReflect.Descriptor instead.
+func (*ChangeAvailabilityCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChangeAvailabilityCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeAvailabilityCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ChangeAvailabilityCall) GetRequest() *ChangeAvailabilityRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ChangeAvailabilityResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ChangeAvailabilityResult_Response
+	//	*ChangeAvailabilityResult_Error
+	Result isChangeAvailabilityResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ChangeAvailabilityResult) Reset() {
+	*x = ChangeAvailabilityResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAvailabilityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAvailabilityResult) ProtoMessage() {}
+
+func (x *ChangeAvailabilityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAvailabilityResult.ProtoReflect.Descriptor instead.
+func (*ChangeAvailabilityResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *ChangeAvailabilityResult) GetResult() isChangeAvailabilityResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ChangeAvailabilityResult) GetResponse() *ChangeAvailabilityResponse {
+	if x, ok := x.GetResult().(*ChangeAvailabilityResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ChangeAvailabilityResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ChangeAvailabilityResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isChangeAvailabilityResult_Result interface {
+	isChangeAvailabilityResult_Result()
+}
+
+type ChangeAvailabilityResult_Response struct {
+	Response *ChangeAvailabilityResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ChangeAvailabilityResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ChangeAvailabilityResult_Response) isChangeAvailabilityResult_Result() {}
+
+func (*ChangeAvailabilityResult_Error) isChangeAvailabilityResult_Result() {}
+
+type ChangeConfigurationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                      `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ChangeConfigurationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ChangeConfigurationCall) Reset() {
+	*x = ChangeConfigurationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeConfigurationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConfigurationCall) ProtoMessage() {}
+
+func (x *ChangeConfigurationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConfigurationCall.ProtoReflect.Descriptor instead.
+func (*ChangeConfigurationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangeConfigurationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeConfigurationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ChangeConfigurationCall) GetRequest() *ChangeConfigurationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ChangeConfigurationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ChangeConfigurationResult_Response
+	//	*ChangeConfigurationResult_Error
+	Result isChangeConfigurationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ChangeConfigurationResult) Reset() {
+	*x = ChangeConfigurationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeConfigurationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConfigurationResult) ProtoMessage() {}
+
+func (x *ChangeConfigurationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConfigurationResult.ProtoReflect.Descriptor instead.
+func (*ChangeConfigurationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *ChangeConfigurationResult) GetResult() isChangeConfigurationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ChangeConfigurationResult) GetResponse() *ChangeConfigurationResponse {
+	if x, ok := x.GetResult().(*ChangeConfigurationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ChangeConfigurationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ChangeConfigurationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isChangeConfigurationResult_Result interface {
+	isChangeConfigurationResult_Result()
+}
+
+type ChangeConfigurationResult_Response struct {
+	Response *ChangeConfigurationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ChangeConfigurationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ChangeConfigurationResult_Response) isChangeConfigurationResult_Result() {}
+
+func (*ChangeConfigurationResult_Error) isChangeConfigurationResult_Result() {}
+
+type ClearCacheCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string             `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ClearCacheRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ClearCacheCall) Reset() {
+	*x = ClearCacheCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCacheCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCacheCall) ProtoMessage() {}
+
+func (x *ClearCacheCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCacheCall.ProtoReflect.Descriptor instead.
+func (*ClearCacheCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearCacheCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClearCacheCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ClearCacheCall) GetRequest() *ClearCacheRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ClearCacheResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ClearCacheResult_Response
+	//	*ClearCacheResult_Error
+	Result isClearCacheResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ClearCacheResult) Reset() {
+	*x = ClearCacheResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCacheResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCacheResult) ProtoMessage() {}
+
+func (x *ClearCacheResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCacheResult.ProtoReflect.Descriptor instead.
+func (*ClearCacheResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *ClearCacheResult) GetResult() isClearCacheResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ClearCacheResult) GetResponse() *ClearCacheResponse {
+	if x, ok := x.GetResult().(*ClearCacheResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ClearCacheResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ClearCacheResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isClearCacheResult_Result interface {
+	isClearCacheResult_Result()
+}
+
+type ClearCacheResult_Response struct {
+	Response *ClearCacheResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ClearCacheResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ClearCacheResult_Response) isClearCacheResult_Result() {}
+
+func (*ClearCacheResult_Error) isClearCacheResult_Result() {}
+
+type ClearChargingProfileCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                       `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ClearChargingProfileRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ClearChargingProfileCall) Reset() {
+	*x = ClearChargingProfileCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearChargingProfileCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChargingProfileCall) ProtoMessage() {}
+
+func (x *ClearChargingProfileCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChargingProfileCall.ProtoReflect.Descriptor instead.
+func (*ClearChargingProfileCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClearChargingProfileCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClearChargingProfileCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ClearChargingProfileCall) GetRequest() *ClearChargingProfileRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ClearChargingProfileResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ClearChargingProfileResult_Response
+	//	*ClearChargingProfileResult_Error
+	Result isClearChargingProfileResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ClearChargingProfileResult) Reset() {
+	*x = ClearChargingProfileResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearChargingProfileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChargingProfileResult) ProtoMessage() {}
+
+func (x *ClearChargingProfileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChargingProfileResult.ProtoReflect.Descriptor instead.
+func (*ClearChargingProfileResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *ClearChargingProfileResult) GetResult() isClearChargingProfileResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ClearChargingProfileResult) GetResponse() *ClearChargingProfileResponse {
+	if x, ok := x.GetResult().(*ClearChargingProfileResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ClearChargingProfileResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ClearChargingProfileResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isClearChargingProfileResult_Result interface {
+	isClearChargingProfileResult_Result()
+}
+
+type ClearChargingProfileResult_Response struct {
+	Response *ClearChargingProfileResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ClearChargingProfileResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ClearChargingProfileResult_Response) isClearChargingProfileResult_Result() {}
+
+func (*ClearChargingProfileResult_Error) isClearChargingProfileResult_Result() {}
+
+type DataTransferCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string               `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *DataTransferRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *DataTransferCall) Reset() {
+	*x = DataTransferCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataTransferCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataTransferCall) ProtoMessage() {}
+
+func (x *DataTransferCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataTransferCall.ProtoReflect.Descriptor instead.
+func (*DataTransferCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DataTransferCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataTransferCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *DataTransferCall) GetRequest() *DataTransferRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DataTransferResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*DataTransferResult_Response
+	//	*DataTransferResult_Error
+	Result isDataTransferResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *DataTransferResult) Reset() {
+	*x = DataTransferResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataTransferResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataTransferResult) ProtoMessage() {}
+
+func (x *DataTransferResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataTransferResult.ProtoReflect.Descriptor instead.
+func (*DataTransferResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{25}
+}
+
+func (m *DataTransferResult) GetResult() isDataTransferResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *DataTransferResult) GetResponse() *DataTransferResponse {
+	if x, ok := x.GetResult().(*DataTransferResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DataTransferResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*DataTransferResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isDataTransferResult_Result interface {
+	isDataTransferResult_Result()
+}
+
+type DataTransferResult_Response struct {
+	Response *DataTransferResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type DataTransferResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DataTransferResult_Response) isDataTransferResult_Result() {}
+
+func (*DataTransferResult_Error) isDataTransferResult_Result() {}
+
+type DeleteCertificateCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                    `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *DeleteCertificateRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *DeleteCertificateCall) Reset() {
+	*x = DeleteCertificateCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCertificateCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCertificateCall) ProtoMessage() {}
+
+func (x *DeleteCertificateCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCertificateCall.ProtoReflect.Descriptor instead.
+func (*DeleteCertificateCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteCertificateCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCertificateCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *DeleteCertificateCall) GetRequest() *DeleteCertificateRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DeleteCertificateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*DeleteCertificateResult_Response
+	//	*DeleteCertificateResult_Error
+	Result isDeleteCertificateResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *DeleteCertificateResult) Reset() {
+	*x = DeleteCertificateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCertificateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCertificateResult) ProtoMessage() {}
+
+func (x *DeleteCertificateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCertificateResult.ProtoReflect.Descriptor instead.
+func (*DeleteCertificateResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{27}
+}
+
+func (m *DeleteCertificateResult) GetResult() isDeleteCertificateResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *DeleteCertificateResult) GetResponse() *DeleteCertificateResponse {
+	if x, ok := x.GetResult().(*DeleteCertificateResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DeleteCertificateResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*DeleteCertificateResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isDeleteCertificateResult_Result interface {
+	isDeleteCertificateResult_Result()
+}
+
+type DeleteCertificateResult_Response struct {
+	Response *DeleteCertificateResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type DeleteCertificateResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DeleteCertificateResult_Response) isDeleteCertificateResult_Result() {}
+
+func (*DeleteCertificateResult_Error) isDeleteCertificateResult_Result() {}
+
+type DiagnosticsStatusNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                                `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *DiagnosticsStatusNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *DiagnosticsStatusNotificationCall) Reset() {
+	*x = DiagnosticsStatusNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsStatusNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsStatusNotificationCall) ProtoMessage() {}
+
+func (x *DiagnosticsStatusNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsStatusNotificationCall.ProtoReflect.Descriptor instead.
+func (*DiagnosticsStatusNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DiagnosticsStatusNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiagnosticsStatusNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *DiagnosticsStatusNotificationCall) GetRequest() *DiagnosticsStatusNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DiagnosticsStatusNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*DiagnosticsStatusNotificationResult_Response
+	//	*DiagnosticsStatusNotificationResult_Error
+	Result isDiagnosticsStatusNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *DiagnosticsStatusNotificationResult) Reset() {
+	*x = DiagnosticsStatusNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsStatusNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsStatusNotificationResult) ProtoMessage() {}
+
+func (x *DiagnosticsStatusNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsStatusNotificationResult.ProtoReflect.Descriptor instead.
+func (*DiagnosticsStatusNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *DiagnosticsStatusNotificationResult) GetResult() isDiagnosticsStatusNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *DiagnosticsStatusNotificationResult) GetResponse() *DiagnosticsStatusNotificationResponse {
+	if x, ok := x.GetResult().(*DiagnosticsStatusNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DiagnosticsStatusNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*DiagnosticsStatusNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isDiagnosticsStatusNotificationResult_Result interface {
+	isDiagnosticsStatusNotificationResult_Result()
+}
+
+type DiagnosticsStatusNotificationResult_Response struct {
+	Response *DiagnosticsStatusNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type DiagnosticsStatusNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*DiagnosticsStatusNotificationResult_Response) isDiagnosticsStatusNotificationResult_Result() {}
+
+func (*DiagnosticsStatusNotificationResult_Error) isDiagnosticsStatusNotificationResult_Result() {}
+
+type ExtendedTriggerMessageCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                         `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ExtendedTriggerMessageRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ExtendedTriggerMessageCall) Reset() {
+	*x = ExtendedTriggerMessageCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedTriggerMessageCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedTriggerMessageCall) ProtoMessage() {}
+
+func (x *ExtendedTriggerMessageCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedTriggerMessageCall.ProtoReflect.Descriptor instead.
+func (*ExtendedTriggerMessageCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExtendedTriggerMessageCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExtendedTriggerMessageCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ExtendedTriggerMessageCall) GetRequest() *ExtendedTriggerMessageRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ExtendedTriggerMessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ExtendedTriggerMessageResult_Response
+	//	*ExtendedTriggerMessageResult_Error
+	Result isExtendedTriggerMessageResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ExtendedTriggerMessageResult) Reset() {
+	*x = ExtendedTriggerMessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedTriggerMessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedTriggerMessageResult) ProtoMessage() {}
+
+func (x *ExtendedTriggerMessageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedTriggerMessageResult.ProtoReflect.Descriptor instead.
+func (*ExtendedTriggerMessageResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{31}
+}
+
+func (m *ExtendedTriggerMessageResult) GetResult() isExtendedTriggerMessageResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ExtendedTriggerMessageResult) GetResponse() *ExtendedTriggerMessageResponse {
+	if x, ok := x.GetResult().(*ExtendedTriggerMessageResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ExtendedTriggerMessageResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ExtendedTriggerMessageResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isExtendedTriggerMessageResult_Result interface {
+	isExtendedTriggerMessageResult_Result()
+}
+
+type ExtendedTriggerMessageResult_Response struct {
+	Response *ExtendedTriggerMessageResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ExtendedTriggerMessageResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ExtendedTriggerMessageResult_Response) isExtendedTriggerMessageResult_Result() {}
+
+func (*ExtendedTriggerMessageResult_Error) isExtendedTriggerMessageResult_Result() {}
+
+type FirmwareStatusNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                             `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *FirmwareStatusNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *FirmwareStatusNotificationCall) Reset() {
+	*x = FirmwareStatusNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareStatusNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareStatusNotificationCall) ProtoMessage() {}
+
+func (x *FirmwareStatusNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareStatusNotificationCall.ProtoReflect.Descriptor instead.
+func (*FirmwareStatusNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FirmwareStatusNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FirmwareStatusNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *FirmwareStatusNotificationCall) GetRequest() *FirmwareStatusNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type FirmwareStatusNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*FirmwareStatusNotificationResult_Response
+	//	*FirmwareStatusNotificationResult_Error
+	Result isFirmwareStatusNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *FirmwareStatusNotificationResult) Reset() {
+	*x = FirmwareStatusNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareStatusNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareStatusNotificationResult) ProtoMessage() {}
+
+func (x *FirmwareStatusNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareStatusNotificationResult.ProtoReflect.Descriptor instead.
+func (*FirmwareStatusNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{33}
+}
+
+func (m *FirmwareStatusNotificationResult) GetResult() isFirmwareStatusNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *FirmwareStatusNotificationResult) GetResponse() *FirmwareStatusNotificationResponse {
+	if x, ok := x.GetResult().(*FirmwareStatusNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *FirmwareStatusNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*FirmwareStatusNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isFirmwareStatusNotificationResult_Result interface {
+	isFirmwareStatusNotificationResult_Result()
+}
+
+type FirmwareStatusNotificationResult_Response struct {
+	Response *FirmwareStatusNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type FirmwareStatusNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*FirmwareStatusNotificationResult_Response) isFirmwareStatusNotificationResult_Result() {}
+
+func (*FirmwareStatusNotificationResult_Error) isFirmwareStatusNotificationResult_Result() {}
+
+type GetCompositeScheduleCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                       `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetCompositeScheduleRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetCompositeScheduleCall) Reset() {
+	*x = GetCompositeScheduleCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompositeScheduleCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositeScheduleCall) ProtoMessage() {}
+
+func (x *GetCompositeScheduleCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositeScheduleCall.ProtoReflect.Descriptor instead.
+func (*GetCompositeScheduleCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCompositeScheduleCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCompositeScheduleCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetCompositeScheduleCall) GetRequest() *GetCompositeScheduleRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetCompositeScheduleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetCompositeScheduleResult_Response
+	//	*GetCompositeScheduleResult_Error
+	Result isGetCompositeScheduleResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetCompositeScheduleResult) Reset() {
+	*x = GetCompositeScheduleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompositeScheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositeScheduleResult) ProtoMessage() {}
+
+func (x *GetCompositeScheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositeScheduleResult.ProtoReflect.Descriptor instead.
+func (*GetCompositeScheduleResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{35}
+}
+
+func (m *GetCompositeScheduleResult) GetResult() isGetCompositeScheduleResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetCompositeScheduleResult) GetResponse() *GetCompositeScheduleResponse {
+	if x, ok := x.GetResult().(*GetCompositeScheduleResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetCompositeScheduleResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetCompositeScheduleResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetCompositeScheduleResult_Result interface {
+	isGetCompositeScheduleResult_Result()
+}
+
+type GetCompositeScheduleResult_Response struct {
+	Response *GetCompositeScheduleResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetCompositeScheduleResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetCompositeScheduleResult_Response) isGetCompositeScheduleResult_Result() {}
+
+func (*GetCompositeScheduleResult_Error) isGetCompositeScheduleResult_Result() {}
+
+type GetConfigurationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                   `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetConfigurationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetConfigurationCall) Reset() {
+	*x = GetConfigurationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigurationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationCall) ProtoMessage() {}
+
+func (x *GetConfigurationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationCall.ProtoReflect.Descriptor instead.
+func (*GetConfigurationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetConfigurationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetConfigurationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetConfigurationCall) GetRequest() *GetConfigurationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetConfigurationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetConfigurationResult_Response
+	//	*GetConfigurationResult_Error
+	Result isGetConfigurationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetConfigurationResult) Reset() {
+	*x = GetConfigurationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigurationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationResult) ProtoMessage() {}
+
+func (x *GetConfigurationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationResult.ProtoReflect.Descriptor instead.
+func (*GetConfigurationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *GetConfigurationResult) GetResult() isGetConfigurationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetConfigurationResult) GetResponse() *GetConfigurationResponse {
+	if x, ok := x.GetResult().(*GetConfigurationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetConfigurationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetConfigurationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetConfigurationResult_Result interface {
+	isGetConfigurationResult_Result()
+}
+
+type GetConfigurationResult_Response struct {
+	Response *GetConfigurationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetConfigurationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetConfigurationResult_Response) isGetConfigurationResult_Result() {}
+
+func (*GetConfigurationResult_Error) isGetConfigurationResult_Result() {}
+
+type GetDiagnosticsCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                 `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetDiagnosticsRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetDiagnosticsCall) Reset() {
+	*x = GetDiagnosticsCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsCall) ProtoMessage() {}
+
+func (x *GetDiagnosticsCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsCall.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDiagnosticsCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsCall) GetRequest() *GetDiagnosticsRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetDiagnosticsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetDiagnosticsResult_Response
+	//	*GetDiagnosticsResult_Error
+	Result isGetDiagnosticsResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetDiagnosticsResult) Reset() {
+	*x = GetDiagnosticsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsResult) ProtoMessage() {}
+
+func (x *GetDiagnosticsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsResult.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{39}
+}
+
+func (m *GetDiagnosticsResult) GetResult() isGetDiagnosticsResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResult) GetResponse() *GetDiagnosticsResponse {
+	if x, ok := x.GetResult().(*GetDiagnosticsResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetDiagnosticsResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetDiagnosticsResult_Result interface {
+	isGetDiagnosticsResult_Result()
+}
+
+type GetDiagnosticsResult_Response struct {
+	Response *GetDiagnosticsResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetDiagnosticsResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetDiagnosticsResult_Response) isGetDiagnosticsResult_Result() {}
+
+func (*GetDiagnosticsResult_Error) isGetDiagnosticsResult_Result() {}
+
+type GetInstalledCertificateIdsCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                             `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetInstalledCertificateIdsRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetInstalledCertificateIdsCall) Reset() {
+	*x = GetInstalledCertificateIdsCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledCertificateIdsCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledCertificateIdsCall) ProtoMessage() {}
+
+func (x *GetInstalledCertificateIdsCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledCertificateIdsCall.ProtoReflect.Descriptor instead.
+func (*GetInstalledCertificateIdsCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetInstalledCertificateIdsCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetInstalledCertificateIdsCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetInstalledCertificateIdsCall) GetRequest() *GetInstalledCertificateIdsRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetInstalledCertificateIdsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetInstalledCertificateIdsResult_Response
+	//	*GetInstalledCertificateIdsResult_Error
+	Result isGetInstalledCertificateIdsResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetInstalledCertificateIdsResult) Reset() {
+	*x = GetInstalledCertificateIdsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledCertificateIdsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledCertificateIdsResult) ProtoMessage() {}
+
+func (x *GetInstalledCertificateIdsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledCertificateIdsResult.ProtoReflect.Descriptor instead.
+func (*GetInstalledCertificateIdsResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *GetInstalledCertificateIdsResult) GetResult() isGetInstalledCertificateIdsResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetInstalledCertificateIdsResult) GetResponse() *GetInstalledCertificateIdsResponse {
+	if x, ok := x.GetResult().(*GetInstalledCertificateIdsResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetInstalledCertificateIdsResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetInstalledCertificateIdsResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetInstalledCertificateIdsResult_Result interface {
+	isGetInstalledCertificateIdsResult_Result()
+}
+
+type GetInstalledCertificateIdsResult_Response struct {
+	Response *GetInstalledCertificateIdsResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetInstalledCertificateIdsResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetInstalledCertificateIdsResult_Response) isGetInstalledCertificateIdsResult_Result() {}
+
+func (*GetInstalledCertificateIdsResult_Error) isGetInstalledCertificateIdsResult_Result() {}
+
+type GetLocalListVersionCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                      `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetLocalListVersionRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetLocalListVersionCall) Reset() {
+	*x = GetLocalListVersionCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocalListVersionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalListVersionCall) ProtoMessage() {}
+
+func (x *GetLocalListVersionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalListVersionCall.ProtoReflect.Descriptor instead.
+func (*GetLocalListVersionCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLocalListVersionCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLocalListVersionCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetLocalListVersionCall) GetRequest() *GetLocalListVersionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetLocalListVersionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetLocalListVersionResult_Response
+	//	*GetLocalListVersionResult_Error
+	Result isGetLocalListVersionResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetLocalListVersionResult) Reset() {
+	*x = GetLocalListVersionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocalListVersionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalListVersionResult) ProtoMessage() {}
+
+func (x *GetLocalListVersionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalListVersionResult.ProtoReflect.Descriptor instead.
+func (*GetLocalListVersionResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *GetLocalListVersionResult) GetResult() isGetLocalListVersionResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetLocalListVersionResult) GetResponse() *GetLocalListVersionResponse {
+	if x, ok := x.GetResult().(*GetLocalListVersionResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLocalListVersionResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetLocalListVersionResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetLocalListVersionResult_Result interface {
+	isGetLocalListVersionResult_Result()
+}
+
+type GetLocalListVersionResult_Response struct {
+	Response *GetLocalListVersionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetLocalListVersionResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetLocalListVersionResult_Response) isGetLocalListVersionResult_Result() {}
+
+func (*GetLocalListVersionResult_Error) isGetLocalListVersionResult_Result() {}
+
+type GetLogCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string         `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *GetLogRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetLogCall) Reset() {
+	*x = GetLogCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogCall) ProtoMessage() {}
+
+func (x *GetLogCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogCall.ProtoReflect.Descriptor instead.
+func (*GetLogCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetLogCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLogCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *GetLogCall) GetRequest() *GetLogRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetLogResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*GetLogResult_Response
+	//	*GetLogResult_Error
+	Result isGetLogResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *GetLogResult) Reset() {
+	*x = GetLogResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogResult) ProtoMessage() {}
+
+func (x *GetLogResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogResult.ProtoReflect.Descriptor instead.
+func (*GetLogResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{45}
+}
+
+func (m *GetLogResult) GetResult() isGetLogResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetLogResult) GetResponse() *GetLogResponse {
+	if x, ok := x.GetResult().(*GetLogResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLogResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*GetLogResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGetLogResult_Result interface {
+	isGetLogResult_Result()
+}
+
+type GetLogResult_Response struct {
+	Response *GetLogResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type GetLogResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetLogResult_Response) isGetLogResult_Result() {}
+
+func (*GetLogResult_Error) isGetLogResult_Result() {}
+
+type HeartbeatCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string            `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *HeartbeatRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *HeartbeatCall) Reset() {
+	*x = HeartbeatCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatCall) ProtoMessage() {}
+
+func (x *HeartbeatCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatCall.ProtoReflect.Descriptor instead.
+func (*HeartbeatCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HeartbeatCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HeartbeatCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *HeartbeatCall) GetRequest() *HeartbeatRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type HeartbeatResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*HeartbeatResult_Response
+	//	*HeartbeatResult_Error
+	Result isHeartbeatResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *HeartbeatResult) Reset() {
+	*x = HeartbeatResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResult) ProtoMessage() {}
+
+func (x *HeartbeatResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResult.ProtoReflect.Descriptor instead.
+func (*HeartbeatResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{47}
+}
+
+func (m *HeartbeatResult) GetResult() isHeartbeatResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *HeartbeatResult) GetResponse() *HeartbeatResponse {
+	if x, ok := x.GetResult().(*HeartbeatResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *HeartbeatResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*HeartbeatResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isHeartbeatResult_Result interface {
+	isHeartbeatResult_Result()
+}
+
+type HeartbeatResult_Response struct {
+	Response *HeartbeatResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type HeartbeatResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*HeartbeatResult_Response) isHeartbeatResult_Result() {}
+
+func (*HeartbeatResult_Error) isHeartbeatResult_Result() {}
+
+type InstallCertificateCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                     `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *InstallCertificateRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *InstallCertificateCall) Reset() {
+	*x = InstallCertificateCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallCertificateCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallCertificateCall) ProtoMessage() {}
+
+func (x *InstallCertificateCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallCertificateCall.ProtoReflect.Descriptor instead.
+func (*InstallCertificateCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InstallCertificateCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstallCertificateCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *InstallCertificateCall) GetRequest() *InstallCertificateRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type InstallCertificateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*InstallCertificateResult_Response
+	//	*InstallCertificateResult_Error
+	Result isInstallCertificateResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *InstallCertificateResult) Reset() {
+	*x = InstallCertificateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallCertificateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallCertificateResult) ProtoMessage() {}
+
+func (x *InstallCertificateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallCertificateResult.ProtoReflect.Descriptor instead.
+func (*InstallCertificateResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{49}
+}
+
+func (m *InstallCertificateResult) GetResult() isInstallCertificateResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *InstallCertificateResult) GetResponse() *InstallCertificateResponse {
+	if x, ok := x.GetResult().(*InstallCertificateResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *InstallCertificateResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*InstallCertificateResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isInstallCertificateResult_Result interface {
+	isInstallCertificateResult_Result()
+}
+
+type InstallCertificateResult_Response struct {
+	Response *InstallCertificateResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type InstallCertificateResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*InstallCertificateResult_Response) isInstallCertificateResult_Result() {}
+
+func (*InstallCertificateResult_Error) isInstallCertificateResult_Result() {}
+
+type LogStatusNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                        `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *LogStatusNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *LogStatusNotificationCall) Reset() {
+	*x = LogStatusNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStatusNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStatusNotificationCall) ProtoMessage() {}
+
+func (x *LogStatusNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStatusNotificationCall.ProtoReflect.Descriptor instead.
+func (*LogStatusNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LogStatusNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LogStatusNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *LogStatusNotificationCall) GetRequest() *LogStatusNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type LogStatusNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*LogStatusNotificationResult_Response
+	//	*LogStatusNotificationResult_Error
+	Result isLogStatusNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *LogStatusNotificationResult) Reset() {
+	*x = LogStatusNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStatusNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStatusNotificationResult) ProtoMessage() {}
+
+func (x *LogStatusNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStatusNotificationResult.ProtoReflect.Descriptor instead.
+func (*LogStatusNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{51}
+}
+
+func (m *LogStatusNotificationResult) GetResult() isLogStatusNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *LogStatusNotificationResult) GetResponse() *LogStatusNotificationResponse {
+	if x, ok := x.GetResult().(*LogStatusNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *LogStatusNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*LogStatusNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isLogStatusNotificationResult_Result interface {
+	isLogStatusNotificationResult_Result()
+}
+
+type LogStatusNotificationResult_Response struct {
+	Response *LogStatusNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type LogStatusNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*LogStatusNotificationResult_Response) isLogStatusNotificationResult_Result() {}
+
+func (*LogStatusNotificationResult_Error) isLogStatusNotificationResult_Result() {}
+
+type MeterValuesCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string              `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *MeterValuesRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *MeterValuesCall) Reset() {
+	*x = MeterValuesCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterValuesCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValuesCall) ProtoMessage() {}
+
+func (x *MeterValuesCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValuesCall.ProtoReflect.Descriptor instead.
+func (*MeterValuesCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MeterValuesCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MeterValuesCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *MeterValuesCall) GetRequest() *MeterValuesRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type MeterValuesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*MeterValuesResult_Response
+	//	*MeterValuesResult_Error
+	Result isMeterValuesResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *MeterValuesResult) Reset() {
+	*x = MeterValuesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterValuesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValuesResult) ProtoMessage() {}
+
+func (x *MeterValuesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValuesResult.ProtoReflect.Descriptor instead.
+func (*MeterValuesResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{53}
+}
+
+func (m *MeterValuesResult) GetResult() isMeterValuesResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *MeterValuesResult) GetResponse() *MeterValuesResponse {
+	if x, ok := x.GetResult().(*MeterValuesResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *MeterValuesResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*MeterValuesResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isMeterValuesResult_Result interface {
+	isMeterValuesResult_Result()
+}
+
+type MeterValuesResult_Response struct {
+	Response *MeterValuesResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type MeterValuesResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*MeterValuesResult_Response) isMeterValuesResult_Result() {}
+
+func (*MeterValuesResult_Error) isMeterValuesResult_Result() {}
+
+type RemoteStartTransactionCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                         `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *RemoteStartTransactionRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *RemoteStartTransactionCall) Reset() {
+	*x = RemoteStartTransactionCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStartTransactionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStartTransactionCall) ProtoMessage() {}
+
+func (x *RemoteStartTransactionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStartTransactionCall.ProtoReflect.Descriptor instead.
+func (*RemoteStartTransactionCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RemoteStartTransactionCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoteStartTransactionCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *RemoteStartTransactionCall) GetRequest() *RemoteStartTransactionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RemoteStartTransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*RemoteStartTransactionResult_Response
+	//	*RemoteStartTransactionResult_Error
+	Result isRemoteStartTransactionResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *RemoteStartTransactionResult) Reset() {
+	*x = RemoteStartTransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStartTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStartTransactionResult) ProtoMessage() {}
+
+func (x *RemoteStartTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStartTransactionResult.ProtoReflect.Descriptor instead.
+func (*RemoteStartTransactionResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{55}
+}
+
+func (m *RemoteStartTransactionResult) GetResult() isRemoteStartTransactionResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *RemoteStartTransactionResult) GetResponse() *RemoteStartTransactionResponse {
+	if x, ok := x.GetResult().(*RemoteStartTransactionResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RemoteStartTransactionResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*RemoteStartTransactionResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRemoteStartTransactionResult_Result interface {
+	isRemoteStartTransactionResult_Result()
+}
+
+type RemoteStartTransactionResult_Response struct {
+	Response *RemoteStartTransactionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type RemoteStartTransactionResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RemoteStartTransactionResult_Response) isRemoteStartTransactionResult_Result() {}
+
+func (*RemoteStartTransactionResult_Error) isRemoteStartTransactionResult_Result() {}
+
+type RemoteStopTransactionCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                        `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *RemoteStopTransactionRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *RemoteStopTransactionCall) Reset() {
+	*x = RemoteStopTransactionCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStopTransactionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStopTransactionCall) ProtoMessage() {}
+
+func (x *RemoteStopTransactionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStopTransactionCall.ProtoReflect.Descriptor instead.
+func (*RemoteStopTransactionCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RemoteStopTransactionCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoteStopTransactionCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *RemoteStopTransactionCall) GetRequest() *RemoteStopTransactionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RemoteStopTransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*RemoteStopTransactionResult_Response
+	//	*RemoteStopTransactionResult_Error
+	Result isRemoteStopTransactionResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *RemoteStopTransactionResult) Reset() {
+	*x = RemoteStopTransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStopTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStopTransactionResult) ProtoMessage() {}
+
+func (x *RemoteStopTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStopTransactionResult.ProtoReflect.Descriptor instead.
+func (*RemoteStopTransactionResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{57}
+}
+
+func (m *RemoteStopTransactionResult) GetResult() isRemoteStopTransactionResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *RemoteStopTransactionResult) GetResponse() *RemoteStopTransactionResponse {
+	if x, ok := x.GetResult().(*RemoteStopTransactionResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *RemoteStopTransactionResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*RemoteStopTransactionResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isRemoteStopTransactionResult_Result interface {
+	isRemoteStopTransactionResult_Result()
+}
+
+type RemoteStopTransactionResult_Response struct {
+	Response *RemoteStopTransactionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type RemoteStopTransactionResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*RemoteStopTransactionResult_Response) isRemoteStopTransactionResult_Result() {}
+
+func (*RemoteStopTransactionResult_Error) isRemoteStopTransactionResult_Result() {}
+
+type ReserveNowCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string             `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ReserveNowRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ReserveNowCall) Reset() {
+	*x = ReserveNowCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveNowCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveNowCall) ProtoMessage() {}
+
+func (x *ReserveNowCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveNowCall.ProtoReflect.Descriptor instead.
+func (*ReserveNowCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReserveNowCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReserveNowCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ReserveNowCall) GetRequest() *ReserveNowRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ReserveNowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ReserveNowResult_Response
+	//	*ReserveNowResult_Error
+	Result isReserveNowResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ReserveNowResult) Reset() {
+	*x = ReserveNowResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveNowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveNowResult) ProtoMessage() {}
+
+func (x *ReserveNowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveNowResult.ProtoReflect.Descriptor instead.
+func (*ReserveNowResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{59}
+}
+
+func (m *ReserveNowResult) GetResult() isReserveNowResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ReserveNowResult) GetResponse() *ReserveNowResponse {
+	if x, ok := x.GetResult().(*ReserveNowResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ReserveNowResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ReserveNowResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isReserveNowResult_Result interface {
+	isReserveNowResult_Result()
+}
+
+type ReserveNowResult_Response struct {
+	Response *ReserveNowResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ReserveNowResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ReserveNowResult_Response) isReserveNowResult_Result() {}
+
+func (*ReserveNowResult_Error) isReserveNowResult_Result() {}
+
+type ResetCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string        `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *ResetRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ResetCall) Reset() {
+	*x = ResetCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCall) ProtoMessage() {}
+
+func (x *ResetCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCall.ProtoReflect.Descriptor instead.
+func (*ResetCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResetCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResetCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *ResetCall) GetRequest() *ResetRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ResetResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*ResetResult_Response
+	//	*ResetResult_Error
+	Result isResetResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *ResetResult) Reset() {
+	*x = ResetResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetResult) ProtoMessage() {}
+
+func (x *ResetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetResult.ProtoReflect.Descriptor instead.
+func (*ResetResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{61}
+}
+
+func (m *ResetResult) GetResult() isResetResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ResetResult) GetResponse() *ResetResponse {
+	if x, ok := x.GetResult().(*ResetResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ResetResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*ResetResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isResetResult_Result interface {
+	isResetResult_Result()
+}
+
+type ResetResult_Response struct {
+	Response *ResetResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type ResetResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ResetResult_Response) isResetResult_Result() {}
+
+func (*ResetResult_Error) isResetResult_Result() {}
+
+type SecurityEventNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                            `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SecurityEventNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SecurityEventNotificationCall) Reset() {
+	*x = SecurityEventNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityEventNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEventNotificationCall) ProtoMessage() {}
+
+func (x *SecurityEventNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEventNotificationCall.ProtoReflect.Descriptor instead.
+func (*SecurityEventNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SecurityEventNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityEventNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SecurityEventNotificationCall) GetRequest() *SecurityEventNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SecurityEventNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SecurityEventNotificationResult_Response
+	//	*SecurityEventNotificationResult_Error
+	Result isSecurityEventNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SecurityEventNotificationResult) Reset() {
+	*x = SecurityEventNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityEventNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEventNotificationResult) ProtoMessage() {}
+
+func (x *SecurityEventNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEventNotificationResult.ProtoReflect.Descriptor instead.
+func (*SecurityEventNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{63}
+}
+
+func (m *SecurityEventNotificationResult) GetResult() isSecurityEventNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SecurityEventNotificationResult) GetResponse() *SecurityEventNotificationResponse {
+	if x, ok := x.GetResult().(*SecurityEventNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SecurityEventNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SecurityEventNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSecurityEventNotificationResult_Result interface {
+	isSecurityEventNotificationResult_Result()
+}
+
+type SecurityEventNotificationResult_Response struct {
+	Response *SecurityEventNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SecurityEventNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SecurityEventNotificationResult_Response) isSecurityEventNotificationResult_Result() {}
+
+func (*SecurityEventNotificationResult_Error) isSecurityEventNotificationResult_Result() {}
+
+type SendLocalListCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SendLocalListRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SendLocalListCall) Reset() {
+	*x = SendLocalListCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendLocalListCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLocalListCall) ProtoMessage() {}
+
+func (x *SendLocalListCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLocalListCall.ProtoReflect.Descriptor instead.
+func (*SendLocalListCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SendLocalListCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SendLocalListCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SendLocalListCall) GetRequest() *SendLocalListRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SendLocalListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SendLocalListResult_Response
+	//	*SendLocalListResult_Error
+	Result isSendLocalListResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SendLocalListResult) Reset() {
+	*x = SendLocalListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendLocalListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLocalListResult) ProtoMessage() {}
+
+func (x *SendLocalListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLocalListResult.ProtoReflect.Descriptor instead.
+func (*SendLocalListResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{65}
+}
+
+func (m *SendLocalListResult) GetResult() isSendLocalListResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SendLocalListResult) GetResponse() *SendLocalListResponse {
+	if x, ok := x.GetResult().(*SendLocalListResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SendLocalListResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SendLocalListResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSendLocalListResult_Result interface {
+	isSendLocalListResult_Result()
+}
+
+type SendLocalListResult_Response struct {
+	Response *SendLocalListResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SendLocalListResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SendLocalListResult_Response) isSendLocalListResult_Result() {}
+
+func (*SendLocalListResult_Error) isSendLocalListResult_Result() {}
+
+type SetChargingProfileCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                     `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SetChargingProfileRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SetChargingProfileCall) Reset() {
+	*x = SetChargingProfileCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChargingProfileCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChargingProfileCall) ProtoMessage() {}
+
+func (x *SetChargingProfileCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChargingProfileCall.ProtoReflect.Descriptor instead.
+func (*SetChargingProfileCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetChargingProfileCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetChargingProfileCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SetChargingProfileCall) GetRequest() *SetChargingProfileRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SetChargingProfileResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SetChargingProfileResult_Response
+	//	*SetChargingProfileResult_Error
+	Result isSetChargingProfileResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SetChargingProfileResult) Reset() {
+	*x = SetChargingProfileResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChargingProfileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChargingProfileResult) ProtoMessage() {}
+
+func (x *SetChargingProfileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChargingProfileResult.ProtoReflect.Descriptor instead.
+func (*SetChargingProfileResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{67}
+}
+
+func (m *SetChargingProfileResult) GetResult() isSetChargingProfileResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SetChargingProfileResult) GetResponse() *SetChargingProfileResponse {
+	if x, ok := x.GetResult().(*SetChargingProfileResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SetChargingProfileResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SetChargingProfileResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSetChargingProfileResult_Result interface {
+	isSetChargingProfileResult_Result()
+}
+
+type SetChargingProfileResult_Response struct {
+	Response *SetChargingProfileResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SetChargingProfileResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SetChargingProfileResult_Response) isSetChargingProfileResult_Result() {}
+
+func (*SetChargingProfileResult_Error) isSetChargingProfileResult_Result() {}
+
+type SignCertificateCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                  `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SignCertificateRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SignCertificateCall) Reset() {
+	*x = SignCertificateCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCertificateCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCertificateCall) ProtoMessage() {}
+
+func (x *SignCertificateCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCertificateCall.ProtoReflect.Descriptor instead.
+func (*SignCertificateCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SignCertificateCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SignCertificateCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SignCertificateCall) GetRequest() *SignCertificateRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SignCertificateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SignCertificateResult_Response
+	//	*SignCertificateResult_Error
+	Result isSignCertificateResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SignCertificateResult) Reset() {
+	*x = SignCertificateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCertificateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCertificateResult) ProtoMessage() {}
+
+func (x *SignCertificateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCertificateResult.ProtoReflect.Descriptor instead.
+func (*SignCertificateResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{69}
+}
+
+func (m *SignCertificateResult) GetResult() isSignCertificateResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SignCertificateResult) GetResponse() *SignCertificateResponse {
+	if x, ok := x.GetResult().(*SignCertificateResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SignCertificateResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SignCertificateResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSignCertificateResult_Result interface {
+	isSignCertificateResult_Result()
+}
+
+type SignCertificateResult_Response struct {
+	Response *SignCertificateResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SignCertificateResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SignCertificateResult_Response) isSignCertificateResult_Result() {}
+
+func (*SignCertificateResult_Error) isSignCertificateResult_Result() {}
+
+type SignedFirmwareStatusNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                                   `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SignedFirmwareStatusNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SignedFirmwareStatusNotificationCall) Reset() {
+	*x = SignedFirmwareStatusNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedFirmwareStatusNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedFirmwareStatusNotificationCall) ProtoMessage() {}
+
+func (x *SignedFirmwareStatusNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedFirmwareStatusNotificationCall.ProtoReflect.Descriptor instead.
+func (*SignedFirmwareStatusNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SignedFirmwareStatusNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SignedFirmwareStatusNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SignedFirmwareStatusNotificationCall) GetRequest() *SignedFirmwareStatusNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SignedFirmwareStatusNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SignedFirmwareStatusNotificationResult_Response
+	//	*SignedFirmwareStatusNotificationResult_Error
+	Result isSignedFirmwareStatusNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SignedFirmwareStatusNotificationResult) Reset() {
+	*x = SignedFirmwareStatusNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedFirmwareStatusNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedFirmwareStatusNotificationResult) ProtoMessage() {}
+
+func (x *SignedFirmwareStatusNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedFirmwareStatusNotificationResult.ProtoReflect.Descriptor instead.
+func (*SignedFirmwareStatusNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{71}
+}
+
+func (m *SignedFirmwareStatusNotificationResult) GetResult() isSignedFirmwareStatusNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SignedFirmwareStatusNotificationResult) GetResponse() *SignedFirmwareStatusNotificationResponse {
+	if x, ok := x.GetResult().(*SignedFirmwareStatusNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SignedFirmwareStatusNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SignedFirmwareStatusNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSignedFirmwareStatusNotificationResult_Result interface {
+	isSignedFirmwareStatusNotificationResult_Result()
+}
+
+type SignedFirmwareStatusNotificationResult_Response struct {
+	Response *SignedFirmwareStatusNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SignedFirmwareStatusNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SignedFirmwareStatusNotificationResult_Response) isSignedFirmwareStatusNotificationResult_Result() {
+}
+
+func (*SignedFirmwareStatusNotificationResult_Error) isSignedFirmwareStatusNotificationResult_Result() {
+}
+
+type SignedUpdateFirmwareCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                       `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *SignedUpdateFirmwareRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *SignedUpdateFirmwareCall) Reset() {
+	*x = SignedUpdateFirmwareCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedUpdateFirmwareCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedUpdateFirmwareCall) ProtoMessage() {}
+
+func (x *SignedUpdateFirmwareCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedUpdateFirmwareCall.ProtoReflect.Descriptor instead.
+func (*SignedUpdateFirmwareCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SignedUpdateFirmwareCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SignedUpdateFirmwareCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *SignedUpdateFirmwareCall) GetRequest() *SignedUpdateFirmwareRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type SignedUpdateFirmwareResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*SignedUpdateFirmwareResult_Response
+	//	*SignedUpdateFirmwareResult_Error
+	Result isSignedUpdateFirmwareResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *SignedUpdateFirmwareResult) Reset() {
+	*x = SignedUpdateFirmwareResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedUpdateFirmwareResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedUpdateFirmwareResult) ProtoMessage() {}
+
+func (x *SignedUpdateFirmwareResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedUpdateFirmwareResult.ProtoReflect.Descriptor instead.
+func (*SignedUpdateFirmwareResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{73}
+}
+
+func (m *SignedUpdateFirmwareResult) GetResult() isSignedUpdateFirmwareResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SignedUpdateFirmwareResult) GetResponse() *SignedUpdateFirmwareResponse {
+	if x, ok := x.GetResult().(*SignedUpdateFirmwareResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *SignedUpdateFirmwareResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*SignedUpdateFirmwareResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isSignedUpdateFirmwareResult_Result interface {
+	isSignedUpdateFirmwareResult_Result()
+}
+
+type SignedUpdateFirmwareResult_Response struct {
+	Response *SignedUpdateFirmwareResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type SignedUpdateFirmwareResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SignedUpdateFirmwareResult_Response) isSignedUpdateFirmwareResult_Result() {}
+
+func (*SignedUpdateFirmwareResult_Error) isSignedUpdateFirmwareResult_Result() {}
+
+type StartTransactionCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                   `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *StartTransactionRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *StartTransactionCall) Reset() {
+	*x = StartTransactionCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTransactionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTransactionCall) ProtoMessage() {}
+
+func (x *StartTransactionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTransactionCall.ProtoReflect.Descriptor instead.
+func (*StartTransactionCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *StartTransactionCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartTransactionCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *StartTransactionCall) GetRequest() *StartTransactionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StartTransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*StartTransactionResult_Response
+	//	*StartTransactionResult_Error
+	Result isStartTransactionResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *StartTransactionResult) Reset() {
+	*x = StartTransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTransactionResult) ProtoMessage() {}
+
+func (x *StartTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTransactionResult.ProtoReflect.Descriptor instead.
+func (*StartTransactionResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{75}
+}
+
+func (m *StartTransactionResult) GetResult() isStartTransactionResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *StartTransactionResult) GetResponse() *StartTransactionResponse {
+	if x, ok := x.GetResult().(*StartTransactionResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StartTransactionResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*StartTransactionResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStartTransactionResult_Result interface {
+	isStartTransactionResult_Result()
+}
+
+type StartTransactionResult_Response struct {
+	Response *StartTransactionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type StartTransactionResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StartTransactionResult_Response) isStartTransactionResult_Result() {}
+
+func (*StartTransactionResult_Error) isStartTransactionResult_Result() {}
+
+type StatusNotificationCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                     `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *StatusNotificationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *StatusNotificationCall) Reset() {
+	*x = StatusNotificationCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusNotificationCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusNotificationCall) ProtoMessage() {}
+
+func (x *StatusNotificationCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusNotificationCall.ProtoReflect.Descriptor instead.
+func (*StatusNotificationCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StatusNotificationCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusNotificationCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *StatusNotificationCall) GetRequest() *StatusNotificationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StatusNotificationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*StatusNotificationResult_Response
+	//	*StatusNotificationResult_Error
+	Result isStatusNotificationResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *StatusNotificationResult) Reset() {
+	*x = StatusNotificationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusNotificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusNotificationResult) ProtoMessage() {}
+
+func (x *StatusNotificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusNotificationResult.ProtoReflect.Descriptor instead.
+func (*StatusNotificationResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{77}
+}
+
+func (m *StatusNotificationResult) GetResult() isStatusNotificationResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *StatusNotificationResult) GetResponse() *StatusNotificationResponse {
+	if x, ok := x.GetResult().(*StatusNotificationResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StatusNotificationResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*StatusNotificationResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStatusNotificationResult_Result interface {
+	isStatusNotificationResult_Result()
+}
+
+type StatusNotificationResult_Response struct {
+	Response *StatusNotificationResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type StatusNotificationResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StatusNotificationResult_Response) isStatusNotificationResult_Result() {}
+
+func (*StatusNotificationResult_Error) isStatusNotificationResult_Result() {}
+
+type StopTransactionCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                  `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *StopTransactionRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *StopTransactionCall) Reset() {
+	*x = StopTransactionCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTransactionCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTransactionCall) ProtoMessage() {}
+
+func (x *StopTransactionCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTransactionCall.ProtoReflect.Descriptor instead.
+func (*StopTransactionCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StopTransactionCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StopTransactionCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *StopTransactionCall) GetRequest() *StopTransactionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StopTransactionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*StopTransactionResult_Response
+	//	*StopTransactionResult_Error
+	Result isStopTransactionResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *StopTransactionResult) Reset() {
+	*x = StopTransactionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTransactionResult) ProtoMessage() {}
+
+func (x *StopTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTransactionResult.ProtoReflect.Descriptor instead.
+func (*StopTransactionResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{79}
+}
+
+func (m *StopTransactionResult) GetResult() isStopTransactionResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *StopTransactionResult) GetResponse() *StopTransactionResponse {
+	if x, ok := x.GetResult().(*StopTransactionResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *StopTransactionResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*StopTransactionResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isStopTransactionResult_Result interface {
+	isStopTransactionResult_Result()
+}
+
+type StopTransactionResult_Response struct {
+	Response *StopTransactionResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type StopTransactionResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*StopTransactionResult_Response) isStopTransactionResult_Result() {}
+
+func (*StopTransactionResult_Error) isStopTransactionResult_Result() {}
+
+type TriggerMessageCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                 `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *TriggerMessageRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *TriggerMessageCall) Reset() {
+	*x = TriggerMessageCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerMessageCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMessageCall) ProtoMessage() {}
+
+func (x *TriggerMessageCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMessageCall.ProtoReflect.Descriptor instead.
+func (*TriggerMessageCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TriggerMessageCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TriggerMessageCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *TriggerMessageCall) GetRequest() *TriggerMessageRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type TriggerMessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*TriggerMessageResult_Response
+	//	*TriggerMessageResult_Error
+	Result isTriggerMessageResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *TriggerMessageResult) Reset() {
+	*x = TriggerMessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerMessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMessageResult) ProtoMessage() {}
+
+func (x *TriggerMessageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMessageResult.ProtoReflect.Descriptor instead.
+func (*TriggerMessageResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{81}
+}
+
+func (m *TriggerMessageResult) GetResult() isTriggerMessageResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *TriggerMessageResult) GetResponse() *TriggerMessageResponse {
+	if x, ok := x.GetResult().(*TriggerMessageResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TriggerMessageResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*TriggerMessageResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isTriggerMessageResult_Result interface {
+	isTriggerMessageResult_Result()
+}
+
+type TriggerMessageResult_Response struct {
+	Response *TriggerMessageResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type TriggerMessageResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*TriggerMessageResult_Response) isTriggerMessageResult_Result() {}
+
+func (*TriggerMessageResult_Error) isTriggerMessageResult_Result() {}
+
+type UnlockConnectorCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                  `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *UnlockConnectorRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *UnlockConnectorCall) Reset() {
+	*x = UnlockConnectorCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockConnectorCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockConnectorCall) ProtoMessage() {}
+
+func (x *UnlockConnectorCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockConnectorCall.ProtoReflect.Descriptor instead.
+func (*UnlockConnectorCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UnlockConnectorCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnlockConnectorCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *UnlockConnectorCall) GetRequest() *UnlockConnectorRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type UnlockConnectorResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*UnlockConnectorResult_Response
+	//	*UnlockConnectorResult_Error
+	Result isUnlockConnectorResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *UnlockConnectorResult) Reset() {
+	*x = UnlockConnectorResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockConnectorResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockConnectorResult) ProtoMessage() {}
+
+func (x *UnlockConnectorResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockConnectorResult.ProtoReflect.Descriptor instead.
+func (*UnlockConnectorResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{83}
+}
+
+func (m *UnlockConnectorResult) GetResult() isUnlockConnectorResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *UnlockConnectorResult) GetResponse() *UnlockConnectorResponse {
+	if x, ok := x.GetResult().(*UnlockConnectorResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UnlockConnectorResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*UnlockConnectorResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUnlockConnectorResult_Result interface {
+	isUnlockConnectorResult_Result()
+}
+
+type UnlockConnectorResult_Response struct {
+	Response *UnlockConnectorResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type UnlockConnectorResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UnlockConnectorResult_Response) isUnlockConnectorResult_Result() {}
+
+func (*UnlockConnectorResult_Error) isUnlockConnectorResult_Result() {}
+
+type UpdateFirmwareCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UniqueId string                 `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	Request  *UpdateFirmwareRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *UpdateFirmwareCall) Reset() {
+	*x = UpdateFirmwareCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFirmwareCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirmwareCall) ProtoMessage() {}
+
+func (x *UpdateFirmwareCall) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirmwareCall.ProtoReflect.Descriptor instead.
+func (*UpdateFirmwareCall) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateFirmwareCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFirmwareCall) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *UpdateFirmwareCall) GetRequest() *UpdateFirmwareRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type UpdateFirmwareResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//	*UpdateFirmwareResult_Response
+	//	*UpdateFirmwareResult_Error
+	Result isUpdateFirmwareResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *UpdateFirmwareResult) Reset() {
+	*x = UpdateFirmwareResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFirmwareResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirmwareResult) ProtoMessage() {}
+
+func (x *UpdateFirmwareResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirmwareResult.ProtoReflect.Descriptor instead.
+func (*UpdateFirmwareResult) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{85}
+}
+
+func (m *UpdateFirmwareResult) GetResult() isUpdateFirmwareResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *UpdateFirmwareResult) GetResponse() *UpdateFirmwareResponse {
+	if x, ok := x.GetResult().(*UpdateFirmwareResult_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *UpdateFirmwareResult) GetError() *CallError {
+	if x, ok := x.GetResult().(*UpdateFirmwareResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUpdateFirmwareResult_Result interface {
+	isUpdateFirmwareResult_Result()
+}
+
+type UpdateFirmwareResult_Response struct {
+	Response *UpdateFirmwareResponse `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type UpdateFirmwareResult_Error struct {
+	Error *CallError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateFirmwareResult_Response) isUpdateFirmwareResult_Result() {}
+
+func (*UpdateFirmwareResult_Error) isUpdateFirmwareResult_Result() {}
+
+type AuthorizationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTag     string     `protobuf:"bytes,1,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+	IdTagInfo *IdTagInfo `protobuf:"bytes,2,opt,name=id_tag_info,json=idTagInfo,proto3" json:"id_tag_info,omitempty"`
+}
+
+func (x *AuthorizationData) Reset() {
+	*x = AuthorizationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationData) ProtoMessage() {}
+
+func (x *AuthorizationData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationData.ProtoReflect.Descriptor instead.
+func (*AuthorizationData) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AuthorizationData) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+func (x *AuthorizationData) GetIdTagInfo() *IdTagInfo {
+	if x != nil {
+		return x.IdTagInfo
+	}
+	return nil
+}
+
+type AuthorizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTag string `protobuf:"bytes,1,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+}
+
+func (x *AuthorizeRequest) Reset() {
+	*x = AuthorizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeRequest) ProtoMessage() {}
+
+func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AuthorizeRequest) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+type AuthorizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTagInfo *IdTagInfo `protobuf:"bytes,1,opt,name=id_tag_info,json=idTagInfo,proto3" json:"id_tag_info,omitempty"`
+}
+
+func (x *AuthorizeResponse) Reset() {
+	*x = AuthorizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponse) ProtoMessage() {}
+
+func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AuthorizeResponse) GetIdTagInfo() *IdTagInfo {
+	if x != nil {
+		return x.IdTagInfo
+	}
+	return nil
+}
+
+type BootNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChargePointVendor       string `protobuf:"bytes,1,opt,name=charge_point_vendor,json=chargePointVendor,proto3" json:"charge_point_vendor,omitempty"`
+	ChargePointModel        string `protobuf:"bytes,2,opt,name=charge_point_model,json=chargePointModel,proto3" json:"charge_point_model,omitempty"`
+	ChargePointSerialNumber string `protobuf:"bytes,3,opt,name=charge_point_serial_number,json=chargePointSerialNumber,proto3" json:"charge_point_serial_number,omitempty"`
+	ChargeBoxSerialNumber   string `protobuf:"bytes,4,opt,name=charge_box_serial_number,json=chargeBoxSerialNumber,proto3" json:"charge_box_serial_number,omitempty"`
+	FirmwareVersion         string `protobuf:"bytes,5,opt,name=firmware_version,json=firmwareVersion,proto3" json:"firmware_version,omitempty"`
+	Iccid                   string `protobuf:"bytes,6,opt,name=iccid,proto3" json:"iccid,omitempty"`
+	Imsi                    string `protobuf:"bytes,7,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	MeterType               string `protobuf:"bytes,8,opt,name=meter_type,json=meterType,proto3" json:"meter_type,omitempty"`
+	MeterSerialNumber       string `protobuf:"bytes,9,opt,name=meter_serial_number,json=meterSerialNumber,proto3" json:"meter_serial_number,omitempty"`
+}
+
+func (x *BootNotificationRequest) Reset() {
+	*x = BootNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootNotificationRequest) ProtoMessage() {}
+
+func (x *BootNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootNotificationRequest.ProtoReflect.Descriptor instead.
+func (*BootNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BootNotificationRequest) GetChargePointVendor() string {
+	if x != nil {
+		return x.ChargePointVendor
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetChargePointModel() string {
+	if x != nil {
+		return x.ChargePointModel
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetChargePointSerialNumber() string {
+	if x != nil {
+		return x.ChargePointSerialNumber
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetChargeBoxSerialNumber() string {
+	if x != nil {
+		return x.ChargeBoxSerialNumber
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetFirmwareVersion() string {
+	if x != nil {
+		return x.FirmwareVersion
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetIccid() string {
+	if x != nil {
+		return x.Iccid
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetMeterType() string {
+	if x != nil {
+		return x.MeterType
+	}
+	return ""
+}
+
+func (x *BootNotificationRequest) GetMeterSerialNumber() string {
+	if x != nil {
+		return x.MeterSerialNumber
+	}
+	return ""
+}
+
+type BootNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentTime string `protobuf:"bytes,1,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
+	Interval    *int32 `protobuf:"varint,2,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BootNotificationResponse) Reset() {
+	*x = BootNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootNotificationResponse) ProtoMessage() {}
+
+func (x *BootNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootNotificationResponse.ProtoReflect.Descriptor instead.
+func (*BootNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BootNotificationResponse) GetCurrentTime() string {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return ""
+}
+
+func (x *BootNotificationResponse) GetInterval() int32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *BootNotificationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId *int32 `protobuf:"varint,1,opt,name=reservation_id,json=reservationId,proto3,oneof" json:"reservation_id,omitempty"`
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CancelReservationRequest) GetReservationId() int32 {
+	if x != nil && x.ReservationId != nil {
+		return *x.ReservationId
+	}
+	return 0
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CancelReservationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CertificateHashData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashAlgorithm  string `protobuf:"bytes,1,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	IssuerNameHash string `protobuf:"bytes,2,opt,name=issuer_name_hash,json=issuerNameHash,proto3" json:"issuer_name_hash,omitempty"`
+	IssuerKeyHash  string `protobuf:"bytes,3,opt,name=issuer_key_hash,json=issuerKeyHash,proto3" json:"issuer_key_hash,omitempty"`
+	SerialNumber   string `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *CertificateHashData) Reset() {
+	*x = CertificateHashData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateHashData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateHashData) ProtoMessage() {}
+
+func (x *CertificateHashData) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateHashData.ProtoReflect.Descriptor instead.
+func (*CertificateHashData) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CertificateHashData) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *CertificateHashData) GetIssuerNameHash() string {
+	if x != nil {
+		return x.IssuerNameHash
+	}
+	return ""
+}
+
+func (x *CertificateHashData) GetIssuerKeyHash() string {
+	if x != nil {
+		return x.IssuerKeyHash
+	}
+	return ""
+}
+
+func (x *CertificateHashData) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type CertificateSignedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertificateChain string `protobuf:"bytes,1,opt,name=certificate_chain,json=certificateChain,proto3" json:"certificate_chain,omitempty"`
+}
+
+func (x *CertificateSignedRequest) Reset() {
+	*x = CertificateSignedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateSignedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSignedRequest) ProtoMessage() {}
+
+func (x *CertificateSignedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSignedRequest.ProtoReflect.Descriptor instead.
+func (*CertificateSignedRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CertificateSignedRequest) GetCertificateChain() string {
+	if x != nil {
+		return x.CertificateChain
+	}
+	return ""
+}
+
+type CertificateSignedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CertificateSignedResponse) Reset() {
+	*x = CertificateSignedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateSignedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSignedResponse) ProtoMessage() {}
+
+func (x *CertificateSignedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSignedResponse.ProtoReflect.Descriptor instead.
+func (*CertificateSignedResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CertificateSignedResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ChangeAvailabilityRequest) Reset() {
+	*x = ChangeAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAvailabilityRequest) ProtoMessage() {}
+
+func (x *ChangeAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ChangeAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ChangeAvailabilityRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *ChangeAvailabilityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ChangeAvailabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ChangeAvailabilityResponse) Reset() {
+	*x = ChangeAvailabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAvailabilityResponse) ProtoMessage() {}
+
+func (x *ChangeAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ChangeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ChangeAvailabilityResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ChangeConfigurationRequest) Reset() {
+	*x = ChangeConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConfigurationRequest) ProtoMessage() {}
+
+func (x *ChangeConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ChangeConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ChangeConfigurationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ChangeConfigurationRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ChangeConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ChangeConfigurationResponse) Reset() {
+	*x = ChangeConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConfigurationResponse) ProtoMessage() {}
+
+func (x *ChangeConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ChangeConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ChangeConfigurationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChargingProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChargingProfileId      *int32            `protobuf:"varint,1,opt,name=charging_profile_id,json=chargingProfileId,proto3,oneof" json:"charging_profile_id,omitempty"`
+	TransactionId          *int32            `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	StackLevel             *int32            `protobuf:"varint,3,opt,name=stack_level,json=stackLevel,proto3,oneof" json:"stack_level,omitempty"`
+	ChargingProfilePurpose string            `protobuf:"bytes,4,opt,name=charging_profile_purpose,json=chargingProfilePurpose,proto3" json:"charging_profile_purpose,omitempty"`
+	ChargingProfileKind    string            `protobuf:"bytes,5,opt,name=charging_profile_kind,json=chargingProfileKind,proto3" json:"charging_profile_kind,omitempty"`
+	RecurrencyKind         string            `protobuf:"bytes,6,opt,name=recurrency_kind,json=recurrencyKind,proto3" json:"recurrency_kind,omitempty"`
+	ValidFrom              string            `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo                string            `protobuf:"bytes,8,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	ChargingSchedule       *ChargingSchedule `protobuf:"bytes,9,opt,name=charging_schedule,json=chargingSchedule,proto3" json:"charging_schedule,omitempty"`
+}
+
+func (x *ChargingProfile) Reset() {
+	*x = ChargingProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingProfile) ProtoMessage() {}
+
+func (x *ChargingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingProfile.ProtoReflect.Descriptor instead.
+func (*ChargingProfile) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ChargingProfile) GetChargingProfileId() int32 {
+	if x != nil && x.ChargingProfileId != nil {
+		return *x.ChargingProfileId
+	}
+	return 0
+}
+
+func (x *ChargingProfile) GetTransactionId() int32 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *ChargingProfile) GetStackLevel() int32 {
+	if x != nil && x.StackLevel != nil {
+		return *x.StackLevel
+	}
+	return 0
+}
+
+func (x *ChargingProfile) GetChargingProfilePurpose() string {
+	if x != nil {
+		return x.ChargingProfilePurpose
+	}
+	return ""
+}
+
+func (x *ChargingProfile) GetChargingProfileKind() string {
+	if x != nil {
+		return x.ChargingProfileKind
+	}
+	return ""
+}
+
+func (x *ChargingProfile) GetRecurrencyKind() string {
+	if x != nil {
+		return x.RecurrencyKind
+	}
+	return ""
+}
+
+func (x *ChargingProfile) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *ChargingProfile) GetValidTo() string {
+	if x != nil {
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *ChargingProfile) GetChargingSchedule() *ChargingSchedule {
+	if x != nil {
+		return x.ChargingSchedule
+	}
+	return nil
+}
+
+type ChargingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration               *int32                    `protobuf:"varint,1,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	StartSchedule          string                    `protobuf:"bytes,2,opt,name=start_schedule,json=startSchedule,proto3" json:"start_schedule,omitempty"`
+	ChargingRateUnit       string                    `protobuf:"bytes,3,opt,name=charging_rate_unit,json=chargingRateUnit,proto3" json:"charging_rate_unit,omitempty"`
+	ChargingSchedulePeriod []*ChargingSchedulePeriod `protobuf:"bytes,4,rep,name=charging_schedule_period,json=chargingSchedulePeriod,proto3" json:"charging_schedule_period,omitempty"`
+	MinChargingRate        *float64                  `protobuf:"fixed64,5,opt,name=min_charging_rate,json=minChargingRate,proto3,oneof" json:"min_charging_rate,omitempty"`
+}
+
+func (x *ChargingSchedule) Reset() {
+	*x = ChargingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingSchedule) ProtoMessage() {}
+
+func (x *ChargingSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingSchedule.ProtoReflect.Descriptor instead.
+func (*ChargingSchedule) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ChargingSchedule) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *ChargingSchedule) GetStartSchedule() string {
+	if x != nil {
+		return x.StartSchedule
+	}
+	return ""
+}
+
+func (x *ChargingSchedule) GetChargingRateUnit() string {
+	if x != nil {
+		return x.ChargingRateUnit
+	}
+	return ""
+}
+
+func (x *ChargingSchedule) GetChargingSchedulePeriod() []*ChargingSchedulePeriod {
+	if x != nil {
+		return x.ChargingSchedulePeriod
+	}
+	return nil
+}
+
+func (x *ChargingSchedule) GetMinChargingRate() float64 {
+	if x != nil && x.MinChargingRate != nil {
+		return *x.MinChargingRate
+	}
+	return 0
+}
+
+type ChargingSchedulePeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartPeriod  *int32   `protobuf:"varint,1,opt,name=start_period,json=startPeriod,proto3,oneof" json:"start_period,omitempty"`
+	Limit        *float64 `protobuf:"fixed64,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	NumberPhases *int32   `protobuf:"varint,3,opt,name=number_phases,json=numberPhases,proto3,oneof" json:"number_phases,omitempty"`
+}
+
+func (x *ChargingSchedulePeriod) Reset() {
+	*x = ChargingSchedulePeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingSchedulePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingSchedulePeriod) ProtoMessage() {}
+
+func (x *ChargingSchedulePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingSchedulePeriod.ProtoReflect.Descriptor instead.
+func (*ChargingSchedulePeriod) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ChargingSchedulePeriod) GetStartPeriod() int32 {
+	if x != nil && x.StartPeriod != nil {
+		return *x.StartPeriod
+	}
+	return 0
+}
+
+func (x *ChargingSchedulePeriod) GetLimit() float64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ChargingSchedulePeriod) GetNumberPhases() int32 {
+	if x != nil && x.NumberPhases != nil {
+		return *x.NumberPhases
+	}
+	return 0
+}
+
+type ClearCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearCacheRequest) Reset() {
+	*x = ClearCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCacheRequest) ProtoMessage() {}
+
+func (x *ClearCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCacheRequest.ProtoReflect.Descriptor instead.
+func (*ClearCacheRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{103}
+}
+
+type ClearCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ClearCacheResponse) Reset() {
+	*x = ClearCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCacheResponse) ProtoMessage() {}
+
+func (x *ClearCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCacheResponse.ProtoReflect.Descriptor instead.
+func (*ClearCacheResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ClearCacheResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ClearChargingProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     *int32 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	ConnectorId            *int32 `protobuf:"varint,2,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	ChargingProfilePurpose string `protobuf:"bytes,3,opt,name=charging_profile_purpose,json=chargingProfilePurpose,proto3" json:"charging_profile_purpose,omitempty"`
+	StackLevel             *int32 `protobuf:"varint,4,opt,name=stack_level,json=stackLevel,proto3,oneof" json:"stack_level,omitempty"`
+}
+
+func (x *ClearChargingProfileRequest) Reset() {
+	*x = ClearChargingProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearChargingProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChargingProfileRequest) ProtoMessage() {}
+
+func (x *ClearChargingProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChargingProfileRequest.ProtoReflect.Descriptor instead.
+func (*ClearChargingProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ClearChargingProfileRequest) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ClearChargingProfileRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *ClearChargingProfileRequest) GetChargingProfilePurpose() string {
+	if x != nil {
+		return x.ChargingProfilePurpose
+	}
+	return ""
+}
+
+func (x *ClearChargingProfileRequest) GetStackLevel() int32 {
+	if x != nil && x.StackLevel != nil {
+		return *x.StackLevel
+	}
+	return 0
+}
+
+type ClearChargingProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ClearChargingProfileResponse) Reset() {
+	*x = ClearChargingProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearChargingProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearChargingProfileResponse) ProtoMessage() {}
+
+func (x *ClearChargingProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearChargingProfileResponse.ProtoReflect.Descriptor instead.
+func (*ClearChargingProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ClearChargingProfileResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ConfigurationKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Readonly *bool  `protobuf:"varint,2,opt,name=readonly,proto3,oneof" json:"readonly,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ConfigurationKey) Reset() {
+	*x = ConfigurationKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationKey) ProtoMessage() {}
+
+func (x *ConfigurationKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationKey.ProtoReflect.Descriptor instead.
+func (*ConfigurationKey) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ConfigurationKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigurationKey) GetReadonly() bool {
+	if x != nil && x.Readonly != nil {
+		return *x.Readonly
+	}
+	return false
+}
+
+func (x *ConfigurationKey) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type DataTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VendorId  string `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Data      string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DataTransferRequest) Reset() {
+	*x = DataTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataTransferRequest) ProtoMessage() {}
+
+func (x *DataTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataTransferRequest.ProtoReflect.Descriptor instead.
+func (*DataTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *DataTransferRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *DataTransferRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DataTransferRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type DataTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DataTransferResponse) Reset() {
+	*x = DataTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataTransferResponse) ProtoMessage() {}
+
+func (x *DataTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataTransferResponse.ProtoReflect.Descriptor instead.
+func (*DataTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *DataTransferResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataTransferResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type DeleteCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertificateHashData *CertificateHashData `protobuf:"bytes,1,opt,name=certificate_hash_data,json=certificateHashData,proto3" json:"certificate_hash_data,omitempty"`
+}
+
+func (x *DeleteCertificateRequest) Reset() {
+	*x = DeleteCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCertificateRequest) ProtoMessage() {}
+
+func (x *DeleteCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCertificateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DeleteCertificateRequest) GetCertificateHashData() *CertificateHashData {
+	if x != nil {
+		return x.CertificateHashData
+	}
+	return nil
+}
+
+type DeleteCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteCertificateResponse) Reset() {
+	*x = DeleteCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCertificateResponse) ProtoMessage() {}
+
+func (x *DeleteCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCertificateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeleteCertificateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DiagnosticsStatusNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DiagnosticsStatusNotificationRequest) Reset() {
+	*x = DiagnosticsStatusNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsStatusNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsStatusNotificationRequest) ProtoMessage() {}
+
+func (x *DiagnosticsStatusNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsStatusNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DiagnosticsStatusNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DiagnosticsStatusNotificationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DiagnosticsStatusNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiagnosticsStatusNotificationResponse) Reset() {
+	*x = DiagnosticsStatusNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsStatusNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsStatusNotificationResponse) ProtoMessage() {}
+
+func (x *DiagnosticsStatusNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsStatusNotificationResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsStatusNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{113}
+}
+
+type ExtendedTriggerMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedMessage string `protobuf:"bytes,1,opt,name=requested_message,json=requestedMessage,proto3" json:"requested_message,omitempty"`
+	ConnectorId      *int32 `protobuf:"varint,2,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+}
+
+func (x *ExtendedTriggerMessageRequest) Reset() {
+	*x = ExtendedTriggerMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedTriggerMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedTriggerMessageRequest) ProtoMessage() {}
+
+func (x *ExtendedTriggerMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedTriggerMessageRequest.ProtoReflect.Descriptor instead.
+func (*ExtendedTriggerMessageRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ExtendedTriggerMessageRequest) GetRequestedMessage() string {
+	if x != nil {
+		return x.RequestedMessage
+	}
+	return ""
+}
+
+func (x *ExtendedTriggerMessageRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+type ExtendedTriggerMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ExtendedTriggerMessageResponse) Reset() {
+	*x = ExtendedTriggerMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedTriggerMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedTriggerMessageResponse) ProtoMessage() {}
+
+func (x *ExtendedTriggerMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedTriggerMessageResponse.ProtoReflect.Descriptor instead.
+func (*ExtendedTriggerMessageResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ExtendedTriggerMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Firmware struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location           string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	RetrieveDateTime   string `protobuf:"bytes,2,opt,name=retrieve_date_time,json=retrieveDateTime,proto3" json:"retrieve_date_time,omitempty"`
+	InstallDateTime    string `protobuf:"bytes,3,opt,name=install_date_time,json=installDateTime,proto3" json:"install_date_time,omitempty"`
+	SigningCertificate string `protobuf:"bytes,4,opt,name=signing_certificate,json=signingCertificate,proto3" json:"signing_certificate,omitempty"`
+	Signature          string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *Firmware) Reset() {
+	*x = Firmware{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Firmware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Firmware) ProtoMessage() {}
+
+func (x *Firmware) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Firmware.ProtoReflect.Descriptor instead.
+func (*Firmware) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Firmware) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Firmware) GetRetrieveDateTime() string {
+	if x != nil {
+		return x.RetrieveDateTime
+	}
+	return ""
+}
+
+func (x *Firmware) GetInstallDateTime() string {
+	if x != nil {
+		return x.InstallDateTime
+	}
+	return ""
+}
+
+func (x *Firmware) GetSigningCertificate() string {
+	if x != nil {
+		return x.SigningCertificate
+	}
+	return ""
+}
+
+func (x *Firmware) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type FirmwareStatusNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *FirmwareStatusNotificationRequest) Reset() {
+	*x = FirmwareStatusNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareStatusNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareStatusNotificationRequest) ProtoMessage() {}
+
+func (x *FirmwareStatusNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareStatusNotificationRequest.ProtoReflect.Descriptor instead.
+func (*FirmwareStatusNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *FirmwareStatusNotificationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type FirmwareStatusNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FirmwareStatusNotificationResponse) Reset() {
+	*x = FirmwareStatusNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirmwareStatusNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareStatusNotificationResponse) ProtoMessage() {}
+
+func (x *FirmwareStatusNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareStatusNotificationResponse.ProtoReflect.Descriptor instead.
+func (*FirmwareStatusNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{118}
+}
+
+type GetCompositeScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId      *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	Duration         *int32 `protobuf:"varint,2,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	ChargingRateUnit string `protobuf:"bytes,3,opt,name=charging_rate_unit,json=chargingRateUnit,proto3" json:"charging_rate_unit,omitempty"`
+}
+
+func (x *GetCompositeScheduleRequest) Reset() {
+	*x = GetCompositeScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompositeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositeScheduleRequest) ProtoMessage() {}
+
+func (x *GetCompositeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetCompositeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetCompositeScheduleRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *GetCompositeScheduleRequest) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *GetCompositeScheduleRequest) GetChargingRateUnit() string {
+	if x != nil {
+		return x.ChargingRateUnit
+	}
+	return ""
+}
+
+type GetCompositeScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ConnectorId      *int32            `protobuf:"varint,2,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	ScheduleStart    string            `protobuf:"bytes,3,opt,name=schedule_start,json=scheduleStart,proto3" json:"schedule_start,omitempty"`
+	ChargingSchedule *ChargingSchedule `protobuf:"bytes,4,opt,name=charging_schedule,json=chargingSchedule,proto3" json:"charging_schedule,omitempty"`
+}
+
+func (x *GetCompositeScheduleResponse) Reset() {
+	*x = GetCompositeScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompositeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositeScheduleResponse) ProtoMessage() {}
+
+func (x *GetCompositeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetCompositeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetCompositeScheduleResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCompositeScheduleResponse) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *GetCompositeScheduleResponse) GetScheduleStart() string {
+	if x != nil {
+		return x.ScheduleStart
+	}
+	return ""
+}
+
+func (x *GetCompositeScheduleResponse) GetChargingSchedule() *ChargingSchedule {
+	if x != nil {
+		return x.ChargingSchedule
+	}
+	return nil
+}
+
+type GetConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetConfigurationRequest) Reset() {
+	*x = GetConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationRequest) ProtoMessage() {}
+
+func (x *GetConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetConfigurationRequest) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type GetConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigurationKey []*ConfigurationKey `protobuf:"bytes,1,rep,name=configuration_key,json=configurationKey,proto3" json:"configuration_key,omitempty"`
+	UnknownKey       []string            `protobuf:"bytes,2,rep,name=unknown_key,json=unknownKey,proto3" json:"unknown_key,omitempty"`
+}
+
+func (x *GetConfigurationResponse) Reset() {
+	*x = GetConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigurationResponse) ProtoMessage() {}
+
+func (x *GetConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetConfigurationResponse) GetConfigurationKey() []*ConfigurationKey {
+	if x != nil {
+		return x.ConfigurationKey
+	}
+	return nil
+}
+
+func (x *GetConfigurationResponse) GetUnknownKey() []string {
+	if x != nil {
+		return x.UnknownKey
+	}
+	return nil
+}
+
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location      string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Retries       *int32 `protobuf:"varint,2,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	RetryInterval *int32 `protobuf:"varint,3,opt,name=retry_interval,json=retryInterval,proto3,oneof" json:"retry_interval,omitempty"`
+	StartTime     string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	StopTime      string `protobuf:"bytes,5,opt,name=stop_time,json=stopTime,proto3" json:"stop_time,omitempty"`
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetDiagnosticsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsRequest) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *GetDiagnosticsRequest) GetRetryInterval() int32 {
+	if x != nil && x.RetryInterval != nil {
+		return *x.RetryInterval
+	}
+	return 0
+}
+
+func (x *GetDiagnosticsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsRequest) GetStopTime() string {
+	if x != nil {
+		return x.StopTime
+	}
+	return ""
+}
+
+type GetDiagnosticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *GetDiagnosticsResponse) Reset() {
+	*x = GetDiagnosticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetDiagnosticsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetInstalledCertificateIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertificateType string `protobuf:"bytes,1,opt,name=certificate_type,json=certificateType,proto3" json:"certificate_type,omitempty"`
+}
+
+func (x *GetInstalledCertificateIdsRequest) Reset() {
+	*x = GetInstalledCertificateIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledCertificateIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledCertificateIdsRequest) ProtoMessage() {}
+
+func (x *GetInstalledCertificateIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledCertificateIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetInstalledCertificateIdsRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetInstalledCertificateIdsRequest) GetCertificateType() string {
+	if x != nil {
+		return x.CertificateType
+	}
+	return ""
+}
+
+type GetInstalledCertificateIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertificateHashData []*CertificateHashData `protobuf:"bytes,1,rep,name=certificate_hash_data,json=certificateHashData,proto3" json:"certificate_hash_data,omitempty"`
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetInstalledCertificateIdsResponse) Reset() {
+	*x = GetInstalledCertificateIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledCertificateIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledCertificateIdsResponse) ProtoMessage() {}
+
+func (x *GetInstalledCertificateIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledCertificateIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetInstalledCertificateIdsResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetInstalledCertificateIdsResponse) GetCertificateHashData() []*CertificateHashData {
+	if x != nil {
+		return x.CertificateHashData
+	}
+	return nil
+}
+
+func (x *GetInstalledCertificateIdsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetLocalListVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLocalListVersionRequest) Reset() {
+	*x = GetLocalListVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocalListVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalListVersionRequest) ProtoMessage() {}
+
+func (x *GetLocalListVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalListVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetLocalListVersionRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{127}
+}
+
+type GetLocalListVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListVersion *int32 `protobuf:"varint,1,opt,name=list_version,json=listVersion,proto3,oneof" json:"list_version,omitempty"`
+}
+
+func (x *GetLocalListVersionResponse) Reset() {
+	*x = GetLocalListVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLocalListVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalListVersionResponse) ProtoMessage() {}
+
+func (x *GetLocalListVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalListVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalListVersionResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetLocalListVersionResponse) GetListVersion() int32 {
+	if x != nil && x.ListVersion != nil {
+		return *x.ListVersion
+	}
+	return 0
+}
+
+type GetLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log           *LogParameters `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	LogType       string         `protobuf:"bytes,2,opt,name=log_type,json=logType,proto3" json:"log_type,omitempty"`
+	RequestId     *int32         `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+	Retries       *int32         `protobuf:"varint,4,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	RetryInterval *int32         `protobuf:"varint,5,opt,name=retry_interval,json=retryInterval,proto3,oneof" json:"retry_interval,omitempty"`
+}
+
+func (x *GetLogRequest) Reset() {
+	*x = GetLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogRequest) ProtoMessage() {}
+
+func (x *GetLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogRequest.ProtoReflect.Descriptor instead.
+func (*GetLogRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetLogRequest) GetLog() *LogParameters {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *GetLogRequest) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+func (x *GetLogRequest) GetRequestId() int32 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+func (x *GetLogRequest) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *GetLogRequest) GetRetryInterval() int32 {
+	if x != nil && x.RetryInterval != nil {
+		return *x.RetryInterval
+	}
+	return 0
+}
+
+type GetLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (x *GetLogResponse) Reset() {
+	*x = GetLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogResponse) ProtoMessage() {}
+
+func (x *GetLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogResponse.ProtoReflect.Descriptor instead.
+func (*GetLogResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetLogResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetLogResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{131}
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentTime string `protobuf:"bytes,1,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *HeartbeatResponse) GetCurrentTime() string {
+	if x != nil {
+		return x.CurrentTime
+	}
+	return ""
+}
+
+type IdTagInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiryDate  string `protobuf:"bytes,1,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	ParentIdTag string `protobuf:"bytes,2,opt,name=parent_id_tag,json=parentIdTag,proto3" json:"parent_id_tag,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *IdTagInfo) Reset() {
+	*x = IdTagInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdTagInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdTagInfo) ProtoMessage() {}
+
+func (x *IdTagInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdTagInfo.ProtoReflect.Descriptor instead.
+func (*IdTagInfo) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *IdTagInfo) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *IdTagInfo) GetParentIdTag() string {
+	if x != nil {
+		return x.ParentIdTag
+	}
+	return ""
+}
+
+func (x *IdTagInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type InstallCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertificateType string `protobuf:"bytes,1,opt,name=certificate_type,json=certificateType,proto3" json:"certificate_type,omitempty"`
+	Certificate     string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *InstallCertificateRequest) Reset() {
+	*x = InstallCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallCertificateRequest) ProtoMessage() {}
+
+func (x *InstallCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallCertificateRequest.ProtoReflect.Descriptor instead.
+func (*InstallCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *InstallCertificateRequest) GetCertificateType() string {
+	if x != nil {
+		return x.CertificateType
+	}
+	return ""
+}
+
+func (x *InstallCertificateRequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type InstallCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *InstallCertificateResponse) Reset() {
+	*x = InstallCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallCertificateResponse) ProtoMessage() {}
+
+func (x *InstallCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallCertificateResponse.ProtoReflect.Descriptor instead.
+func (*InstallCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *InstallCertificateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type LogParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemoteLocation  string `protobuf:"bytes,1,opt,name=remote_location,json=remoteLocation,proto3" json:"remote_location,omitempty"`
+	OldestTimestamp string `protobuf:"bytes,2,opt,name=oldest_timestamp,json=oldestTimestamp,proto3" json:"oldest_timestamp,omitempty"`
+	LatestTimestamp string `protobuf:"bytes,3,opt,name=latest_timestamp,json=latestTimestamp,proto3" json:"latest_timestamp,omitempty"`
+}
+
+func (x *LogParameters) Reset() {
+	*x = LogParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogParameters) ProtoMessage() {}
+
+func (x *LogParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogParameters.ProtoReflect.Descriptor instead.
+func (*LogParameters) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *LogParameters) GetRemoteLocation() string {
+	if x != nil {
+		return x.RemoteLocation
+	}
+	return ""
+}
+
+func (x *LogParameters) GetOldestTimestamp() string {
+	if x != nil {
+		return x.OldestTimestamp
+	}
+	return ""
+}
+
+func (x *LogParameters) GetLatestTimestamp() string {
+	if x != nil {
+		return x.LatestTimestamp
+	}
+	return ""
+}
+
+type LogStatusNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RequestId *int32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+}
+
+func (x *LogStatusNotificationRequest) Reset() {
+	*x = LogStatusNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStatusNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStatusNotificationRequest) ProtoMessage() {}
+
+func (x *LogStatusNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStatusNotificationRequest.ProtoReflect.Descriptor instead.
+func (*LogStatusNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *LogStatusNotificationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LogStatusNotificationRequest) GetRequestId() int32 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+type LogStatusNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogStatusNotificationResponse) Reset() {
+	*x = LogStatusNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStatusNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStatusNotificationResponse) ProtoMessage() {}
+
+func (x *LogStatusNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStatusNotificationResponse.ProtoReflect.Descriptor instead.
+func (*LogStatusNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{138}
+}
+
+type MeterValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp    string          `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SampledValue []*SampledValue `protobuf:"bytes,2,rep,name=sampled_value,json=sampledValue,proto3" json:"sampled_value,omitempty"`
+}
+
+func (x *MeterValue) Reset() {
+	*x = MeterValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValue) ProtoMessage() {}
+
+func (x *MeterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValue.ProtoReflect.Descriptor instead.
+func (*MeterValue) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MeterValue) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MeterValue) GetSampledValue() []*SampledValue {
+	if x != nil {
+		return x.SampledValue
+	}
+	return nil
+}
+
+type MeterValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId   *int32        `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	TransactionId *int32        `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	MeterValue    []*MeterValue `protobuf:"bytes,3,rep,name=meter_value,json=meterValue,proto3" json:"meter_value,omitempty"`
+}
+
+func (x *MeterValuesRequest) Reset() {
+	*x = MeterValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValuesRequest) ProtoMessage() {}
+
+func (x *MeterValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValuesRequest.ProtoReflect.Descriptor instead.
+func (*MeterValuesRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MeterValuesRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *MeterValuesRequest) GetTransactionId() int32 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *MeterValuesRequest) GetMeterValue() []*MeterValue {
+	if x != nil {
+		return x.MeterValue
+	}
+	return nil
+}
+
+type MeterValuesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MeterValuesResponse) Reset() {
+	*x = MeterValuesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeterValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValuesResponse) ProtoMessage() {}
+
+func (x *MeterValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValuesResponse.ProtoReflect.Descriptor instead.
+func (*MeterValuesResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{141}
+}
+
+type RemoteStartTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId     *int32           `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	IdTag           string           `protobuf:"bytes,2,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+	ChargingProfile *ChargingProfile `protobuf:"bytes,3,opt,name=charging_profile,json=chargingProfile,proto3" json:"charging_profile,omitempty"`
+}
+
+func (x *RemoteStartTransactionRequest) Reset() {
+	*x = RemoteStartTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStartTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStartTransactionRequest) ProtoMessage() {}
+
+func (x *RemoteStartTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStartTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoteStartTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RemoteStartTransactionRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *RemoteStartTransactionRequest) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+func (x *RemoteStartTransactionRequest) GetChargingProfile() *ChargingProfile {
+	if x != nil {
+		return x.ChargingProfile
+	}
+	return nil
+}
+
+type RemoteStartTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RemoteStartTransactionResponse) Reset() {
+	*x = RemoteStartTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStartTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStartTransactionResponse) ProtoMessage() {}
+
+func (x *RemoteStartTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStartTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoteStartTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RemoteStartTransactionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RemoteStopTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId *int32 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+}
+
+func (x *RemoteStopTransactionRequest) Reset() {
+	*x = RemoteStopTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStopTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStopTransactionRequest) ProtoMessage() {}
+
+func (x *RemoteStopTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStopTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoteStopTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RemoteStopTransactionRequest) GetTransactionId() int32 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type RemoteStopTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RemoteStopTransactionResponse) Reset() {
+	*x = RemoteStopTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteStopTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStopTransactionResponse) ProtoMessage() {}
+
+func (x *RemoteStopTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStopTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoteStopTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RemoteStopTransactionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ReserveNowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId   *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	ExpiryDate    string `protobuf:"bytes,2,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	IdTag         string `protobuf:"bytes,3,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+	ParentIdTag   string `protobuf:"bytes,4,opt,name=parent_id_tag,json=parentIdTag,proto3" json:"parent_id_tag,omitempty"`
+	ReservationId *int32 `protobuf:"varint,5,opt,name=reservation_id,json=reservationId,proto3,oneof" json:"reservation_id,omitempty"`
+}
+
+func (x *ReserveNowRequest) Reset() {
+	*x = ReserveNowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveNowRequest) ProtoMessage() {}
+
+func (x *ReserveNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveNowRequest.ProtoReflect.Descriptor instead.
+func (*ReserveNowRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ReserveNowRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *ReserveNowRequest) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *ReserveNowRequest) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+func (x *ReserveNowRequest) GetParentIdTag() string {
+	if x != nil {
+		return x.ParentIdTag
+	}
+	return ""
+}
+
+func (x *ReserveNowRequest) GetReservationId() int32 {
+	if x != nil && x.ReservationId != nil {
+		return *x.ReservationId
+	}
+	return 0
+}
+
+type ReserveNowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReserveNowResponse) Reset() {
+	*x = ReserveNowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveNowResponse) ProtoMessage() {}
+
+func (x *ReserveNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveNowResponse.ProtoReflect.Descriptor instead.
+func (*ReserveNowResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ReserveNowResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ResetRequest) Reset() {
+	*x = ResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRequest) ProtoMessage() {}
+
+func (x *ResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRequest.ProtoReflect.Descriptor instead.
+func (*ResetRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ResetRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResetResponse) Reset() {
+	*x = ResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetResponse) ProtoMessage() {}
+
+func (x *ResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetResponse.ProtoReflect.Descriptor instead.
+func (*ResetResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ResetResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SampledValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value     string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Context   string `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Measurand string `protobuf:"bytes,4,opt,name=measurand,proto3" json:"measurand,omitempty"`
+	Phase     string `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Location  string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Unit      string `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *SampledValue) Reset() {
+	*x = SampledValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampledValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampledValue) ProtoMessage() {}
+
+func (x *SampledValue) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampledValue.ProtoReflect.Descriptor instead.
+func (*SampledValue) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SampledValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SampledValue) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *SampledValue) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *SampledValue) GetMeasurand() string {
+	if x != nil {
+		return x.Measurand
+	}
+	return ""
+}
+
+func (x *SampledValue) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *SampledValue) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SampledValue) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type SecurityEventNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TechInfo  string `protobuf:"bytes,3,opt,name=tech_info,json=techInfo,proto3" json:"tech_info,omitempty"`
+}
+
+func (x *SecurityEventNotificationRequest) Reset() {
+	*x = SecurityEventNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityEventNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEventNotificationRequest) ProtoMessage() {}
+
+func (x *SecurityEventNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEventNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SecurityEventNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SecurityEventNotificationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SecurityEventNotificationRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *SecurityEventNotificationRequest) GetTechInfo() string {
+	if x != nil {
+		return x.TechInfo
+	}
+	return ""
+}
+
+type SecurityEventNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SecurityEventNotificationResponse) Reset() {
+	*x = SecurityEventNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityEventNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEventNotificationResponse) ProtoMessage() {}
+
+func (x *SecurityEventNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEventNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SecurityEventNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{152}
+}
+
+type SendLocalListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListVersion            *int32               `protobuf:"varint,1,opt,name=list_version,json=listVersion,proto3,oneof" json:"list_version,omitempty"`
+	LocalAuthorizationList []*AuthorizationData `protobuf:"bytes,2,rep,name=local_authorization_list,json=localAuthorizationList,proto3" json:"local_authorization_list,omitempty"`
+	UpdateType             string               `protobuf:"bytes,3,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"`
+}
+
+func (x *SendLocalListRequest) Reset() {
+	*x = SendLocalListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendLocalListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLocalListRequest) ProtoMessage() {}
+
+func (x *SendLocalListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLocalListRequest.ProtoReflect.Descriptor instead.
+func (*SendLocalListRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SendLocalListRequest) GetListVersion() int32 {
+	if x != nil && x.ListVersion != nil {
+		return *x.ListVersion
+	}
+	return 0
+}
+
+func (x *SendLocalListRequest) GetLocalAuthorizationList() []*AuthorizationData {
+	if x != nil {
+		return x.LocalAuthorizationList
+	}
+	return nil
+}
+
+func (x *SendLocalListRequest) GetUpdateType() string {
+	if x != nil {
+		return x.UpdateType
+	}
+	return ""
+}
+
+type SendLocalListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SendLocalListResponse) Reset() {
+	*x = SendLocalListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendLocalListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLocalListResponse) ProtoMessage() {}
+
+func (x *SendLocalListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLocalListResponse.ProtoReflect.Descriptor instead.
+func (*SendLocalListResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SendLocalListResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SetChargingProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId        *int32           `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	CsChargingProfiles *ChargingProfile `protobuf:"bytes,2,opt,name=cs_charging_profiles,json=csChargingProfiles,proto3" json:"cs_charging_profiles,omitempty"`
+}
+
+func (x *SetChargingProfileRequest) Reset() {
+	*x = SetChargingProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChargingProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChargingProfileRequest) ProtoMessage() {}
+
+func (x *SetChargingProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChargingProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetChargingProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SetChargingProfileRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *SetChargingProfileRequest) GetCsChargingProfiles() *ChargingProfile {
+	if x != nil {
+		return x.CsChargingProfiles
+	}
+	return nil
+}
+
+type SetChargingProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetChargingProfileResponse) Reset() {
+	*x = SetChargingProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetChargingProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChargingProfileResponse) ProtoMessage() {}
+
+func (x *SetChargingProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChargingProfileResponse.ProtoReflect.Descriptor instead.
+func (*SetChargingProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SetChargingProfileResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SignCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Csr string `protobuf:"bytes,1,opt,name=csr,proto3" json:"csr,omitempty"`
+}
+
+func (x *SignCertificateRequest) Reset() {
+	*x = SignCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCertificateRequest) ProtoMessage() {}
+
+func (x *SignCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCertificateRequest.ProtoReflect.Descriptor instead.
+func (*SignCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *SignCertificateRequest) GetCsr() string {
+	if x != nil {
+		return x.Csr
+	}
+	return ""
+}
+
+type SignCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SignCertificateResponse) Reset() {
+	*x = SignCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCertificateResponse) ProtoMessage() {}
+
+func (x *SignCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCertificateResponse.ProtoReflect.Descriptor instead.
+func (*SignCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SignCertificateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SignedFirmwareStatusNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RequestId *int32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+}
+
+func (x *SignedFirmwareStatusNotificationRequest) Reset() {
+	*x = SignedFirmwareStatusNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedFirmwareStatusNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedFirmwareStatusNotificationRequest) ProtoMessage() {}
+
+func (x *SignedFirmwareStatusNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedFirmwareStatusNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SignedFirmwareStatusNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SignedFirmwareStatusNotificationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SignedFirmwareStatusNotificationRequest) GetRequestId() int32 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+type SignedFirmwareStatusNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SignedFirmwareStatusNotificationResponse) Reset() {
+	*x = SignedFirmwareStatusNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedFirmwareStatusNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedFirmwareStatusNotificationResponse) ProtoMessage() {}
+
+func (x *SignedFirmwareStatusNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedFirmwareStatusNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SignedFirmwareStatusNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{160}
+}
+
+type SignedUpdateFirmwareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Retries       *int32    `protobuf:"varint,1,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	RetryInterval *int32    `protobuf:"varint,2,opt,name=retry_interval,json=retryInterval,proto3,oneof" json:"retry_interval,omitempty"`
+	RequestId     *int32    `protobuf:"varint,3,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+	Firmware      *Firmware `protobuf:"bytes,4,opt,name=firmware,proto3" json:"firmware,omitempty"`
+}
+
+func (x *SignedUpdateFirmwareRequest) Reset() {
+	*x = SignedUpdateFirmwareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedUpdateFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedUpdateFirmwareRequest) ProtoMessage() {}
+
+func (x *SignedUpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedUpdateFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*SignedUpdateFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SignedUpdateFirmwareRequest) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *SignedUpdateFirmwareRequest) GetRetryInterval() int32 {
+	if x != nil && x.RetryInterval != nil {
+		return *x.RetryInterval
+	}
+	return 0
+}
+
+func (x *SignedUpdateFirmwareRequest) GetRequestId() int32 {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return 0
+}
+
+func (x *SignedUpdateFirmwareRequest) GetFirmware() *Firmware {
+	if x != nil {
+		return x.Firmware
+	}
+	return nil
+}
+
+type SignedUpdateFirmwareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SignedUpdateFirmwareResponse) Reset() {
+	*x = SignedUpdateFirmwareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedUpdateFirmwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedUpdateFirmwareResponse) ProtoMessage() {}
+
+func (x *SignedUpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedUpdateFirmwareResponse.ProtoReflect.Descriptor instead.
+func (*SignedUpdateFirmwareResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *SignedUpdateFirmwareResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type StartTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId   *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	IdTag         string `protobuf:"bytes,2,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+	MeterStart    *int32 `protobuf:"varint,3,opt,name=meter_start,json=meterStart,proto3,oneof" json:"meter_start,omitempty"`
+	ReservationId *int32 `protobuf:"varint,4,opt,name=reservation_id,json=reservationId,proto3,oneof" json:"reservation_id,omitempty"`
+	Timestamp     string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *StartTransactionRequest) Reset() {
+	*x = StartTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTransactionRequest) ProtoMessage() {}
+
+func (x *StartTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTransactionRequest.ProtoReflect.Descriptor instead.
+func (*StartTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *StartTransactionRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *StartTransactionRequest) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+func (x *StartTransactionRequest) GetMeterStart() int32 {
+	if x != nil && x.MeterStart != nil {
+		return *x.MeterStart
+	}
+	return 0
+}
+
+func (x *StartTransactionRequest) GetReservationId() int32 {
+	if x != nil && x.ReservationId != nil {
+		return *x.ReservationId
+	}
+	return 0
+}
+
+func (x *StartTransactionRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type StartTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTagInfo     *IdTagInfo `protobuf:"bytes,1,opt,name=id_tag_info,json=idTagInfo,proto3" json:"id_tag_info,omitempty"`
+	TransactionId *int32     `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+}
+
+func (x *StartTransactionResponse) Reset() {
+	*x = StartTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTransactionResponse) ProtoMessage() {}
+
+func (x *StartTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTransactionResponse.ProtoReflect.Descriptor instead.
+func (*StartTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *StartTransactionResponse) GetIdTagInfo() *IdTagInfo {
+	if x != nil {
+		return x.IdTagInfo
+	}
+	return nil
+}
+
+func (x *StartTransactionResponse) GetTransactionId() int32 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type StatusNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId     *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+	ErrorCode       string `protobuf:"bytes,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Info            string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Status          string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp       string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VendorId        string `protobuf:"bytes,6,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorErrorCode string `protobuf:"bytes,7,opt,name=vendor_error_code,json=vendorErrorCode,proto3" json:"vendor_error_code,omitempty"`
+}
+
+func (x *StatusNotificationRequest) Reset() {
+	*x = StatusNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusNotificationRequest) ProtoMessage() {}
+
+func (x *StatusNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusNotificationRequest.ProtoReflect.Descriptor instead.
+func (*StatusNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *StatusNotificationRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+func (x *StatusNotificationRequest) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *StatusNotificationRequest) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *StatusNotificationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusNotificationRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *StatusNotificationRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *StatusNotificationRequest) GetVendorErrorCode() string {
+	if x != nil {
+		return x.VendorErrorCode
+	}
+	return ""
+}
+
+type StatusNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatusNotificationResponse) Reset() {
+	*x = StatusNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusNotificationResponse) ProtoMessage() {}
+
+func (x *StatusNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusNotificationResponse.ProtoReflect.Descriptor instead.
+func (*StatusNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{166}
+}
+
+type StopTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTag           string        `protobuf:"bytes,1,opt,name=id_tag,json=idTag,proto3" json:"id_tag,omitempty"`
+	MeterStop       *int32        `protobuf:"varint,2,opt,name=meter_stop,json=meterStop,proto3,oneof" json:"meter_stop,omitempty"`
+	Timestamp       string        `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TransactionId   *int32        `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Reason          string        `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TransactionData []*MeterValue `protobuf:"bytes,6,rep,name=transaction_data,json=transactionData,proto3" json:"transaction_data,omitempty"`
+}
+
+func (x *StopTransactionRequest) Reset() {
+	*x = StopTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTransactionRequest) ProtoMessage() {}
+
+func (x *StopTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTransactionRequest.ProtoReflect.Descriptor instead.
+func (*StopTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *StopTransactionRequest) GetIdTag() string {
+	if x != nil {
+		return x.IdTag
+	}
+	return ""
+}
+
+func (x *StopTransactionRequest) GetMeterStop() int32 {
+	if x != nil && x.MeterStop != nil {
+		return *x.MeterStop
+	}
+	return 0
+}
+
+func (x *StopTransactionRequest) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *StopTransactionRequest) GetTransactionId() int32 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *StopTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StopTransactionRequest) GetTransactionData() []*MeterValue {
+	if x != nil {
+		return x.TransactionData
+	}
+	return nil
+}
+
+type StopTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdTagInfo *IdTagInfo `protobuf:"bytes,1,opt,name=id_tag_info,json=idTagInfo,proto3" json:"id_tag_info,omitempty"`
+}
+
+func (x *StopTransactionResponse) Reset() {
+	*x = StopTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTransactionResponse) ProtoMessage() {}
+
+func (x *StopTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTransactionResponse.ProtoReflect.Descriptor instead.
+func (*StopTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *StopTransactionResponse) GetIdTagInfo() *IdTagInfo {
+	if x != nil {
+		return x.IdTagInfo
+	}
+	return nil
+}
+
+type TriggerMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedMessage string `protobuf:"bytes,1,opt,name=requested_message,json=requestedMessage,proto3" json:"requested_message,omitempty"`
+	ConnectorId      *int32 `protobuf:"varint,2,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+}
+
+func (x *TriggerMessageRequest) Reset() {
+	*x = TriggerMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMessageRequest) ProtoMessage() {}
+
+func (x *TriggerMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMessageRequest.ProtoReflect.Descriptor instead.
+func (*TriggerMessageRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *TriggerMessageRequest) GetRequestedMessage() string {
+	if x != nil {
+		return x.RequestedMessage
+	}
+	return ""
+}
+
+func (x *TriggerMessageRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+type TriggerMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TriggerMessageResponse) Reset() {
+	*x = TriggerMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerMessageResponse) ProtoMessage() {}
+
+func (x *TriggerMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerMessageResponse.ProtoReflect.Descriptor instead.
+func (*TriggerMessageResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *TriggerMessageResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnlockConnectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectorId *int32 `protobuf:"varint,1,opt,name=connector_id,json=connectorId,proto3,oneof" json:"connector_id,omitempty"`
+}
+
+func (x *UnlockConnectorRequest) Reset() {
+	*x = UnlockConnectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockConnectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockConnectorRequest) ProtoMessage() {}
+
+func (x *UnlockConnectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockConnectorRequest.ProtoReflect.Descriptor instead.
+func (*UnlockConnectorRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *UnlockConnectorRequest) GetConnectorId() int32 {
+	if x != nil && x.ConnectorId != nil {
+		return *x.ConnectorId
+	}
+	return 0
+}
+
+type UnlockConnectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UnlockConnectorResponse) Reset() {
+	*x = UnlockConnectorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockConnectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockConnectorResponse) ProtoMessage() {}
+
+func (x *UnlockConnectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockConnectorResponse.ProtoReflect.Descriptor instead.
+func (*UnlockConnectorResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *UnlockConnectorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateFirmwareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location      string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Retries       *int32 `protobuf:"varint,2,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	RetrieveDate  string `protobuf:"bytes,3,opt,name=retrieve_date,json=retrieveDate,proto3" json:"retrieve_date,omitempty"`
+	RetryInterval *int32 `protobuf:"varint,4,opt,name=retry_interval,json=retryInterval,proto3,oneof" json:"retry_interval,omitempty"`
+}
+
+func (x *UpdateFirmwareRequest) Reset() {
+	*x = UpdateFirmwareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirmwareRequest) ProtoMessage() {}
+
+func (x *UpdateFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *UpdateFirmwareRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateFirmwareRequest) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *UpdateFirmwareRequest) GetRetrieveDate() string {
+	if x != nil {
+		return x.RetrieveDate
+	}
+	return ""
+}
+
+func (x *UpdateFirmwareRequest) GetRetryInterval() int32 {
+	if x != nil && x.RetryInterval != nil {
+		return *x.RetryInterval
+	}
+	return 0
+}
+
+type UpdateFirmwareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateFirmwareResponse) Reset() {
+	*x = UpdateFirmwareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ocpp16_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFirmwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirmwareResponse) ProtoMessage() {}
+
+func (x *UpdateFirmwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ocpp16_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirmwareResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFirmwareResponse) Descriptor() ([]byte, []int) {
+	return file_ocpp16_proto_rawDescGZIP(), []int{174}
+}
+
+var File_ocpp16_proto protoreflect.FileDescriptor
+
+var file_ocpp16_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x1d, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57,
+	0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x75,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0x84, 0x14, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x60, 0x0a, 0x1a, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1b,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x66, 0x0a, 0x1c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x1e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x51, 0x0a, 0x15, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x1e, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x5d, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x15, 0x67, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x1e, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x70, 0x0a, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x1f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
+	0x6e, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55,
+	0x0a, 0x17, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x14, 0x73, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x19, 0x73, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x17, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1a, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x70, 0x0a,
+	0x20, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x7d, 0x0a, 0x25, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x21, 0x67, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x63, 0x0a, 0x1b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x1e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x1b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x15, 0x0a, 0x05,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x63, 0x0a, 0x1b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x19, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x1d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x1b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x14, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x1f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x16,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x1c, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x47, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x48, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x1b, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x21,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x70, 0x0a, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6e,
+	0x6f, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x18, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x4e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x1d, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x73, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x19, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x51, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5a, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x52, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72,
+	0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x1b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x53, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x63, 0x0a, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x21, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x55, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1e, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a,
+	0x26, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x56, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x22, 0x67, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x57, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x58, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x1a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72,
+	0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x59,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xfb, 0x20, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x19, 0x62,
+	0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x17, 0x62, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x15, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x85, 0x01,
+	0x0a, 0x27, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x24, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7c, 0x0a, 0x24, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72,
+	0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x21, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x14,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x19,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x17, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x5a, 0x0a, 0x18, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x23,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x20, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x73, 0x69, 0x67,
+	0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x1f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x1c, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x8f, 0x01, 0x0a, 0x2b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x27, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x69, 0x0a, 0x1d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x1b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x14,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x43, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1f,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x16, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x45, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x64, 0x61, 0x74,
+	0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x1c, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x47,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x48,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x1f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1b,
+	0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x21, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6e, 0x6f,
+	0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x18, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x1d,
+	0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x4f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x73, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x19, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x51,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x52, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x1b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x53, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x54, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x21, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x55, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x26,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x56, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x22, 0x67, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x57, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x58, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x59, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x70, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7e, 0x0a, 0x14, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01,
+	0x0a, 0x17, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x82, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x93, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x72, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x86, 0x01,
+	0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x76,
+	0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12,
+	0x35, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x80,
+	0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x21, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa7,
+	0x01, 0x0a, 0x23, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x92, 0x01, 0x0a, 0x1e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7e, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7a, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x37,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x84, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6a, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x70, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x88, 0x01, 0x0a,
+	0x19, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x74, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8a, 0x01,
+	0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x43, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x72, 0x0a, 0x0e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x81, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x77, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x1f, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x78, 0x0a, 0x11, 0x53,
+	0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x82, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7c, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x24, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x26, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12,
+	0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95,
+	0x01, 0x0a, 0x1a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7e, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x18,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x7c, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01,
+	0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7a, 0x0a, 0x12, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x37,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x7c, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x7a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5d, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64,
+	0x54, 0x61, 0x67, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x49, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x69, 0x64, 0x54,
+	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x29, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x64,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x54, 0x61,
+	0x67, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x69, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x17, 0x42, 0x6f,
+	0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x78, 0x5f, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x42, 0x6f, 0x78, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x72,
+	0x6d, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x63, 0x63, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x63, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d,
+	0x73, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x83, 0x01,
+	0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x59, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x33,
+	0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x34, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a,
+	0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xeb, 0x03, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x38, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f,
+	0x12, 0x45, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0xb6, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x58, 0x0a,
+	0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x16, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x16,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73,
+	0x22, 0x13, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x38, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x36, 0x0a, 0x1c, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x68, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x6f, 0x6e, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x22, 0x65, 0x0a, 0x13, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x42, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4f, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x33, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3e, 0x0a, 0x24, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x25, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x85, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x3b, 0x0a, 0x21, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x24, 0x0a, 0x22, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdd, 0x01, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0xd9,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x4e, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x56, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x6c, 0x6f, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x4c, 0x6f, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x03, 0x6c,
+	0x6f, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x12, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x09,
+	0x49, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x54, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x22, 0x34, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6c, 0x64,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x69, 0x0a, 0x1c, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x39, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x4d,
+	0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x15,
+	0x0a, 0x13, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x06, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x64, 0x54, 0x61, 0x67, 0x12, 0x42, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1e, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe7, 0x01,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64,
+	0x54, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x54, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22,
+	0x71, 0x0a, 0x20, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x63, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x23, 0x0a, 0x21, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x18, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x2f, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x9f, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x14, 0x63, 0x73, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x12, 0x63,
+	0x73, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x22, 0x34, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x73, 0x72, 0x22, 0x31, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x74, 0x0a, 0x27, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x2a, 0x0a,
+	0x28, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x1b, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x08, 0x66, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfc, 0x01, 0x0a,
+	0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x15, 0x0a, 0x06, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x64, 0x54, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0a,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x18,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x74,
+	0x61, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x69, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x19, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x96, 0x02, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64,
+	0x54, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x53, 0x74,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x49, 0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x69,
+	0x64, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x7d, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x17,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xc2, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xac,
+	0x11, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x6c, 0x6c,
+	0x1a, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x59, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
+	0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x16, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x1a, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5c, 0x0a,
+	0x14, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x5c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x22, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x1a,
+	0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x59, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x62, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5f, 0x0a, 0x15,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x43,
+	0x61, 0x6c, 0x6c, 0x1a, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a,
+	0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x13, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47,
+	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1b, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x20, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x4a, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1c, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1d, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x6f, 0x63,
+	0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1f, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a,
+	0x1f, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x62, 0x0a, 0x16, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x24,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x6e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x61,
+	0x6c, 0x6c, 0x1a, 0x14, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x20,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x5c, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x0d, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x28, 0x01, 0x30, 0x01, 0x32, 0x8b, 0x0b,
+	0x0a, 0x14, 0x43, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x12, 0x15, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x17, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x42,
+	0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x1a,
+	0x1a, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x77, 0x0a, 0x1d, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x2b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x6e, 0x0a, 0x1a, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x12, 0x15, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x17, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x41, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x17, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31,
+	0x36, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x20, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d,
+	0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1d,
+	0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x6b, 0x0a,
+	0x19, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x53, 0x69,
+	0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x1d, 0x2e, 0x6f, 0x63, 0x70,
+	0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5f, 0x0a, 0x15, 0x4c, 0x6f, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x4c,
+	0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x20, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2c, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x72,
+	0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a,
+	0x13, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x13, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x6f, 0x63, 0x70, 0x70,
+	0x31, 0x36, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x13, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0d, 0x2e,
+	0x6f, 0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0x0d, 0x2e, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x11, 0x5a, 0x0f, 0x6f,
+	0x63, 0x70, 0x70, 0x31, 0x36, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ocpp16_proto_rawDescOnce sync.Once
+	file_ocpp16_proto_rawDescData = file_ocpp16_proto_rawDesc
+)
+
+func file_ocpp16_proto_rawDescGZIP() []byte {
+	file_ocpp16_proto_rawDescOnce.Do(func() {
+		file_ocpp16_proto_rawDescData = protoimpl.X.CompressGZIP(file_ocpp16_proto_rawDescData)
+	})
+	return file_ocpp16_proto_rawDescData
+}
+
+var file_ocpp16_proto_msgTypes = make([]protoimpl.MessageInfo, 175)
+var file_ocpp16_proto_goTypes = []interface{}{
+	(*Empty)(nil),                                    // 0: ocpp16.Empty
+	(*ChargePoint)(nil),                              // 1: ocpp16.ChargePoint
+	(*CallError)(nil),                                // 2: ocpp16.CallError
+	(*Subscription)(nil),                             // 3: ocpp16.Subscription
+	(*Command)(nil),                                  // 4: ocpp16.Command
+	(*Call)(nil),                                     // 5: ocpp16.Call
+	(*Reply)(nil),                                    // 6: ocpp16.Reply
+	(*Event)(nil),                                    // 7: ocpp16.Event
+	(*AuthorizeCall)(nil),                            // 8: ocpp16.AuthorizeCall
+	(*AuthorizeResult)(nil),                          // 9: ocpp16.AuthorizeResult
+	(*BootNotificationCall)(nil),                     // 10: ocpp16.BootNotificationCall
+	(*BootNotificationResult)(nil),                   // 11: ocpp16.BootNotificationResult
+	(*CancelReservationCall)(nil),                    // 12: ocpp16.CancelReservationCall
+	(*CancelReservationResult)(nil),                  // 13: ocpp16.CancelReservationResult
+	(*CertificateSignedCall)(nil),                    // 14: ocpp16.CertificateSignedCall
+	(*CertificateSignedResult)(nil),                  // 15: ocpp16.CertificateSignedResult
+	(*ChangeAvailabilityCall)(nil),                   // 16: ocpp16.ChangeAvailabilityCall
+	(*ChangeAvailabilityResult)(nil),                 // 17: ocpp16.ChangeAvailabilityResult
+	(*ChangeConfigurationCall)(nil),                  // 18: ocpp16.ChangeConfigurationCall
+	(*ChangeConfigurationResult)(nil),                // 19: ocpp16.ChangeConfigurationResult
+	(*ClearCacheCall)(nil),                           // 20: ocpp16.ClearCacheCall
+	(*ClearCacheResult)(nil),                         // 21: ocpp16.ClearCacheResult
+	(*ClearChargingProfileCall)(nil),                 // 22: ocpp16.ClearChargingProfileCall
+	(*ClearChargingProfileResult)(nil),               // 23: ocpp16.ClearChargingProfileResult
+	(*DataTransferCall)(nil),                         // 24: ocpp16.DataTransferCall
+	(*DataTransferResult)(nil),                       // 25: ocpp16.DataTransferResult
+	(*DeleteCertificateCall)(nil),                    // 26: ocpp16.DeleteCertificateCall
+	(*DeleteCertificateResult)(nil),                  // 27: ocpp16.DeleteCertificateResult
+	(*DiagnosticsStatusNotificationCall)(nil),        // 28: ocpp16.DiagnosticsStatusNotificationCall
+	(*DiagnosticsStatusNotificationResult)(nil),      // 29: ocpp16.DiagnosticsStatusNotificationResult
+	(*ExtendedTriggerMessageCall)(nil),               // 30: ocpp16.ExtendedTriggerMessageCall
+	(*ExtendedTriggerMessageResult)(nil),             // 31: ocpp16.ExtendedTriggerMessageResult
+	(*FirmwareStatusNotificationCall)(nil),           // 32: ocpp16.FirmwareStatusNotificationCall
+	(*FirmwareStatusNotificationResult)(nil),         // 33: ocpp16.FirmwareStatusNotificationResult
+	(*GetCompositeScheduleCall)(nil),                 // 34: ocpp16.GetCompositeScheduleCall
+	(*GetCompositeScheduleResult)(nil),               // 35: ocpp16.GetCompositeScheduleResult
+	(*GetConfigurationCall)(nil),                     // 36: ocpp16.GetConfigurationCall
+	(*GetConfigurationResult)(nil),                   // 37: ocpp16.GetConfigurationResult
+	(*GetDiagnosticsCall)(nil),                       // 38: ocpp16.GetDiagnosticsCall
+	(*GetDiagnosticsResult)(nil),                     // 39: ocpp16.GetDiagnosticsResult
+	(*GetInstalledCertificateIdsCall)(nil),           // 40: ocpp16.GetInstalledCertificateIdsCall
+	(*GetInstalledCertificateIdsResult)(nil),         // 41: ocpp16.GetInstalledCertificateIdsResult
+	(*GetLocalListVersionCall)(nil),                  // 42: ocpp16.GetLocalListVersionCall
+	(*GetLocalListVersionResult)(nil),                // 43: ocpp16.GetLocalListVersionResult
+	(*GetLogCall)(nil),                               // 44: ocpp16.GetLogCall
+	(*GetLogResult)(nil),                             // 45: ocpp16.GetLogResult
+	(*HeartbeatCall)(nil),                            // 46: ocpp16.HeartbeatCall
+	(*HeartbeatResult)(nil),                          // 47: ocpp16.HeartbeatResult
+	(*InstallCertificateCall)(nil),                   // 48: ocpp16.InstallCertificateCall
+	(*InstallCertificateResult)(nil),                 // 49: ocpp16.InstallCertificateResult
+	(*LogStatusNotificationCall)(nil),                // 50: ocpp16.LogStatusNotificationCall
+	(*LogStatusNotificationResult)(nil),              // 51: ocpp16.LogStatusNotificationResult
+	(*MeterValuesCall)(nil),                          // 52: ocpp16.MeterValuesCall
+	(*MeterValuesResult)(nil),                        // 53: ocpp16.MeterValuesResult
+	(*RemoteStartTransactionCall)(nil),               // 54: ocpp16.RemoteStartTransactionCall
+	(*RemoteStartTransactionResult)(nil),             // 55: ocpp16.RemoteStartTransactionResult
+	(*RemoteStopTransactionCall)(nil),                // 56: ocpp16.RemoteStopTransactionCall
+	(*RemoteStopTransactionResult)(nil),              // 57: ocpp16.RemoteStopTransactionResult
+	(*ReserveNowCall)(nil),                           // 58: ocpp16.ReserveNowCall
+	(*ReserveNowResult)(nil),                         // 59: ocpp16.ReserveNowResult
+	(*ResetCall)(nil),                                // 60: ocpp16.ResetCall
+	(*ResetResult)(nil),                              // 61: ocpp16.ResetResult
+	(*SecurityEventNotificationCall)(nil),            // 62: ocpp16.SecurityEventNotificationCall
+	(*SecurityEventNotificationResult)(nil),          // 63: ocpp16.SecurityEventNotificationResult
+	(*SendLocalListCall)(nil),                        // 64: ocpp16.SendLocalListCall
+	(*SendLocalListResult)(nil),                      // 65: ocpp16.SendLocalListResult
+	(*SetChargingProfileCall)(nil),                   // 66: ocpp16.SetChargingProfileCall
+	(*SetChargingProfileResult)(nil),                 // 67: ocpp16.SetChargingProfileResult
+	(*SignCertificateCall)(nil),                      // 68: ocpp16.SignCertificateCall
+	(*SignCertificateResult)(nil),                    // 69: ocpp16.SignCertificateResult
+	(*SignedFirmwareStatusNotificationCall)(nil),     // 70: ocpp16.SignedFirmwareStatusNotificationCall
+	(*SignedFirmwareStatusNotificationResult)(nil),   // 71: ocpp16.SignedFirmwareStatusNotificationResult
+	(*SignedUpdateFirmwareCall)(nil),                 // 72: ocpp16.SignedUpdateFirmwareCall
+	(*SignedUpdateFirmwareResult)(nil),               // 73: ocpp16.SignedUpdateFirmwareResult
+	(*StartTransactionCall)(nil),                     // 74: ocpp16.StartTransactionCall
+	(*StartTransactionResult)(nil),                   // 75: ocpp16.StartTransactionResult
+	(*StatusNotificationCall)(nil),                   // 76: ocpp16.StatusNotificationCall
+	(*StatusNotificationResult)(nil),                 // 77: ocpp16.StatusNotificationResult
+	(*StopTransactionCall)(nil),                      // 78: ocpp16.StopTransactionCall
+	(*StopTransactionResult)(nil),                    // 79: ocpp16.StopTransactionResult
+	(*TriggerMessageCall)(nil),                       // 80: ocpp16.TriggerMessageCall
+	(*TriggerMessageResult)(nil),                     // 81: ocpp16.TriggerMessageResult
+	(*UnlockConnectorCall)(nil),                      // 82: ocpp16.UnlockConnectorCall
+	(*UnlockConnectorResult)(nil),                    // 83: ocpp16.UnlockConnectorResult
+	(*UpdateFirmwareCall)(nil),                       // 84: ocpp16.UpdateFirmwareCall
+	(*UpdateFirmwareResult)(nil),                     // 85: ocpp16.UpdateFirmwareResult
+	(*AuthorizationData)(nil),                        // 86: ocpp16.AuthorizationData
+	(*AuthorizeRequest)(nil),                         // 87: ocpp16.AuthorizeRequest
+	(*AuthorizeResponse)(nil),                        // 88: ocpp16.AuthorizeResponse
+	(*BootNotificationRequest)(nil),                  // 89: ocpp16.BootNotificationRequest
+	(*BootNotificationResponse)(nil),                 // 90: ocpp16.BootNotificationResponse
+	(*CancelReservationRequest)(nil),                 // 91: ocpp16.CancelReservationRequest
+	(*CancelReservationResponse)(nil),                // 92: ocpp16.CancelReservationResponse
+	(*CertificateHashData)(nil),                      // 93: ocpp16.CertificateHashData
+	(*CertificateSignedRequest)(nil),                 // 94: ocpp16.CertificateSignedRequest
+	(*CertificateSignedResponse)(nil),                // 95: ocpp16.CertificateSignedResponse
+	(*ChangeAvailabilityRequest)(nil),                // 96: ocpp16.ChangeAvailabilityRequest
+	(*ChangeAvailabilityResponse)(nil),               // 97: ocpp16.ChangeAvailabilityResponse
+	(*ChangeConfigurationRequest)(nil),               // 98: ocpp16.ChangeConfigurationRequest
+	(*ChangeConfigurationResponse)(nil),              // 99: ocpp16.ChangeConfigurationResponse
+	(*ChargingProfile)(nil),                          // 100: ocpp16.ChargingProfile
+	(*ChargingSchedule)(nil),                         // 101: ocpp16.ChargingSchedule
+	(*ChargingSchedulePeriod)(nil),                   // 102: ocpp16.ChargingSchedulePeriod
+	(*ClearCacheRequest)(nil),                        // 103: ocpp16.ClearCacheRequest
+	(*ClearCacheResponse)(nil),                       // 104: ocpp16.ClearCacheResponse
+	(*ClearChargingProfileRequest)(nil),              // 105: ocpp16.ClearChargingProfileRequest
+	(*ClearChargingProfileResponse)(nil),             // 106: ocpp16.ClearChargingProfileResponse
+	(*ConfigurationKey)(nil),                         // 107: ocpp16.ConfigurationKey
+	(*DataTransferRequest)(nil),                      // 108: ocpp16.DataTransferRequest
+	(*DataTransferResponse)(nil),                     // 109: ocpp16.DataTransferResponse
+	(*DeleteCertificateRequest)(nil),                 // 110: ocpp16.DeleteCertificateRequest
+	(*DeleteCertificateResponse)(nil),                // 111: ocpp16.DeleteCertificateResponse
+	(*DiagnosticsStatusNotificationRequest)(nil),     // 112: ocpp16.DiagnosticsStatusNotificationRequest
+	(*DiagnosticsStatusNotificationResponse)(nil),    // 113: ocpp16.DiagnosticsStatusNotificationResponse
+	(*ExtendedTriggerMessageRequest)(nil),            // 114: ocpp16.ExtendedTriggerMessageRequest
+	(*ExtendedTriggerMessageResponse)(nil),           // 115: ocpp16.ExtendedTriggerMessageResponse
+	(*Firmware)(nil),                                 // 116: ocpp16.Firmware
+	(*FirmwareStatusNotificationRequest)(nil),        // 117: ocpp16.FirmwareStatusNotificationRequest
+	(*FirmwareStatusNotificationResponse)(nil),       // 118: ocpp16.FirmwareStatusNotificationResponse
+	(*GetCompositeScheduleRequest)(nil),              // 119: ocpp16.GetCompositeScheduleRequest
+	(*GetCompositeScheduleResponse)(nil),             // 120: ocpp16.GetCompositeScheduleResponse
+	(*GetConfigurationRequest)(nil),                  // 121: ocpp16.GetConfigurationRequest
+	(*GetConfigurationResponse)(nil),                 // 122: ocpp16.GetConfigurationResponse
+	(*GetDiagnosticsRequest)(nil),                    // 123: ocpp16.GetDiagnosticsRequest
+	(*GetDiagnosticsResponse)(nil),                   // 124: ocpp16.GetDiagnosticsResponse
+	(*GetInstalledCertificateIdsRequest)(nil),        // 125: ocpp16.GetInstalledCertificateIdsRequest
+	(*GetInstalledCertificateIdsResponse)(nil),       // 126: ocpp16.GetInstalledCertificateIdsResponse
+	(*GetLocalListVersionRequest)(nil),               // 127: ocpp16.GetLocalListVersionRequest
+	(*GetLocalListVersionResponse)(nil),              // 128: ocpp16.GetLocalListVersionResponse
+	(*GetLogRequest)(nil),                            // 129: ocpp16.GetLogRequest
+	(*GetLogResponse)(nil),                           // 130: ocpp16.GetLogResponse
+	(*HeartbeatRequest)(nil),                         // 131: ocpp16.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                        // 132: ocpp16.HeartbeatResponse
+	(*IdTagInfo)(nil),                                // 133: ocpp16.IdTagInfo
+	(*InstallCertificateRequest)(nil),                // 134: ocpp16.InstallCertificateRequest
+	(*InstallCertificateResponse)(nil),               // 135: ocpp16.InstallCertificateResponse
+	(*LogParameters)(nil),                            // 136: ocpp16.LogParameters
+	(*LogStatusNotificationRequest)(nil),             // 137: ocpp16.LogStatusNotificationRequest
+	(*LogStatusNotificationResponse)(nil),            // 138: ocpp16.LogStatusNotificationResponse
+	(*MeterValue)(nil),                               // 139: ocpp16.MeterValue
+	(*MeterValuesRequest)(nil),                       // 140: ocpp16.MeterValuesRequest
+	(*MeterValuesResponse)(nil),                      // 141: ocpp16.MeterValuesResponse
+	(*RemoteStartTransactionRequest)(nil),            // 142: ocpp16.RemoteStartTransactionRequest
+	(*RemoteStartTransactionResponse)(nil),           // 143: ocpp16.RemoteStartTransactionResponse
+	(*RemoteStopTransactionRequest)(nil),             // 144: ocpp16.RemoteStopTransactionRequest
+	(*RemoteStopTransactionResponse)(nil),            // 145: ocpp16.RemoteStopTransactionResponse
+	(*ReserveNowRequest)(nil),                        // 146: ocpp16.ReserveNowRequest
+	(*ReserveNowResponse)(nil),                       // 147: ocpp16.ReserveNowResponse
+	(*ResetRequest)(nil),                             // 148: ocpp16.ResetRequest
+	(*ResetResponse)(nil),                            // 149: ocpp16.ResetResponse
+	(*SampledValue)(nil),                             // 150: ocpp16.SampledValue
+	(*SecurityEventNotificationRequest)(nil),         // 151: ocpp16.SecurityEventNotificationRequest
+	(*SecurityEventNotificationResponse)(nil),        // 152: ocpp16.SecurityEventNotificationResponse
+	(*SendLocalListRequest)(nil),                     // 153: ocpp16.SendLocalListRequest
+	(*SendLocalListResponse)(nil),                    // 154: ocpp16.SendLocalListResponse
+	(*SetChargingProfileRequest)(nil),                // 155: ocpp16.SetChargingProfileRequest
+	(*SetChargingProfileResponse)(nil),               // 156: ocpp16.SetChargingProfileResponse
+	(*SignCertificateRequest)(nil),                   // 157: ocpp16.SignCertificateRequest
+	(*SignCertificateResponse)(nil),                  // 158: ocpp16.SignCertificateResponse
+	(*SignedFirmwareStatusNotificationRequest)(nil),  // 159: ocpp16.SignedFirmwareStatusNotificationRequest
+	(*SignedFirmwareStatusNotificationResponse)(nil), // 160: ocpp16.SignedFirmwareStatusNotificationResponse
+	(*SignedUpdateFirmwareRequest)(nil),              // 161: ocpp16.SignedUpdateFirmwareRequest
+	(*SignedUpdateFirmwareResponse)(nil),             // 162: ocpp16.SignedUpdateFirmwareResponse
+	(*StartTransactionRequest)(nil),                  // 163: ocpp16.StartTransactionRequest
+	(*StartTransactionResponse)(nil),                 // 164: ocpp16.StartTransactionResponse
+	(*StatusNotificationRequest)(nil),                // 165: ocpp16.StatusNotificationRequest
+	(*StatusNotificationResponse)(nil),               // 166: ocpp16.StatusNotificationResponse
+	(*StopTransactionRequest)(nil),                   // 167: ocpp16.StopTransactionRequest
+	(*StopTransactionResponse)(nil),                  // 168: ocpp16.StopTransactionResponse
+	(*TriggerMessageRequest)(nil),                    // 169: ocpp16.TriggerMessageRequest
+	(*TriggerMessageResponse)(nil),                   // 170: ocpp16.TriggerMessageResponse
+	(*UnlockConnectorRequest)(nil),                   // 171: ocpp16.UnlockConnectorRequest
+	(*UnlockConnectorResponse)(nil),                  // 172: ocpp16.UnlockConnectorResponse
+	(*UpdateFirmwareRequest)(nil),                    // 173: ocpp16.UpdateFirmwareRequest
+	(*UpdateFirmwareResponse)(nil),                   // 174: ocpp16.UpdateFirmwareResponse
+}
+var file_ocpp16_proto_depIdxs = []int32{
+	3,   // 0: ocpp16.Command.subscribe:type_name -> ocpp16.Subscription
+	3,   // 1: ocpp16.Command.unsubscribe:type_name -> ocpp16.Subscription
+	5,   // 2: ocpp16.Command.call:type_name -> ocpp16.Call
+	91,  // 3: ocpp16.Call.cancel_reservation_request:type_name -> ocpp16.CancelReservationRequest
+	96,  // 4: ocpp16.Call.change_availability_request:type_name -> ocpp16.ChangeAvailabilityRequest
+	98,  // 5: ocpp16.Call.change_configuration_request:type_name -> ocpp16.ChangeConfigurationRequest
+	103, // 6: ocpp16.Call.clear_cache_request:type_name -> ocpp16.ClearCacheRequest
+	105, // 7: ocpp16.Call.clear_charging_profile_request:type_name -> ocpp16.ClearChargingProfileRequest
+	108, // 8: ocpp16.Call.data_transfer_request:type_name -> ocpp16.DataTransferRequest
+	119, // 9: ocpp16.Call.get_composite_schedule_request:type_name -> ocpp16.GetCompositeScheduleRequest
+	121, // 10: ocpp16.Call.get_configuration_request:type_name -> ocpp16.GetConfigurationRequest
+	123, // 11: ocpp16.Call.get_diagnostics_request:type_name -> ocpp16.GetDiagnosticsRequest
+	127, // 12: ocpp16.Call.get_local_list_version_request:type_name -> ocpp16.GetLocalListVersionRequest
+	142, // 13: ocpp16.Call.remote_start_transaction_request:type_name -> ocpp16.RemoteStartTransactionRequest
+	144, // 14: ocpp16.Call.remote_stop_transaction_request:type_name -> ocpp16.RemoteStopTransactionRequest
+	146, // 15: ocpp16.Call.reserve_now_request:type_name -> ocpp16.ReserveNowRequest
+	148, // 16: ocpp16.Call.reset_request:type_name -> ocpp16.ResetRequest
+	153, // 17: ocpp16.Call.send_local_list_request:type_name -> ocpp16.SendLocalListRequest
+	155, // 18: ocpp16.Call.set_charging_profile_request:type_name -> ocpp16.SetChargingProfileRequest
+	169, // 19: ocpp16.Call.trigger_message_request:type_name -> ocpp16.TriggerMessageRequest
+	171, // 20: ocpp16.Call.unlock_connector_request:type_name -> ocpp16.UnlockConnectorRequest
+	173, // 21: ocpp16.Call.update_firmware_request:type_name -> ocpp16.UpdateFirmwareRequest
+	94,  // 22: ocpp16.Call.certificate_signed_request:type_name -> ocpp16.CertificateSignedRequest
+	110, // 23: ocpp16.Call.delete_certificate_request:type_name -> ocpp16.DeleteCertificateRequest
+	114, // 24: ocpp16.Call.extended_trigger_message_request:type_name -> ocpp16.ExtendedTriggerMessageRequest
+	125, // 25: ocpp16.Call.get_installed_certificate_ids_request:type_name -> ocpp16.GetInstalledCertificateIdsRequest
+	129, // 26: ocpp16.Call.get_log_request:type_name -> ocpp16.GetLogRequest
+	134, // 27: ocpp16.Call.install_certificate_request:type_name -> ocpp16.InstallCertificateRequest
+	161, // 28: ocpp16.Call.signed_update_firmware_request:type_name -> ocpp16.SignedUpdateFirmwareRequest
+	2,   // 29: ocpp16.Reply.error:type_name -> ocpp16.CallError
+	92,  // 30: ocpp16.Reply.cancel_reservation_response:type_name -> ocpp16.CancelReservationResponse
+	97,  // 31: ocpp16.Reply.change_availability_response:type_name -> ocpp16.ChangeAvailabilityResponse
+	99,  // 32: ocpp16.Reply.change_configuration_response:type_name -> ocpp16.ChangeConfigurationResponse
+	104, // 33: ocpp16.Reply.clear_cache_response:type_name -> ocpp16.ClearCacheResponse
+	106, // 34: ocpp16.Reply.clear_charging_profile_response:type_name -> ocpp16.ClearChargingProfileResponse
+	109, // 35: ocpp16.Reply.data_transfer_response:type_name -> ocpp16.DataTransferResponse
+	120, // 36: ocpp16.Reply.get_composite_schedule_response:type_name -> ocpp16.GetCompositeScheduleResponse
+	122, // 37: ocpp16.Reply.get_configuration_response:type_name -> ocpp16.GetConfigurationResponse
+	124, // 38: ocpp16.Reply.get_diagnostics_response:type_name -> ocpp16.GetDiagnosticsResponse
+	128, // 39: ocpp16.Reply.get_local_list_version_response:type_name -> ocpp16.GetLocalListVersionResponse
+	143, // 40: ocpp16.Reply.remote_start_transaction_response:type_name -> ocpp16.RemoteStartTransactionResponse
+	145, // 41: ocpp16.Reply.remote_stop_transaction_response:type_name -> ocpp16.RemoteStopTransactionResponse
+	147, // 42: ocpp16.Reply.reserve_now_response:type_name -> ocpp16.ReserveNowResponse
+	149, // 43: ocpp16.Reply.reset_response:type_name -> ocpp16.ResetResponse
+	154, // 44: ocpp16.Reply.send_local_list_response:type_name -> ocpp16.SendLocalListResponse
+	156, // 45: ocpp16.Reply.set_charging_profile_response:type_name -> ocpp16.SetChargingProfileResponse
+	170, // 46: ocpp16.Reply.trigger_message_response:type_name -> ocpp16.TriggerMessageResponse
+	172, // 47: ocpp16.Reply.unlock_connector_response:type_name -> ocpp16.UnlockConnectorResponse
+	174, // 48: ocpp16.Reply.update_firmware_response:type_name -> ocpp16.UpdateFirmwareResponse
+	95,  // 49: ocpp16.Reply.certificate_signed_response:type_name -> ocpp16.CertificateSignedResponse
+	111, // 50: ocpp16.Reply.delete_certificate_response:type_name -> ocpp16.DeleteCertificateResponse
+	115, // 51: ocpp16.Reply.extended_trigger_message_response:type_name -> ocpp16.ExtendedTriggerMessageResponse
+	126, // 52: ocpp16.Reply.get_installed_certificate_ids_response:type_name -> ocpp16.GetInstalledCertificateIdsResponse
+	130, // 53: ocpp16.Reply.get_log_response:type_name -> ocpp16.GetLogResponse
+	135, // 54: ocpp16.Reply.install_certificate_response:type_name -> ocpp16.InstallCertificateResponse
+	162, // 55: ocpp16.Reply.signed_update_firmware_response:type_name -> ocpp16.SignedUpdateFirmwareResponse
+	0,   // 56: ocpp16.Event.online:type_name -> ocpp16.Empty
+	0,   // 57: ocpp16.Event.offline:type_name -> ocpp16.Empty
+	2,   // 58: ocpp16.Event.error:type_name -> ocpp16.CallError
+	87,  // 59: ocpp16.Event.authorize_request:type_name -> ocpp16.AuthorizeRequest
+	89,  // 60: ocpp16.Event.boot_notification_request:type_name -> ocpp16.BootNotificationRequest
+	108, // 61: ocpp16.Event.data_transfer_request:type_name -> ocpp16.DataTransferRequest
+	112, // 62: ocpp16.Event.diagnostics_status_notification_request:type_name -> ocpp16.DiagnosticsStatusNotificationRequest
+	117, // 63: ocpp16.Event.firmware_status_notification_request:type_name -> ocpp16.FirmwareStatusNotificationRequest
+	131, // 64: ocpp16.Event.heartbeat_request:type_name -> ocpp16.HeartbeatRequest
+	140, // 65: ocpp16.Event.meter_values_request:type_name -> ocpp16.MeterValuesRequest
+	163, // 66: ocpp16.Event.start_transaction_request:type_name -> ocpp16.StartTransactionRequest
+	165, // 67: ocpp16.Event.status_notification_request:type_name -> ocpp16.StatusNotificationRequest
+	167, // 68: ocpp16.Event.stop_transaction_request:type_name -> ocpp16.StopTransactionRequest
+	151, // 69: ocpp16.Event.security_event_notification_request:type_name -> ocpp16.SecurityEventNotificationRequest
+	157, // 70: ocpp16.Event.sign_certificate_request:type_name -> ocpp16.SignCertificateRequest
+	137, // 71: ocpp16.Event.log_status_notification_request:type_name -> ocpp16.LogStatusNotificationRequest
+	159, // 72: ocpp16.Event.signed_firmware_status_notification_request:type_name -> ocpp16.SignedFirmwareStatusNotificationRequest
+	92,  // 73: ocpp16.Event.cancel_reservation_response:type_name -> ocpp16.CancelReservationResponse
+	97,  // 74: ocpp16.Event.change_availability_response:type_name -> ocpp16.ChangeAvailabilityResponse
+	99,  // 75: ocpp16.Event.change_configuration_response:type_name -> ocpp16.ChangeConfigurationResponse
+	104, // 76: ocpp16.Event.clear_cache_response:type_name -> ocpp16.ClearCacheResponse
+	106, // 77: ocpp16.Event.clear_charging_profile_response:type_name -> ocpp16.ClearChargingProfileResponse
+	109, // 78: ocpp16.Event.data_transfer_response:type_name -> ocpp16.DataTransferResponse
+	120, // 79: ocpp16.Event.get_composite_schedule_response:type_name -> ocpp16.GetCompositeScheduleResponse
+	122, // 80: ocpp16.Event.get_configuration_response:type_name -> ocpp16.GetConfigurationResponse
+	124, // 81: ocpp16.Event.get_diagnostics_response:type_name -> ocpp16.GetDiagnosticsResponse
+	128, // 82: ocpp16.Event.get_local_list_version_response:type_name -> ocpp16.GetLocalListVersionResponse
+	143, // 83: ocpp16.Event.remote_start_transaction_response:type_name -> ocpp16.RemoteStartTransactionResponse
+	145, // 84: ocpp16.Event.remote_stop_transaction_response:type_name -> ocpp16.RemoteStopTransactionResponse
+	147, // 85: ocpp16.Event.reserve_now_response:type_name -> ocpp16.ReserveNowResponse
+	149, // 86: ocpp16.Event.reset_response:type_name -> ocpp16.ResetResponse
+	154, // 87: ocpp16.Event.send_local_list_response:type_name -> ocpp16.SendLocalListResponse
+	156, // 88: ocpp16.Event.set_charging_profile_response:type_name -> ocpp16.SetChargingProfileResponse
+	170, // 89: ocpp16.Event.trigger_message_response:type_name -> ocpp16.TriggerMessageResponse
+	172, // 90: ocpp16.Event.unlock_connector_response:type_name -> ocpp16.UnlockConnectorResponse
+	174, // 91: ocpp16.Event.update_firmware_response:type_name -> ocpp16.UpdateFirmwareResponse
+	95,  // 92: ocpp16.Event.certificate_signed_response:type_name -> ocpp16.CertificateSignedResponse
+	111, // 93: ocpp16.Event.delete_certificate_response:type_name -> ocpp16.DeleteCertificateResponse
+	115, // 94: ocpp16.Event.extended_trigger_message_response:type_name -> ocpp16.ExtendedTriggerMessageResponse
+	126, // 95: ocpp16.Event.get_installed_certificate_ids_response:type_name -> ocpp16.GetInstalledCertificateIdsResponse
+	130, // 96: ocpp16.Event.get_log_response:type_name -> ocpp16.GetLogResponse
+	135, // 97: ocpp16.Event.install_certificate_response:type_name -> ocpp16.InstallCertificateResponse
+	162, // 98: ocpp16.Event.signed_update_firmware_response:type_name -> ocpp16.SignedUpdateFirmwareResponse
+	87,  // 99: ocpp16.AuthorizeCall.request:type_name -> ocpp16.AuthorizeRequest
+	88,  // 100: ocpp16.AuthorizeResult.response:type_name -> ocpp16.AuthorizeResponse
+	2,   // 101: ocpp16.AuthorizeResult.error:type_name -> ocpp16.CallError
+	89,  // 102: ocpp16.BootNotificationCall.request:type_name -> ocpp16.BootNotificationRequest
+	90,  // 103: ocpp16.BootNotificationResult.response:type_name -> ocpp16.BootNotificationResponse
+	2,   // 104: ocpp16.BootNotificationResult.error:type_name -> ocpp16.CallError
+	91,  // 105: ocpp16.CancelReservationCall.request:type_name -> ocpp16.CancelReservationRequest
+	92,  // 106: ocpp16.CancelReservationResult.response:type_name -> ocpp16.CancelReservationResponse
+	2,   // 107: ocpp16.CancelReservationResult.error:type_name -> ocpp16.CallError
+	94,  // 108: ocpp16.CertificateSignedCall.request:type_name -> ocpp16.CertificateSignedRequest
+	95,  // 109: ocpp16.CertificateSignedResult.response:type_name -> ocpp16.CertificateSignedResponse
+	2,   // 110: ocpp16.CertificateSignedResult.error:type_name -> ocpp16.CallError
+	96,  // 111: ocpp16.ChangeAvailabilityCall.request:type_name -> ocpp16.ChangeAvailabilityRequest
+	97,  // 112: ocpp16.ChangeAvailabilityResult.response:type_name -> ocpp16.ChangeAvailabilityResponse
+	2,   // 113: ocpp16.ChangeAvailabilityResult.error:type_name -> ocpp16.CallError
+	98,  // 114: ocpp16.ChangeConfigurationCall.request:type_name -> ocpp16.ChangeConfigurationRequest
+	99,  // 115: ocpp16.ChangeConfigurationResult.response:type_name -> ocpp16.ChangeConfigurationResponse
+	2,   // 116: ocpp16.ChangeConfigurationResult.error:type_name -> ocpp16.CallError
+	103, // 117: ocpp16.ClearCacheCall.request:type_name -> ocpp16.ClearCacheRequest
+	104, // 118: ocpp16.ClearCacheResult.response:type_name -> ocpp16.ClearCacheResponse
+	2,   // 119: ocpp16.ClearCacheResult.error:type_name -> ocpp16.CallError
+	105, // 120: ocpp16.ClearChargingProfileCall.request:type_name -> ocpp16.ClearChargingProfileRequest
+	106, // 121: ocpp16.ClearChargingProfileResult.response:type_name -> ocpp16.ClearChargingProfileResponse
+	2,   // 122: ocpp16.ClearChargingProfileResult.error:type_name -> ocpp16.CallError
+	108, // 123: ocpp16.DataTransferCall.request:type_name -> ocpp16.DataTransferRequest
+	109, // 124: ocpp16.DataTransferResult.response:type_name -> ocpp16.DataTransferResponse
+	2,   // 125: ocpp16.DataTransferResult.error:type_name -> ocpp16.CallError
+	110, // 126: ocpp16.DeleteCertificateCall.request:type_name -> ocpp16.DeleteCertificateRequest
+	111, // 127: ocpp16.DeleteCertificateResult.response:type_name -> ocpp16.DeleteCertificateResponse
+	2,   // 128: ocpp16.DeleteCertificateResult.error:type_name -> ocpp16.CallError
+	112, // 129: ocpp16.DiagnosticsStatusNotificationCall.request:type_name -> ocpp16.DiagnosticsStatusNotificationRequest
+	113, // 130: ocpp16.DiagnosticsStatusNotificationResult.response:type_name -> ocpp16.DiagnosticsStatusNotificationResponse
+	2,   // 131: ocpp16.DiagnosticsStatusNotificationResult.error:type_name -> ocpp16.CallError
+	114, // 132: ocpp16.ExtendedTriggerMessageCall.request:type_name -> ocpp16.ExtendedTriggerMessageRequest
+	115, // 133: ocpp16.ExtendedTriggerMessageResult.response:type_name -> ocpp16.ExtendedTriggerMessageResponse
+	2,   // 134: ocpp16.ExtendedTriggerMessageResult.error:type_name -> ocpp16.CallError
+	117, // 135: ocpp16.FirmwareStatusNotificationCall.request:type_name -> ocpp16.FirmwareStatusNotificationRequest
+	118, // 136: ocpp16.FirmwareStatusNotificationResult.response:type_name -> ocpp16.FirmwareStatusNotificationResponse
+	2,   // 137: ocpp16.FirmwareStatusNotificationResult.error:type_name -> ocpp16.CallError
+	119, // 138: ocpp16.GetCompositeScheduleCall.request:type_name -> ocpp16.GetCompositeScheduleRequest
+	120, // 139: ocpp16.GetCompositeScheduleResult.response:type_name -> ocpp16.GetCompositeScheduleResponse
+	2,   // 140: ocpp16.GetCompositeScheduleResult.error:type_name -> ocpp16.CallError
+	121, // 141: ocpp16.GetConfigurationCall.request:type_name -> ocpp16.GetConfigurationRequest
+	122, // 142: ocpp16.GetConfigurationResult.response:type_name -> ocpp16.GetConfigurationResponse
+	2,   // 143: ocpp16.GetConfigurationResult.error:type_name -> ocpp16.CallError
+	123, // 144: ocpp16.GetDiagnosticsCall.request:type_name -> ocpp16.GetDiagnosticsRequest
+	124, // 145: ocpp16.GetDiagnosticsResult.response:type_name -> ocpp16.GetDiagnosticsResponse
+	2,   // 146: ocpp16.GetDiagnosticsResult.error:type_name -> ocpp16.CallError
+	125, // 147: ocpp16.GetInstalledCertificateIdsCall.request:type_name -> ocpp16.GetInstalledCertificateIdsRequest
+	126, // 148: ocpp16.GetInstalledCertificateIdsResult.response:type_name -> ocpp16.GetInstalledCertificateIdsResponse
+	2,   // 149: ocpp16.GetInstalledCertificateIdsResult.error:type_name -> ocpp16.CallError
+	127, // 150: ocpp16.GetLocalListVersionCall.request:type_name -> ocpp16.GetLocalListVersionRequest
+	128, // 151: ocpp16.GetLocalListVersionResult.response:type_name -> ocpp16.GetLocalListVersionResponse
+	2,   // 152: ocpp16.GetLocalListVersionResult.error:type_name -> ocpp16.CallError
+	129, // 153: ocpp16.GetLogCall.request:type_name -> ocpp16.GetLogRequest
+	130, // 154: ocpp16.GetLogResult.response:type_name -> ocpp16.GetLogResponse
+	2,   // 155: ocpp16.GetLogResult.error:type_name -> ocpp16.CallError
+	131, // 156: ocpp16.HeartbeatCall.request:type_name -> ocpp16.HeartbeatRequest
+	132, // 157: ocpp16.HeartbeatResult.response:type_name -> ocpp16.HeartbeatResponse
+	2,   // 158: ocpp16.HeartbeatResult.error:type_name -> ocpp16.CallError
+	134, // 159: ocpp16.InstallCertificateCall.request:type_name -> ocpp16.InstallCertificateRequest
+	135, // 160: ocpp16.InstallCertificateResult.response:type_name -> ocpp16.InstallCertificateResponse
+	2,   // 161: ocpp16.InstallCertificateResult.error:type_name -> ocpp16.CallError
+	137, // 162: ocpp16.LogStatusNotificationCall.request:type_name -> ocpp16.LogStatusNotificationRequest
+	138, // 163: ocpp16.LogStatusNotificationResult.response:type_name -> ocpp16.LogStatusNotificationResponse
+	2,   // 164: ocpp16.LogStatusNotificationResult.error:type_name -> ocpp16.CallError
+	140, // 165: ocpp16.MeterValuesCall.request:type_name -> ocpp16.MeterValuesRequest
+	141, // 166: ocpp16.MeterValuesResult.response:type_name -> ocpp16.MeterValuesResponse
+	2,   // 167: ocpp16.MeterValuesResult.error:type_name -> ocpp16.CallError
+	142, // 168: ocpp16.RemoteStartTransactionCall.request:type_name -> ocpp16.RemoteStartTransactionRequest
+	143, // 169: ocpp16.RemoteStartTransactionResult.response:type_name -> ocpp16.RemoteStartTransactionResponse
+	2,   // 170: ocpp16.RemoteStartTransactionResult.error:type_name -> ocpp16.CallError
+	144, // 171: ocpp16.RemoteStopTransactionCall.request:type_name -> ocpp16.RemoteStopTransactionRequest
+	145, // 172: ocpp16.RemoteStopTransactionResult.response:type_name -> ocpp16.RemoteStopTransactionResponse
+	2,   // 173: ocpp16.RemoteStopTransactionResult.error:type_name -> ocpp16.CallError
+	146, // 174: ocpp16.ReserveNowCall.request:type_name -> ocpp16.ReserveNowRequest
+	147, // 175: ocpp16.ReserveNowResult.response:type_name -> ocpp16.ReserveNowResponse
+	2,   // 176: ocpp16.ReserveNowResult.error:type_name -> ocpp16.CallError
+	148, // 177: ocpp16.ResetCall.request:type_name -> ocpp16.ResetRequest
+	149, // 178: ocpp16.ResetResult.response:type_name -> ocpp16.ResetResponse
+	2,   // 179: ocpp16.ResetResult.error:type_name -> ocpp16.CallError
+	151, // 180: ocpp16.SecurityEventNotificationCall.request:type_name -> ocpp16.SecurityEventNotificationRequest
+	152, // 181: ocpp16.SecurityEventNotificationResult.response:type_name -> ocpp16.SecurityEventNotificationResponse
+	2,   // 182: ocpp16.SecurityEventNotificationResult.error:type_name -> ocpp16.CallError
+	153, // 183: ocpp16.SendLocalListCall.request:type_name -> ocpp16.SendLocalListRequest
+	154, // 184: ocpp16.SendLocalListResult.response:type_name -> ocpp16.SendLocalListResponse
+	2,   // 185: ocpp16.SendLocalListResult.error:type_name -> ocpp16.CallError
+	155, // 186: ocpp16.SetChargingProfileCall.request:type_name -> ocpp16.SetChargingProfileRequest
+	156, // 187: ocpp16.SetChargingProfileResult.response:type_name -> ocpp16.SetChargingProfileResponse
+	2,   // 188: ocpp16.SetChargingProfileResult.error:type_name -> ocpp16.CallError
+	157, // 189: ocpp16.SignCertificateCall.request:type_name -> ocpp16.SignCertificateRequest
+	158, // 190: ocpp16.SignCertificateResult.response:type_name -> ocpp16.SignCertificateResponse
+	2,   // 191: ocpp16.SignCertificateResult.error:type_name -> ocpp16.CallError
+	159, // 192: ocpp16.SignedFirmwareStatusNotificationCall.request:type_name -> ocpp16.SignedFirmwareStatusNotificationRequest
+	160, // 193: ocpp16.SignedFirmwareStatusNotificationResult.response:type_name -> ocpp16.SignedFirmwareStatusNotificationResponse
+	2,   // 194: ocpp16.SignedFirmwareStatusNotificationResult.error:type_name -> ocpp16.CallError
+	161, // 195: ocpp16.SignedUpdateFirmwareCall.request:type_name -> ocpp16.SignedUpdateFirmwareRequest
+	162, // 196: ocpp16.SignedUpdateFirmwareResult.response:type_name -> ocpp16.SignedUpdateFirmwareResponse
+	2,   // 197: ocpp16.SignedUpdateFirmwareResult.error:type_name -> ocpp16.CallError
+	163, // 198: ocpp16.StartTransactionCall.request:type_name -> ocpp16.StartTransactionRequest
+	164, // 199: ocpp16.StartTransactionResult.response:type_name -> ocpp16.StartTransactionResponse
+	2,   // 200: ocpp16.StartTransactionResult.error:type_name -> ocpp16.CallError
+	165, // 201: ocpp16.StatusNotificationCall.request:type_name -> ocpp16.StatusNotificationRequest
+	166, // 202: ocpp16.StatusNotificationResult.response:type_name -> ocpp16.StatusNotificationResponse
+	2,   // 203: ocpp16.StatusNotificationResult.error:type_name -> ocpp16.CallError
+	167, // 204: ocpp16.StopTransactionCall.request:type_name -> ocpp16.StopTransactionRequest
+	168, // 205: ocpp16.StopTransactionResult.response:type_name -> ocpp16.StopTransactionResponse
+	2,   // 206: ocpp16.StopTransactionResult.error:type_name -> ocpp16.CallError
+	169, // 207: ocpp16.TriggerMessageCall.request:type_name -> ocpp16.TriggerMessageRequest
+	170, // 208: ocpp16.TriggerMessageResult.response:type_name -> ocpp16.TriggerMessageResponse
+	2,   // 209: ocpp16.TriggerMessageResult.error:type_name -> ocpp16.CallError
+	171, // 210: ocpp16.UnlockConnectorCall.request:type_name -> ocpp16.UnlockConnectorRequest
+	172, // 211: ocpp16.UnlockConnectorResult.response:type_name -> ocpp16.UnlockConnectorResponse
+	2,   // 212: ocpp16.UnlockConnectorResult.error:type_name -> ocpp16.CallError
+	173, // 213: ocpp16.UpdateFirmwareCall.request:type_name -> ocpp16.UpdateFirmwareRequest
+	174, // 214: ocpp16.UpdateFirmwareResult.response:type_name -> ocpp16.UpdateFirmwareResponse
+	2,   // 215: ocpp16.UpdateFirmwareResult.error:type_name -> ocpp16.CallError
+	133, // 216: ocpp16.AuthorizationData.id_tag_info:type_name -> ocpp16.IdTagInfo
+	133, // 217: ocpp16.AuthorizeResponse.id_tag_info:type_name -> ocpp16.IdTagInfo
+	101, // 218: ocpp16.ChargingProfile.charging_schedule:type_name -> ocpp16.ChargingSchedule
+	102, // 219: ocpp16.ChargingSchedule.charging_schedule_period:type_name -> ocpp16.ChargingSchedulePeriod
+	93,  // 220: ocpp16.DeleteCertificateRequest.certificate_hash_data:type_name -> ocpp16.CertificateHashData
+	101, // 221: ocpp16.GetCompositeScheduleResponse.charging_schedule:type_name -> ocpp16.ChargingSchedule
+	107, // 222: ocpp16.GetConfigurationResponse.configuration_key:type_name -> ocpp16.ConfigurationKey
+	93,  // 223: ocpp16.GetInstalledCertificateIdsResponse.certificate_hash_data:type_name -> ocpp16.CertificateHashData
+	136, // 224: ocpp16.GetLogRequest.log:type_name -> ocpp16.LogParameters
+	150, // 225: ocpp16.MeterValue.sampled_value:type_name -> ocpp16.SampledValue
+	139, // 226: ocpp16.MeterValuesRequest.meter_value:type_name -> ocpp16.MeterValue
+	100, // 227: ocpp16.RemoteStartTransactionRequest.charging_profile:type_name -> ocpp16.ChargingProfile
+	86,  // 228: ocpp16.SendLocalListRequest.local_authorization_list:type_name -> ocpp16.AuthorizationData
+	100, // 229: ocpp16.SetChargingProfileRequest.cs_charging_profiles:type_name -> ocpp16.ChargingProfile
+	116, // 230: ocpp16.SignedUpdateFirmwareRequest.firmware:type_name -> ocpp16.Firmware
+	133, // 231: ocpp16.StartTransactionResponse.id_tag_info:type_name -> ocpp16.IdTagInfo
+	139, // 232: ocpp16.StopTransactionRequest.transaction_data:type_name -> ocpp16.MeterValue
+	133, // 233: ocpp16.StopTransactionResponse.id_tag_info:type_name -> ocpp16.IdTagInfo
+	12,  // 234: ocpp16.ChargePointService.CancelReservation:input_type -> ocpp16.CancelReservationCall
+	16,  // 235: ocpp16.ChargePointService.ChangeAvailability:input_type -> ocpp16.ChangeAvailabilityCall
+	18,  // 236: ocpp16.ChargePointService.ChangeConfiguration:input_type -> ocpp16.ChangeConfigurationCall
+	20,  // 237: ocpp16.ChargePointService.ClearCache:input_type -> ocpp16.ClearCacheCall
+	22,  // 238: ocpp16.ChargePointService.ClearChargingProfile:input_type -> ocpp16.ClearChargingProfileCall
+	24,  // 239: ocpp16.ChargePointService.DataTransfer:input_type -> ocpp16.DataTransferCall
+	34,  // 240: ocpp16.ChargePointService.GetCompositeSchedule:input_type -> ocpp16.GetCompositeScheduleCall
+	36,  // 241: ocpp16.ChargePointService.GetConfiguration:input_type -> ocpp16.GetConfigurationCall
+	38,  // 242: ocpp16.ChargePointService.GetDiagnostics:input_type -> ocpp16.GetDiagnosticsCall
+	42,  // 243: ocpp16.ChargePointService.GetLocalListVersion:input_type -> ocpp16.GetLocalListVersionCall
+	54,  // 244: ocpp16.ChargePointService.RemoteStartTransaction:input_type -> ocpp16.RemoteStartTransactionCall
+	56,  // 245: ocpp16.ChargePointService.RemoteStopTransaction:input_type -> ocpp16.RemoteStopTransactionCall
+	58,  // 246: ocpp16.ChargePointService.ReserveNow:input_type -> ocpp16.ReserveNowCall
+	60,  // 247: ocpp16.ChargePointService.Reset:input_type -> ocpp16.ResetCall
+	64,  // 248: ocpp16.ChargePointService.SendLocalList:input_type -> ocpp16.SendLocalListCall
+	66,  // 249: ocpp16.ChargePointService.SetChargingProfile:input_type -> ocpp16.SetChargingProfileCall
+	80,  // 250: ocpp16.ChargePointService.TriggerMessage:input_type -> ocpp16.TriggerMessageCall
+	82,  // 251: ocpp16.ChargePointService.UnlockConnector:input_type -> ocpp16.UnlockConnectorCall
+	84,  // 252: ocpp16.ChargePointService.UpdateFirmware:input_type -> ocpp16.UpdateFirmwareCall
+	14,  // 253: ocpp16.ChargePointService.CertificateSigned:input_type -> ocpp16.CertificateSignedCall
+	26,  // 254: ocpp16.ChargePointService.DeleteCertificate:input_type -> ocpp16.DeleteCertificateCall
+	30,  // 255: ocpp16.ChargePointService.ExtendedTriggerMessage:input_type -> ocpp16.ExtendedTriggerMessageCall
+	40,  // 256: ocpp16.ChargePointService.GetInstalledCertificateIds:input_type -> ocpp16.GetInstalledCertificateIdsCall
+	44,  // 257: ocpp16.ChargePointService.GetLog:input_type -> ocpp16.GetLogCall
+	48,  // 258: ocpp16.ChargePointService.InstallCertificate:input_type -> ocpp16.InstallCertificateCall
+	72,  // 259: ocpp16.ChargePointService.SignedUpdateFirmware:input_type -> ocpp16.SignedUpdateFirmwareCall
+	4,   // 260: ocpp16.ChargePointService.Connect:input_type -> ocpp16.Command
+	8,   // 261: ocpp16.CentralSystemService.Authorize:input_type -> ocpp16.AuthorizeCall
+	10,  // 262: ocpp16.CentralSystemService.BootNotification:input_type -> ocpp16.BootNotificationCall
+	24,  // 263: ocpp16.CentralSystemService.DataTransfer:input_type -> ocpp16.DataTransferCall
+	28,  // 264: ocpp16.CentralSystemService.DiagnosticsStatusNotification:input_type -> ocpp16.DiagnosticsStatusNotificationCall
+	32,  // 265: ocpp16.CentralSystemService.FirmwareStatusNotification:input_type -> ocpp16.FirmwareStatusNotificationCall
+	46,  // 266: ocpp16.CentralSystemService.Heartbeat:input_type -> ocpp16.HeartbeatCall
+	52,  // 267: ocpp16.CentralSystemService.MeterValues:input_type -> ocpp16.MeterValuesCall
+	74,  // 268: ocpp16.CentralSystemService.StartTransaction:input_type -> ocpp16.StartTransactionCall
+	76,  // 269: ocpp16.CentralSystemService.StatusNotification:input_type -> ocpp16.StatusNotificationCall
+	78,  // 270: ocpp16.CentralSystemService.StopTransaction:input_type -> ocpp16.StopTransactionCall
+	62,  // 271: ocpp16.CentralSystemService.SecurityEventNotification:input_type -> ocpp16.SecurityEventNotificationCall
+	68,  // 272: ocpp16.CentralSystemService.SignCertificate:input_type -> ocpp16.SignCertificateCall
+	50,  // 273: ocpp16.CentralSystemService.LogStatusNotification:input_type -> ocpp16.LogStatusNotificationCall
+	70,  // 274: ocpp16.CentralSystemService.SignedFirmwareStatusNotification:input_type -> ocpp16.SignedFirmwareStatusNotificationCall
+	1,   // 275: ocpp16.CentralSystemService.ChargingPointOnline:input_type -> ocpp16.ChargePoint
+	1,   // 276: ocpp16.CentralSystemService.ChargingPointOffline:input_type -> ocpp16.ChargePoint
+	6,   // 277: ocpp16.CentralSystemService.Reply:input_type -> ocpp16.Reply
+	13,  // 278: ocpp16.ChargePointService.CancelReservation:output_type -> ocpp16.CancelReservationResult
+	17,  // 279: ocpp16.ChargePointService.ChangeAvailability:output_type -> ocpp16.ChangeAvailabilityResult
+	19,  // 280: ocpp16.ChargePointService.ChangeConfiguration:output_type -> ocpp16.ChangeConfigurationResult
+	21,  // 281: ocpp16.ChargePointService.ClearCache:output_type -> ocpp16.ClearCacheResult
+	23,  // 282: ocpp16.ChargePointService.ClearChargingProfile:output_type -> ocpp16.ClearChargingProfileResult
+	25,  // 283: ocpp16.ChargePointService.DataTransfer:output_type -> ocpp16.DataTransferResult
+	35,  // 284: ocpp16.ChargePointService.GetCompositeSchedule:output_type -> ocpp16.GetCompositeScheduleResult
+	37,  // 285: ocpp16.ChargePointService.GetConfiguration:output_type -> ocpp16.GetConfigurationResult
+	39,  // 286: ocpp16.ChargePointService.GetDiagnostics:output_type -> ocpp16.GetDiagnosticsResult
+	43,  // 287: ocpp16.ChargePointService.GetLocalListVersion:output_type -> ocpp16.GetLocalListVersionResult
+	55,  // 288: ocpp16.ChargePointService.RemoteStartTransaction:output_type -> ocpp16.RemoteStartTransactionResult
+	57,  // 289: ocpp16.ChargePointService.RemoteStopTransaction:output_type -> ocpp16.RemoteStopTransactionResult
+	59,  // 290: ocpp16.ChargePointService.ReserveNow:output_type -> ocpp16.ReserveNowResult
+	61,  // 291: ocpp16.ChargePointService.Reset:output_type -> ocpp16.ResetResult
+	65,  // 292: ocpp16.ChargePointService.SendLocalList:output_type -> ocpp16.SendLocalListResult
+	67,  // 293: ocpp16.ChargePointService.SetChargingProfile:output_type -> ocpp16.SetChargingProfileResult
+	81,  // 294: ocpp16.ChargePointService.TriggerMessage:output_type -> ocpp16.TriggerMessageResult
+	83,  // 295: ocpp16.ChargePointService.UnlockConnector:output_type -> ocpp16.UnlockConnectorResult
+	85,  // 296: ocpp16.ChargePointService.UpdateFirmware:output_type -> ocpp16.UpdateFirmwareResult
+	15,  // 297: ocpp16.ChargePointService.CertificateSigned:output_type -> ocpp16.CertificateSignedResult
+	27,  // 298: ocpp16.ChargePointService.DeleteCertificate:output_type -> ocpp16.DeleteCertificateResult
+	31,  // 299: ocpp16.ChargePointService.ExtendedTriggerMessage:output_type -> ocpp16.ExtendedTriggerMessageResult
+	41,  // 300: ocpp16.ChargePointService.GetInstalledCertificateIds:output_type -> ocpp16.GetInstalledCertificateIdsResult
+	45,  // 301: ocpp16.ChargePointService.GetLog:output_type -> ocpp16.GetLogResult
+	49,  // 302: ocpp16.ChargePointService.InstallCertificate:output_type -> ocpp16.InstallCertificateResult
+	73,  // 303: ocpp16.ChargePointService.SignedUpdateFirmware:output_type -> ocpp16.SignedUpdateFirmwareResult
+	7,   // 304: ocpp16.ChargePointService.Connect:output_type -> ocpp16.Event
+	9,   // 305: ocpp16.CentralSystemService.Authorize:output_type -> ocpp16.AuthorizeResult
+	11,  // 306: ocpp16.CentralSystemService.BootNotification:output_type -> ocpp16.BootNotificationResult
+	25,  // 307: ocpp16.CentralSystemService.DataTransfer:output_type -> ocpp16.DataTransferResult
+	29,  // 308: ocpp16.CentralSystemService.DiagnosticsStatusNotification:output_type -> ocpp16.DiagnosticsStatusNotificationResult
+	33,  // 309: ocpp16.CentralSystemService.FirmwareStatusNotification:output_type -> ocpp16.FirmwareStatusNotificationResult
+	47,  // 310: ocpp16.CentralSystemService.Heartbeat:output_type -> ocpp16.HeartbeatResult
+	53,  // 311: ocpp16.CentralSystemService.MeterValues:output_type -> ocpp16.MeterValuesResult
+	75,  // 312: ocpp16.CentralSystemService.StartTransaction:output_type -> ocpp16.StartTransactionResult
+	77,  // 313: ocpp16.CentralSystemService.StatusNotification:output_type -> ocpp16.StatusNotificationResult
+	79,  // 314: ocpp16.CentralSystemService.StopTransaction:output_type -> ocpp16.StopTransactionResult
+	63,  // 315: ocpp16.CentralSystemService.SecurityEventNotification:output_type -> ocpp16.SecurityEventNotificationResult
+	69,  // 316: ocpp16.CentralSystemService.SignCertificate:output_type -> ocpp16.SignCertificateResult
+	51,  // 317: ocpp16.CentralSystemService.LogStatusNotification:output_type -> ocpp16.LogStatusNotificationResult
+	71,  // 318: ocpp16.CentralSystemService.SignedFirmwareStatusNotification:output_type -> ocpp16.SignedFirmwareStatusNotificationResult
+	0,   // 319: ocpp16.CentralSystemService.ChargingPointOnline:output_type -> ocpp16.Empty
+	0,   // 320: ocpp16.CentralSystemService.ChargingPointOffline:output_type -> ocpp16.Empty
+	0,   // 321: ocpp16.CentralSystemService.Reply:output_type -> ocpp16.Empty
+	278, // [278:322] is the sub-list for method output_type
+	234, // [234:278] is the sub-list for method input_type
+	234, // [234:234] is the sub-list for extension type_name
+	234, // [234:234] is the sub-list for extension extendee
+	0,   // [0:234] is the sub-list for field type_name
+}
+
+func init() { file_ocpp16_proto_init() }
+func file_ocpp16_proto_init() {
+	if File_ocpp16_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ocpp16_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Command); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Call); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateSignedCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateSignedResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeAvailabilityCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeAvailabilityResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeConfigurationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeConfigurationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearChargingProfileCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearChargingProfileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTransferCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTransferResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCertificateCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCertificateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsStatusNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsStatusNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedTriggerMessageCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedTriggerMessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareStatusNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareStatusNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompositeScheduleCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompositeScheduleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledCertificateIdsCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledCertificateIdsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLocalListVersionCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLocalListVersionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallCertificateCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallCertificateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStatusNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStatusNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterValuesCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterValuesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStartTransactionCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStartTransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStopTransactionCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStopTransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveNowCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveNowResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityEventNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityEventNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendLocalListCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendLocalListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChargingProfileCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChargingProfileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedFirmwareStatusNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedFirmwareStatusNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedUpdateFirmwareCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedUpdateFirmwareResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTransactionCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusNotificationCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusNotificationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTransactionCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTransactionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerMessageCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerMessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockConnectorCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockConnectorResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFirmwareCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFirmwareResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateHashData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateSignedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateSignedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargingProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargingSchedulePeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearChargingProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearChargingProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsStatusNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsStatusNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedTriggerMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedTriggerMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Firmware); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareStatusNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareStatusNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompositeScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompositeScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledCertificateIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledCertificateIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLocalListVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLocalListVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdTagInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStatusNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStatusNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeterValuesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStartTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStartTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStopTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteStopTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveNowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveNowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampledValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityEventNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityEventNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendLocalListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendLocalListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChargingProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetChargingProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedFirmwareStatusNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedFirmwareStatusNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedUpdateFirmwareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedUpdateFirmwareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockConnectorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockConnectorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFirmwareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ocpp16_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFirmwareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ocpp16_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*Command_Subscribe)(nil),
+		(*Command_Unsubscribe)(nil),
+		(*Command_Call)(nil),
+	}
+	file_ocpp16_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*Call_CancelReservationRequest)(nil),
+		(*Call_ChangeAvailabilityRequest)(nil),
+		(*Call_ChangeConfigurationRequest)(nil),
+		(*Call_ClearCacheRequest)(nil),
+		(*Call_ClearChargingProfileRequest)(nil),
+		(*Call_DataTransferRequest)(nil),
+		(*Call_GetCompositeScheduleRequest)(nil),
+		(*Call_GetConfigurationRequest)(nil),
+		(*Call_GetDiagnosticsRequest)(nil),
+		(*Call_GetLocalListVersionRequest)(nil),
+		(*Call_RemoteStartTransactionRequest)(nil),
+		(*Call_RemoteStopTransactionRequest)(nil),
+		(*Call_ReserveNowRequest)(nil),
+		(*Call_ResetRequest)(nil),
+		(*Call_SendLocalListRequest)(nil),
+		(*Call_SetChargingProfileRequest)(nil),
+		(*Call_TriggerMessageRequest)(nil),
+		(*Call_UnlockConnectorRequest)(nil),
+		(*Call_UpdateFirmwareRequest)(nil),
+		(*Call_CertificateSignedRequest)(nil),
+		(*Call_DeleteCertificateRequest)(nil),
+		(*Call_ExtendedTriggerMessageRequest)(nil),
+		(*Call_GetInstalledCertificateIdsRequest)(nil),
+		(*Call_GetLogRequest)(nil),
+		(*Call_InstallCertificateRequest)(nil),
+		(*Call_SignedUpdateFirmwareRequest)(nil),
+	}
+	file_ocpp16_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*Reply_Error)(nil),
+		(*Reply_CancelReservationResponse)(nil),
+		(*Reply_ChangeAvailabilityResponse)(nil),
+		(*Reply_ChangeConfigurationResponse)(nil),
+		(*Reply_ClearCacheResponse)(nil),
+		(*Reply_ClearChargingProfileResponse)(nil),
+		(*Reply_DataTransferResponse)(nil),
+		(*Reply_GetCompositeScheduleResponse)(nil),
+		(*Reply_GetConfigurationResponse)(nil),
+		(*Reply_GetDiagnosticsResponse)(nil),
+		(*Reply_GetLocalListVersionResponse)(nil),
+		(*Reply_RemoteStartTransactionResponse)(nil),
+		(*Reply_RemoteStopTransactionResponse)(nil),
+		(*Reply_ReserveNowResponse)(nil),
+		(*Reply_ResetResponse)(nil),
+		(*Reply_SendLocalListResponse)(nil),
+		(*Reply_SetChargingProfileResponse)(nil),
+		(*Reply_TriggerMessageResponse)(nil),
+		(*Reply_UnlockConnectorResponse)(nil),
+		(*Reply_UpdateFirmwareResponse)(nil),
+		(*Reply_CertificateSignedResponse)(nil),
+		(*Reply_DeleteCertificateResponse)(nil),
+		(*Reply_ExtendedTriggerMessageResponse)(nil),
+		(*Reply_GetInstalledCertificateIdsResponse)(nil),
+		(*Reply_GetLogResponse)(nil),
+		(*Reply_InstallCertificateResponse)(nil),
+		(*Reply_SignedUpdateFirmwareResponse)(nil),
+	}
+	file_ocpp16_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*Event_Online)(nil),
+		(*Event_Offline)(nil),
+		(*Event_Error)(nil),
+		(*Event_AuthorizeRequest)(nil),
+		(*Event_BootNotificationRequest)(nil),
+		(*Event_DataTransferRequest)(nil),
+		(*Event_DiagnosticsStatusNotificationRequest)(nil),
+		(*Event_FirmwareStatusNotificationRequest)(nil),
+		(*Event_HeartbeatRequest)(nil),
+		(*Event_MeterValuesRequest)(nil),
+		(*Event_StartTransactionRequest)(nil),
+		(*Event_StatusNotificationRequest)(nil),
+		(*Event_StopTransactionRequest)(nil),
+		(*Event_SecurityEventNotificationRequest)(nil),
+		(*Event_SignCertificateRequest)(nil),
+		(*Event_LogStatusNotificationRequest)(nil),
+		(*Event_SignedFirmwareStatusNotificationRequest)(nil),
+		(*Event_CancelReservationResponse)(nil),
+		(*Event_ChangeAvailabilityResponse)(nil),
+		(*Event_ChangeConfigurationResponse)(nil),
+		(*Event_ClearCacheResponse)(nil),
+		(*Event_ClearChargingProfileResponse)(nil),
+		(*Event_DataTransferResponse)(nil),
+		(*Event_GetCompositeScheduleResponse)(nil),
+		(*Event_GetConfigurationResponse)(nil),
+		(*Event_GetDiagnosticsResponse)(nil),
+		(*Event_GetLocalListVersionResponse)(nil),
+		(*Event_RemoteStartTransactionResponse)(nil),
+		(*Event_RemoteStopTransactionResponse)(nil),
+		(*Event_ReserveNowResponse)(nil),
+		(*Event_ResetResponse)(nil),
+		(*Event_SendLocalListResponse)(nil),
+		(*Event_SetChargingProfileResponse)(nil),
+		(*Event_TriggerMessageResponse)(nil),
+		(*Event_UnlockConnectorResponse)(nil),
+		(*Event_UpdateFirmwareResponse)(nil),
+		(*Event_CertificateSignedResponse)(nil),
+		(*Event_DeleteCertificateResponse)(nil),
+		(*Event_ExtendedTriggerMessageResponse)(nil),
+		(*Event_GetInstalledCertificateIdsResponse)(nil),
+		(*Event_GetLogResponse)(nil),
+		(*Event_InstallCertificateResponse)(nil),
+		(*Event_SignedUpdateFirmwareResponse)(nil),
+	}
+	file_ocpp16_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*AuthorizeResult_Response)(nil),
+		(*AuthorizeResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*BootNotificationResult_Response)(nil),
+		(*BootNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*CancelReservationResult_Response)(nil),
+		(*CancelReservationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*CertificateSignedResult_Response)(nil),
+		(*CertificateSignedResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*ChangeAvailabilityResult_Response)(nil),
+		(*ChangeAvailabilityResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*ChangeConfigurationResult_Response)(nil),
+		(*ChangeConfigurationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*ClearCacheResult_Response)(nil),
+		(*ClearCacheResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*ClearChargingProfileResult_Response)(nil),
+		(*ClearChargingProfileResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*DataTransferResult_Response)(nil),
+		(*DataTransferResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*DeleteCertificateResult_Response)(nil),
+		(*DeleteCertificateResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*DiagnosticsStatusNotificationResult_Response)(nil),
+		(*DiagnosticsStatusNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*ExtendedTriggerMessageResult_Response)(nil),
+		(*ExtendedTriggerMessageResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[33].OneofWrappers = []interface{}{
+		(*FirmwareStatusNotificationResult_Response)(nil),
+		(*FirmwareStatusNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*GetCompositeScheduleResult_Response)(nil),
+		(*GetCompositeScheduleResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*GetConfigurationResult_Response)(nil),
+		(*GetConfigurationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*GetDiagnosticsResult_Response)(nil),
+		(*GetDiagnosticsResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*GetInstalledCertificateIdsResult_Response)(nil),
+		(*GetInstalledCertificateIdsResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*GetLocalListVersionResult_Response)(nil),
+		(*GetLocalListVersionResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*GetLogResult_Response)(nil),
+		(*GetLogResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*HeartbeatResult_Response)(nil),
+		(*HeartbeatResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*InstallCertificateResult_Response)(nil),
+		(*InstallCertificateResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[51].OneofWrappers = []interface{}{
+		(*LogStatusNotificationResult_Response)(nil),
+		(*LogStatusNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[53].OneofWrappers = []interface{}{
+		(*MeterValuesResult_Response)(nil),
+		(*MeterValuesResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*RemoteStartTransactionResult_Response)(nil),
+		(*RemoteStartTransactionResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[57].OneofWrappers = []interface{}{
+		(*RemoteStopTransactionResult_Response)(nil),
+		(*RemoteStopTransactionResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[59].OneofWrappers = []interface{}{
+		(*ReserveNowResult_Response)(nil),
+		(*ReserveNowResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[61].OneofWrappers = []interface{}{
+		(*ResetResult_Response)(nil),
+		(*ResetResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[63].OneofWrappers = []interface{}{
+		(*SecurityEventNotificationResult_Response)(nil),
+		(*SecurityEventNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[65].OneofWrappers = []interface{}{
+		(*SendLocalListResult_Response)(nil),
+		(*SendLocalListResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[67].OneofWrappers = []interface{}{
+		(*SetChargingProfileResult_Response)(nil),
+		(*SetChargingProfileResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[69].OneofWrappers = []interface{}{
+		(*SignCertificateResult_Response)(nil),
+		(*SignCertificateResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[71].OneofWrappers = []interface{}{
+		(*SignedFirmwareStatusNotificationResult_Response)(nil),
+		(*SignedFirmwareStatusNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[73].OneofWrappers = []interface{}{
+		(*SignedUpdateFirmwareResult_Response)(nil),
+		(*SignedUpdateFirmwareResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*StartTransactionResult_Response)(nil),
+		(*StartTransactionResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[77].OneofWrappers = []interface{}{
+		(*StatusNotificationResult_Response)(nil),
+		(*StatusNotificationResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[79].OneofWrappers = []interface{}{
+		(*StopTransactionResult_Response)(nil),
+		(*StopTransactionResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[81].OneofWrappers = []interface{}{
+		(*TriggerMessageResult_Response)(nil),
+		(*TriggerMessageResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[83].OneofWrappers = []interface{}{
+		(*UnlockConnectorResult_Response)(nil),
+		(*UnlockConnectorResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[85].OneofWrappers = []interface{}{
+		(*UpdateFirmwareResult_Response)(nil),
+		(*UpdateFirmwareResult_Error)(nil),
+	}
+	file_ocpp16_proto_msgTypes[90].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[91].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[100].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[101].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[102].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[105].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[107].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[114].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[119].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[120].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[123].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[128].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[129].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[137].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[140].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[142].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[144].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[146].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[153].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[155].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[159].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[161].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[163].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[164].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[165].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[167].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[169].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[171].OneofWrappers = []interface{}{}
+	file_ocpp16_proto_msgTypes[173].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ocpp16_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   175,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_ocpp16_proto_goTypes,
+		DependencyIndexes: file_ocpp16_proto_depIdxs,
+		MessageInfos:      file_ocpp16_proto_msgTypes,
+	}.Build()
+	File_ocpp16_proto = out.File
+	file_ocpp16_proto_rawDesc = nil
+	file_ocpp16_proto_goTypes = nil
+	file_ocpp16_proto_depIdxs = nil
+}
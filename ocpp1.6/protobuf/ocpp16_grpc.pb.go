@@ -0,0 +1,1736 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ChargePointServiceClient is the client API for ChargePointService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChargePointServiceClient interface {
+	CancelReservation(ctx context.Context, in *CancelReservationCall, opts ...grpc.CallOption) (*CancelReservationResult, error)
+	ChangeAvailability(ctx context.Context, in *ChangeAvailabilityCall, opts ...grpc.CallOption) (*ChangeAvailabilityResult, error)
+	ChangeConfiguration(ctx context.Context, in *ChangeConfigurationCall, opts ...grpc.CallOption) (*ChangeConfigurationResult, error)
+	ClearCache(ctx context.Context, in *ClearCacheCall, opts ...grpc.CallOption) (*ClearCacheResult, error)
+	ClearChargingProfile(ctx context.Context, in *ClearChargingProfileCall, opts ...grpc.CallOption) (*ClearChargingProfileResult, error)
+	DataTransfer(ctx context.Context, in *DataTransferCall, opts ...grpc.CallOption) (*DataTransferResult, error)
+	GetCompositeSchedule(ctx context.Context, in *GetCompositeScheduleCall, opts ...grpc.CallOption) (*GetCompositeScheduleResult, error)
+	GetConfiguration(ctx context.Context, in *GetConfigurationCall, opts ...grpc.CallOption) (*GetConfigurationResult, error)
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsCall, opts ...grpc.CallOption) (*GetDiagnosticsResult, error)
+	GetLocalListVersion(ctx context.Context, in *GetLocalListVersionCall, opts ...grpc.CallOption) (*GetLocalListVersionResult, error)
+	RemoteStartTransaction(ctx context.Context, in *RemoteStartTransactionCall, opts ...grpc.CallOption) (*RemoteStartTransactionResult, error)
+	RemoteStopTransaction(ctx context.Context, in *RemoteStopTransactionCall, opts ...grpc.CallOption) (*RemoteStopTransactionResult, error)
+	ReserveNow(ctx context.Context, in *ReserveNowCall, opts ...grpc.CallOption) (*ReserveNowResult, error)
+	Reset(ctx context.Context, in *ResetCall, opts ...grpc.CallOption) (*ResetResult, error)
+	SendLocalList(ctx context.Context, in *SendLocalListCall, opts ...grpc.CallOption) (*SendLocalListResult, error)
+	SetChargingProfile(ctx context.Context, in *SetChargingProfileCall, opts ...grpc.CallOption) (*SetChargingProfileResult, error)
+	TriggerMessage(ctx context.Context, in *TriggerMessageCall, opts ...grpc.CallOption) (*TriggerMessageResult, error)
+	UnlockConnector(ctx context.Context, in *UnlockConnectorCall, opts ...grpc.CallOption) (*UnlockConnectorResult, error)
+	UpdateFirmware(ctx context.Context, in *UpdateFirmwareCall, opts ...grpc.CallOption) (*UpdateFirmwareResult, error)
+	CertificateSigned(ctx context.Context, in *CertificateSignedCall, opts ...grpc.CallOption) (*CertificateSignedResult, error)
+	DeleteCertificate(ctx context.Context, in *DeleteCertificateCall, opts ...grpc.CallOption) (*DeleteCertificateResult, error)
+	ExtendedTriggerMessage(ctx context.Context, in *ExtendedTriggerMessageCall, opts ...grpc.CallOption) (*ExtendedTriggerMessageResult, error)
+	GetInstalledCertificateIds(ctx context.Context, in *GetInstalledCertificateIdsCall, opts ...grpc.CallOption) (*GetInstalledCertificateIdsResult, error)
+	GetLog(ctx context.Context, in *GetLogCall, opts ...grpc.CallOption) (*GetLogResult, error)
+	InstallCertificate(ctx context.Context, in *InstallCertificateCall, opts ...grpc.CallOption) (*InstallCertificateResult, error)
+	SignedUpdateFirmware(ctx context.Context, in *SignedUpdateFirmwareCall, opts ...grpc.CallOption) (*SignedUpdateFirmwareResult, error)
+	//Connect subscribes to the events of the charging points and sends calls, the replies come back as events
+	Connect(ctx context.Context, opts ...grpc.CallOption) (ChargePointService_ConnectClient, error)
+}
+
+type chargePointServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChargePointServiceClient(cc grpc.ClientConnInterface) ChargePointServiceClient {
+	return &chargePointServiceClient{cc}
+}
+
+func (c *chargePointServiceClient) CancelReservation(ctx context.Context, in *CancelReservationCall, opts ...grpc.CallOption) (*CancelReservationResult, error) {
+	out := new(CancelReservationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/CancelReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ChangeAvailability(ctx context.Context, in *ChangeAvailabilityCall, opts ...grpc.CallOption) (*ChangeAvailabilityResult, error) {
+	out := new(ChangeAvailabilityResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ChangeAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ChangeConfiguration(ctx context.Context, in *ChangeConfigurationCall, opts ...grpc.CallOption) (*ChangeConfigurationResult, error) {
+	out := new(ChangeConfigurationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ChangeConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ClearCache(ctx context.Context, in *ClearCacheCall, opts ...grpc.CallOption) (*ClearCacheResult, error) {
+	out := new(ClearCacheResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ClearCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ClearChargingProfile(ctx context.Context, in *ClearChargingProfileCall, opts ...grpc.CallOption) (*ClearChargingProfileResult, error) {
+	out := new(ClearChargingProfileResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ClearChargingProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) DataTransfer(ctx context.Context, in *DataTransferCall, opts ...grpc.CallOption) (*DataTransferResult, error) {
+	out := new(DataTransferResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/DataTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetCompositeSchedule(ctx context.Context, in *GetCompositeScheduleCall, opts ...grpc.CallOption) (*GetCompositeScheduleResult, error) {
+	out := new(GetCompositeScheduleResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetCompositeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetConfiguration(ctx context.Context, in *GetConfigurationCall, opts ...grpc.CallOption) (*GetConfigurationResult, error) {
+	out := new(GetConfigurationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsCall, opts ...grpc.CallOption) (*GetDiagnosticsResult, error) {
+	out := new(GetDiagnosticsResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetLocalListVersion(ctx context.Context, in *GetLocalListVersionCall, opts ...grpc.CallOption) (*GetLocalListVersionResult, error) {
+	out := new(GetLocalListVersionResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetLocalListVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) RemoteStartTransaction(ctx context.Context, in *RemoteStartTransactionCall, opts ...grpc.CallOption) (*RemoteStartTransactionResult, error) {
+	out := new(RemoteStartTransactionResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/RemoteStartTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) RemoteStopTransaction(ctx context.Context, in *RemoteStopTransactionCall, opts ...grpc.CallOption) (*RemoteStopTransactionResult, error) {
+	out := new(RemoteStopTransactionResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/RemoteStopTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ReserveNow(ctx context.Context, in *ReserveNowCall, opts ...grpc.CallOption) (*ReserveNowResult, error) {
+	out := new(ReserveNowResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ReserveNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) Reset(ctx context.Context, in *ResetCall, opts ...grpc.CallOption) (*ResetResult, error) {
+	out := new(ResetResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/Reset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) SendLocalList(ctx context.Context, in *SendLocalListCall, opts ...grpc.CallOption) (*SendLocalListResult, error) {
+	out := new(SendLocalListResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/SendLocalList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) SetChargingProfile(ctx context.Context, in *SetChargingProfileCall, opts ...grpc.CallOption) (*SetChargingProfileResult, error) {
+	out := new(SetChargingProfileResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/SetChargingProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) TriggerMessage(ctx context.Context, in *TriggerMessageCall, opts ...grpc.CallOption) (*TriggerMessageResult, error) {
+	out := new(TriggerMessageResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/TriggerMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) UnlockConnector(ctx context.Context, in *UnlockConnectorCall, opts ...grpc.CallOption) (*UnlockConnectorResult, error) {
+	out := new(UnlockConnectorResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/UnlockConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) UpdateFirmware(ctx context.Context, in *UpdateFirmwareCall, opts ...grpc.CallOption) (*UpdateFirmwareResult, error) {
+	out := new(UpdateFirmwareResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/UpdateFirmware", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) CertificateSigned(ctx context.Context, in *CertificateSignedCall, opts ...grpc.CallOption) (*CertificateSignedResult, error) {
+	out := new(CertificateSignedResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/CertificateSigned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) DeleteCertificate(ctx context.Context, in *DeleteCertificateCall, opts ...grpc.CallOption) (*DeleteCertificateResult, error) {
+	out := new(DeleteCertificateResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/DeleteCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) ExtendedTriggerMessage(ctx context.Context, in *ExtendedTriggerMessageCall, opts ...grpc.CallOption) (*ExtendedTriggerMessageResult, error) {
+	out := new(ExtendedTriggerMessageResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/ExtendedTriggerMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetInstalledCertificateIds(ctx context.Context, in *GetInstalledCertificateIdsCall, opts ...grpc.CallOption) (*GetInstalledCertificateIdsResult, error) {
+	out := new(GetInstalledCertificateIdsResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetInstalledCertificateIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) GetLog(ctx context.Context, in *GetLogCall, opts ...grpc.CallOption) (*GetLogResult, error) {
+	out := new(GetLogResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/GetLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) InstallCertificate(ctx context.Context, in *InstallCertificateCall, opts ...grpc.CallOption) (*InstallCertificateResult, error) {
+	out := new(InstallCertificateResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/InstallCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) SignedUpdateFirmware(ctx context.Context, in *SignedUpdateFirmwareCall, opts ...grpc.CallOption) (*SignedUpdateFirmwareResult, error) {
+	out := new(SignedUpdateFirmwareResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.ChargePointService/SignedUpdateFirmware", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargePointServiceClient) Connect(ctx context.Context, opts ...grpc.CallOption) (ChargePointService_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChargePointService_ServiceDesc.Streams[0], "/ocpp16.ChargePointService/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chargePointServiceConnectClient{stream}
+	return x, nil
+}
+
+type ChargePointService_ConnectClient interface {
+	Send(*Command) error
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type chargePointServiceConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *chargePointServiceConnectClient) Send(m *Command) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *chargePointServiceConnectClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChargePointServiceServer is the server API for ChargePointService service.
+// All implementations must embed UnimplementedChargePointServiceServer
+// for forward compatibility
+type ChargePointServiceServer interface {
+	CancelReservation(context.Context, *CancelReservationCall) (*CancelReservationResult, error)
+	ChangeAvailability(context.Context, *ChangeAvailabilityCall) (*ChangeAvailabilityResult, error)
+	ChangeConfiguration(context.Context, *ChangeConfigurationCall) (*ChangeConfigurationResult, error)
+	ClearCache(context.Context, *ClearCacheCall) (*ClearCacheResult, error)
+	ClearChargingProfile(context.Context, *ClearChargingProfileCall) (*ClearChargingProfileResult, error)
+	DataTransfer(context.Context, *DataTransferCall) (*DataTransferResult, error)
+	GetCompositeSchedule(context.Context, *GetCompositeScheduleCall) (*GetCompositeScheduleResult, error)
+	GetConfiguration(context.Context, *GetConfigurationCall) (*GetConfigurationResult, error)
+	GetDiagnostics(context.Context, *GetDiagnosticsCall) (*GetDiagnosticsResult, error)
+	GetLocalListVersion(context.Context, *GetLocalListVersionCall) (*GetLocalListVersionResult, error)
+	RemoteStartTransaction(context.Context, *RemoteStartTransactionCall) (*RemoteStartTransactionResult, error)
+	RemoteStopTransaction(context.Context, *RemoteStopTransactionCall) (*RemoteStopTransactionResult, error)
+	ReserveNow(context.Context, *ReserveNowCall) (*ReserveNowResult, error)
+	Reset(context.Context, *ResetCall) (*ResetResult, error)
+	SendLocalList(context.Context, *SendLocalListCall) (*SendLocalListResult, error)
+	SetChargingProfile(context.Context, *SetChargingProfileCall) (*SetChargingProfileResult, error)
+	TriggerMessage(context.Context, *TriggerMessageCall) (*TriggerMessageResult, error)
+	UnlockConnector(context.Context, *UnlockConnectorCall) (*UnlockConnectorResult, error)
+	UpdateFirmware(context.Context, *UpdateFirmwareCall) (*UpdateFirmwareResult, error)
+	CertificateSigned(context.Context, *CertificateSignedCall) (*CertificateSignedResult, error)
+	DeleteCertificate(context.Context, *DeleteCertificateCall) (*DeleteCertificateResult, error)
+	ExtendedTriggerMessage(context.Context, *ExtendedTriggerMessageCall) (*ExtendedTriggerMessageResult, error)
+	GetInstalledCertificateIds(context.Context, *GetInstalledCertificateIdsCall) (*GetInstalledCertificateIdsResult, error)
+	GetLog(context.Context, *GetLogCall) (*GetLogResult, error)
+	InstallCertificate(context.Context, *InstallCertificateCall) (*InstallCertificateResult, error)
+	SignedUpdateFirmware(context.Context, *SignedUpdateFirmwareCall) (*SignedUpdateFirmwareResult, error)
+	//Connect subscribes to the events of the charging points and sends calls, the replies come back as events
+	Connect(ChargePointService_ConnectServer) error
+	mustEmbedUnimplementedChargePointServiceServer()
+}
+
+// UnimplementedChargePointServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChargePointServiceServer struct {
+}
+
+func (UnimplementedChargePointServiceServer) CancelReservation(context.Context, *CancelReservationCall) (*CancelReservationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedChargePointServiceServer) ChangeAvailability(context.Context, *ChangeAvailabilityCall) (*ChangeAvailabilityResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAvailability not implemented")
+}
+func (UnimplementedChargePointServiceServer) ChangeConfiguration(context.Context, *ChangeConfigurationCall) (*ChangeConfigurationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeConfiguration not implemented")
+}
+func (UnimplementedChargePointServiceServer) ClearCache(context.Context, *ClearCacheCall) (*ClearCacheResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCache not implemented")
+}
+func (UnimplementedChargePointServiceServer) ClearChargingProfile(context.Context, *ClearChargingProfileCall) (*ClearChargingProfileResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearChargingProfile not implemented")
+}
+func (UnimplementedChargePointServiceServer) DataTransfer(context.Context, *DataTransferCall) (*DataTransferResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataTransfer not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetCompositeSchedule(context.Context, *GetCompositeScheduleCall) (*GetCompositeScheduleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompositeSchedule not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetConfiguration(context.Context, *GetConfigurationCall) (*GetConfigurationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetDiagnostics(context.Context, *GetDiagnosticsCall) (*GetDiagnosticsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetLocalListVersion(context.Context, *GetLocalListVersionCall) (*GetLocalListVersionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocalListVersion not implemented")
+}
+func (UnimplementedChargePointServiceServer) RemoteStartTransaction(context.Context, *RemoteStartTransactionCall) (*RemoteStartTransactionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteStartTransaction not implemented")
+}
+func (UnimplementedChargePointServiceServer) RemoteStopTransaction(context.Context, *RemoteStopTransactionCall) (*RemoteStopTransactionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteStopTransaction not implemented")
+}
+func (UnimplementedChargePointServiceServer) ReserveNow(context.Context, *ReserveNowCall) (*ReserveNowResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveNow not implemented")
+}
+func (UnimplementedChargePointServiceServer) Reset(context.Context, *ResetCall) (*ResetResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedChargePointServiceServer) SendLocalList(context.Context, *SendLocalListCall) (*SendLocalListResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLocalList not implemented")
+}
+func (UnimplementedChargePointServiceServer) SetChargingProfile(context.Context, *SetChargingProfileCall) (*SetChargingProfileResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChargingProfile not implemented")
+}
+func (UnimplementedChargePointServiceServer) TriggerMessage(context.Context, *TriggerMessageCall) (*TriggerMessageResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerMessage not implemented")
+}
+func (UnimplementedChargePointServiceServer) UnlockConnector(context.Context, *UnlockConnectorCall) (*UnlockConnectorResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockConnector not implemented")
+}
+func (UnimplementedChargePointServiceServer) UpdateFirmware(context.Context, *UpdateFirmwareCall) (*UpdateFirmwareResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFirmware not implemented")
+}
+func (UnimplementedChargePointServiceServer) CertificateSigned(context.Context, *CertificateSignedCall) (*CertificateSignedResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertificateSigned not implemented")
+}
+func (UnimplementedChargePointServiceServer) DeleteCertificate(context.Context, *DeleteCertificateCall) (*DeleteCertificateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCertificate not implemented")
+}
+func (UnimplementedChargePointServiceServer) ExtendedTriggerMessage(context.Context, *ExtendedTriggerMessageCall) (*ExtendedTriggerMessageResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendedTriggerMessage not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetInstalledCertificateIds(context.Context, *GetInstalledCertificateIdsCall) (*GetInstalledCertificateIdsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledCertificateIds not implemented")
+}
+func (UnimplementedChargePointServiceServer) GetLog(context.Context, *GetLogCall) (*GetLogResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLog not implemented")
+}
+func (UnimplementedChargePointServiceServer) InstallCertificate(context.Context, *InstallCertificateCall) (*InstallCertificateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallCertificate not implemented")
+}
+func (UnimplementedChargePointServiceServer) SignedUpdateFirmware(context.Context, *SignedUpdateFirmwareCall) (*SignedUpdateFirmwareResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedUpdateFirmware not implemented")
+}
+func (UnimplementedChargePointServiceServer) Connect(ChargePointService_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedChargePointServiceServer) mustEmbedUnimplementedChargePointServiceServer() {}
+
+// UnsafeChargePointServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChargePointServiceServer will
+// result in compilation errors.
+type UnsafeChargePointServiceServer interface {
+	mustEmbedUnimplementedChargePointServiceServer()
+}
+
+func RegisterChargePointServiceServer(s grpc.ServiceRegistrar, srv ChargePointServiceServer) {
+	s.RegisterService(&ChargePointService_ServiceDesc, srv)
+}
+
+func _ChargePointService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/CancelReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).CancelReservation(ctx, req.(*CancelReservationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ChangeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAvailabilityCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ChangeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ChangeAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ChangeAvailability(ctx, req.(*ChangeAvailabilityCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ChangeConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeConfigurationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ChangeConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ChangeConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ChangeConfiguration(ctx, req.(*ChangeConfigurationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ClearCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ClearCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ClearCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ClearCache(ctx, req.(*ClearCacheCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ClearChargingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearChargingProfileCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ClearChargingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ClearChargingProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ClearChargingProfile(ctx, req.(*ClearChargingProfileCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_DataTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataTransferCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).DataTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/DataTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).DataTransfer(ctx, req.(*DataTransferCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetCompositeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompositeScheduleCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetCompositeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetCompositeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetCompositeSchedule(ctx, req.(*GetCompositeScheduleCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetConfiguration(ctx, req.(*GetConfigurationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetDiagnostics(ctx, req.(*GetDiagnosticsCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetLocalListVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocalListVersionCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetLocalListVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetLocalListVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetLocalListVersion(ctx, req.(*GetLocalListVersionCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_RemoteStartTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteStartTransactionCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).RemoteStartTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/RemoteStartTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).RemoteStartTransaction(ctx, req.(*RemoteStartTransactionCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_RemoteStopTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteStopTransactionCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).RemoteStopTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/RemoteStopTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).RemoteStopTransaction(ctx, req.(*RemoteStopTransactionCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ReserveNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveNowCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ReserveNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ReserveNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ReserveNow(ctx, req.(*ReserveNowCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/Reset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).Reset(ctx, req.(*ResetCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_SendLocalList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendLocalListCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).SendLocalList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/SendLocalList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).SendLocalList(ctx, req.(*SendLocalListCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_SetChargingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChargingProfileCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).SetChargingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/SetChargingProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).SetChargingProfile(ctx, req.(*SetChargingProfileCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_TriggerMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerMessageCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).TriggerMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/TriggerMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).TriggerMessage(ctx, req.(*TriggerMessageCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_UnlockConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockConnectorCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).UnlockConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/UnlockConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).UnlockConnector(ctx, req.(*UnlockConnectorCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_UpdateFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFirmwareCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).UpdateFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/UpdateFirmware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).UpdateFirmware(ctx, req.(*UpdateFirmwareCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_CertificateSigned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateSignedCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).CertificateSigned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/CertificateSigned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).CertificateSigned(ctx, req.(*CertificateSignedCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_DeleteCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCertificateCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).DeleteCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/DeleteCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).DeleteCertificate(ctx, req.(*DeleteCertificateCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_ExtendedTriggerMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendedTriggerMessageCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).ExtendedTriggerMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/ExtendedTriggerMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).ExtendedTriggerMessage(ctx, req.(*ExtendedTriggerMessageCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetInstalledCertificateIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledCertificateIdsCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetInstalledCertificateIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetInstalledCertificateIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetInstalledCertificateIds(ctx, req.(*GetInstalledCertificateIdsCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_GetLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).GetLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/GetLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).GetLog(ctx, req.(*GetLogCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_InstallCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallCertificateCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).InstallCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/InstallCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).InstallCertificate(ctx, req.(*InstallCertificateCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_SignedUpdateFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedUpdateFirmwareCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargePointServiceServer).SignedUpdateFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.ChargePointService/SignedUpdateFirmware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargePointServiceServer).SignedUpdateFirmware(ctx, req.(*SignedUpdateFirmwareCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargePointService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ChargePointServiceServer).Connect(&chargePointServiceConnectServer{stream})
+}
+
+type ChargePointService_ConnectServer interface {
+	Send(*Event) error
+	Recv() (*Command, error)
+	grpc.ServerStream
+}
+
+type chargePointServiceConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *chargePointServiceConnectServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *chargePointServiceConnectServer) Recv() (*Command, error) {
+	m := new(Command)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ChargePointService_ServiceDesc is the grpc.ServiceDesc for ChargePointService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChargePointService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocpp16.ChargePointService",
+	HandlerType: (*ChargePointServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CancelReservation",
+			Handler:    _ChargePointService_CancelReservation_Handler,
+		},
+		{
+			MethodName: "ChangeAvailability",
+			Handler:    _ChargePointService_ChangeAvailability_Handler,
+		},
+		{
+			MethodName: "ChangeConfiguration",
+			Handler:    _ChargePointService_ChangeConfiguration_Handler,
+		},
+		{
+			MethodName: "ClearCache",
+			Handler:    _ChargePointService_ClearCache_Handler,
+		},
+		{
+			MethodName: "ClearChargingProfile",
+			Handler:    _ChargePointService_ClearChargingProfile_Handler,
+		},
+		{
+			MethodName: "DataTransfer",
+			Handler:    _ChargePointService_DataTransfer_Handler,
+		},
+		{
+			MethodName: "GetCompositeSchedule",
+			Handler:    _ChargePointService_GetCompositeSchedule_Handler,
+		},
+		{
+			MethodName: "GetConfiguration",
+			Handler:    _ChargePointService_GetConfiguration_Handler,
+		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _ChargePointService_GetDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetLocalListVersion",
+			Handler:    _ChargePointService_GetLocalListVersion_Handler,
+		},
+		{
+			MethodName: "RemoteStartTransaction",
+			Handler:    _ChargePointService_RemoteStartTransaction_Handler,
+		},
+		{
+			MethodName: "RemoteStopTransaction",
+			Handler:    _ChargePointService_RemoteStopTransaction_Handler,
+		},
+		{
+			MethodName: "ReserveNow",
+			Handler:    _ChargePointService_ReserveNow_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _ChargePointService_Reset_Handler,
+		},
+		{
+			MethodName: "SendLocalList",
+			Handler:    _ChargePointService_SendLocalList_Handler,
+		},
+		{
+			MethodName: "SetChargingProfile",
+			Handler:    _ChargePointService_SetChargingProfile_Handler,
+		},
+		{
+			MethodName: "TriggerMessage",
+			Handler:    _ChargePointService_TriggerMessage_Handler,
+		},
+		{
+			MethodName: "UnlockConnector",
+			Handler:    _ChargePointService_UnlockConnector_Handler,
+		},
+		{
+			MethodName: "UpdateFirmware",
+			Handler:    _ChargePointService_UpdateFirmware_Handler,
+		},
+		{
+			MethodName: "CertificateSigned",
+			Handler:    _ChargePointService_CertificateSigned_Handler,
+		},
+		{
+			MethodName: "DeleteCertificate",
+			Handler:    _ChargePointService_DeleteCertificate_Handler,
+		},
+		{
+			MethodName: "ExtendedTriggerMessage",
+			Handler:    _ChargePointService_ExtendedTriggerMessage_Handler,
+		},
+		{
+			MethodName: "GetInstalledCertificateIds",
+			Handler:    _ChargePointService_GetInstalledCertificateIds_Handler,
+		},
+		{
+			MethodName: "GetLog",
+			Handler:    _ChargePointService_GetLog_Handler,
+		},
+		{
+			MethodName: "InstallCertificate",
+			Handler:    _ChargePointService_InstallCertificate_Handler,
+		},
+		{
+			MethodName: "SignedUpdateFirmware",
+			Handler:    _ChargePointService_SignedUpdateFirmware_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _ChargePointService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ocpp16.proto",
+}
+
+// CentralSystemServiceClient is the client API for CentralSystemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CentralSystemServiceClient interface {
+	Authorize(ctx context.Context, in *AuthorizeCall, opts ...grpc.CallOption) (*AuthorizeResult, error)
+	BootNotification(ctx context.Context, in *BootNotificationCall, opts ...grpc.CallOption) (*BootNotificationResult, error)
+	DataTransfer(ctx context.Context, in *DataTransferCall, opts ...grpc.CallOption) (*DataTransferResult, error)
+	DiagnosticsStatusNotification(ctx context.Context, in *DiagnosticsStatusNotificationCall, opts ...grpc.CallOption) (*DiagnosticsStatusNotificationResult, error)
+	FirmwareStatusNotification(ctx context.Context, in *FirmwareStatusNotificationCall, opts ...grpc.CallOption) (*FirmwareStatusNotificationResult, error)
+	Heartbeat(ctx context.Context, in *HeartbeatCall, opts ...grpc.CallOption) (*HeartbeatResult, error)
+	MeterValues(ctx context.Context, in *MeterValuesCall, opts ...grpc.CallOption) (*MeterValuesResult, error)
+	StartTransaction(ctx context.Context, in *StartTransactionCall, opts ...grpc.CallOption) (*StartTransactionResult, error)
+	StatusNotification(ctx context.Context, in *StatusNotificationCall, opts ...grpc.CallOption) (*StatusNotificationResult, error)
+	StopTransaction(ctx context.Context, in *StopTransactionCall, opts ...grpc.CallOption) (*StopTransactionResult, error)
+	SecurityEventNotification(ctx context.Context, in *SecurityEventNotificationCall, opts ...grpc.CallOption) (*SecurityEventNotificationResult, error)
+	SignCertificate(ctx context.Context, in *SignCertificateCall, opts ...grpc.CallOption) (*SignCertificateResult, error)
+	LogStatusNotification(ctx context.Context, in *LogStatusNotificationCall, opts ...grpc.CallOption) (*LogStatusNotificationResult, error)
+	SignedFirmwareStatusNotification(ctx context.Context, in *SignedFirmwareStatusNotificationCall, opts ...grpc.CallOption) (*SignedFirmwareStatusNotificationResult, error)
+	ChargingPointOnline(ctx context.Context, in *ChargePoint, opts ...grpc.CallOption) (*Empty, error)
+	ChargingPointOffline(ctx context.Context, in *ChargePoint, opts ...grpc.CallOption) (*Empty, error)
+	//Reply receives the replies to the calls that were not sent by ChargePointService
+	Reply(ctx context.Context, in *Reply, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type centralSystemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCentralSystemServiceClient(cc grpc.ClientConnInterface) CentralSystemServiceClient {
+	return &centralSystemServiceClient{cc}
+}
+
+func (c *centralSystemServiceClient) Authorize(ctx context.Context, in *AuthorizeCall, opts ...grpc.CallOption) (*AuthorizeResult, error) {
+	out := new(AuthorizeResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) BootNotification(ctx context.Context, in *BootNotificationCall, opts ...grpc.CallOption) (*BootNotificationResult, error) {
+	out := new(BootNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/BootNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) DataTransfer(ctx context.Context, in *DataTransferCall, opts ...grpc.CallOption) (*DataTransferResult, error) {
+	out := new(DataTransferResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/DataTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) DiagnosticsStatusNotification(ctx context.Context, in *DiagnosticsStatusNotificationCall, opts ...grpc.CallOption) (*DiagnosticsStatusNotificationResult, error) {
+	out := new(DiagnosticsStatusNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/DiagnosticsStatusNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) FirmwareStatusNotification(ctx context.Context, in *FirmwareStatusNotificationCall, opts ...grpc.CallOption) (*FirmwareStatusNotificationResult, error) {
+	out := new(FirmwareStatusNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/FirmwareStatusNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) Heartbeat(ctx context.Context, in *HeartbeatCall, opts ...grpc.CallOption) (*HeartbeatResult, error) {
+	out := new(HeartbeatResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) MeterValues(ctx context.Context, in *MeterValuesCall, opts ...grpc.CallOption) (*MeterValuesResult, error) {
+	out := new(MeterValuesResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/MeterValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) StartTransaction(ctx context.Context, in *StartTransactionCall, opts ...grpc.CallOption) (*StartTransactionResult, error) {
+	out := new(StartTransactionResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/StartTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) StatusNotification(ctx context.Context, in *StatusNotificationCall, opts ...grpc.CallOption) (*StatusNotificationResult, error) {
+	out := new(StatusNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/StatusNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) StopTransaction(ctx context.Context, in *StopTransactionCall, opts ...grpc.CallOption) (*StopTransactionResult, error) {
+	out := new(StopTransactionResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/StopTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) SecurityEventNotification(ctx context.Context, in *SecurityEventNotificationCall, opts ...grpc.CallOption) (*SecurityEventNotificationResult, error) {
+	out := new(SecurityEventNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/SecurityEventNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) SignCertificate(ctx context.Context, in *SignCertificateCall, opts ...grpc.CallOption) (*SignCertificateResult, error) {
+	out := new(SignCertificateResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/SignCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) LogStatusNotification(ctx context.Context, in *LogStatusNotificationCall, opts ...grpc.CallOption) (*LogStatusNotificationResult, error) {
+	out := new(LogStatusNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/LogStatusNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) SignedFirmwareStatusNotification(ctx context.Context, in *SignedFirmwareStatusNotificationCall, opts ...grpc.CallOption) (*SignedFirmwareStatusNotificationResult, error) {
+	out := new(SignedFirmwareStatusNotificationResult)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/SignedFirmwareStatusNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) ChargingPointOnline(ctx context.Context, in *ChargePoint, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/ChargingPointOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) ChargingPointOffline(ctx context.Context, in *ChargePoint, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/ChargingPointOffline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centralSystemServiceClient) Reply(ctx context.Context, in *Reply, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ocpp16.CentralSystemService/Reply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CentralSystemServiceServer is the server API for CentralSystemService service.
+// All implementations must embed UnimplementedCentralSystemServiceServer
+// for forward compatibility
+type CentralSystemServiceServer interface {
+	Authorize(context.Context, *AuthorizeCall) (*AuthorizeResult, error)
+	BootNotification(context.Context, *BootNotificationCall) (*BootNotificationResult, error)
+	DataTransfer(context.Context, *DataTransferCall) (*DataTransferResult, error)
+	DiagnosticsStatusNotification(context.Context, *DiagnosticsStatusNotificationCall) (*DiagnosticsStatusNotificationResult, error)
+	FirmwareStatusNotification(context.Context, *FirmwareStatusNotificationCall) (*FirmwareStatusNotificationResult, error)
+	Heartbeat(context.Context, *HeartbeatCall) (*HeartbeatResult, error)
+	MeterValues(context.Context, *MeterValuesCall) (*MeterValuesResult, error)
+	StartTransaction(context.Context, *StartTransactionCall) (*StartTransactionResult, error)
+	StatusNotification(context.Context, *StatusNotificationCall) (*StatusNotificationResult, error)
+	StopTransaction(context.Context, *StopTransactionCall) (*StopTransactionResult, error)
+	SecurityEventNotification(context.Context, *SecurityEventNotificationCall) (*SecurityEventNotificationResult, error)
+	SignCertificate(context.Context, *SignCertificateCall) (*SignCertificateResult, error)
+	LogStatusNotification(context.Context, *LogStatusNotificationCall) (*LogStatusNotificationResult, error)
+	SignedFirmwareStatusNotification(context.Context, *SignedFirmwareStatusNotificationCall) (*SignedFirmwareStatusNotificationResult, error)
+	ChargingPointOnline(context.Context, *ChargePoint) (*Empty, error)
+	ChargingPointOffline(context.Context, *ChargePoint) (*Empty, error)
+	//Reply receives the replies to the calls that were not sent by ChargePointService
+	Reply(context.Context, *Reply) (*Empty, error)
+	mustEmbedUnimplementedCentralSystemServiceServer()
+}
+
+// UnimplementedCentralSystemServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCentralSystemServiceServer struct {
+}
+
+func (UnimplementedCentralSystemServiceServer) Authorize(context.Context, *AuthorizeCall) (*AuthorizeResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) BootNotification(context.Context, *BootNotificationCall) (*BootNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) DataTransfer(context.Context, *DataTransferCall) (*DataTransferResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataTransfer not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) DiagnosticsStatusNotification(context.Context, *DiagnosticsStatusNotificationCall) (*DiagnosticsStatusNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiagnosticsStatusNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) FirmwareStatusNotification(context.Context, *FirmwareStatusNotificationCall) (*FirmwareStatusNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FirmwareStatusNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) Heartbeat(context.Context, *HeartbeatCall) (*HeartbeatResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) MeterValues(context.Context, *MeterValuesCall) (*MeterValuesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeterValues not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) StartTransaction(context.Context, *StartTransactionCall) (*StartTransactionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTransaction not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) StatusNotification(context.Context, *StatusNotificationCall) (*StatusNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatusNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) StopTransaction(context.Context, *StopTransactionCall) (*StopTransactionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopTransaction not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) SecurityEventNotification(context.Context, *SecurityEventNotificationCall) (*SecurityEventNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecurityEventNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) SignCertificate(context.Context, *SignCertificateCall) (*SignCertificateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCertificate not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) LogStatusNotification(context.Context, *LogStatusNotificationCall) (*LogStatusNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogStatusNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) SignedFirmwareStatusNotification(context.Context, *SignedFirmwareStatusNotificationCall) (*SignedFirmwareStatusNotificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedFirmwareStatusNotification not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) ChargingPointOnline(context.Context, *ChargePoint) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargingPointOnline not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) ChargingPointOffline(context.Context, *ChargePoint) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargingPointOffline not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) Reply(context.Context, *Reply) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reply not implemented")
+}
+func (UnimplementedCentralSystemServiceServer) mustEmbedUnimplementedCentralSystemServiceServer() {}
+
+// UnsafeCentralSystemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CentralSystemServiceServer will
+// result in compilation errors.
+type UnsafeCentralSystemServiceServer interface {
+	mustEmbedUnimplementedCentralSystemServiceServer()
+}
+
+func RegisterCentralSystemServiceServer(s grpc.ServiceRegistrar, srv CentralSystemServiceServer) {
+	s.RegisterService(&CentralSystemService_ServiceDesc, srv)
+}
+
+func _CentralSystemService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).Authorize(ctx, req.(*AuthorizeCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_BootNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).BootNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/BootNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).BootNotification(ctx, req.(*BootNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_DataTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataTransferCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).DataTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/DataTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).DataTransfer(ctx, req.(*DataTransferCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_DiagnosticsStatusNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnosticsStatusNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).DiagnosticsStatusNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/DiagnosticsStatusNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).DiagnosticsStatusNotification(ctx, req.(*DiagnosticsStatusNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_FirmwareStatusNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FirmwareStatusNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).FirmwareStatusNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/FirmwareStatusNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).FirmwareStatusNotification(ctx, req.(*FirmwareStatusNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).Heartbeat(ctx, req.(*HeartbeatCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_MeterValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeterValuesCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).MeterValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/MeterValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).MeterValues(ctx, req.(*MeterValuesCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_StartTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTransactionCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).StartTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/StartTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).StartTransaction(ctx, req.(*StartTransactionCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_StatusNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).StatusNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/StatusNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).StatusNotification(ctx, req.(*StatusNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_StopTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTransactionCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).StopTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/StopTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).StopTransaction(ctx, req.(*StopTransactionCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_SecurityEventNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityEventNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).SecurityEventNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/SecurityEventNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).SecurityEventNotification(ctx, req.(*SecurityEventNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_SignCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCertificateCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).SignCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/SignCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).SignCertificate(ctx, req.(*SignCertificateCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_LogStatusNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStatusNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).LogStatusNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/LogStatusNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).LogStatusNotification(ctx, req.(*LogStatusNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_SignedFirmwareStatusNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedFirmwareStatusNotificationCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).SignedFirmwareStatusNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/SignedFirmwareStatusNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).SignedFirmwareStatusNotification(ctx, req.(*SignedFirmwareStatusNotificationCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_ChargingPointOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargePoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).ChargingPointOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/ChargingPointOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).ChargingPointOnline(ctx, req.(*ChargePoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_ChargingPointOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargePoint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).ChargingPointOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/ChargingPointOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).ChargingPointOffline(ctx, req.(*ChargePoint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CentralSystemService_Reply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Reply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentralSystemServiceServer).Reply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocpp16.CentralSystemService/Reply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentralSystemServiceServer).Reply(ctx, req.(*Reply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CentralSystemService_ServiceDesc is the grpc.ServiceDesc for CentralSystemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CentralSystemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocpp16.CentralSystemService",
+	HandlerType: (*CentralSystemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Authorize",
+			Handler:    _CentralSystemService_Authorize_Handler,
+		},
+		{
+			MethodName: "BootNotification",
+			Handler:    _CentralSystemService_BootNotification_Handler,
+		},
+		{
+			MethodName: "DataTransfer",
+			Handler:    _CentralSystemService_DataTransfer_Handler,
+		},
+		{
+			MethodName: "DiagnosticsStatusNotification",
+			Handler:    _CentralSystemService_DiagnosticsStatusNotification_Handler,
+		},
+		{
+			MethodName: "FirmwareStatusNotification",
+			Handler:    _CentralSystemService_FirmwareStatusNotification_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _CentralSystemService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "MeterValues",
+			Handler:    _CentralSystemService_MeterValues_Handler,
+		},
+		{
+			MethodName: "StartTransaction",
+			Handler:    _CentralSystemService_StartTransaction_Handler,
+		},
+		{
+			MethodName: "StatusNotification",
+			Handler:    _CentralSystemService_StatusNotification_Handler,
+		},
+		{
+			MethodName: "StopTransaction",
+			Handler:    _CentralSystemService_StopTransaction_Handler,
+		},
+		{
+			MethodName: "SecurityEventNotification",
+			Handler:    _CentralSystemService_SecurityEventNotification_Handler,
+		},
+		{
+			MethodName: "SignCertificate",
+			Handler:    _CentralSystemService_SignCertificate_Handler,
+		},
+		{
+			MethodName: "LogStatusNotification",
+			Handler:    _CentralSystemService_LogStatusNotification_Handler,
+		},
+		{
+			MethodName: "SignedFirmwareStatusNotification",
+			Handler:    _CentralSystemService_SignedFirmwareStatusNotification_Handler,
+		},
+		{
+			MethodName: "ChargingPointOnline",
+			Handler:    _CentralSystemService_ChargingPointOnline_Handler,
+		},
+		{
+			MethodName: "ChargingPointOffline",
+			Handler:    _CentralSystemService_ChargingPointOffline_Handler,
+		},
+		{
+			MethodName: "Reply",
+			Handler:    _CentralSystemService_Reply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ocpp16.proto",
+}
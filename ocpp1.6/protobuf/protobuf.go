@@ -0,0 +1,171 @@
+//Package protobuf is the grpc api of the grpc plugins, generated from ocpp16.proto, and the conversions between its
+//messages and the payloads of the protocol package. A payload is converted through its json encoding, the fields of
+//the messages keep the json names of the ocpp payloads
+package protobuf
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative ocpp16.proto
+
+import (
+	"encoding/json"
+	"fmt"
+	"ocpp16/protocol"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+//the rpcs of the services named after an action
+var (
+	//ChargePointActions are the requests of the charging points, served by the backends in CentralSystemService
+	ChargePointActions = actions(&CentralSystemService_ServiceDesc)
+	//CentralSystemActions are the calls sent to the charging points, served by the server in ChargePointService
+	CentralSystemActions = actions(&ChargePointService_ServiceDesc)
+)
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+func actions(desc *grpc.ServiceDesc) []string {
+	var actions []string
+	for _, method := range desc.Methods {
+		if _, ok := protocol.OCPP16M.GetTraitAction(method.MethodName); ok {
+			actions = append(actions, method.MethodName)
+		}
+	}
+	return actions
+}
+
+//NewRequest returns a pointer to an empty request of action, nil for an unknown action
+func NewRequest(action string) protocol.Request {
+	trait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil
+	}
+	return reflect.New(trait.RequestType()).Interface().(protocol.Request)
+}
+
+//NewResponse returns a pointer to an empty response of action, nil for an unknown action
+func NewResponse(action string) protocol.Response {
+	trait, ok := protocol.OCPP16M.GetTraitAction(action)
+	if !ok {
+		return nil
+	}
+	return reflect.New(trait.ResponseType()).Interface().(protocol.Response)
+}
+
+//NewCallError converts the CallError of a charging point
+func NewCallError(e *protocol.CallError) *CallError {
+	return &CallError{ErrorCode: string(e.ErrorCode), ErrorDescription: e.ErrorDescription}
+}
+
+func newMessage(name string) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName("ocpp16." + name))
+	if err != nil {
+		return nil, fmt.Errorf("protobuf: no message %s", name)
+	}
+	return mt.New().Interface(), nil
+}
+
+//Message converts a request or a response of the protocol package to the message of its name, e.g. a
+//protocol.ResetRequest to a *ResetRequest
+func Message(payload interface{}) (proto.Message, error) {
+	name := reflect.Indirect(reflect.ValueOf(payload)).Type().Name()
+	m, err := newMessage(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err = unmarshalOptions.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("protobuf: %s, %w", name, err)
+	}
+	return m, nil
+}
+
+//Payload converts m to the payload of the protocol package v points to
+func Payload(m proto.Message, v interface{}) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("protobuf: %s, %w", m.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+//SetPayload sets the field of m whose message is the one of payload: the request of an <Action>Call, the response of
+//an <Action>Result, or the oneof case of payload in a Call, a Reply or an Event
+func SetPayload(m proto.Message, payload interface{}) error {
+	pm, err := Message(payload)
+	if err != nil {
+		return err
+	}
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); fd.Message() != nil && fd.Message().FullName() == pm.ProtoReflect().Descriptor().FullName() {
+			r.Set(fd, protoreflect.ValueOfMessage(pm.ProtoReflect()))
+			return nil
+		}
+	}
+	return fmt.Errorf("protobuf: %s has no field of %s", r.Descriptor().Name(), pm.ProtoReflect().Descriptor().Name())
+}
+
+//GetPayload returns the request or the response set in m as a pointer to a payload of the protocol package, nil when
+//m holds none, e.g. an <Action>Result with an error
+func GetPayload(m proto.Message) (interface{}, error) {
+	var payload interface{}
+	var err error
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return true
+		}
+		switch name := string(fd.Message().Name()); {
+		case strings.HasSuffix(name, "Request"):
+			if request := NewRequest(strings.TrimSuffix(name, "Request")); request != nil {
+				payload = request
+			}
+		case strings.HasSuffix(name, "Response"):
+			if response := NewResponse(strings.TrimSuffix(name, "Response")); response != nil {
+				payload = response
+			}
+		}
+		if payload == nil {
+			return true
+		}
+		err = Payload(v.Message().Interface(), payload)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+//SetError sets the error of m, an <Action>Result, a Reply or an Event
+func SetError(m proto.Message, e *CallError) {
+	r := m.ProtoReflect()
+	r.Set(r.Descriptor().Fields().ByName("error"), protoreflect.ValueOfMessage(e.ProtoReflect()))
+}
+
+//NewCall returns the <Action>Call of request, e.g. a *ResetCall for a protocol.ResetRequest
+func NewCall(id string, uniqueid string, request protocol.Request) (proto.Message, error) {
+	m, err := newMessage(request.Action() + "Call")
+	if err != nil {
+		return nil, err
+	}
+	r := m.ProtoReflect()
+	r.Set(r.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString(id))
+	r.Set(r.Descriptor().Fields().ByName("unique_id"), protoreflect.ValueOfString(uniqueid))
+	if err = SetPayload(m, request); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
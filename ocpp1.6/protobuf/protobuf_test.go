@@ -0,0 +1,200 @@
+package protobuf
+
+import (
+	"bytes"
+	"ocpp16/protocol"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+//checkFields fails on a field of the go struct t missing in the message md or of another type, the payloads are
+//converted through their json encoding so a missing field would be dropped silently
+func checkFields(t *testing.T, typ reflect.Type, md protoreflect.MessageDescriptor) {
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fd := md.Fields().ByJSONName(name)
+		if fd == nil {
+			t.Errorf("%s has no field %s", md.FullName(), name)
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Slice {
+			if !fd.IsList() {
+				t.Errorf("%s.%s is not repeated", md.FullName(), name)
+			}
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+			if fd.Kind() != protoreflect.MessageKind && !fd.HasPresence() {
+				t.Errorf("%s.%s is not optional", md.FullName(), name)
+			}
+		}
+		var kinds []protoreflect.Kind
+		switch ft.Kind() {
+		case reflect.String:
+			kinds = []protoreflect.Kind{protoreflect.StringKind}
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			kinds = []protoreflect.Kind{protoreflect.Int32Kind, protoreflect.Int64Kind}
+		case reflect.Float32, reflect.Float64:
+			kinds = []protoreflect.Kind{protoreflect.DoubleKind, protoreflect.FloatKind}
+		case reflect.Bool:
+			kinds = []protoreflect.Kind{protoreflect.BoolKind}
+		case reflect.Struct:
+			if fd.Kind() != protoreflect.MessageKind || string(fd.Message().Name()) != ft.Name() {
+				t.Errorf("%s.%s is not a %s", md.FullName(), name, ft.Name())
+				continue
+			}
+			checkFields(t, ft, fd.Message())
+			continue
+		}
+		ok := false
+		for _, k := range kinds {
+			ok = ok || fd.Kind() == k
+		}
+		if !ok {
+			t.Errorf("%s.%s is a %s, the go field a %s", md.FullName(), name, fd.Kind(), sf.Type)
+		}
+	}
+}
+
+//TestPayloads checks that the messages of ocpp16.proto have every field of the payloads of the protocol package
+func TestPayloads(t *testing.T) {
+	if len(ChargePointActions) != 14 || len(CentralSystemActions) != 26 {
+		t.Fatalf("%d requests of the charging points and %d calls", len(ChargePointActions), len(CentralSystemActions))
+	}
+	for _, action := range append(append([]string{}, ChargePointActions...), CentralSystemActions...) {
+		for _, payload := range []interface{}{NewRequest(action), NewResponse(action)} {
+			m, err := Message(payload)
+			if err != nil {
+				t.Errorf("%s: %v", action, err)
+				continue
+			}
+			checkFields(t, reflect.TypeOf(payload).Elem(), m.ProtoReflect().Descriptor())
+		}
+		for _, name := range []string{action + "Call", action + "Result"} {
+			if _, err := newMessage(name); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	zero, limit, txID := 0, 16.5, 42
+	tests := []protocol.Request{
+		&protocol.ResetRequest{Type: "Soft"},
+		&protocol.ClearCacheRequest{},
+		&protocol.ChangeAvailabilityRequest{ConnectorId: &zero, Type: "Inoperative"},
+		&protocol.GetConfigurationRequest{Key: []string{"HeartbeatInterval", "MeterValueSampleInterval"}},
+		&protocol.SetChargingProfileRequest{
+			ConnectorId: &zero,
+			ChargingProfile: protocol.ChargingProfile{
+				ChargingProfileId:      &txID,
+				StackLevel:             &zero,
+				ChargingProfilePurpose: "TxDefaultProfile",
+				ChargingProfileKind:    "Absolute",
+				ChargingSchedule: protocol.ChargingSchedule{
+					ChargingRateUnit: "A",
+					ChargingSchedulePeriod: []protocol.ChargingSchedulePeriod{
+						{StartPeriod: &zero, Limit: &limit},
+						{StartPeriod: &txID, Limit: &limit},
+					},
+				},
+			},
+		},
+	}
+	for _, req := range tests {
+		call, err := NewCall("CP001", "1", req)
+		if err != nil {
+			t.Fatalf("%s: %v", req.Action(), err)
+		}
+		data, err := proto.Marshal(call)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := newMessage(req.Action() + "Call")
+		if err = proto.Unmarshal(data, got); err != nil {
+			t.Fatal(err)
+		}
+		payload, err := GetPayload(got)
+		if err != nil {
+			t.Fatalf("%s: %v", req.Action(), err)
+		}
+		if !reflect.DeepEqual(payload, req) {
+			t.Errorf("%s: got %+v, want %+v", req.Action(), payload, req)
+		}
+	}
+}
+
+func TestWire(t *testing.T) {
+	//ResetCall{id: "CP001", unique_id: "1", request: {type: "Soft"}} as the field numbers of ocpp16.proto encode it
+	var request, want []byte
+	request = protowire.AppendTag(request, 1, protowire.BytesType)
+	request = protowire.AppendString(request, "Soft")
+	want = protowire.AppendTag(want, 1, protowire.BytesType)
+	want = protowire.AppendString(want, "CP001")
+	want = protowire.AppendTag(want, 2, protowire.BytesType)
+	want = protowire.AppendString(want, "1")
+	want = protowire.AppendTag(want, 3, protowire.BytesType)
+	want = protowire.AppendBytes(want, request)
+
+	call, err := NewCall("CP001", "1", protocol.ResetRequest{Type: "Soft"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(call)
+	if err != nil || !bytes.Equal(data, want) {
+		t.Fatalf("got %x, %v, want %x", data, err, want)
+	}
+	//an optional field is sent when it is 0
+	zero := 0
+	m, err := Message(&protocol.ChangeAvailabilityRequest{ConnectorId: &zero, Type: "Inoperative"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ = proto.Marshal(m); !bytes.HasPrefix(data, []byte{0x08, 0x00}) {
+		t.Errorf("connector id 0 missing in %x", data)
+	}
+}
+
+func TestOneof(t *testing.T) {
+	reply := &Reply{Id: "CP001", UniqueId: "2", Action: protocol.ResetName}
+	if err := SetPayload(reply, &protocol.ResetResponse{Status: "Rejected"}); err != nil {
+		t.Fatal(err)
+	}
+	if reply.GetResetResponse().GetStatus() != "Rejected" {
+		t.Fatalf("unexpected reply %v", reply)
+	}
+	e := &Event{Id: "CP001", UniqueId: "3", Action: protocol.HeartbeatName}
+	if err := SetPayload(e, &protocol.HeartbeatRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if payload, err := GetPayload(e); err != nil || reflect.TypeOf(payload) != reflect.TypeOf(&protocol.HeartbeatRequest{}) {
+		t.Fatalf("unexpected payload %+v, err %v", payload, err)
+	}
+	result := &ResetResult{}
+	SetError(result, &CallError{ErrorCode: "NotSupported"})
+	if payload, err := GetPayload(result); err != nil || payload != nil || result.GetError().GetErrorCode() != "NotSupported" {
+		t.Fatalf("unexpected result %v, payload %+v, err %v", result, payload, err)
+	}
+	//a Call of a Command can not carry a request of the charging points
+	if err := SetPayload(&Call{Id: "CP001"}, &protocol.HeartbeatRequest{}); err == nil {
+		t.Error("Heartbeat call set")
+	}
+}
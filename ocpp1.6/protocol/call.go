@@ -1,7 +1,9 @@
 package protocol
 
 import (
+	"crypto/rand"
 	"encoding/json"
+	"fmt"
 )
 
 type Call struct {
@@ -77,6 +79,28 @@ func (c *Call) SpecificRequest() Request {
 		c.Request = c.Request.(UpdateFirmwareRequest)
 	case GetDiagnosticsRequest:
 		c.Request = c.Request.(GetDiagnosticsRequest)
+	case SecurityEventNotificationRequest:
+		c.Request = c.Request.(SecurityEventNotificationRequest)
+	case SignCertificateRequest:
+		c.Request = c.Request.(SignCertificateRequest)
+	case CertificateSignedRequest:
+		c.Request = c.Request.(CertificateSignedRequest)
+	case InstallCertificateRequest:
+		c.Request = c.Request.(InstallCertificateRequest)
+	case DeleteCertificateRequest:
+		c.Request = c.Request.(DeleteCertificateRequest)
+	case GetInstalledCertificateIdsRequest:
+		c.Request = c.Request.(GetInstalledCertificateIdsRequest)
+	case GetLogRequest:
+		c.Request = c.Request.(GetLogRequest)
+	case LogStatusNotificationRequest:
+		c.Request = c.Request.(LogStatusNotificationRequest)
+	case SignedUpdateFirmwareRequest:
+		c.Request = c.Request.(SignedUpdateFirmwareRequest)
+	case SignedFirmwareStatusNotificationRequest:
+		c.Request = c.Request.(SignedFirmwareStatusNotificationRequest)
+	case ExtendedTriggerMessageRequest:
+		c.Request = c.Request.(ExtendedTriggerMessageRequest)
 	default:
 	}
 	return c.Request
@@ -92,3 +116,12 @@ func (c *Call) MarshalJSON() ([]byte, error) {
 	fields[0], fields[1], fields[2], fields[3] = int(c.MessageTypeID), c.UniqueID, c.Action, c.Request
 	return json.Marshal(fields)
 }
+
+//NewUniqueID returns a random uuid, it fits in the 36 characters allowed for a message id
+func NewUniqueID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -76,6 +76,28 @@ func (cr *CallResult) SpecificResponse() Response {
 		cr.Response = cr.Response.(UpdateFirmwareResponse)
 	case GetDiagnosticsResponse:
 		cr.Response = cr.Response.(GetDiagnosticsResponse)
+	case SecurityEventNotificationResponse:
+		cr.Response = cr.Response.(SecurityEventNotificationResponse)
+	case SignCertificateResponse:
+		cr.Response = cr.Response.(SignCertificateResponse)
+	case CertificateSignedResponse:
+		cr.Response = cr.Response.(CertificateSignedResponse)
+	case InstallCertificateResponse:
+		cr.Response = cr.Response.(InstallCertificateResponse)
+	case DeleteCertificateResponse:
+		cr.Response = cr.Response.(DeleteCertificateResponse)
+	case GetInstalledCertificateIdsResponse:
+		cr.Response = cr.Response.(GetInstalledCertificateIdsResponse)
+	case GetLogResponse:
+		cr.Response = cr.Response.(GetLogResponse)
+	case LogStatusNotificationResponse:
+		cr.Response = cr.Response.(LogStatusNotificationResponse)
+	case SignedUpdateFirmwareResponse:
+		cr.Response = cr.Response.(SignedUpdateFirmwareResponse)
+	case SignedFirmwareStatusNotificationResponse:
+		cr.Response = cr.Response.(SignedFirmwareStatusNotificationResponse)
+	case ExtendedTriggerMessageResponse:
+		cr.Response = cr.Response.(ExtendedTriggerMessageResponse)
 	default:
 	}
 	return cr.Response
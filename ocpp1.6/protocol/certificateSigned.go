@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type CertificateSignedStatus string
+
+const (
+	CertificateSignedStatusAccepted CertificateSignedStatus = "Accepted"
+	CertificateSignedStatusRejected CertificateSignedStatus = "Rejected"
+)
+
+func init() {
+	Validate.RegisterValidation("certificateSignedStatus", func(f validator.FieldLevel) bool {
+		status := CertificateSignedStatus(f.Field().String())
+		switch status {
+		case CertificateSignedStatusAccepted, CertificateSignedStatusRejected:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type CertificateSignedRequest struct {
+	CertificateChain string `json:"certificateChain" validate:"required,max=10000"`
+}
+
+func (CertificateSignedRequest) Action() string {
+	return CertificateSignedName
+}
+
+func (r *CertificateSignedRequest) Reset() {
+	r.CertificateChain = ""
+}
+
+type CertificateSignedResponse struct {
+	Status CertificateSignedStatus `json:"status" validate:"required,certificateSignedStatus"`
+}
+
+func (CertificateSignedResponse) Action() string {
+	return CertificateSignedName
+}
+
+func (r *CertificateSignedResponse) Reset() {
+	r.Status = ""
+}
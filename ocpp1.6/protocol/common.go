@@ -144,6 +144,21 @@ const (
 	CallResultName                    = "CallResult"
 )
 
+//actions of the security extension
+const (
+	SecurityEventNotificationName        = "SecurityEventNotification"
+	SignCertificateName                  = "SignCertificate"
+	CertificateSignedName                = "CertificateSigned"
+	InstallCertificateName               = "InstallCertificate"
+	DeleteCertificateName                = "DeleteCertificate"
+	GetInstalledCertificateIdsName       = "GetInstalledCertificateIds"
+	GetLogName                           = "GetLog"
+	LogStatusNotificationName            = "LogStatusNotification"
+	SignedUpdateFirmwareName             = "SignedUpdateFirmware"
+	SignedFirmwareStatusNotificationName = "SignedFirmwareStatusNotification"
+	ExtendedTriggerMessageName           = "ExtendedTriggerMessage"
+)
+
 type MessageType int
 
 const (
@@ -0,0 +1,73 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type HashAlgorithm string
+
+const (
+	HashAlgorithmSHA256 HashAlgorithm = "SHA256"
+	HashAlgorithmSHA384 HashAlgorithm = "SHA384"
+	HashAlgorithmSHA512 HashAlgorithm = "SHA512"
+)
+
+type DeleteCertificateStatus string
+
+const (
+	DeleteCertificateStatusAccepted DeleteCertificateStatus = "Accepted"
+	DeleteCertificateStatusFailed   DeleteCertificateStatus = "Failed"
+	DeleteCertificateStatusNotFound DeleteCertificateStatus = "NotFound"
+)
+
+func init() {
+	Validate.RegisterValidation("hashAlgorithm", func(f validator.FieldLevel) bool {
+		algorithm := HashAlgorithm(f.Field().String())
+		switch algorithm {
+		case HashAlgorithmSHA256, HashAlgorithmSHA384, HashAlgorithmSHA512:
+			return true
+		default:
+			return false
+		}
+	})
+	Validate.RegisterValidation("deleteCertificateStatus", func(f validator.FieldLevel) bool {
+		status := DeleteCertificateStatus(f.Field().String())
+		switch status {
+		case DeleteCertificateStatusAccepted, DeleteCertificateStatusFailed, DeleteCertificateStatusNotFound:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type CertificateHashData struct {
+	HashAlgorithm  HashAlgorithm `json:"hashAlgorithm" validate:"required,hashAlgorithm"`
+	IssuerNameHash string        `json:"issuerNameHash" validate:"required,max=128"`
+	IssuerKeyHash  string        `json:"issuerKeyHash" validate:"required,max=128"`
+	SerialNumber   string        `json:"serialNumber" validate:"required,max=40"`
+}
+
+type DeleteCertificateRequest struct {
+	CertificateHashData CertificateHashData `json:"certificateHashData" validate:"required"`
+}
+
+func (DeleteCertificateRequest) Action() string {
+	return DeleteCertificateName
+}
+
+func (r *DeleteCertificateRequest) Reset() {
+	r.CertificateHashData = CertificateHashData{}
+}
+
+type DeleteCertificateResponse struct {
+	Status DeleteCertificateStatus `json:"status" validate:"required,deleteCertificateStatus"`
+}
+
+func (DeleteCertificateResponse) Action() string {
+	return DeleteCertificateName
+}
+
+func (r *DeleteCertificateResponse) Reset() {
+	r.Status = ""
+}
@@ -0,0 +1,51 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+//ExtendedMessageTrigger is the message an ExtendedTriggerMessage requests, the responses reuse TriggerMessageStatus
+type ExtendedMessageTrigger string
+
+const (
+	SignChargePointCertificateTrigger ExtendedMessageTrigger = "SignChargePointCertificate"
+)
+
+func init() {
+	Validate.RegisterValidation("extendedMessageTrigger", func(f validator.FieldLevel) bool {
+		trigger := ExtendedMessageTrigger(f.Field().String())
+		switch trigger {
+		case BootNotificationName, LogStatusNotificationName, FirmwareStatusNotificationName, HeartbeatName, MeterValuesName,
+			SignChargePointCertificateTrigger, StatusNotificationName:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type ExtendedTriggerMessageRequest struct {
+	RequestedMessage ExtendedMessageTrigger `json:"requestedMessage" validate:"required,extendedMessageTrigger"`
+	ConnectorId      *int                   `json:"connectorId,omitempty" validate:"omitempty,gt=0"`
+}
+
+func (ExtendedTriggerMessageRequest) Action() string {
+	return ExtendedTriggerMessageName
+}
+
+func (r *ExtendedTriggerMessageRequest) Reset() {
+	r.RequestedMessage = ""
+	r.ConnectorId = nil
+}
+
+type ExtendedTriggerMessageResponse struct {
+	Status TriggerMessageStatus `json:"status" validate:"required,triggerMessageStatus"`
+}
+
+func (ExtendedTriggerMessageResponse) Action() string {
+	return ExtendedTriggerMessageName
+}
+
+func (r *ExtendedTriggerMessageResponse) Reset() {
+	r.Status = ""
+}
@@ -0,0 +1,50 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type GetInstalledCertificateStatus string
+
+const (
+	GetInstalledCertificateStatusAccepted GetInstalledCertificateStatus = "Accepted"
+	GetInstalledCertificateStatusNotFound GetInstalledCertificateStatus = "NotFound"
+)
+
+func init() {
+	Validate.RegisterValidation("getInstalledCertificateStatus", func(f validator.FieldLevel) bool {
+		status := GetInstalledCertificateStatus(f.Field().String())
+		switch status {
+		case GetInstalledCertificateStatusAccepted, GetInstalledCertificateStatusNotFound:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type GetInstalledCertificateIdsRequest struct {
+	CertificateType CertificateUse `json:"certificateType" validate:"required,certificateUse"`
+}
+
+func (GetInstalledCertificateIdsRequest) Action() string {
+	return GetInstalledCertificateIdsName
+}
+
+func (r *GetInstalledCertificateIdsRequest) Reset() {
+	r.CertificateType = ""
+}
+
+type GetInstalledCertificateIdsResponse struct {
+	CertificateHashData []CertificateHashData         `json:"certificateHashData,omitempty" validate:"omitempty,dive"`
+	Status              GetInstalledCertificateStatus `json:"status" validate:"required,getInstalledCertificateStatus"`
+}
+
+func (GetInstalledCertificateIdsResponse) Action() string {
+	return GetInstalledCertificateIdsName
+}
+
+func (r *GetInstalledCertificateIdsResponse) Reset() {
+	r.CertificateHashData = nil
+	r.Status = ""
+}
@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type LogType string
+
+const (
+	LogTypeDiagnosticsLog LogType = "DiagnosticsLog"
+	LogTypeSecurityLog    LogType = "SecurityLog"
+)
+
+type LogStatus string
+
+const (
+	LogStatusAccepted         LogStatus = "Accepted"
+	LogStatusRejected         LogStatus = "Rejected"
+	LogStatusAcceptedCanceled LogStatus = "AcceptedCanceled"
+)
+
+func init() {
+	Validate.RegisterValidation("logType", func(f validator.FieldLevel) bool {
+		logType := LogType(f.Field().String())
+		switch logType {
+		case LogTypeDiagnosticsLog, LogTypeSecurityLog:
+			return true
+		default:
+			return false
+		}
+	})
+	Validate.RegisterValidation("logStatus", func(f validator.FieldLevel) bool {
+		status := LogStatus(f.Field().String())
+		switch status {
+		case LogStatusAccepted, LogStatusRejected, LogStatusAcceptedCanceled:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type LogParameters struct {
+	RemoteLocation  string `json:"remoteLocation" validate:"required,max=512"`
+	OldestTimestamp string `json:"oldestTimestamp,omitempty" validate:"omitempty,dateTime"`
+	LatestTimestamp string `json:"latestTimestamp,omitempty" validate:"omitempty,dateTime"`
+}
+
+type GetLogRequest struct {
+	Log           LogParameters `json:"log" validate:"required"`
+	LogType       LogType       `json:"logType" validate:"required,logType"`
+	RequestId     *int          `json:"requestId" validate:"required"`
+	Retries       *int          `json:"retries,omitempty" validate:"omitempty,gte=0"`
+	RetryInterval *int          `json:"retryInterval,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (GetLogRequest) Action() string {
+	return GetLogName
+}
+
+func (r *GetLogRequest) Reset() {
+	r.Log = LogParameters{}
+	r.LogType = ""
+	r.RequestId = nil
+	r.Retries = nil
+	r.RetryInterval = nil
+}
+
+type GetLogResponse struct {
+	Status   LogStatus `json:"status" validate:"required,logStatus"`
+	Filename string    `json:"filename,omitempty" validate:"omitempty,max=255"`
+}
+
+func (GetLogResponse) Action() string {
+	return GetLogName
+}
+
+func (r *GetLogResponse) Reset() {
+	r.Status = ""
+	r.Filename = ""
+}
@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type CertificateUse string
+
+const (
+	CertificateUseCentralSystemRootCertificate CertificateUse = "CentralSystemRootCertificate"
+	CertificateUseManufacturerRootCertificate  CertificateUse = "ManufacturerRootCertificate"
+)
+
+type CertificateStatus string
+
+const (
+	CertificateStatusAccepted CertificateStatus = "Accepted"
+	CertificateStatusFailed   CertificateStatus = "Failed"
+	CertificateStatusRejected CertificateStatus = "Rejected"
+)
+
+func init() {
+	Validate.RegisterValidation("certificateUse", func(f validator.FieldLevel) bool {
+		use := CertificateUse(f.Field().String())
+		switch use {
+		case CertificateUseCentralSystemRootCertificate, CertificateUseManufacturerRootCertificate:
+			return true
+		default:
+			return false
+		}
+	})
+	Validate.RegisterValidation("certificateStatus", func(f validator.FieldLevel) bool {
+		status := CertificateStatus(f.Field().String())
+		switch status {
+		case CertificateStatusAccepted, CertificateStatusFailed, CertificateStatusRejected:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type InstallCertificateRequest struct {
+	CertificateType CertificateUse `json:"certificateType" validate:"required,certificateUse"`
+	Certificate     string         `json:"certificate" validate:"required,max=5500"`
+}
+
+func (InstallCertificateRequest) Action() string {
+	return InstallCertificateName
+}
+
+func (r *InstallCertificateRequest) Reset() {
+	r.CertificateType = ""
+	r.Certificate = ""
+}
+
+type InstallCertificateResponse struct {
+	Status CertificateStatus `json:"status" validate:"required,certificateStatus"`
+}
+
+func (InstallCertificateResponse) Action() string {
+	return InstallCertificateName
+}
+
+func (r *InstallCertificateResponse) Reset() {
+	r.Status = ""
+}
@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type UploadLogStatus string
+
+const (
+	UploadLogStatusBadMessage            UploadLogStatus = "BadMessage"
+	UploadLogStatusIdle                  UploadLogStatus = "Idle"
+	UploadLogStatusNotSupportedOperation UploadLogStatus = "NotSupportedOperation"
+	UploadLogStatusPermissionDenied      UploadLogStatus = "PermissionDenied"
+	UploadLogStatusUploaded              UploadLogStatus = "Uploaded"
+	UploadLogStatusUploadFailure         UploadLogStatus = "UploadFailure"
+	UploadLogStatusUploading             UploadLogStatus = "Uploading"
+)
+
+func init() {
+	Validate.RegisterValidation("uploadLogStatus", func(f validator.FieldLevel) bool {
+		status := UploadLogStatus(f.Field().String())
+		switch status {
+		case UploadLogStatusBadMessage, UploadLogStatusIdle, UploadLogStatusNotSupportedOperation, UploadLogStatusPermissionDenied,
+			UploadLogStatusUploaded, UploadLogStatusUploadFailure, UploadLogStatusUploading:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type LogStatusNotificationRequest struct {
+	Status    UploadLogStatus `json:"status" validate:"required,uploadLogStatus"`
+	RequestId *int            `json:"requestId,omitempty" validate:"omitempty"`
+}
+
+func (LogStatusNotificationRequest) Action() string {
+	return LogStatusNotificationName
+}
+
+func (r *LogStatusNotificationRequest) Reset() {
+	r.Status = ""
+	r.RequestId = nil
+}
+
+type LogStatusNotificationResponse struct{}
+
+func (LogStatusNotificationResponse) Action() string {
+	return LogStatusNotificationName
+}
+
+func (r *LogStatusNotificationResponse) Reset() {}
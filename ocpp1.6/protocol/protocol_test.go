@@ -675,7 +675,7 @@ func TestStopTransactionRequest(t *testing.T) {
 func StopTransactionResponseSuccess() *StopTransactionResponse {
 
 	return &StopTransactionResponse{
-		IdTagInfo: IdTagInfo{
+		IdTagInfo: &IdTagInfo{
 			ExpiryDate:  time.Now().Format(ISO8601),
 			ParentIdTag: IdToken(RandomString(15)),
 			Status:      authAccepted,
@@ -687,7 +687,7 @@ func StopTransactionResponseSuccess() *StopTransactionResponse {
 func StopTransactionResponseFail() *StopTransactionResponse {
 
 	return &StopTransactionResponse{
-		IdTagInfo: IdTagInfo{
+		IdTagInfo: &IdTagInfo{
 			ExpiryDate:  "2021-12-13",
 			ParentIdTag: IdToken(RandomString(25)),
 			Status:      AuthorizationStatus(RandomString(14)),
@@ -1384,7 +1384,7 @@ func SetSendLocalListSuccess() *SendLocalListRequest {
 		LocalAuthorizationList: []AuthorizationData{
 			AuthorizationData{
 				IdTag: RandomString(10),
-				IdTagInfo: IdTagInfo{
+				IdTagInfo: &IdTagInfo{
 					ExpiryDate:  time.Now().Format(ISO8601),
 					ParentIdTag: IdToken(RandomString(10)),
 					Status:      authExpired,
@@ -1402,7 +1402,7 @@ func SetSendLocalListFail() *SendLocalListRequest {
 		LocalAuthorizationList: []AuthorizationData{
 			AuthorizationData{
 				IdTag: RandomString(10),
-				IdTagInfo: IdTagInfo{
+				IdTagInfo: &IdTagInfo{
 					ExpiryDate:  RandomString(10),
 					ParentIdTag: IdToken(RandomString(100)),
 					Status:      AuthorizationStatus(RandomString(100)),
@@ -1722,3 +1722,164 @@ func TestGetCompositeScheduleRequest(t *testing.T) {
 // 	}
 // 	fmt.Printf("%+v\n", callResult)
 // }
+
+/*****************security extension***************/
+
+func TestSecurityEventNotificationRequest(t *testing.T) {
+	req := &SecurityEventNotificationRequest{
+		Type:      "FirmwareUpdated",
+		Timestamp: time.Now().Format(ISO8601),
+	}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	req.Type = RandomString(51)
+	if err := Validate.Struct(req); err == nil {
+		t.Error("type longer than 50 must be rejected")
+	}
+}
+
+func TestSignCertificate(t *testing.T) {
+	if err := Validate.Struct(&SignCertificateRequest{Csr: RandomString(5500)}); err != nil {
+		t.Error(err)
+	}
+	if err := Validate.Struct(&SignCertificateRequest{Csr: RandomString(5501)}); err == nil {
+		t.Error("csr longer than 5500 must be rejected")
+	}
+	if err := Validate.Struct(&SignCertificateResponse{Status: GenericStatusAccepted}); err != nil {
+		t.Error(err)
+	}
+	if err := Validate.Struct(&SignCertificateResponse{Status: "Failed"}); err == nil {
+		t.Error("invalid status must be rejected")
+	}
+}
+
+func TestCertificate(t *testing.T) {
+	install := &InstallCertificateRequest{
+		CertificateType: CertificateUseCentralSystemRootCertificate,
+		Certificate:     RandomString(100),
+	}
+	if err := Validate.Struct(install); err != nil {
+		t.Error(err)
+	}
+	install.CertificateType = "V2GRootCertificate"
+	if err := Validate.Struct(install); err == nil {
+		t.Error("invalid certificateType must be rejected")
+	}
+	hashData := CertificateHashData{
+		HashAlgorithm:  HashAlgorithmSHA256,
+		IssuerNameHash: RandomString(64),
+		IssuerKeyHash:  RandomString(64),
+		SerialNumber:   RandomString(20),
+	}
+	if err := Validate.Struct(&DeleteCertificateRequest{CertificateHashData: hashData}); err != nil {
+		t.Error(err)
+	}
+	ids := &GetInstalledCertificateIdsResponse{
+		CertificateHashData: []CertificateHashData{hashData},
+		Status:              GetInstalledCertificateStatusAccepted,
+	}
+	if err := Validate.Struct(ids); err != nil {
+		t.Error(err)
+	}
+	ids.CertificateHashData[0].HashAlgorithm = "MD5"
+	if err := Validate.Struct(ids); err == nil {
+		t.Error("invalid hashAlgorithm must be rejected")
+	}
+}
+
+func TestGetLogRequest(t *testing.T) {
+	requestId := 1
+	req := &GetLogRequest{
+		Log:       LogParameters{RemoteLocation: "ftp://logs.example.com/"},
+		LogType:   LogTypeSecurityLog,
+		RequestId: &requestId,
+	}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	req.RequestId = nil
+	if err := Validate.Struct(req); err == nil {
+		t.Error("missing requestId must be rejected")
+	}
+	if err := Validate.Struct(&LogStatusNotificationRequest{Status: UploadLogStatusUploaded, RequestId: &requestId}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSignedUpdateFirmwareRequest(t *testing.T) {
+	requestId := 1
+	req := &SignedUpdateFirmwareRequest{
+		RequestId: &requestId,
+		Firmware: Firmware{
+			Location:           "https://firmware.example.com/cp.bin",
+			RetrieveDateTime:   time.Now().Format(ISO8601),
+			SigningCertificate: RandomString(100),
+			Signature:          RandomString(100),
+		},
+	}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	req.Firmware.Signature = ""
+	if err := Validate.Struct(req); err == nil {
+		t.Error("missing signature must be rejected")
+	}
+	if err := Validate.Struct(&SignedFirmwareStatusNotificationRequest{Status: SignedFirmwareStatusSignatureVerified}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExtendedTriggerMessageRequest(t *testing.T) {
+	if err := Validate.Struct(&ExtendedTriggerMessageRequest{RequestedMessage: SignChargePointCertificateTrigger}); err != nil {
+		t.Error(err)
+	}
+	if err := Validate.Struct(&ExtendedTriggerMessageRequest{RequestedMessage: DiagnosticsStatusNotificationName}); err == nil {
+		t.Error("DiagnosticsStatusNotification can not be triggered by ExtendedTriggerMessage")
+	}
+}
+
+//the optional IdTagInfo is left out when it is nil, the zero value of IdTagInfo would be sent as {"status":""}
+func TestStopTransactionResponseIdTagInfo(t *testing.T) {
+	cases := []struct {
+		res     *StopTransactionResponse
+		payload string
+	}{
+		{&StopTransactionResponse{}, `{}`},
+		{&StopTransactionResponse{IdTagInfo: &IdTagInfo{Status: authAccepted}}, `{"idTagInfo":{"status":"Accepted"}}`},
+	}
+	for _, c := range cases {
+		if err := Validate.Struct(c.res); err != nil {
+			t.Errorf("%s: %v", c.payload, err)
+		}
+		payload, _ := json.Marshal(c.res)
+		if string(payload) != c.payload {
+			t.Errorf("payload %s, want %s", payload, c.payload)
+		}
+		var res StopTransactionResponse
+		if err := json.Unmarshal(payload, &res); err != nil || (res.IdTagInfo == nil) != (c.res.IdTagInfo == nil) {
+			t.Errorf("%s decoded to %+v, err(%v)", payload, res, err)
+		}
+	}
+	res := StopTransactionResponseSuccess()
+	res.Reset()
+	if res.IdTagInfo != nil {
+		t.Error("Reset kept IdTagInfo")
+	}
+}
+
+//an AuthorizationData without IdTagInfo removes the idTag in a Differential update, it is sent without idTagInfo
+func TestAuthorizationDataIdTagInfo(t *testing.T) {
+	listVersion := 2
+	req := &SendLocalListRequest{ListVersion: &listVersion, UpdateType: "Differential", LocalAuthorizationList: []AuthorizationData{
+		{IdTag: "added", IdTagInfo: &IdTagInfo{Status: authAccepted}},
+		{IdTag: "removed"},
+	}}
+	if err := Validate.Struct(req); err != nil {
+		t.Error(err)
+	}
+	payload, _ := json.Marshal(req.LocalAuthorizationList)
+	if want := `[{"idTag":"added","idTagInfo":{"status":"Accepted"}},{"idTag":"removed"}]`; string(payload) != want {
+		t.Errorf("payload %s, want %s", payload, want)
+	}
+}
@@ -0,0 +1,25 @@
+package protocol
+
+type SecurityEventNotificationRequest struct {
+	Type      string `json:"type" validate:"required,max=50"`
+	Timestamp string `json:"timestamp" validate:"required,dateTime"`
+	TechInfo  string `json:"techInfo,omitempty" validate:"omitempty,max=255"`
+}
+
+func (SecurityEventNotificationRequest) Action() string {
+	return SecurityEventNotificationName
+}
+
+func (r *SecurityEventNotificationRequest) Reset() {
+	r.Type = ""
+	r.Timestamp = ""
+	r.TechInfo = ""
+}
+
+type SecurityEventNotificationResponse struct{}
+
+func (SecurityEventNotificationResponse) Action() string {
+	return SecurityEventNotificationName
+}
+
+func (r *SecurityEventNotificationResponse) Reset() {}
@@ -40,9 +40,11 @@ const (
 type UpdateType string
 type UpdateStatus string
 
+//AuthorizationData without IdTagInfo removes idTag from the list in a Differential update, the pointer tells the
+//removal apart from an entry, see StopTransactionResponse
 type AuthorizationData struct {
-	IdTag     string    `json:"idTag" validate:"required,max=20"`
-	IdTagInfo IdTagInfo `json:"idTagInfo,omitempty" validate:"omitempty"` //TODO: validate required if update type is Full
+	IdTag     string     `json:"idTag" validate:"required,max=20"`
+	IdTagInfo *IdTagInfo `json:"idTagInfo,omitempty" validate:"omitempty"` //TODO: validate required if update type is Full
 }
 
 type SendLocalListRequest struct {
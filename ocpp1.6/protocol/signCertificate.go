@@ -0,0 +1,48 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type GenericStatus string
+
+const (
+	GenericStatusAccepted GenericStatus = "Accepted"
+	GenericStatusRejected GenericStatus = "Rejected"
+)
+
+func init() {
+	Validate.RegisterValidation("genericStatus", func(f validator.FieldLevel) bool {
+		status := GenericStatus(f.Field().String())
+		switch status {
+		case GenericStatusAccepted, GenericStatusRejected:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type SignCertificateRequest struct {
+	Csr string `json:"csr" validate:"required,max=5500"`
+}
+
+func (SignCertificateRequest) Action() string {
+	return SignCertificateName
+}
+
+func (r *SignCertificateRequest) Reset() {
+	r.Csr = ""
+}
+
+type SignCertificateResponse struct {
+	Status GenericStatus `json:"status" validate:"required,genericStatus"`
+}
+
+func (SignCertificateResponse) Action() string {
+	return SignCertificateName
+}
+
+func (r *SignCertificateResponse) Reset() {
+	r.Status = ""
+}
@@ -0,0 +1,63 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+//SignedFirmwareStatus extends FirmwareStatus with the states of the signed firmware update
+type SignedFirmwareStatus string
+
+const (
+	SignedFirmwareStatusDownloaded                SignedFirmwareStatus = "Downloaded"
+	SignedFirmwareStatusDownloadFailed            SignedFirmwareStatus = "DownloadFailed"
+	SignedFirmwareStatusDownloading               SignedFirmwareStatus = "Downloading"
+	SignedFirmwareStatusDownloadScheduled         SignedFirmwareStatus = "DownloadScheduled"
+	SignedFirmwareStatusDownloadPaused            SignedFirmwareStatus = "DownloadPaused"
+	SignedFirmwareStatusIdle                      SignedFirmwareStatus = "Idle"
+	SignedFirmwareStatusInstallationFailed        SignedFirmwareStatus = "InstallationFailed"
+	SignedFirmwareStatusInstalling                SignedFirmwareStatus = "Installing"
+	SignedFirmwareStatusInstalled                 SignedFirmwareStatus = "Installed"
+	SignedFirmwareStatusInstallRebooting          SignedFirmwareStatus = "InstallRebooting"
+	SignedFirmwareStatusInstallScheduled          SignedFirmwareStatus = "InstallScheduled"
+	SignedFirmwareStatusInstallVerificationFailed SignedFirmwareStatus = "InstallVerificationFailed"
+	SignedFirmwareStatusInvalidSignature          SignedFirmwareStatus = "InvalidSignature"
+	SignedFirmwareStatusSignatureVerified         SignedFirmwareStatus = "SignatureVerified"
+)
+
+func init() {
+	Validate.RegisterValidation("signedFirmwareStatus", func(f validator.FieldLevel) bool {
+		status := SignedFirmwareStatus(f.Field().String())
+		switch status {
+		case SignedFirmwareStatusDownloaded, SignedFirmwareStatusDownloadFailed, SignedFirmwareStatusDownloading,
+			SignedFirmwareStatusDownloadScheduled, SignedFirmwareStatusDownloadPaused, SignedFirmwareStatusIdle,
+			SignedFirmwareStatusInstallationFailed, SignedFirmwareStatusInstalling, SignedFirmwareStatusInstalled,
+			SignedFirmwareStatusInstallRebooting, SignedFirmwareStatusInstallScheduled, SignedFirmwareStatusInstallVerificationFailed,
+			SignedFirmwareStatusInvalidSignature, SignedFirmwareStatusSignatureVerified:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type SignedFirmwareStatusNotificationRequest struct {
+	Status    SignedFirmwareStatus `json:"status" validate:"required,signedFirmwareStatus"`
+	RequestId *int                 `json:"requestId,omitempty" validate:"omitempty"`
+}
+
+func (SignedFirmwareStatusNotificationRequest) Action() string {
+	return SignedFirmwareStatusNotificationName
+}
+
+func (r *SignedFirmwareStatusNotificationRequest) Reset() {
+	r.Status = ""
+	r.RequestId = nil
+}
+
+type SignedFirmwareStatusNotificationResponse struct{}
+
+func (SignedFirmwareStatusNotificationResponse) Action() string {
+	return SignedFirmwareStatusNotificationName
+}
+
+func (r *SignedFirmwareStatusNotificationResponse) Reset() {}
@@ -0,0 +1,66 @@
+package protocol
+
+import (
+	validator "github.com/go-playground/validator/v10"
+)
+
+type UpdateFirmwareStatus string
+
+const (
+	UpdateFirmwareStatusAccepted           UpdateFirmwareStatus = "Accepted"
+	UpdateFirmwareStatusRejected           UpdateFirmwareStatus = "Rejected"
+	UpdateFirmwareStatusAcceptedCanceled   UpdateFirmwareStatus = "AcceptedCanceled"
+	UpdateFirmwareStatusInvalidCertificate UpdateFirmwareStatus = "InvalidCertificate"
+	UpdateFirmwareStatusRevokedCertificate UpdateFirmwareStatus = "RevokedCertificate"
+)
+
+func init() {
+	Validate.RegisterValidation("updateFirmwareStatus", func(f validator.FieldLevel) bool {
+		status := UpdateFirmwareStatus(f.Field().String())
+		switch status {
+		case UpdateFirmwareStatusAccepted, UpdateFirmwareStatusRejected, UpdateFirmwareStatusAcceptedCanceled,
+			UpdateFirmwareStatusInvalidCertificate, UpdateFirmwareStatusRevokedCertificate:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+type Firmware struct {
+	Location           string `json:"location" validate:"required,max=512"`
+	RetrieveDateTime   string `json:"retrieveDateTime" validate:"required,dateTime"`
+	InstallDateTime    string `json:"installDateTime,omitempty" validate:"omitempty,dateTime"`
+	SigningCertificate string `json:"signingCertificate" validate:"required,max=5500"`
+	Signature          string `json:"signature" validate:"required,max=800"`
+}
+
+type SignedUpdateFirmwareRequest struct {
+	Retries       *int     `json:"retries,omitempty" validate:"omitempty,gte=0"`
+	RetryInterval *int     `json:"retryInterval,omitempty" validate:"omitempty,gte=0"`
+	RequestId     *int     `json:"requestId" validate:"required"`
+	Firmware      Firmware `json:"firmware" validate:"required"`
+}
+
+func (SignedUpdateFirmwareRequest) Action() string {
+	return SignedUpdateFirmwareName
+}
+
+func (r *SignedUpdateFirmwareRequest) Reset() {
+	r.Retries = nil
+	r.RetryInterval = nil
+	r.RequestId = nil
+	r.Firmware = Firmware{}
+}
+
+type SignedUpdateFirmwareResponse struct {
+	Status UpdateFirmwareStatus `json:"status" validate:"required,updateFirmwareStatus"`
+}
+
+func (SignedUpdateFirmwareResponse) Action() string {
+	return SignedUpdateFirmwareName
+}
+
+func (r *SignedUpdateFirmwareResponse) Reset() {
+	r.Status = ""
+}
@@ -56,8 +56,10 @@ func (r *StopTransactionRequest) Reset() {
 	r.TransactionData = nil
 }
 
+//StopTransactionResponse has no IdTagInfo when the transaction was stopped without idTag, it is a pointer like the
+//other optional objects: the zero value of IdTagInfo would be sent as {"status":""}, which the schema rejects
 type StopTransactionResponse struct {
-	IdTagInfo IdTagInfo `json:"idTagInfo,omitempty" validate:"omitempty"`
+	IdTagInfo *IdTagInfo `json:"idTagInfo,omitempty" validate:"omitempty"`
 }
 
 func (StopTransactionResponse) Action() string {
@@ -65,5 +67,5 @@ func (StopTransactionResponse) Action() string {
 }
 
 func (r *StopTransactionResponse) Reset() {
-	r.IdTagInfo = IdTagInfo{}
+	r.IdTagInfo = nil
 }
@@ -36,11 +36,22 @@ func init() {
 		TriggerMessageTrait{},
 		UpdateFirmwareTrait{},
 		GetDiagnosticsTrait{},
+		SecurityEventNotificationTrait{},
+		SignCertificateTrait{},
+		CertificateSignedTrait{},
+		InstallCertificateTrait{},
+		DeleteCertificateTrait{},
+		GetInstalledCertificateIdsTrait{},
+		GetLogTrait{},
+		LogStatusNotificationTrait{},
+		SignedUpdateFirmwareTrait{},
+		SignedFirmwareStatusNotificationTrait{},
+		ExtendedTriggerMessageTrait{},
 	)
 }
 
 var OCPP16M = &OCPP16Map{
-	traitMap: make(map[string]ocpptrait),
+	traitMap: make(map[string]Trait),
 }
 
 type Request interface {
@@ -53,19 +64,20 @@ type Response interface {
 
 type RequestHandler func(ctx context.Context, id string, uniqueid string, request Request) (Response, error) //charge point request handler
 type ResponseHandler func(ctx context.Context, id string, uniqueid string, response Response) error          //charge point response handler
-type ocpptrait interface {
+//Trait binds an action to its request and response types, the server uses it to unmarshal the payloads
+type Trait interface {
 	Action() string
 	RequestType() reflect.Type
 	ResponseType() reflect.Type
 }
-type traitMap map[string]ocpptrait
+type traitMap map[string]Trait
 
 type OCPP16Map struct {
 	sync.RWMutex
 	traitMap
 }
 
-func (m *OCPP16Map) register(traits ...ocpptrait) {
+func (m *OCPP16Map) register(traits ...Trait) {
 	m.Lock()
 	defer m.Unlock()
 	for _, trait := range traits {
@@ -81,7 +93,7 @@ func (m *OCPP16Map) SupportActions() []string {
 	return actions
 }
 
-func (m *OCPP16Map) GetTraitAction(action string) (ocpptrait, bool) {
+func (m *OCPP16Map) GetTraitAction(action string) (Trait, bool) {
 	m.RLock()
 	defer m.RUnlock()
 	if v, ok := m.traitMap[action]; ok {
@@ -511,3 +523,168 @@ func (GetDiagnosticsTrait) RequestType() reflect.Type {
 func (GetDiagnosticsTrait) ResponseType() reflect.Type {
 	return reflect.TypeOf(GetDiagnosticsResponse{})
 }
+
+//SecurityEventNotification
+type SecurityEventNotificationTrait struct{}
+
+func (SecurityEventNotificationTrait) Action() string {
+	return SecurityEventNotificationName
+}
+
+func (SecurityEventNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SecurityEventNotificationRequest{})
+}
+
+func (SecurityEventNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SecurityEventNotificationResponse{})
+}
+
+//SignCertificate
+type SignCertificateTrait struct{}
+
+func (SignCertificateTrait) Action() string {
+	return SignCertificateName
+}
+
+func (SignCertificateTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SignCertificateRequest{})
+}
+
+func (SignCertificateTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SignCertificateResponse{})
+}
+
+//CertificateSigned
+type CertificateSignedTrait struct{}
+
+func (CertificateSignedTrait) Action() string {
+	return CertificateSignedName
+}
+
+func (CertificateSignedTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(CertificateSignedRequest{})
+}
+
+func (CertificateSignedTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(CertificateSignedResponse{})
+}
+
+//InstallCertificate
+type InstallCertificateTrait struct{}
+
+func (InstallCertificateTrait) Action() string {
+	return InstallCertificateName
+}
+
+func (InstallCertificateTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(InstallCertificateRequest{})
+}
+
+func (InstallCertificateTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(InstallCertificateResponse{})
+}
+
+//DeleteCertificate
+type DeleteCertificateTrait struct{}
+
+func (DeleteCertificateTrait) Action() string {
+	return DeleteCertificateName
+}
+
+func (DeleteCertificateTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(DeleteCertificateRequest{})
+}
+
+func (DeleteCertificateTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(DeleteCertificateResponse{})
+}
+
+//GetInstalledCertificateIds
+type GetInstalledCertificateIdsTrait struct{}
+
+func (GetInstalledCertificateIdsTrait) Action() string {
+	return GetInstalledCertificateIdsName
+}
+
+func (GetInstalledCertificateIdsTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetInstalledCertificateIdsRequest{})
+}
+
+func (GetInstalledCertificateIdsTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetInstalledCertificateIdsResponse{})
+}
+
+//GetLog
+type GetLogTrait struct{}
+
+func (GetLogTrait) Action() string {
+	return GetLogName
+}
+
+func (GetLogTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(GetLogRequest{})
+}
+
+func (GetLogTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(GetLogResponse{})
+}
+
+//LogStatusNotification
+type LogStatusNotificationTrait struct{}
+
+func (LogStatusNotificationTrait) Action() string {
+	return LogStatusNotificationName
+}
+
+func (LogStatusNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(LogStatusNotificationRequest{})
+}
+
+func (LogStatusNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(LogStatusNotificationResponse{})
+}
+
+//SignedUpdateFirmware
+type SignedUpdateFirmwareTrait struct{}
+
+func (SignedUpdateFirmwareTrait) Action() string {
+	return SignedUpdateFirmwareName
+}
+
+func (SignedUpdateFirmwareTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SignedUpdateFirmwareRequest{})
+}
+
+func (SignedUpdateFirmwareTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SignedUpdateFirmwareResponse{})
+}
+
+//SignedFirmwareStatusNotification
+type SignedFirmwareStatusNotificationTrait struct{}
+
+func (SignedFirmwareStatusNotificationTrait) Action() string {
+	return SignedFirmwareStatusNotificationName
+}
+
+func (SignedFirmwareStatusNotificationTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(SignedFirmwareStatusNotificationRequest{})
+}
+
+func (SignedFirmwareStatusNotificationTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(SignedFirmwareStatusNotificationResponse{})
+}
+
+//ExtendedTriggerMessage
+type ExtendedTriggerMessageTrait struct{}
+
+func (ExtendedTriggerMessageTrait) Action() string {
+	return ExtendedTriggerMessageName
+}
+
+func (ExtendedTriggerMessageTrait) RequestType() reflect.Type {
+	return reflect.TypeOf(ExtendedTriggerMessageRequest{})
+}
+
+func (ExtendedTriggerMessageTrait) ResponseType() reflect.Type {
+	return reflect.TypeOf(ExtendedTriggerMessageResponse{})
+}
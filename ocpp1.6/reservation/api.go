@@ -0,0 +1,74 @@
+package reservation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"ocpp16/protocol"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the reservations on r, e.g. the group of the rest api:
+//
+//	GET    /reservations                      the active reservations, ?chargePointId= for the ones of a charging point
+//	POST   /reservations/:id                  sends the ReserveNowRequest of the body, replies its status
+//	DELETE /reservations/:id/:reservationId   sends CancelReservation, replies its status
+//
+//a reservation rejected before it is sent is answered with 409 and the status it got
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/reservations", m.list)
+	r.POST("/reservations/:id", m.reserve)
+	r.DELETE("/reservations/:id/:reservationId", m.cancel)
+}
+
+func (m *Manager) list(c *gin.Context) {
+	c.JSON(http.StatusOK, m.Reservations(c.Query("chargePointId")))
+}
+
+func (m *Manager) reserve(c *gin.Context) {
+	req := protocol.ReserveNowRequest{}
+	err := c.ShouldBindJSON(&req)
+	if err == nil {
+		err = protocol.Validate.Struct(&req)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid ReserveNowRequest, %v", err)})
+		return
+	}
+	status, err := m.Reserve(c.Request.Context(), c.Param("id"), req)
+	var rejection *Rejection
+	switch {
+	case errors.As(err, &rejection):
+		c.JSON(http.StatusConflict, gin.H{"status": rejection.Status, "error": rejection.Reason})
+	case err != nil && status == "":
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"status": status, "error": err.Error()})
+	default:
+		c.JSON(http.StatusOK, protocol.ReserveNowResponse{Status: status})
+	}
+}
+
+func (m *Manager) cancel(c *gin.Context) {
+	reservationID, err := strconv.Atoi(c.Param("reservationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid reservationId(%s)", c.Param("reservationId"))})
+		return
+	}
+	status, err := m.Cancel(c.Request.Context(), c.Param("id"), reservationID)
+	if err != nil && status == "" {
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": status, "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, protocol.CancelReservationResponse{Status: status})
+}
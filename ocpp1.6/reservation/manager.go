@@ -0,0 +1,421 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sort"
+	"sync"
+	"time"
+)
+
+//Server is the part of *ocpp16server.Server the manager needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Config struct {
+	Path    string        //json file of the active reservations, in memory only if empty
+	Timeout time.Duration //of a call to a charging point, 30s if 0
+	Confirm time.Duration //a charging point reports the connector Reserved within, 1m if 0
+}
+
+//Rejection is a reservation rejected before it is sent to the charging point
+type Rejection struct {
+	Status protocol.ReservationStatus
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("%s, %s", r.Status, r.Reason)
+}
+
+type Manager struct {
+	server       Server
+	receiver     Receiver
+	conf         Config
+	mu           sync.Mutex
+	reservations map[key]*Reservation                          //the active ones
+	pending      map[key]*Reservation                          //sent by Reserve, not answered yet
+	statuses     map[string]map[int]protocol.ChargePointStatus //charging point id -> connector id -> status
+	timers       map[key]*time.Timer
+	now          func() time.Time
+	onError      func(id string, err error)
+}
+
+//NewManager loads the reservations of conf.Path, the events are sent to receiver, which can be nil
+func NewManager(s Server, receiver Receiver, conf Config) (*Manager, error) {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 30 * time.Second
+	}
+	if conf.Confirm <= 0 {
+		conf.Confirm = time.Minute
+	}
+	reservations, err := load(conf.Path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{
+		server:       s,
+		receiver:     receiver,
+		conf:         conf,
+		reservations: reservations,
+		pending:      make(map[key]*Reservation),
+		statuses:     make(map[string]map[int]protocol.ChargePointStatus),
+		timers:       make(map[key]*time.Timer),
+		now:          time.Now,
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, r := range reservations {
+		m.schedule(k, r.ExpiryDate)
+	}
+	return m, nil
+}
+
+//SetErrorHandler gets the errors of the expiries and the events, and the warnings on the statuses reported by the
+//charging points
+func (m *Manager) SetErrorHandler(fn func(id string, err error)) {
+	m.onError = fn
+}
+
+func (m *Manager) handle(id string, err error) {
+	if err != nil && m.onError != nil {
+		m.onError(id, err)
+	}
+}
+
+func (m *Manager) emit(e *Event) {
+	if m.receiver != nil {
+		m.handle(e.Reservation.ChargePoint, m.receiver.ReservationEvent(e))
+	}
+}
+
+//Reservations returns the active reservations ordered by charging point and id, of the charging point id if not empty
+func (m *Manager) Reservations(id string) []Reservation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reservations := []Reservation{}
+	for _, r := range m.reservations {
+		if id == "" || r.ChargePoint == id {
+			reservations = append(reservations, *r)
+		}
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		if reservations[i].ChargePoint != reservations[j].ChargePoint {
+			return reservations[i].ChargePoint < reservations[j].ChargePoint
+		}
+		return reservations[i].ID < reservations[j].ID
+	})
+	return reservations
+}
+
+//save writes the active reservations, m.mu is held
+func (m *Manager) save() error {
+	reservations := make([]*Reservation, 0, len(m.reservations))
+	for _, r := range m.reservations {
+		reservations = append(reservations, r)
+	}
+	sort.Slice(reservations, func(i, j int) bool {
+		return reservations[i].ChargePoint < reservations[j].ChargePoint || (reservations[i].ChargePoint == reservations[j].ChargePoint && reservations[i].ID < reservations[j].ID)
+	})
+	return save(m.conf.Path, reservations)
+}
+
+//schedule expires the reservation k at expiry, m.mu is held
+func (m *Manager) schedule(k key, expiry time.Time) {
+	if t, ok := m.timers[k]; ok {
+		t.Stop()
+	}
+	m.timers[k] = time.AfterFunc(expiry.Sub(m.now()), func() {
+		m.mu.Lock()
+		r, ok := m.reservations[k]
+		if !ok || m.now().Before(r.ExpiryDate) {
+			m.mu.Unlock()
+			return
+		}
+		e, err := m.end(k, Expired, nil)
+		m.mu.Unlock()
+		m.handle(k.chargePoint, err)
+		m.emit(e)
+	})
+}
+
+//end removes the active reservation k with status, m.mu is held
+func (m *Manager) end(k key, status Status, transactionID *int) (*Event, error) {
+	r := m.reservations[k]
+	if t, ok := m.timers[k]; ok {
+		t.Stop()
+		delete(m.timers, k)
+	}
+	delete(m.reservations, k)
+	now := m.now()
+	ended := *r
+	ended.Status, ended.EndedAt, ended.TransactionID = status, &now, transactionID
+	return &Event{Type: status, Reservation: ended}, m.save()
+}
+
+//check returns why req conflicts with the reservations, the pending ones included, and the status of the connectors
+//of the charging point id, nil if it does not. m.mu is held
+func (m *Manager) check(id string, req *protocol.ReserveNowRequest, expiry time.Time) *Rejection {
+	if !expiry.After(m.now()) {
+		return &Rejection{Status: protocol.ReservationStatusRejected, Reason: fmt.Sprintf("expiryDate(%s) passed", req.ExpiryDate)}
+	}
+	connectorID := *req.ConnectorId
+	for _, reservations := range []map[key]*Reservation{m.reservations, m.pending} {
+		for k, r := range reservations {
+			if k.chargePoint != id || k.id == *req.ReservationId {
+				continue
+			}
+			if connectorID > 0 && r.ConnectorID == connectorID {
+				return &Rejection{Status: protocol.ReservationStatusOccupied, Reason: fmt.Sprintf("connector(%d) held by reservation(%d)", connectorID, r.ID)}
+			}
+			if r.IdTag == req.IdTag {
+				return &Rejection{Status: protocol.ReservationStatusRejected, Reason: fmt.Sprintf("idTag(%s) holds reservation(%d)", req.IdTag, r.ID)}
+			}
+		}
+	}
+	if connectorID == 0 {
+		return nil
+	}
+	switch status := m.statuses[id][connectorID]; status {
+	case faulted:
+		return &Rejection{Status: protocol.ReservationStatusFaulted, Reason: fmt.Sprintf("connector(%d) %s", connectorID, status)}
+	case unavailable:
+		return &Rejection{Status: protocol.ReservationStatusUnavailable, Reason: fmt.Sprintf("connector(%d) %s", connectorID, status)}
+	case preparing, charging, suspendedEV, suspendedEVSE, finishing:
+		return &Rejection{Status: protocol.ReservationStatusOccupied, Reason: fmt.Sprintf("connector(%d) %s", connectorID, status)}
+	case reserved:
+		if r, ok := m.reservations[key{id, *req.ReservationId}]; !ok || r.ConnectorID != connectorID {
+			return &Rejection{Status: protocol.ReservationStatusOccupied, Reason: fmt.Sprintf("connector(%d) %s", connectorID, status)}
+		}
+	}
+	return nil
+}
+
+//Reserve sends req to the charging point id unless it conflicts with the active reservations or the status of the
+//connector, then a *Rejection is returned. A reservation with the id of an active one replaces it. the reservation
+//holds its connector and idTag while it is sent, until the charging point answers it
+func (m *Manager) Reserve(ctx context.Context, id string, req protocol.ReserveNowRequest) (protocol.ReservationStatus, error) {
+	if err := protocol.Validate.Struct(&req); err != nil {
+		return "", err
+	}
+	expiry, err := time.Parse(time.RFC3339Nano, req.ExpiryDate)
+	if err != nil {
+		return "", fmt.Errorf("invalid expiryDate(%s)", req.ExpiryDate)
+	}
+	k := key{id, *req.ReservationId}
+	r := &Reservation{
+		ID:          *req.ReservationId,
+		ChargePoint: id,
+		ConnectorID: *req.ConnectorId,
+		IdTag:       req.IdTag,
+		ParentIdTag: req.ParentIdTag,
+		ExpiryDate:  expiry,
+		Status:      Reserved,
+		CreatedAt:   m.now(),
+	}
+	m.mu.Lock()
+	rejection := m.check(id, &req, expiry)
+	if _, ok := m.pending[k]; ok && rejection == nil {
+		rejection = &Rejection{Status: protocol.ReservationStatusRejected, Reason: fmt.Sprintf("reservation(%d) being sent", r.ID)}
+	}
+	if rejection == nil {
+		m.pending[k] = r
+	}
+	m.mu.Unlock()
+	if rejection != nil {
+		return rejection.Status, rejection
+	}
+	res, err := ocpp16server.Send(ctx, m.server, id, req, m.conf.Timeout)
+	reply, ok := res.(*protocol.ReserveNowResponse)
+	if err == nil && !ok {
+		err = fmt.Errorf("unexpected ReserveNow reply %+v", res)
+	}
+	m.mu.Lock()
+	delete(m.pending, k)
+	if err != nil || reply.Status != protocol.ReservationStatusAccepted {
+		m.mu.Unlock()
+		if err != nil {
+			return "", err
+		}
+		return reply.Status, nil
+	}
+	if old, ok := m.reservations[k]; ok && old.ConnectorID == r.ConnectorID {
+		r.Confirmed = old.Confirmed
+	}
+	m.reservations[k] = r
+	m.schedule(k, expiry)
+	err = m.save()
+	m.mu.Unlock()
+	time.AfterFunc(m.conf.Confirm, func() { m.confirmed(k, r) })
+	m.emit(&Event{Type: Reserved, Reservation: *r})
+	return reply.Status, err
+}
+
+//confirmed warns when the connector of r is not reported Reserved
+func (m *Manager) confirmed(k key, r *Reservation) {
+	m.mu.Lock()
+	current, ok := m.reservations[k]
+	unconfirmed := ok && current == r && !r.Confirmed
+	m.mu.Unlock()
+	if unconfirmed {
+		m.handle(k.chargePoint, fmt.Errorf("connector(%d) not Reserved %s after the reservation(%d)", r.ConnectorID, m.conf.Confirm, r.ID))
+	}
+}
+
+//Check rejects the ReserveNow calls sent to the charging point id that conflict with the reservations, the ones not
+//sent by Reserve included, e.g. by the active plugins. it is a hook of the server, see SetCallHooks
+func (m *Manager) Check(id string, call *protocol.Call) error {
+	req, ok := call.Request.(protocol.ReserveNowRequest)
+	if !ok || req.ConnectorId == nil || req.ReservationId == nil {
+		return nil
+	}
+	expiry, err := time.Parse(time.RFC3339Nano, req.ExpiryDate)
+	if err != nil {
+		return fmt.Errorf("invalid expiryDate(%s)", req.ExpiryDate)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rejection := m.check(id, &req, expiry); rejection != nil {
+		return rejection
+	}
+	return nil
+}
+
+//Cancel sends CancelReservation to the charging point id and ends the reservation, also when the charging point
+//rejects it as it does not hold it
+func (m *Manager) Cancel(ctx context.Context, id string, reservationID int) (protocol.CancelReservationStatus, error) {
+	res, err := ocpp16server.Send(ctx, m.server, id, protocol.CancelReservationRequest{ReservationId: &reservationID}, m.conf.Timeout)
+	if err != nil {
+		return "", err
+	}
+	reply, ok := res.(*protocol.CancelReservationResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected CancelReservation reply %+v", res)
+	}
+	status := Cancelled
+	if reply.Status != protocol.CancelReservationStatusAccepted {
+		status = Released
+	}
+	k := key{id, reservationID}
+	m.mu.Lock()
+	if _, ok = m.reservations[k]; !ok {
+		m.mu.Unlock()
+		return reply.Status, nil
+	}
+	e, err := m.end(k, status, nil)
+	m.mu.Unlock()
+	m.emit(e)
+	return reply.Status, err
+}
+
+//status records the status of the connector of the charging point id and checks it against its reservations
+func (m *Manager) status(id string, req *protocol.StatusNotificationRequest) {
+	if req.ConnectorId == nil {
+		return
+	}
+	connectorID := *req.ConnectorId
+	m.mu.Lock()
+	statuses, ok := m.statuses[id]
+	if !ok {
+		statuses = make(map[int]protocol.ChargePointStatus)
+		m.statuses[id] = statuses
+	}
+	previous := statuses[connectorID]
+	statuses[connectorID] = req.Status
+	var held, zero *Reservation
+	for k, r := range m.reservations {
+		if k.chargePoint != id {
+			continue
+		}
+		if r.ConnectorID == connectorID && connectorID > 0 {
+			held = r
+		} else if r.ConnectorID == 0 && (zero == nil || r.CreatedAt.Before(zero.CreatedAt)) {
+			zero = r
+		}
+	}
+	var e *Event
+	var err, warning error
+	switch {
+	case req.Status == reserved && held != nil:
+		held.Confirmed = true
+		err = m.save()
+	case req.Status == reserved && zero != nil:
+		zero.Confirmed = true
+		err = m.save()
+	case req.Status == reserved:
+		warning = fmt.Errorf("connector(%d) Reserved without a reservation", connectorID)
+	case previous == reserved && req.Status == available && held != nil:
+		status := Released
+		if !m.now().Before(held.ExpiryDate) {
+			status = Expired
+		}
+		e, err = m.end(key{id, held.ID}, status, nil)
+	}
+	m.mu.Unlock()
+	m.handle(id, err)
+	m.handle(id, warning)
+	if e != nil {
+		m.emit(e)
+	}
+}
+
+//use ends the reservation consumed by a StartTransaction of the charging point id
+func (m *Manager) use(id string, req *protocol.StartTransactionRequest, transactionID int) {
+	k := key{id, *req.ReservationId}
+	m.mu.Lock()
+	r, ok := m.reservations[k]
+	if !ok {
+		m.mu.Unlock()
+		m.handle(id, fmt.Errorf("StartTransaction with the reservation(%d) not active", k.id))
+		return
+	}
+	var warning error
+	if req.ConnectorId != nil && r.ConnectorID > 0 && r.ConnectorID != *req.ConnectorId {
+		warning = fmt.Errorf("reservation(%d) of connector(%d) used on connector(%d)", r.ID, r.ConnectorID, *req.ConnectorId)
+	}
+	e, err := m.end(k, Used, &transactionID)
+	m.mu.Unlock()
+	m.handle(id, warning)
+	m.handle(id, err)
+	m.emit(e)
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin following the statuses of the connectors and the StartTransactions with a reservationId, register
+//it on the server in place of plugin
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return handler, ok
+	}
+	switch action {
+	case protocol.StatusNotificationName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			p.m.status(id, request.(*protocol.StatusNotificationRequest))
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	case protocol.StartTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			res, err := handler(ctx, id, uniqueid, request)
+			req := request.(*protocol.StartTransactionRequest)
+			if start, ok := res.(*protocol.StartTransactionResponse); ok && err == nil && req.ReservationId != nil && start.TransactionId != nil {
+				if start.IdTagInfo.Status == "Accepted" {
+					p.m.use(id, req, *start.TransactionId)
+				}
+			}
+			return res, err
+		}, true
+	}
+	return handler, ok
+}
@@ -0,0 +1,106 @@
+//Package reservation keeps the reservations made with ReserveNow: it rejects the conflicting ones before they are
+//sent, expires them, consumes them with the StartTransaction of their reservationId and checks the charging points
+//report their connectors Reserved
+package reservation
+
+import (
+	"encoding/json"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+//Status is the state of a reservation, Reserved until it ends
+type Status string
+
+const (
+	Reserved  Status = "Reserved"
+	Used      Status = "Used"      //by the StartTransaction of its reservationId
+	Cancelled Status = "Cancelled" //by CancelReservation
+	Expired   Status = "Expired"   //at its expiryDate
+	Released  Status = "Released"  //the charging point left Reserved on its own
+)
+
+//the statuses of StatusNotification
+const (
+	available     protocol.ChargePointStatus = "Available"
+	preparing     protocol.ChargePointStatus = "Preparing"
+	charging      protocol.ChargePointStatus = "Charging"
+	suspendedEVSE protocol.ChargePointStatus = "SuspendedEVSE"
+	suspendedEV   protocol.ChargePointStatus = "SuspendedEV"
+	finishing     protocol.ChargePointStatus = "Finishing"
+	reserved      protocol.ChargePointStatus = "Reserved"
+	unavailable   protocol.ChargePointStatus = "Unavailable"
+	faulted       protocol.ChargePointStatus = "Faulted"
+)
+
+type Reservation struct {
+	ID            int        `json:"reservationId"`
+	ChargePoint   string     `json:"chargePointId"`
+	ConnectorID   int        `json:"connectorId"`
+	IdTag         string     `json:"idTag"`
+	ParentIdTag   string     `json:"parentIdTag,omitempty"`
+	ExpiryDate    time.Time  `json:"expiryDate"`
+	Status        Status     `json:"status"`
+	Confirmed     bool       `json:"confirmed"` //the connector was reported Reserved
+	CreatedAt     time.Time  `json:"createdAt"`
+	EndedAt       *time.Time `json:"endedAt,omitempty"`
+	TransactionID *int       `json:"transactionId,omitempty"` //of the StartTransaction that used it
+}
+
+//Event is a change of the status of a reservation, Reserved when it is accepted by the charging point
+type Event struct {
+	Type        Status      `json:"type"`
+	Reservation Reservation `json:"reservation"`
+}
+
+//Receiver is implemented by the passive plugins that take the reservation events
+type Receiver interface {
+	ReservationEvent(e *Event) error
+}
+
+//key identifies a reservation, the reservation ids are unique per charging point
+type key struct {
+	chargePoint string
+	id          int
+}
+
+func load(path string) (map[key]*Reservation, error) {
+	reservations := make(map[key]*Reservation)
+	if path == "" {
+		return reservations, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return reservations, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var list []*Reservation
+	if err = json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("reservation file(%s) corrupted, err(%v)", path, err)
+	}
+	for _, r := range list {
+		reservations[key{r.ChargePoint, r.ID}] = r
+	}
+	return reservations, nil
+}
+
+//save writes the reservations to path, nothing if path is empty
+func save(path string, reservations []*Reservation) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.Marshal(reservations)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data)
+}
@@ -0,0 +1,237 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/transaction"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func intp(i int) *int {
+	return &i
+}
+
+//chargePoint accepts the reservations and the cancellations of the reservations it holds
+type chargePoint struct {
+	mu   sync.Mutex
+	held map[int]bool
+}
+
+func (cp *chargePoint) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	switch req := call.Request.(type) {
+	case protocol.ReserveNowRequest:
+		cp.held[*req.ReservationId] = true
+		return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusAccepted}, nil, nil
+	case protocol.CancelReservationRequest:
+		if !cp.held[*req.ReservationId] {
+			return &protocol.CancelReservationResponse{Status: protocol.CancelReservationStatusRejected}, nil, nil
+		}
+		delete(cp.held, *req.ReservationId)
+		return &protocol.CancelReservationResponse{Status: protocol.CancelReservationStatusAccepted}, nil, nil
+	}
+	return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+}
+
+//receiver records the events and the warnings
+type receiver struct {
+	mu       sync.Mutex
+	events   []Status
+	warnings []string
+}
+
+func (r *receiver) ReservationEvent(e *Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e.Type)
+	return nil
+}
+
+func (r *receiver) warn(id string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.warnings = append(r.warnings, err.Error())
+}
+
+func (r *receiver) take() ([]Status, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events, warnings := r.events, r.warnings
+	r.events, r.warnings = nil, nil
+	return events, warnings
+}
+
+func reserve(connectorID int, reservationID int, idTag string, expiry time.Time) protocol.ReserveNowRequest {
+	return protocol.ReserveNowRequest{ConnectorId: intp(connectorID), ReservationId: intp(reservationID), IdTag: idTag, ExpiryDate: expiry.UTC().Format(time.RFC3339)}
+}
+
+func TestReserve(t *testing.T) {
+	cp := &chargePoint{held: make(map[int]bool)}
+	r := &receiver{}
+	path := filepath.Join(t.TempDir(), "reservations.json")
+	m, err := NewManager(cp, r, Config{Path: path, Confirm: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetErrorHandler(r.warn)
+	transactions, _ := transaction.NewManager(transaction.NewMemoryRepository())
+	plugin := m.Wrap(transactions.Wrap(local.NewActionPlugin()))
+	handle := func(request protocol.Request) protocol.Response {
+		handler, _ := plugin.RequestHandler(request.Action())
+		res, err := handler(context.Background(), "CP001", protocol.NewUniqueID(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	status := func(connectorID int, s protocol.ChargePointStatus) {
+		handle(&protocol.StatusNotificationRequest{ConnectorId: intp(connectorID), ErrorCode: "NoError", Status: s})
+	}
+	expiry := time.Now().Add(time.Hour)
+
+	status(2, faulted)
+	if _, err = m.Reserve(context.Background(), "CP001", reserve(1, 1, "tag1", expiry)); err != nil {
+		t.Fatal(err)
+	}
+	//conflicts are rejected before they are sent
+	var rejection *Rejection
+	for _, c := range []struct {
+		req      protocol.ReserveNowRequest
+		expected protocol.ReservationStatus
+	}{
+		{reserve(1, 2, "tag2", expiry), protocol.ReservationStatusOccupied},
+		{reserve(3, 2, "tag1", expiry), protocol.ReservationStatusRejected},
+		{reserve(2, 2, "tag2", expiry), protocol.ReservationStatusFaulted},
+		{reserve(3, 2, "tag2", time.Now().Add(-time.Minute)), protocol.ReservationStatusRejected},
+	} {
+		if s, err := m.Reserve(context.Background(), "CP001", c.req); !errors.As(err, &rejection) || s != c.expected || cp.held[2] {
+			t.Fatalf("reservation %+v got %s(%v)", c.req, s, err)
+		}
+	}
+	status(1, reserved)
+	if reservations := m.Reservations("CP001"); len(reservations) != 1 || !reservations[0].Confirmed {
+		t.Fatalf("unexpected reservations %+v", reservations)
+	}
+
+	//the reservation is used by the StartTransaction of its reservationId
+	handle(&protocol.StartTransactionRequest{ConnectorId: intp(1), IdTag: "tag1", MeterStart: intp(0), ReservationId: intp(1), Timestamp: time.Now().UTC().Format(time.RFC3339)})
+	if events, warnings := r.take(); len(events) != 2 || events[0] != Reserved || events[1] != Used || len(warnings) != 0 || len(m.Reservations("")) != 0 {
+		t.Fatalf("unexpected events %v, warnings %v", events, warnings)
+	}
+
+	//a reservation not reported Reserved is warned about, one the charging point no longer holds is released
+	m.Reserve(context.Background(), "CP001", reserve(3, 4, "tag4", expiry))
+	time.Sleep(50 * time.Millisecond)
+	delete(cp.held, 4)
+	if s, err := m.Cancel(context.Background(), "CP001", 4); err != nil || s != protocol.CancelReservationStatusRejected {
+		t.Fatalf("cancel got %s(%v)", s, err)
+	}
+	if events, warnings := r.take(); len(events) != 2 || events[1] != Released || len(warnings) != 1 {
+		t.Fatalf("unexpected events %v, warnings %v", events, warnings)
+	}
+	status(3, reserved)
+	if _, warnings := r.take(); len(warnings) != 1 {
+		t.Fatalf("Reserved without reservation not warned, %v", warnings)
+	}
+
+	//the reservations survive a restart and expire
+	m.Reserve(context.Background(), "CP001", reserve(4, 5, "tag5", time.Now().Add(time.Second)))
+	r.take()
+	if m, err = NewManager(cp, r, Config{Path: path}); err != nil || len(m.Reservations("CP001")) != 1 {
+		t.Fatalf("reservations not loaded %+v(%v)", m.Reservations("CP001"), err)
+	}
+	time.Sleep(1500 * time.Millisecond)
+	//the first manager expires it too
+	if events, _ := r.take(); len(events) != 2 || events[0] != Expired || len(m.Reservations("")) != 0 {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
+
+//slow holds the ReserveNow calls until release is closed, then rejects them
+type slow struct {
+	sent    chan struct{}
+	release chan struct{}
+}
+
+func (cp *slow) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	cp.sent <- struct{}{}
+	<-cp.release
+	return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusRejected}, nil, nil
+}
+
+func TestPending(t *testing.T) {
+	cp := &slow{sent: make(chan struct{}, 1), release: make(chan struct{})}
+	m, _ := NewManager(cp, nil, Config{})
+	expiry := time.Now().Add(time.Hour)
+	statusC := make(chan protocol.ReservationStatus, 1)
+	go func() {
+		s, _ := m.Reserve(context.Background(), "CP001", reserve(1, 1, "tag1", expiry))
+		statusC <- s
+	}()
+	<-cp.sent
+	//the connector and the idTag are held while the reservation is sent, by Reserve and by the hook of the server
+	var rejection *Rejection
+	for _, c := range []struct {
+		req      protocol.ReserveNowRequest
+		expected protocol.ReservationStatus
+	}{
+		{reserve(1, 2, "tag2", expiry), protocol.ReservationStatusOccupied},
+		{reserve(2, 2, "tag1", expiry), protocol.ReservationStatusRejected},
+		{reserve(2, 1, "tag1", expiry), protocol.ReservationStatusRejected},
+	} {
+		if s, err := m.Reserve(context.Background(), "CP001", c.req); !errors.As(err, &rejection) || s != c.expected {
+			t.Fatalf("reservation %+v got %s(%v)", c.req, s, err)
+		}
+	}
+	if err := m.Check("CP001", &protocol.Call{Action: protocol.ReserveNowName, Request: reserve(1, 2, "tag2", expiry)}); !errors.As(err, &rejection) {
+		t.Fatalf("conflicting ReserveNow of a plugin got %v", err)
+	}
+	if err := m.Check("CP002", &protocol.Call{Action: protocol.ReserveNowName, Request: reserve(1, 2, "tag2", expiry)}); err != nil {
+		t.Fatal(err)
+	}
+	//the slot is released when the charging point rejects the reservation
+	close(cp.release)
+	if s := <-statusC; s != protocol.ReservationStatusRejected {
+		t.Fatalf("reservation got %s", s)
+	}
+	go func() { <-cp.sent }()
+	if s, err := m.Reserve(context.Background(), "CP001", reserve(1, 2, "tag2", expiry)); err != nil || s != protocol.ReservationStatusRejected || len(m.Reservations("")) != 0 {
+		t.Fatalf("reservation got %s(%v)", s, err)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	m, _ := NewManager(&chargePoint{held: make(map[int]bool)}, nil, Config{})
+	m.RegisterAPI(engine)
+	do := func(method string, url string, body string) (int, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return w.Code, w.Body.String()
+	}
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	if code, body := do(http.MethodPost, "/reservations/CP001", `{"connectorId":1,"reservationId":1,"idTag":"a","expiryDate":"`+expiry+`"}`); code != http.StatusOK || body != `{"status":"Accepted"}` {
+		t.Fatalf("reserve got %d %s", code, body)
+	}
+	if code, body := do(http.MethodPost, "/reservations/CP001", `{"connectorId":1,"reservationId":2,"idTag":"b","expiryDate":"`+expiry+`"}`); code != http.StatusConflict || !strings.Contains(body, `"status":"Occupied"`) {
+		t.Fatalf("conflicting reservation got %d %s", code, body)
+	}
+	if code, body := do(http.MethodDelete, "/reservations/CP001/1", ""); code != http.StatusOK || body != `{"status":"Accepted"}` {
+		t.Fatalf("cancel got %d %s", code, body)
+	}
+	if code, body := do(http.MethodGet, "/reservations", ""); code != http.StatusOK || body != `[]` {
+		t.Fatalf("list got %d %s", code, body)
+	}
+}
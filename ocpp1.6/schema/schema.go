@@ -0,0 +1,316 @@
+//Package schema validates raw ocpp1.6 payloads against the official json schemas (draft-04), which are embedded.
+//Only the keywords the ocpp schemas use are supported: type, properties, required, additionalProperties, items, enum, maxLength,
+//format and multipleOf, a schema with another keyword is not loaded
+package schema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"ocpp16/protocol"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+//go:embed json/*.json
+var files embed.FS
+
+//Error is a payload violating its schema, Code is the ocpp error code to answer with
+type Error struct {
+	Code    protocol.ErrCodeType
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("%s: %s %s", e.Code, e.Field, e.Message)
+}
+
+//Schema is a compiled json schema
+type Schema struct {
+	ID                   string             `json:"id"`
+	Title                string             `json:"title"`
+	Type                 string             `json:"type"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties *bool              `json:"additionalProperties"`
+	Items                *Schema            `json:"items"`
+	Enum                 []string           `json:"enum"`
+	MaxLength            *int               `json:"maxLength"`
+	Format               string             `json:"format"`
+	MultipleOf           *float64           `json:"multipleOf"`
+}
+
+//keywords are the keywords Validate supports, $schema, id and title only describe the schema
+var keywords = map[string]bool{
+	"$schema": true, "id": true, "title": true,
+	"type": true, "properties": true, "required": true, "additionalProperties": true, "items": true,
+	"enum": true, "maxLength": true, "format": true, "multipleOf": true,
+}
+
+//checkKeywords returns an error for the first keyword of the schema v, or of its properties and items, Validate
+//would silently ignore
+func checkKeywords(field string, v map[string]interface{}) error {
+	for keyword, value := range v {
+		if !keywords[keyword] {
+			return fmt.Errorf("not support keyword(%s) current, field(%s)", keyword, field)
+		}
+		switch keyword {
+		case "properties":
+			properties, _ := value.(map[string]interface{})
+			for name, property := range properties {
+				if property, ok := property.(map[string]interface{}); ok {
+					if err := checkKeywords(join(field, name), property); err != nil {
+						return err
+					}
+				}
+			}
+		case "items":
+			if items, ok := value.(map[string]interface{}); ok {
+				if err := checkKeywords(field+"[]", items); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+//Set holds the request and response schemas of the actions of one ocpp version
+type Set struct {
+	requests  map[string]*Schema
+	responses map[string]*Schema
+}
+
+var (
+	ocpp16     *Set
+	ocpp16Err  error
+	ocpp16Once sync.Once
+)
+
+//OCPP16 returns the schemas of ocpp1.6, the files are named <Action>.json and <Action>Response.json
+func OCPP16() *Set {
+	ocpp16Once.Do(func() {
+		ocpp16, ocpp16Err = load()
+	})
+	if ocpp16Err != nil {
+		panic(ocpp16Err)
+	}
+	return ocpp16
+}
+
+func load() (*Set, error) {
+	names, err := files.ReadDir("json")
+	if err != nil {
+		return nil, err
+	}
+	set := &Set{requests: map[string]*Schema{}, responses: map[string]*Schema{}}
+	for _, entry := range names {
+		data, err := files.ReadFile(path.Join("json", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		s, err := parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("schema %s, err:(%v)", entry.Name(), err)
+		}
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		if action := strings.TrimSuffix(name, "Response"); action != name {
+			set.responses[action] = s
+		} else {
+			set.requests[name] = s
+		}
+	}
+	return set, nil
+}
+
+//parse compiles the schema data, it fails on the keywords Validate does not support
+func parse(data []byte) (*Schema, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if err := checkKeywords("", raw); err != nil {
+		return nil, err
+	}
+	s := &Schema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+//Actions returns the actions with a request schema
+func (s *Set) Actions() []string {
+	actions := make([]string, 0, len(s.requests))
+	for action := range s.requests {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+//ValidateRequest validates the request payload of action, actions without schema are not checked
+func (s *Set) ValidateRequest(action string, payload []byte) *Error {
+	return validate(s.requests[action], payload)
+}
+
+//ValidateResponse validates the response payload of action, actions without schema are not checked
+func (s *Set) ValidateResponse(action string, payload []byte) *Error {
+	return validate(s.responses[action], payload)
+}
+
+func validate(s *Schema, payload []byte) *Error {
+	if s == nil {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return &Error{Code: protocol.FormationViolation, Message: fmt.Sprintf("invalid json, err:(%v)", err)}
+	}
+	return s.Validate("", v)
+}
+
+//Validate checks v decoded by encoding/json with UseNumber, field is the path of v in the payload
+func (s *Schema) Validate(field string, v interface{}) *Error {
+	switch s.Type {
+	case "object":
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return typeError(field, "an object")
+		}
+		return s.validateObject(field, object)
+	case "array":
+		array, ok := v.([]interface{})
+		if !ok {
+			return typeError(field, "an array")
+		}
+		if s.Items != nil {
+			for i, item := range array {
+				if err := s.Items.Validate(fmt.Sprintf("%s[%d]", field, i), item); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return typeError(field, "a string")
+		}
+		return s.validateString(field, str)
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return typeError(field, "an integer")
+		}
+		if f, err := n.Float64(); err != nil || f != math.Trunc(f) {
+			return typeError(field, "an integer")
+		}
+		return s.validateNumber(field, n)
+	case "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return typeError(field, "a number")
+		}
+		return s.validateNumber(field, n)
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return typeError(field, "a boolean")
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(field string, object map[string]interface{}) *Error {
+	for _, name := range s.Required {
+		if _, ok := object[name]; !ok {
+			return &Error{Code: protocol.OccurenceConstraintViolation, Field: join(field, name), Message: "is required"}
+		}
+	}
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return &Error{Code: protocol.FormationViolation, Field: join(field, name), Message: "is not allowed"}
+			}
+			continue
+		}
+		if err := property.Validate(join(field, name), object[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateString(field string, str string) *Error {
+	if s.MaxLength != nil && utf8.RuneCountInString(str) > *s.MaxLength {
+		return &Error{Code: protocol.PropertyConstraintViolation, Field: field, Message: fmt.Sprintf("exceeds the max length %d", *s.MaxLength)}
+	}
+	if len(s.Enum) > 0 {
+		found := false
+		for _, value := range s.Enum {
+			if value == str {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &Error{Code: protocol.PropertyConstraintViolation, Field: field, Message: fmt.Sprintf("(%s) is not one of %s", str, strings.Join(s.Enum, ","))}
+		}
+	}
+	switch s.Format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return &Error{Code: protocol.PropertyConstraintViolation, Field: field, Message: fmt.Sprintf("(%s) is not a date-time", str)}
+		}
+	case "uri":
+		if u, err := url.Parse(str); err != nil || u.Scheme == "" {
+			return &Error{Code: protocol.PropertyConstraintViolation, Field: field, Message: fmt.Sprintf("(%s) is not an uri", str)}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateNumber(field string, n json.Number) *Error {
+	if s.MultipleOf == nil {
+		return nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return typeError(field, "a number")
+	}
+	//the quotient of a decimal by 0.1 is not exact in floating point
+	if q := f / *s.MultipleOf; math.Abs(q-math.Round(q)) > 1e-9*math.Max(1, math.Abs(q)) {
+		return &Error{Code: protocol.PropertyConstraintViolation, Field: field, Message: fmt.Sprintf("(%s) is not a multiple of %g", n, *s.MultipleOf)}
+	}
+	return nil
+}
+
+func typeError(field string, want string) *Error {
+	return &Error{Code: protocol.TypeConstraintViolation, Field: field, Message: "must be " + want}
+}
+
+func join(field string, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"ocpp16/protocol"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	set := OCPP16()
+	if len(set.requests) != 28 || len(set.responses) != 28 {
+		t.Fatalf("%d request schemas and %d response schemas, want 28", len(set.requests), len(set.responses))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	set := OCPP16()
+	cases := []struct {
+		action  string
+		request bool
+		payload string
+		code    protocol.ErrCodeType
+		field   string
+	}{
+		{protocol.BootNotificationName, true, `{"chargePointVendor":"v","chargePointModel":"m"}`, "", ""},
+		{protocol.BootNotificationName, true, `{"chargePointModel":"m"}`, protocol.OccurenceConstraintViolation, "chargePointVendor"},
+		{protocol.BootNotificationName, true, `{"chargePointVendor":"v","chargePointModel":"m","color":"red"}`, protocol.FormationViolation, "color"},
+		{protocol.BootNotificationName, true, `{"chargePointVendor":"v","chargePointModel":1}`, protocol.TypeConstraintViolation, "chargePointModel"},
+		{protocol.BootNotificationName, true, `[`, protocol.FormationViolation, ""},
+		{protocol.AuthorizeName, true, `{"idTag":"012345678901234567890"}`, protocol.PropertyConstraintViolation, "idTag"},
+		{protocol.StatusNotificationName, true, `{"connectorId":1.5,"errorCode":"NoError","status":"Available"}`, protocol.TypeConstraintViolation, "connectorId"},
+		{protocol.StatusNotificationName, true, `{"connectorId":1,"errorCode":"NoError","status":"Sleeping"}`, protocol.PropertyConstraintViolation, "status"},
+		{protocol.StatusNotificationName, true, `{"connectorId":1,"errorCode":"NoError","status":"Available","timestamp":"yesterday"}`, protocol.PropertyConstraintViolation, "timestamp"},
+		{protocol.MeterValuesName, true, `{"connectorId":1,"meterValue":[{"timestamp":"2021-01-01T00:00:00Z","sampledValue":[{"value":"1","unit":"Parsec"}]}]}`, protocol.PropertyConstraintViolation, "meterValue[0].sampledValue[0].unit"},
+		{protocol.GetConfigurationName, false, `{"configurationKey":[{"key":"HeartbeatInterval","readonly":"no"}]}`, protocol.TypeConstraintViolation, "configurationKey[0].readonly"},
+		{protocol.HeartbeatName, false, `{"currentTime":"2021-01-01T00:00:00Z"}`, "", ""},
+		{protocol.SecurityEventNotificationName, true, `{}`, "", ""},
+		{protocol.SetChargingProfileName, true, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16.3}]}}}`, "", ""},
+		{protocol.SetChargingProfileName, true, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16.35}]}}}`, protocol.PropertyConstraintViolation, "csChargingProfiles.chargingSchedule.chargingSchedulePeriod[0].limit"},
+	}
+	for _, c := range cases {
+		var err *Error
+		if c.request {
+			err = set.ValidateRequest(c.action, []byte(c.payload))
+		} else {
+			err = set.ValidateResponse(c.action, []byte(c.payload))
+		}
+		if c.code == "" {
+			if err != nil {
+				t.Errorf("%s %s: %v", c.action, c.payload, err)
+			}
+			continue
+		}
+		if err == nil || err.Code != c.code || err.Field != c.field {
+			t.Errorf("%s %s: %v, want %s %s", c.action, c.payload, err, c.code, c.field)
+		}
+	}
+}
+
+func TestUnsupportedKeyword(t *testing.T) {
+	if _, err := parse([]byte(`{"type":"object","properties":{"limit":{"type":"number","multipleOf":0.1}}}`)); err != nil {
+		t.Fatal(err)
+	}
+	_, err := parse([]byte(`{"type":"object","properties":{"periods":{"type":"array","items":{"type":"integer","minimum":0}}}}`))
+	if err == nil || err.Error() != "not support keyword(minimum) current, field(periods[])" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
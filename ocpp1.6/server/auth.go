@@ -0,0 +1,216 @@
+package server
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+//security profiles of the ocpp1.6 security whitepaper
+const (
+	SecurityProfileNone      = 0 //no authentication, the default
+	SecurityProfileBasicAuth = 1 //http basic authentication
+	SecurityProfileTLS       = 2 //http basic authentication over tls with server certificate
+	SecurityProfileMutualTLS = 3 //tls with client certificate, the common name must be the charging point identity
+)
+
+var (
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrTLSRequired       = errors.New("tls required")
+	ErrClientCertMissing = errors.New("client certificate missing")
+	ErrClientCAsMissing  = errors.New("security profile 3 requires the certificate authorities of the client certificates (tls_client_ca)")
+)
+
+//Authenticator checks the basic authentication password of a charging point before its websocket is upgraded,
+//identity is the last segment of the service uri, which is also the username
+type Authenticator interface {
+	Authenticate(identity string, password string) (bool, error)
+}
+
+//StaticAuthenticator authenticates with a fixed password per charging point identity
+type StaticAuthenticator struct {
+	sync.RWMutex
+	passwords map[string]string
+}
+
+func NewStaticAuthenticator(passwords map[string]string) *StaticAuthenticator {
+	a := &StaticAuthenticator{passwords: make(map[string]string, len(passwords))}
+	for identity, password := range passwords {
+		a.passwords[identity] = password
+	}
+	return a
+}
+
+//NewFileAuthenticator loads the passwords from a file with one "identity password" pair per line,
+//empty lines and lines beginning with # are skipped
+func NewFileAuthenticator(path string) (*StaticAuthenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	passwords := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		v := strings.Fields(line)
+		if len(v) != 2 {
+			return nil, fmt.Errorf("auth file(%s) line %d: want \"identity password\"", path, lineNum)
+		}
+		passwords[v[0]] = v[1]
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewStaticAuthenticator(passwords), nil
+}
+
+//SetPassword adds or replaces the password of identity
+func (a *StaticAuthenticator) SetPassword(identity string, password string) {
+	a.Lock()
+	defer a.Unlock()
+	a.passwords[identity] = password
+}
+
+func (a *StaticAuthenticator) DeletePassword(identity string) {
+	a.Lock()
+	defer a.Unlock()
+	delete(a.passwords, identity)
+}
+
+func (a *StaticAuthenticator) Authenticate(identity string, password string) (bool, error) {
+	a.RLock()
+	expected, ok := a.passwords[identity]
+	a.RUnlock()
+	if !ok {
+		return false, nil
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(password)) == 1, nil
+}
+
+//SetSecurityProfile sets the security profile every charging point must satisfy to be upgraded
+func (s *Server) SetSecurityProfile(profile int) error {
+	if profile < SecurityProfileNone || profile > SecurityProfileMutualTLS {
+		return fmt.Errorf("not support security profile(%d) current", profile)
+	}
+	s.securityProfile = profile
+	return nil
+}
+
+//SetAuthenticator sets the authenticator consulted by the security profiles with basic authentication
+func (s *Server) SetAuthenticator(authenticator Authenticator) {
+	s.authenticator = authenticator
+}
+
+//SetClientCAs sets the certificate authorities the client certificates are verified with,
+//ServeTLS requests a client certificate once they are set
+func (s *Server) SetClientCAs(pool *x509.CertPool) {
+	s.clientCAs = pool
+}
+
+//LoadClientCAs reads the pem encoded certificate authorities of the client certificates from path
+func LoadClientCAs(path string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+//authenticate checks the request of the charging point against the security profile of the server
+func (s *Server) authenticate(r *http.Request, p *point) error {
+	switch s.securityProfile {
+	case SecurityProfileNone:
+		return nil
+	case SecurityProfileBasicAuth:
+		return s.basicAuth(r, p)
+	case SecurityProfileTLS:
+		if r.TLS == nil {
+			return ErrTLSRequired
+		}
+		return s.basicAuth(r, p)
+	case SecurityProfileMutualTLS:
+		if r.TLS == nil {
+			return ErrTLSRequired
+		}
+		if len(r.TLS.PeerCertificates) == 0 {
+			return ErrClientCertMissing
+		}
+		//the chain was verified during the handshake, only the identity is left
+		if cn := r.TLS.PeerCertificates[0].Subject.CommonName; cn != p.ID {
+			return fmt.Errorf("client certificate common name(%s) mismatch", cn)
+		}
+		return nil
+	default:
+		return fmt.Errorf("not support security profile(%d) current", s.securityProfile)
+	}
+}
+
+func (s *Server) basicAuth(r *http.Request, p *point) error {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return ErrUnauthorized
+	}
+	if username != p.ID {
+		return fmt.Errorf("username(%s) mismatch", username)
+	}
+	if s.authenticator == nil {
+		return errors.New("authenticator not registered")
+	}
+	valid, err := s.authenticator.Authenticate(p.ID, password)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+//reject answers the upgrade request with 401, basic authentication is challenged unless the profile uses client certificates
+func (s *Server) reject(c *gin.Context, p *point, err error) {
+	log.Errorf("id(%s) unauthorized, security profile(%d), remote(%s), err:(%v)", p.String(), s.securityProfile, c.Request.RemoteAddr, err)
+	if s.securityProfile == SecurityProfileBasicAuth || s.securityProfile == SecurityProfileTLS {
+		c.Header("WWW-Authenticate", `Basic realm="ocpp"`)
+	}
+	c.AbortWithStatus(http.StatusUnauthorized)
+}
+
+//checkSecurity reports the settings the security profile can not work with, security profile 3 can not verify any
+//client certificate without client CAs
+func (s *Server) checkSecurity() error {
+	if s.securityProfile == SecurityProfileMutualTLS && s.clientCAs == nil {
+		return ErrClientCAsMissing
+	}
+	return nil
+}
+
+//tlsConfig requests a client certificate when client CAs are set, a missing certificate is left to the security profile
+func (s *Server) tlsConfig() *tls.Config {
+	if s.clientCAs == nil {
+		return nil
+	}
+	return &tls.Config{
+		ClientCAs:  s.clientCAs,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+}
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"crypto/x509"
+	"errors"
+	"testing"
+)
+
+func TestCheckSecurity(t *testing.T) {
+	s := &Server{}
+	if err := s.SetSecurityProfile(SecurityProfileMutualTLS); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.checkSecurity(); !errors.Is(err, ErrClientCAsMissing) {
+		t.Fatalf("profile 3 without client CAs got %v", err)
+	}
+	s.SetClientCAs(x509.NewCertPool())
+	if err := s.checkSecurity(); err != nil {
+		t.Fatal(err)
+	}
+	if cfg := s.tlsConfig(); cfg == nil || cfg.ClientCAs == nil {
+		t.Fatalf("unexpected tls config %+v", cfg)
+	}
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"ocpp16/protocol"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//ErrActionNotSupported is returned by NewRequest for the actions the ocpp version does not define
+var ErrActionNotSupported = errors.New("action not supported")
+
+//ChargePointState is the state of the connection of a charging point to this node
+type ChargePointState struct {
+	ID          string       `json:"id"`
+	Subprotocol string       `json:"subprotocol"`
+	RemoteAddr  string       `json:"remoteAddr"`
+	ConnectedAt time.Time    `json:"connectedAt"`
+	QueuedCalls int          `json:"queuedCalls"` //active calls not replied yet, including the pending one
+	PendingCall *PendingCall `json:"pendingCall,omitempty"`
+}
+
+//PendingCall is the active call sent to the charging point that waits for its reply
+type PendingCall struct {
+	UniqueID string           `json:"uniqueId"`
+	Action   string           `json:"action"`
+	Request  protocol.Request `json:"request"`
+	SentAt   string           `json:"sentAt"`
+}
+
+func (s *Server) chargePointState(ws *Wsconn) ChargePointState {
+	state := ChargePointState{
+		ID:          ws.id,
+		Subprotocol: ws.subprotocol.name,
+		RemoteAddr:  ws.conn.RemoteAddr().String(),
+		ConnectedAt: ws.connectedAt,
+	}
+	if q, ok := s.dispatcher.requestQueueMap.getQueue(ws.id); ok {
+		state.QueuedCalls = q.len()
+	}
+	if req, ok := s.getPendingRequest(ws.id); ok && req.call.UID() != "" {
+		state.PendingCall = &PendingCall{
+			UniqueID: req.call.UniqueID,
+			Action:   req.call.Action,
+			Request:  req.call.Request,
+			SentAt:   req.reqTime,
+		}
+	}
+	return state
+}
+
+//ChargePoints returns the charging points connected to this node, ordered by id
+func (s *Server) ChargePoints() []ChargePointState {
+	s.wsconns.RLock()
+	conns := make([]*Wsconn, 0, len(s.wsconns.wsmap))
+	for _, ws := range s.wsconns.wsmap {
+		conns = append(conns, ws)
+	}
+	s.wsconns.RUnlock()
+	states := make([]ChargePointState, 0, len(conns))
+	for _, ws := range conns {
+		states = append(states, s.chargePointState(ws))
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].ID < states[j].ID })
+	return states
+}
+
+//ChargePoint returns the state of id, false if it is not connected to this node
+func (s *Server) ChargePoint(id string) (ChargePointState, bool) {
+	ws, ok := s.getConn(id)
+	if !ok {
+		return ChargePointState{}, false
+	}
+	return s.chargePointState(ws), true
+}
+
+//NewRequest restores the request of action from its json payload, in the ocpp version negotiated by id.
+//when id is not connected to this node, the most preferred version that defines action is used
+func (s *Server) NewRequest(id string, action string, payload []byte) (protocol.Request, error) {
+	if ws, ok := s.getConn(id); ok {
+		if _, ok := ws.subprotocol.traitMap.GetTraitAction(action); !ok {
+			return nil, fmt.Errorf("%w, action(%s), id(%s), subprotocol(%s)", ErrActionNotSupported, action, id, ws.subprotocol.name)
+		}
+		return ws.subprotocol.newRequest(action, payload)
+	}
+	for _, name := range s.preference {
+		if p := s.subprotocols[name]; p != nil {
+			if _, ok := p.traitMap.GetTraitAction(action); ok {
+				return p.newRequest(action, payload)
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w, action(%s), id(%s)", ErrActionNotSupported, action, id)
+}
+
+//Router returns the gin engine that serves the websocket, so plugins can add their http apis next to it
+func (s *Server) Router() gin.IRouter {
+	return s.ginServer
+}
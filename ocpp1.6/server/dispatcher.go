@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"ocpp16/protocol"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -15,9 +17,19 @@ import (
 // Activecallhandler is used to send requests from the center system to the charging point
 type ActiveCallHandler func(ctx context.Context, id string, call *protocol.Call) error
 
+// SyncActiveCallHandler is used to send requests from the center system to the charging point and wait for its reply
+type SyncActiveCallHandler func(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+
+var (
+	ErrResponseTimeout = errors.New("charging point response timeout")
+	ErrConnClosed      = errors.New("charging point connection closed")
+	ErrNotConnected    = errors.New("charging point not connected")
+)
+
 type request struct {
-	call    *protocol.Call
-	reqTime string
+	call     *protocol.Call
+	reqTime  string
+	canceled int32 //the caller of Server.Call gave up before the request was sent, it is dropped from the queue
 }
 
 type callStateMap struct {
@@ -70,6 +82,77 @@ func (m *callStateMap) requestDone(id string, uniqueid string) {
 	}
 }
 
+//callReply is the reply of the charging point to an active call, only one of the fields is set
+type callReply struct {
+	response  protocol.Response
+	callError *protocol.CallError
+	err       error
+}
+
+//callWaiterMap holds the callers of Server.Call that are waiting for the reply of the charging point, id -> uniqueid -> reply
+type callWaiterMap struct {
+	waiters map[string]map[string]chan *callReply
+	sync.Mutex
+}
+
+func newCallWaiterMap() *callWaiterMap {
+	return &callWaiterMap{waiters: make(map[string]map[string]chan *callReply)}
+}
+
+func (m *callWaiterMap) addWaiter(id string, uniqueid string) (chan *callReply, error) {
+	m.Lock()
+	defer m.Unlock()
+	waiters, ok := m.waiters[id]
+	if !ok {
+		waiters = make(map[string]chan *callReply)
+		m.waiters[id] = waiters
+	}
+	if _, ok = waiters[uniqueid]; ok {
+		return nil, fmt.Errorf("duplicate uniqueid, a call with the same uniqueid is waiting for reply, id(%s), uniqueid(%s)", id, uniqueid)
+	}
+	replyC := make(chan *callReply, 1)
+	waiters[uniqueid] = replyC
+	return replyC, nil
+}
+
+func (m *callWaiterMap) deleteWaiter(id string, uniqueid string) {
+	m.Lock()
+	defer m.Unlock()
+	if waiters, ok := m.waiters[id]; ok {
+		delete(waiters, uniqueid)
+		if len(waiters) == 0 {
+			delete(m.waiters, id)
+		}
+	}
+}
+
+//notifyWaiter never blocks, the reply channel is buffered and removed from the map before sending
+func (m *callWaiterMap) notifyWaiter(id string, uniqueid string, reply *callReply) {
+	m.Lock()
+	defer m.Unlock()
+	waiters, ok := m.waiters[id]
+	if !ok {
+		return
+	}
+	if replyC, ok := waiters[uniqueid]; ok {
+		delete(waiters, uniqueid)
+		if len(waiters) == 0 {
+			delete(m.waiters, id)
+		}
+		replyC <- reply
+	}
+}
+
+//cancelWaiters releases all callers waiting on id, used when the connection is closed
+func (m *callWaiterMap) cancelWaiters(id string, err error) {
+	m.Lock()
+	defer m.Unlock()
+	for uniqueid, replyC := range m.waiters[id] {
+		replyC <- &callReply{err: fmt.Errorf("%w, id(%s), uniqueid(%s)", err, id, uniqueid)}
+	}
+	delete(m.waiters, id)
+}
+
 type requestQueueMap struct {
 	queueMap map[string]queue
 	sync.RWMutex
@@ -122,6 +205,7 @@ type dispatcher struct {
 	server          *Server
 	callStateMap    *callStateMap
 	requestQueueMap *requestQueueMap
+	callWaiterMap   *callWaiterMap
 	timeout         time.Duration
 	requestC        chan string
 	nextReadyC      chan string
@@ -137,6 +221,7 @@ func DefaultDispatcher(s *Server) (d *dispatcher) {
 		server:          s,
 		callStateMap:    newCallStateMap(),
 		requestQueueMap: newRequesQueueMap(),
+		callWaiterMap:   newCallWaiterMap(),
 		timeout:         time.Second * time.Duration(defalutResponseTimeout),
 		requestC:        make(chan string, 10),
 		nextReadyC:      make(chan string, 10),
@@ -153,6 +238,7 @@ func DispatcherWithTimeout(s *Server, resTimeout int) (d *dispatcher) {
 		server:          s,
 		callStateMap:    newCallStateMap(),
 		requestQueueMap: newRequesQueueMap(),
+		callWaiterMap:   newCallWaiterMap(),
 		timeout:         time.Second * time.Duration(resTimeout),
 		requestC:        make(chan string, 10),
 		nextReadyC:      make(chan string, 10),
@@ -234,8 +320,13 @@ func (d *dispatcher) run() {
 			id = timeOutFlag.id
 			if ctx, ok = contextMap[id]; ok && ctx.isActive() && timeOutFlag.uniqueid == ctx.uniqueid {
 				ctx.cancel()
+				if pendingReq, ok := d.callStateMap.getPendingRequest(id); ok && pendingReq.call.UID() == ctx.uniqueid {
+					d.server.metrics.timeout(pendingReq.call.Action)
+					d.server.events.Timeout(id, pendingReq.call.Action, ctx.uniqueid, time.Now())
+				}
 				d.requestDone(id, ctx.uniqueid)
 				contextMap[id] = timeoutContext{}
+				d.callWaiterMap.notifyWaiter(id, ctx.uniqueid, &callReply{err: fmt.Errorf("%w, id(%s), uniqueid(%s)", ErrResponseTimeout, id, ctx.uniqueid)})
 				if ws, ok := d.server.getConn(id); ok {
 					go ws.responseHandler(ctx.uniqueid, protocol.CallErrorName, &protocol.CallError{
 						MessageTypeID:    protocol.CALL_ERROR,
@@ -272,12 +363,18 @@ func (d *dispatcher) dispatchNextRequest(id string) (timeoutCtx timeoutContext)
 		return
 	}
 	req, ok := q.peek()
+	for ok && atomic.LoadInt32(&req.(*request).canceled) == 1 {
+		q.pop()
+		d.server.deleteStoredCall(id, req.(*request).call.UID())
+		log.Debugf("drop canceled request,id(%s),uniqueid(%s)", id, req.(*request).call.UID())
+		req, ok = q.peek()
+	}
 	if !ok {
-		log.Errorf("queue peek is empty,id(%s)", id)
+		log.Debugf("queue is empty after dropping the canceled requests,id(%s)", id)
 		return
 	}
 	request := req.(*request)
-	request.reqTime = time.Now().Format(time.RFC3339)
+	request.reqTime = time.Now().Format(time.RFC3339Nano)
 	call := request.call
 	uniqueid := call.UID()
 	message, err := json.Marshal(call)
@@ -292,6 +389,7 @@ func (d *dispatcher) dispatchNextRequest(id string) (timeoutCtx timeoutContext)
 	ws, ok := d.server.getConn(id)
 	if !ok {
 		d.requestDone(id, uniqueid)
+		d.callWaiterMap.notifyWaiter(id, uniqueid, &callReply{err: fmt.Errorf("%w, id(%s), uniqueid(%s)", ErrConnClosed, id, uniqueid)})
 		log.Errorf("get ws conn error, conn may be close, id(%s), uniqueid(%s), request(%+v)", id, uniqueid, request)
 		return
 	}
@@ -300,6 +398,7 @@ func (d *dispatcher) dispatchNextRequest(id string) (timeoutCtx timeoutContext)
 			log.Errorf("write message error:id(%s), uniqueid(%s), request(%+v), err(%v)", id, uniqueid, request, err)
 			return
 		}
+		d.server.metrics.message(directionOut, Call, call.Action)
 	}()
 	ctx := func() (timeoutCtx timeoutContext) {
 		if d.timeout < 0 {
@@ -351,33 +450,103 @@ func (d *dispatcher) requestDone(id string, uniqueid string) {
 		return
 	}
 	requestQueue.pop()
+	d.server.deleteStoredCall(id, uniqueid)
 	d.callStateMap.requestDone(id, uniqueid)
 	d.nextReadyC <- id
 }
 
 func (d *dispatcher) appendRequest(ctx context.Context, id string, call *protocol.Call) error {
+	_, err := d.enqueue(ctx, id, call, true)
+	return err
+}
+
+//enqueue validates and queues the call, it returns the request queued, nil if the call is stored to be replayed when the
+//charging point connects. without replay, a call to a charging point not connected yet fails with ErrNotConnected
+func (d *dispatcher) enqueue(ctx context.Context, id string, call *protocol.Call, replay bool) (*request, error) {
 	log.Debugf("active call, append request, id(%s),call(%+v)", id, call)
 	if call == nil || call.UniqueID == "" {
 		log.Errorf("active call failed, call is nil or uniqueid is nil,id(%s),call(%+v)", id, call)
-		return fmt.Errorf("active call failed, call is nil or uniqueid is nil,id(%s),call(%+v)", id, call)
+		return nil, fmt.Errorf("active call failed, call is nil or uniqueid is nil,id(%s),call(%+v)", id, call)
 	}
-	if err := d.server.validate.Struct(call); err != nil {
-		log.Errorf("active call failed, invaild call,id(%s),call(%+v), err(%v)", id, call, checkValidatorError(err, call.Action))
-		return fmt.Errorf("active call failed, invaild call,id(%s),call(%+v), err(%v)", id, call, checkValidatorError(err, call.Action))
+	for _, hook := range d.server.callHooks {
+		if err := hook(id, call); err != nil {
+			log.Errorf("active call failed, hook error(%v),id(%s),call(%+v)", err, id, call)
+			return nil, fmt.Errorf("active call failed, hook error(%v),id(%s),call(%+v)", err, id, call)
+		}
+	}
+	subprotocol, err := d.server.subprotocolOf(id, call)
+	if err != nil {
+		log.Errorf("active call failed, %v, call(%+v)", err, call)
+		return nil, fmt.Errorf("active call failed, %v, call(%+v)", err, call)
 	}
-	if _, ok := d.server.ocpp16map.GetTraitAction(call.Action); !ok {
-		log.Errorf("active call failed, not support action(%s) current,id(%s), call(%+v)", call.Action, id, call)
-		return fmt.Errorf("active call failed, not support action(%s) current,id(%s), call(%+v)", call.Action, id, call)
+	if err := subprotocol.validate.Struct(call); err != nil {
+		d.server.metrics.validationFailure(directionOut, checkValidatorError(err, call.Action))
+		log.Errorf("active call failed, invaild call,id(%s),call(%+v), err(%v)", id, call, checkValidatorError(err, call.Action))
+		return nil, fmt.Errorf("active call failed, invaild call,id(%s),call(%+v), err(%v)", id, call, checkValidatorError(err, call.Action))
 	}
 	req := call.SpecificRequest()
-	if err := d.server.validate.Struct(req); err != nil {
+	if err := subprotocol.validate.Struct(req); err != nil {
+		d.server.metrics.validationFailure(directionOut, checkValidatorError(err, call.Action))
 		log.Errorf("active call failed, validate  payload error(%v),id(%s),call(%+v)", checkValidatorError(err, call.Action), id, call)
-		return fmt.Errorf("active call failed, validate  payload error(%v),id(%s),call(%+v)", checkValidatorError(err, call.Action), id, call)
+		return nil, fmt.Errorf("active call failed, validate  payload error(%v),id(%s),call(%+v)", checkValidatorError(err, call.Action), id, call)
+	}
+	if e := subprotocol.checkSchemaOf(call.Action, true, req); e != nil {
+		d.server.metrics.validationFailure(directionOut, e)
+		log.Errorf("active call failed, validate payload schema error(%v),id(%s),call(%+v)", e, id, call)
+		return nil, fmt.Errorf("active call failed, validate payload schema error(%v),id(%s),call(%+v)", e, id, call)
 	}
-	if err := d.requestQueueMap.pushRequset(id, &request{call: call, reqTime: time.Now().Format(time.RFC3339)}); err != nil {
+	if !d.requestQueueMap.queueExists(id) && d.server.ownsSession(id) {
+		if !replay {
+			return nil, fmt.Errorf("active call failed, %w, id(%s),call(%+v)", ErrNotConnected, id, call)
+		}
+		//the server has restarted and the charging point has not reconnected yet, the call is replayed when it connects
+		if err := d.server.storeCall(id, call); err != nil {
+			log.Errorf("active call failed, store call error(%v),id(%s),call(%+v)", err, id, call)
+			return nil, fmt.Errorf("active call failed, store call error(%v),id(%s),call(%+v)", err, id, call)
+		}
+		return nil, nil
+	}
+	//store the call before it can be dispatched, otherwise the reply may delete it before it is stored
+	if err := d.server.storeCall(id, call); err != nil {
+		log.Errorf("store call error(%v),id(%s),call(%+v)", err, id, call)
+	}
+	r := &request{call: call, reqTime: time.Now().Format(time.RFC3339)}
+	if err := d.requestQueueMap.pushRequset(id, r); err != nil {
+		d.server.deleteStoredCall(id, call.UniqueID)
 		log.Error(err)
-		return err
+		return nil, err
 	}
 	d.requestC <- id
-	return nil
+	return r, nil
+}
+
+//call appends the request like appendRequest, then waits for the CallResult or CallError of the charging point.
+//if ctx is done first, the request is dropped unless it has been sent already. the call is not stored for a charging
+//point that has not reconnected yet, nothing would bound the wait for it
+func (d *dispatcher) call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	if call == nil || call.UniqueID == "" {
+		log.Errorf("active call failed, call is nil or uniqueid is nil,id(%s),call(%+v)", id, call)
+		return nil, nil, fmt.Errorf("active call failed, call is nil or uniqueid is nil,id(%s),call(%+v)", id, call)
+	}
+	replyC, err := d.callWaiterMap.addWaiter(id, call.UniqueID)
+	if err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+	r, err := d.enqueue(ctx, id, call, false)
+	if err != nil {
+		d.callWaiterMap.deleteWaiter(id, call.UniqueID)
+		return nil, nil, err
+	}
+	select {
+	case reply := <-replyC:
+		return reply.response, reply.callError, reply.err
+	case <-ctx.Done():
+		d.callWaiterMap.deleteWaiter(id, call.UniqueID)
+		if r != nil {
+			atomic.StoreInt32(&r.canceled, 1)
+		}
+		d.server.deleteStoredCall(id, call.UniqueID)
+		return nil, nil, fmt.Errorf("active call canceled, id(%s), uniqueid(%s), err(%w)", id, call.UniqueID, ctx.Err())
+	}
 }
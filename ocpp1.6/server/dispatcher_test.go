@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"ocpp16/config"
+	"ocpp16/protocol"
+	"ocpp16/session"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const testName = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+//TestMain sets the logger and the configuration once, the goroutines of the servers of a test outlive it
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "ocpp16-server-logs-")
+	if err != nil {
+		panic(err)
+	}
+	SetLogger(initLogger(dir))
+	config.GCONF.HeartbeatTimeout = 30
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+//newTestServer returns a server answering within responseTimeout seconds, served on the ws path /ocpp/:name/:id
+func newTestServer(t *testing.T, responseTimeout int, options ...func(*Server)) (*Server, *httptest.Server) {
+	gin.SetMode(gin.TestMode)
+	s := defaultServer(false, responseTimeout)
+	for _, option := range options {
+		option(s)
+	}
+	s.ginServer.GET("/ocpp/:name/:id", s.wsHandler)
+	ts := httptest.NewServer(s.ginServer)
+	t.Cleanup(ts.Close)
+	return s, ts
+}
+
+//dial connects the charging point CP001 to the server, it returns its id on the server
+func dial(t *testing.T, s *Server, ts *httptest.Server) (string, *websocket.Conn) {
+	dialer := websocket.Dialer{Subprotocols: []string{OCPP16}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ocpp/"+testName+"/CP001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	id := testName + "-CP001"
+	for i := 0; !s.connExists(id); i++ {
+		if i == 100 {
+			t.Fatal("charging point not registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return id, conn
+}
+
+//connect returns a server answering within responseTimeout seconds and the connection of a charging point to it
+func connect(t *testing.T, responseTimeout int) (*Server, string, *websocket.Conn) {
+	s, ts := newTestServer(t, responseTimeout)
+	id, conn := dial(t, s, ts)
+	return s, id, conn
+}
+
+func reset(uniqueid string) *protocol.Call {
+	return &protocol.Call{MessageTypeID: protocol.CALL, UniqueID: uniqueid, Action: protocol.ResetName, Request: &protocol.ResetRequest{Type: "Soft"}}
+}
+
+//receive reads the next call sent to the charging point, its uniqueid
+func receive(t *testing.T, conn *websocket.Conn) string {
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	_, message, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields []json.RawMessage
+	var uniqueid string
+	if err = json.Unmarshal(message, &fields); err != nil || len(fields) != 4 {
+		t.Fatalf("unexpected call %s", message)
+	}
+	json.Unmarshal(fields[1], &uniqueid)
+	return uniqueid
+}
+
+type result struct {
+	res       protocol.Response
+	callError *protocol.CallError
+	err       error
+}
+
+func TestCallWaiters(t *testing.T) {
+	m := newCallWaiterMap()
+	replyC, _ := m.addWaiter("CP001", "1")
+	if _, err := m.addWaiter("CP001", "1"); err == nil {
+		t.Fatal("duplicate uniqueid accepted")
+	}
+	otherC, _ := m.addWaiter("CP001", "2")
+	m.notifyWaiter("CP001", "1", &callReply{response: &protocol.ResetResponse{Status: "Accepted"}})
+	m.notifyWaiter("CP001", "1", &callReply{}) //no waiter left, dropped
+	if reply := <-replyC; reply.response == nil || len(replyC) != 0 {
+		t.Fatalf("unexpected reply %+v", reply)
+	}
+	m.cancelWaiters("CP001", ErrConnClosed)
+	if reply := <-otherC; !errors.Is(reply.err, ErrConnClosed) {
+		t.Fatalf("unexpected reply %+v", reply)
+	}
+	if len(m.waiters) != 0 {
+		t.Fatalf("waiters left %+v", m.waiters)
+	}
+}
+
+func call(ctx context.Context, s *Server, id string, c *protocol.Call) chan result {
+	resultC := make(chan result, 1)
+	go func() {
+		res, callError, err := s.Call(ctx, id, c)
+		resultC <- result{res, callError, err}
+	}()
+	return resultC
+}
+
+func TestCallResult(t *testing.T) {
+	s, id, conn := connect(t, 3)
+	resultC := call(context.Background(), s, id, reset("1"))
+	if uniqueid := receive(t, conn); uniqueid != "1" {
+		t.Fatalf("unexpected uniqueid %s", uniqueid)
+	}
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"1",{"status":"Accepted"}]`))
+	r := <-resultC
+	if res, ok := r.res.(*protocol.ResetResponse); !ok || res.Status != "Accepted" || r.callError != nil || r.err != nil {
+		t.Fatalf("unexpected result %+v", r)
+	}
+}
+
+func TestCallError(t *testing.T) {
+	s, id, conn := connect(t, 3)
+	resultC := call(context.Background(), s, id, reset("1"))
+	receive(t, conn)
+	conn.WriteMessage(websocket.TextMessage, []byte(`[4,"1","NotSupported","soft reset not supported",{}]`))
+	r := <-resultC
+	if r.callError == nil || r.callError.ErrorCode != protocol.NotSupported || r.callError.ErrorDescription != "soft reset not supported" || r.res != nil || r.err != nil {
+		t.Fatalf("unexpected result %+v %+v", r, r.callError)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	s, id, conn := connect(t, 1)
+	resultC := call(context.Background(), s, id, reset("1"))
+	receive(t, conn)
+	if r := <-resultC; !errors.Is(r.err, ErrResponseTimeout) {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	s.dispatcher.callWaiterMap.Lock()
+	defer s.dispatcher.callWaiterMap.Unlock()
+	if len(s.dispatcher.callWaiterMap.waiters) != 0 {
+		t.Fatal("waiter left after the timeout")
+	}
+}
+
+//a call canceled while queued behind another one is never sent
+func TestCallCanceled(t *testing.T) {
+	s, id, conn := connect(t, 3)
+	firstC := call(context.Background(), s, id, reset("1"))
+	receive(t, conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	secondC := call(ctx, s, id, reset("2"))
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	if r := <-secondC; !errors.Is(r.err, context.Canceled) {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	thirdC := call(context.Background(), s, id, reset("3"))
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"1",{"status":"Accepted"}]`))
+	if r := <-firstC; r.err != nil {
+		t.Fatal(r.err)
+	}
+	if uniqueid := receive(t, conn); uniqueid != "3" {
+		t.Fatalf("call %s sent after the cancellation", uniqueid)
+	}
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"3",{"status":"Accepted"}]`))
+	if r := <-thirdC; r.err != nil {
+		t.Fatal(r.err)
+	}
+}
+
+func TestCallDisconnected(t *testing.T) {
+	s, id, conn := connect(t, 3)
+	resultC := call(context.Background(), s, id, reset("1"))
+	receive(t, conn)
+	conn.Close()
+	select {
+	case r := <-resultC:
+		if !errors.Is(r.err, ErrConnClosed) {
+			t.Fatalf("unexpected result %+v", r)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("waiter not released on the disconnection")
+	}
+}
+
+//a call to a charging point this node owns but that has not reconnected yet is stored to be replayed, unless the
+//caller waits for its reply
+func TestCallNotConnected(t *testing.T) {
+	store := session.NewMemoryStore()
+	s, _ := newTestServer(t, 3, func(s *Server) { s.SetSessionStore(store, "node") })
+	store.SetOwner("CP009", "node")
+	if _, _, err := s.Call(context.Background(), "CP009", reset("1")); !errors.Is(err, ErrNotConnected) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := s.HandleActiveCall(context.Background(), "CP009", reset("2")); err != nil {
+		t.Fatal(err)
+	}
+	if calls, _ := store.Calls("CP009"); len(calls) != 1 || calls[0].UniqueID != "2" {
+		t.Fatalf("unexpected stored calls %+v", calls)
+	}
+}
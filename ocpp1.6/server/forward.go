@@ -0,0 +1,260 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"ocpp16/protocol"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//forwardPath is registered on every node that has a node address, the calls posted to it must be signed with the
+//forward secret shared by the nodes
+const forwardPath = "/ocpp16/forward"
+
+const (
+	forwardSignatureHeader = "X-OCPP-Forward-Signature"
+	forwardTimestampHeader = "X-OCPP-Forward-Timestamp"
+	//forwardMaxSkew is how old a forwarded call may be, a captured request can not be replayed later
+	forwardMaxSkew = 5 * time.Minute
+)
+
+var ErrForwardSecret = errors.New("forward secret is empty, the nodes can not authenticate the forwarded calls")
+
+//Forwarder sends an active call to the node that holds the connection of the charging point
+type Forwarder interface {
+	Forward(ctx context.Context, node string, id string, call *protocol.Call, wait bool) (protocol.Response, *protocol.CallError, error)
+}
+
+type forwardReply struct {
+	Response  json.RawMessage `json:"response,omitempty"`
+	CallError json.RawMessage `json:"callError,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+//httpForwarder posts the calls to the ws service of the owner node, over https when it has a tls configuration.
+//the calls are signed but without tls their payloads, id tags included, cross the network in clear text
+type httpForwarder struct {
+	server *Server
+	client *http.Client
+	scheme string
+}
+
+func newHTTPForwarder(s *Server, tlsConf *tls.Config) *httpForwarder {
+	f := &httpForwarder{
+		server: s,
+		client: &http.Client{},
+		scheme: "http",
+	}
+	if tlsConf != nil {
+		f.client.Transport = &http.Transport{TLSClientConfig: tlsConf}
+		f.scheme = "https"
+	}
+	return f
+}
+
+func (f *httpForwarder) Forward(ctx context.Context, node string, id string, call *protocol.Call, wait bool) (protocol.Response, *protocol.CallError, error) {
+	body, err := json.Marshal(call)
+	if err != nil {
+		return nil, nil, err
+	}
+	subprotocol, err := f.server.subprotocolOf(id, call)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := url.Values{"subprotocol": []string{subprotocol.name}}
+	if wait {
+		query.Set("wait", "1")
+	}
+	u := fmt.Sprintf("%s://%s%s/%s?%s", f.scheme, node, forwardPath, url.PathEscape(id), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(forwardTimestampHeader, timestamp)
+	req.Header.Set(forwardSignatureHeader, signForward(f.server.forwardSecret, timestamp, req.URL.RequestURI(), body))
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("forward call to node(%s) failed, id(%s), uniqueid(%s), err(%v)", node, id, call.UniqueID, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var reply forwardReply
+	if err = json.Unmarshal(data, &reply); err != nil {
+		return nil, nil, fmt.Errorf("invalid forward reply from node(%s), status(%d), body(%s)", node, resp.StatusCode, String(data))
+	}
+	if reply.Error != "" {
+		return nil, nil, fmt.Errorf("node(%s): %s", node, reply.Error)
+	}
+	if len(reply.CallError) > 0 {
+		callError, err := unmarshalCallError(reply.CallError)
+		return nil, callError, err
+	}
+	if len(reply.Response) > 0 {
+		res, err := subprotocol.newResponse(call.Action, reply.Response)
+		return res, nil, err
+	}
+	return nil, nil, nil
+}
+
+//signForward returns the hex hmac-sha256 of timestamp, the request uri and the body, the uri holds the charging point
+//id and whether the caller waits for the reply
+func signForward(secret string, timestamp string, uri string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(uri))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+//verifyForward checks the signature and the age of a forwarded call
+func (s *Server) verifyForward(r *http.Request, body []byte) error {
+	timestamp := r.Header.Get(forwardTimestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid forward timestamp(%s)", timestamp)
+	}
+	if skew := time.Since(time.Unix(seconds, 0)); skew > forwardMaxSkew || skew < -forwardMaxSkew {
+		return fmt.Errorf("forward timestamp(%s) out of range", timestamp)
+	}
+	expected := signForward(s.forwardSecret, timestamp, r.URL.RequestURI(), body)
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get(forwardSignatureHeader))) {
+		return errors.New("invalid forward signature")
+	}
+	return nil
+}
+
+//forwardHandler receives the calls forwarded by other nodes, they are never forwarded again
+func (s *Server) forwardHandler(c *gin.Context) {
+	id := c.Param("id")
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, forwardReply{Error: err.Error()})
+		return
+	}
+	if err = s.verifyForward(c.Request, data); err != nil {
+		log.Errorf("forwarded call rejected, id(%s), remote(%s), err(%v)", id, c.ClientIP(), err)
+		c.JSON(http.StatusUnauthorized, forwardReply{Error: err.Error()})
+		return
+	}
+	subprotocol, ok := s.subprotocols[c.DefaultQuery("subprotocol", OCPP16)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, forwardReply{Error: fmt.Sprintf("not support subprotocol(%s) current", c.Query("subprotocol"))})
+		return
+	}
+	call, err := unmarshalCall(subprotocol, data)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, forwardReply{Error: err.Error()})
+		return
+	}
+	log.Debugf("forwarded call, id(%s), call(%+v)", id, call)
+	if c.Query("wait") != "1" {
+		if err = s.dispatcher.appendRequest(c.Request.Context(), id, call); err != nil {
+			c.JSON(http.StatusOK, forwardReply{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, forwardReply{})
+		return
+	}
+	res, callError, err := s.dispatcher.call(c.Request.Context(), id, call)
+	var reply forwardReply
+	switch {
+	case err != nil:
+		reply.Error = err.Error()
+	case callError != nil:
+		reply.CallError, _ = json.Marshal(callError)
+	case res != nil:
+		reply.Response, _ = json.Marshal(res)
+	}
+	c.JSON(http.StatusOK, reply)
+}
+
+//remoteOwner returns the node holding the connection of id when it is not this node
+func (s *Server) remoteOwner(id string) (string, bool) {
+	if s.node == "" || s.connExists(id) {
+		return "", false
+	}
+	node, ok, err := s.sessionStore.Owner(id)
+	if err != nil {
+		log.Errorf("get owner of id(%s) from session store error(%v)", id, err)
+		return "", false
+	}
+	if !ok || node == s.node {
+		return "", false
+	}
+	return node, true
+}
+
+func unmarshalCall(subprotocol *subprotocol, data []byte) (*protocol.Call, error) {
+	fields, err := parseMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("invalid num of call fields(%+v),exptect 4 fields", fields)
+	}
+	uniqueid, ok := fields[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid uniqueid(%v), must be string", fields[1])
+	}
+	action, ok := fields[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid call action(%v), must be string", fields[2])
+	}
+	payload, err := json.Marshal(fields[3])
+	if err != nil {
+		return nil, err
+	}
+	req, err := subprotocol.newRequest(action, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        action,
+		Request:       req,
+	}, nil
+}
+
+func unmarshalCallError(data []byte) (*protocol.CallError, error) {
+	fields, err := parseMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid num of callError fields(%+v),exptect 5 fields", fields)
+	}
+	uniqueid, _ := fields[1].(string)
+	errCode, _ := fields[2].(string)
+	errorDescription, _ := fields[3].(string)
+	callError := &protocol.CallError{
+		MessageTypeID:    protocol.CALL_ERROR,
+		UniqueID:         uniqueid,
+		ErrorCode:        protocol.ErrCodeType(errCode),
+		ErrorDescription: errorDescription,
+	}
+	if err = callError.UnmarshalErrorDetails(fields[4]); err != nil {
+		return nil, err
+	}
+	return callError, nil
+}
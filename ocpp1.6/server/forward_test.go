@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	"ocpp16/session"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestForward(t *testing.T) {
+	store := session.NewMemoryStore()
+	cluster := func(node string, secret string) func(*Server) {
+		return func(s *Server) {
+			s.SetSessionStore(store, node)
+			s.SetForwardSecret(secret)
+		}
+	}
+	owner, ts := newTestServer(t, 3, cluster("owner", "secret"))
+	id, conn := dial(t, owner, ts)
+	store.SetOwner(id, strings.TrimPrefix(ts.URL, "http://"))
+
+	other, _ := newTestServer(t, 3, cluster("other", "secret"))
+	resultC := call(context.Background(), other, id, reset("1"))
+	receive(t, conn)
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"1",{"status":"Accepted"}]`))
+	if r := <-resultC; r.err != nil || r.res.(*protocol.ResetResponse).Status != "Accepted" {
+		t.Fatalf("unexpected result %+v", r)
+	}
+
+	//a node with another secret, or a client without any, is refused
+	intruder, _ := newTestServer(t, 3, cluster("intruder", "guess"))
+	if _, _, err := intruder.Call(context.Background(), id, reset("2")); err == nil || !strings.Contains(err.Error(), "invalid forward signature") {
+		t.Fatalf("forward with another secret got %v", err)
+	}
+	w := httptest.NewRecorder()
+	owner.ginServer.ServeHTTP(w, httptest.NewRequest(http.MethodPost, forwardPath+"/"+id, strings.NewReader(`[2,"3","Reset",{"type":"Soft"}]`)))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("unsigned forward got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestForwardTLS(t *testing.T) {
+	store := session.NewMemoryStore()
+	owner, _ := newTestServer(t, 3, func(s *Server) {
+		s.SetSessionStore(store, "owner")
+		s.SetForwardSecret("secret")
+	})
+	tlsServer := httptest.NewTLSServer(owner.ginServer)
+	defer tlsServer.Close()
+	dialer := websocket.Dialer{Subprotocols: []string{OCPP16}, TLSClientConfig: tlsServer.Client().Transport.(*http.Transport).TLSClientConfig}
+	conn, _, err := dialer.Dial("wss"+strings.TrimPrefix(tlsServer.URL, "https")+"/ocpp/"+testName+"/CP001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	id := testName + "-CP001"
+	store.SetOwner(id, strings.TrimPrefix(tlsServer.URL, "https://"))
+
+	//a node forwarding over http is refused by the tls service
+	plain, _ := newTestServer(t, 3, func(s *Server) {
+		s.SetSessionStore(store, "plain")
+		s.SetForwardSecret("secret")
+	})
+	if _, _, err := plain.Call(context.Background(), id, reset("1")); err == nil {
+		t.Fatal("forwarded over http to a tls node")
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(tlsServer.Certificate())
+	other, _ := newTestServer(t, 3, func(s *Server) {
+		s.SetSessionStore(store, "other")
+		s.SetForwardSecret("secret")
+		s.SetForwardTLS(&tls.Config{RootCAs: pool})
+	})
+	resultC := call(context.Background(), other, id, reset("2"))
+	receive(t, conn)
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"2",{"status":"Accepted"}]`))
+	if r := <-resultC; r.err != nil || r.res.(*protocol.ResetResponse).Status != "Accepted" {
+		t.Fatalf("unexpected result %+v", r)
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const (
+	directionIn  = "in"
+	directionOut = "out"
+)
+
+//serverMetrics are the metrics of the server exposed on /metrics
+type serverMetrics struct {
+	registry           *prometheus.Registry
+	messages           *prometheus.CounterVec
+	latency            *prometheus.HistogramVec
+	timeouts           *prometheus.CounterVec
+	validationFailures *prometheus.CounterVec
+}
+
+func newServerMetrics(s *Server) *serverMetrics {
+	m := &serverMetrics{
+		registry: prometheus.NewRegistry(),
+		messages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ocpp_messages_total",
+			Help: "Messages exchanged with the charging points by direction (in is received), message type and action.",
+		}, []string{"direction", "type", "action"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "ocpp_response_latency_seconds",
+			Help: "Time between sending an active call and receiving its CallResult or CallError.",
+		}, []string{"action"}),
+		timeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ocpp_response_timeouts_total",
+			Help: "Active calls the charging points did not reply to within the response timeout.",
+		}, []string{"action"}),
+		validationFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ocpp_validation_failures_total",
+			Help: "Messages rejected by the struct or schema validation by direction and error code.",
+		}, []string{"direction", "code"}),
+	}
+	m.registry.MustRegister(m.messages, m.latency, m.timeouts, m.validationFailures, &stateCollector{s: s},
+		collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	return m
+}
+
+var (
+	connectedDesc = prometheus.NewDesc("ocpp_connected_charge_points",
+		"Charging points currently connected to this node.", nil, nil)
+	reactorConnectionsDesc = prometheus.NewDesc("ocpp_reactor_connections",
+		"Connections served by each epoll reactor.", []string{"reactor"}, nil)
+	queueDepthDesc = prometheus.NewDesc("ocpp_dispatcher_queue_depth",
+		"Active calls queued for all the charging points, including the ones waiting for their reply.", nil, nil)
+	eventQueueDepthDesc = prometheus.NewDesc("ocpp_event_queue_depth",
+		"Frames waiting to be written to the event sinks.", nil, nil)
+	eventsDroppedDesc = prometheus.NewDesc("ocpp_events_dropped_total",
+		"Frames not written to the event sinks, full is dropped from the queue, failed is a write the sinks failed.", []string{"reason"}, nil)
+)
+
+//stateCollector reads the state of the server when it is scraped
+type stateCollector struct {
+	s *Server
+}
+
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectedDesc
+	ch <- reactorConnectionsDesc
+	ch <- queueDepthDesc
+	ch <- eventQueueDepthDesc
+	ch <- eventsDroppedDesc
+}
+
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.s
+	s.wsconns.RLock()
+	connected := len(s.wsconns.wsmap)
+	s.wsconns.RUnlock()
+	ch <- prometheus.MustNewConstMetric(connectedDesc, prometheus.GaugeValue, float64(connected))
+	if s.loadBalancer != nil {
+		s.loadBalancer.iterate(func(i int, react *reactor) error {
+			ch <- prometheus.MustNewConstMetric(reactorConnectionsDesc, prometheus.GaugeValue, float64(atomic.LoadInt32(&react.connectCount)), strconv.Itoa(i))
+			return nil
+		})
+	}
+	if s.dispatcher != nil {
+		depth := 0
+		m := s.dispatcher.requestQueueMap
+		m.RLock()
+		for _, q := range m.queueMap {
+			depth += q.len()
+		}
+		m.RUnlock()
+		ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(depth))
+	}
+	if s.events != nil {
+		ch <- prometheus.MustNewConstMetric(eventQueueDepthDesc, prometheus.GaugeValue, float64(s.events.Queued()))
+		ch <- prometheus.MustNewConstMetric(eventsDroppedDesc, prometheus.CounterValue, float64(s.events.Dropped()), "full")
+		ch <- prometheus.MustNewConstMetric(eventsDroppedDesc, prometheus.CounterValue, float64(s.events.Failed()), "failed")
+	}
+}
+
+func (m *serverMetrics) message(direction string, typ string, action string) {
+	m.messages.WithLabelValues(direction, typ, action).Inc()
+}
+
+func (m *serverMetrics) validationFailure(direction string, e *Error) {
+	m.validationFailures.WithLabelValues(direction, string(e.ErrorCode)).Inc()
+}
+
+func (m *serverMetrics) timeout(action string) {
+	m.timeouts.WithLabelValues(action).Inc()
+}
+
+//replied observes the latency of the active call the charging point has replied to, reqTime is the time it was sent
+func (m *serverMetrics) replied(req *request) {
+	sent, err := time.Parse(time.RFC3339Nano, req.reqTime)
+	if err != nil {
+		return
+	}
+	m.latency.WithLabelValues(req.call.Action).Observe(time.Since(sent).Seconds())
+}
+
+//Metrics returns the registry served on /metrics, plugins may register their own collectors in it
+func (s *Server) Metrics() *prometheus.Registry {
+	return s.metrics.registry
+}
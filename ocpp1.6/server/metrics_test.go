@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"ocpp16/events"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestMetrics(t *testing.T) {
+	s, ts := newTestServer(t, 3)
+	stream := events.NewStream(events.Options{})
+	defer stream.Close()
+	s.SetEventStream(stream)
+	id, conn := dial(t, s, ts)
+	resultC := call(context.Background(), s, id, reset("1"))
+	receive(t, conn)
+	conn.WriteMessage(websocket.TextMessage, []byte(`[3,"1",{"status":"Accepted"}]`))
+	<-resultC
+	resp, err := http.Get(ts.URL + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	for _, want := range []string{
+		"ocpp_connected_charge_points 1\n",
+		`ocpp_messages_total{action="Reset",direction="out",type="Call"} 1` + "\n",
+		`ocpp_messages_total{action="Reset",direction="in",type="CallResult"} 1` + "\n",
+		`ocpp_response_latency_seconds_count{action="Reset"} 1` + "\n",
+		"ocpp_dispatcher_queue_depth 0\n",
+		"# TYPE ocpp_events_dropped_total counter\n",
+		`ocpp_events_dropped_total{reason="full"} 0` + "\n",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("%q missing from\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocpp16/protocol"
+	"time"
+)
+
+//PassivePlugin is an ActionPlugin notified of the connections of the charging points, the managers wrap it to track
+//the requests it handles
+type PassivePlugin interface {
+	ActionPlugin
+	ChargingPointOnline(id string) error
+	ChargingPointOffline(id string) error
+}
+
+//Caller sends active calls to the charging points, *Server is one
+type Caller interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+//CallErrorReply is the CallError a charging point replied to an active call with
+type CallErrorReply struct {
+	Action           string
+	ErrorCode        protocol.ErrCodeType
+	ErrorDescription string
+}
+
+func (e *CallErrorReply) Error() string {
+	return fmt.Sprintf("%s CallError(%s), %s", e.Action, e.ErrorCode, e.ErrorDescription)
+}
+
+//Send calls the charging point id with req under a new uniqueid and waits for its reply within timeout, a CallError
+//is returned as a *CallErrorReply
+func Send(ctx context.Context, c Caller, id string, req protocol.Request, timeout time.Duration) (protocol.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, callError, err := c.Call(ctx, id, &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      protocol.NewUniqueID(),
+		Action:        req.Action(),
+		Request:       req,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if callError != nil {
+		return nil, &CallErrorReply{Action: req.Action(), ErrorCode: callError.ErrorCode, ErrorDescription: callError.ErrorDescription}
+	}
+	return res, nil
+}
+
+//InternalError is the error a request handler replies for err, an InternalError unless err is an *Error already
+func InternalError(err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return &Error{
+		ErrorCode:        protocol.CallInternalError,
+		ErrorDescription: err.Error(),
+		ErrorDetails:     protocol.ErrorDetails{},
+	}
+}
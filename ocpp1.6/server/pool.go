@@ -26,6 +26,17 @@ var reflectInstance = func(t reflect.Type) interface{} {
 	return argv.Interface()
 }
 
+//clone returns a shallow copy of the object pointed to by x, the copy stays valid after x has been put back into the pool
+var clone = func(x interface{}) interface{} {
+	v := reflect.ValueOf(x)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return x
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	return c.Interface()
+}
+
 type ocppTypePools struct {
 	mu    sync.RWMutex
 	pools map[reflect.Type]*sync.Pool
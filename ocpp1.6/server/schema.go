@@ -0,0 +1,54 @@
+package server
+
+import (
+	"encoding/json"
+	"ocpp16/protocol"
+	"ocpp16/schema"
+)
+
+//SetSchemaValidation enables the validation of the raw payloads of the ocpp1.6 frames against the official json schemas,
+//in addition to the validate tags of the protocol structs. the actions without schema, e.g. the security extension, are not checked
+func (s *Server) SetSchemaValidation(enable bool) {
+	if enable {
+		s.subprotocols[OCPP16].schemas = schema.OCPP16()
+		return
+	}
+	s.subprotocols[OCPP16].schemas = nil
+}
+
+//checkSchema validates the raw payload of a request or a response of action, nil if schema validation is disabled
+func (p *subprotocol) checkSchema(action string, request bool, payload []byte) *Error {
+	if p.schemas == nil {
+		return nil
+	}
+	var err *schema.Error
+	if request {
+		err = p.schemas.ValidateRequest(action, payload)
+	} else {
+		err = p.schemas.ValidateResponse(action, payload)
+	}
+	if err == nil {
+		return nil
+	}
+	return &Error{
+		ErrorCode:        err.Code,
+		ErrorDescription: err.Error(),
+		ErrorDetails:     protocol.ErrorDetails{},
+	}
+}
+
+//checkSchemaOf validates the payload a request or a response built by the server marshals to
+func (p *subprotocol) checkSchemaOf(action string, request bool, v interface{}) *Error {
+	if p.schemas == nil {
+		return nil
+	}
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return &Error{
+			ErrorCode:        protocol.CallInternalError,
+			ErrorDescription: err.Error(),
+			ErrorDetails:     protocol.ErrorDetails{},
+		}
+	}
+	return p.checkSchema(action, request, payload)
+}
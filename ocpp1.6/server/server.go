@@ -2,25 +2,27 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
-	validator "github.com/go-playground/validator/v10"
 	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"net/http"
 	"ocpp16/config"
-	local "ocpp16/plugin/passive/local"
+	"ocpp16/events"
 	"ocpp16/protocol"
-	"reflect"
+	"ocpp16/session"
 	"runtime"
-	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 )
 
-var serverSubprotocols = []string{"ocpp1.6"}
-
 const (
 	defaultReadBufferSize  = 16 * 1024
 	defaultWriteBufferSize = 16 * 1024
@@ -35,22 +37,34 @@ type Server struct {
 	ginServer         *gin.Engine
 	upgrader          websocket.Upgrader
 	wsconns           *wsconns
-	validate          *validator.Validate
-	ocpp16map         *protocol.OCPP16Map
+	subprotocols      map[string]*subprotocol
+	preference        []string
 	dispatcher        *dispatcher
 	loadBalancer      LoadBalancer
 	once              sync.Once
 	cond              *sync.Cond
 	wg                sync.WaitGroup
-	actionPlugin      ActionPlugin
 	connectHandler    []func(ws *Wsconn) error
 	disconnectHandler []func(ws *Wsconn) error
+	node              string
+	sessionStore      session.Store
+	forwarder         Forwarder
+	forwardOnce       sync.Once
+	forwardSecret     string
+	stopping          int32
+	securityProfile   int
+	authenticator     Authenticator
+	clientCAs         *x509.CertPool
+	metrics           *serverMetrics
+	events            *events.Stream
+	callHooks         []func(id string, call *protocol.Call) error
 }
 
 func (s *Server) clientOnConnect(ws *Wsconn) {
 	s.dispatcher.callStateMap.createNewRequest(ws.id)
 	s.dispatcher.requestQueueMap.createNewQueue(ws.id)
 	s.registerConn(ws.id, ws.fd, ws)
+	s.claimSession(ws)
 	if s.connectHandler != nil {
 		for _, handler := range s.connectHandler {
 			go func() {
@@ -66,8 +80,10 @@ func (s *Server) clientOnConnect(ws *Wsconn) {
 func (s *Server) clientOnDisconnect(ws *Wsconn) {
 	s.deleteConn(ws.id, ws.fd)
 	s.deleteDispatcherQueue(ws.id)
+	s.releaseSession(ws.id)
 	s.deleteDispatcherCallState(ws.id)
 	s.cancelContex(ws.id)
+	s.cancelCallWaiters(ws.id)
 	if s.disconnectHandler != nil {
 		for _, handler := range s.disconnectHandler {
 			go func() {
@@ -80,6 +96,11 @@ func (s *Server) clientOnDisconnect(ws *Wsconn) {
 	}
 }
 
+//SetEventStream streams every frame exchanged with the charging points and the timeouts of the active calls, call it before serving
+func (s *Server) SetEventStream(stream *events.Stream) {
+	s.events = stream
+}
+
 func (s *Server) SetConnectHandlers(fns ...func(ws *Wsconn) error) {
 	s.connectHandler = append(s.connectHandler, fns...)
 }
@@ -88,6 +109,12 @@ func (s *Server) SetDisconnetHandlers(fns ...func(ws *Wsconn) error) {
 	s.disconnectHandler = append(s.disconnectHandler, fns...)
 }
 
+//SetCallHooks adds hooks run on every active call of this node before it is validated and queued,
+//they may rewrite its request and an error fails the call
+func (s *Server) SetCallHooks(fns ...func(id string, call *protocol.Call) error) {
+	s.callHooks = append(s.callHooks, fns...)
+}
+
 func (s *Server) cancelContex(id string) {
 	s.dispatcher.cancelContext(id)
 }
@@ -128,15 +155,42 @@ func (s *Server) deleteDispatcherQueue(id string) {
 	s.dispatcher.requestQueueMap.deleteQueue(id)
 }
 
+func (s *Server) cancelCallWaiters(id string) {
+	s.dispatcher.callWaiterMap.cancelWaiters(id, ErrConnClosed)
+}
+
+func (s *Server) callReply(id string, uniqueid string, reply *callReply) {
+	s.dispatcher.callWaiterMap.notifyWaiter(id, uniqueid, reply)
+}
+
 func (s *Server) HandleActiveCall(ctx context.Context, id string, call *protocol.Call) error {
+	if node, ok := s.remoteOwner(id); ok {
+		_, _, err := s.forwarder.Forward(ctx, node, id, call, false)
+		return err
+	}
 	return s.dispatcher.appendRequest(ctx, id, call)
 }
+
+//Call sends the call to the charging point like HandleActiveCall, but blocks until the matching CallResult or CallError arrives,
+//the dispatcher response timeout fires or ctx is done. exactly one of the returned values is non-nil
+func (s *Server) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	if node, ok := s.remoteOwner(id); ok {
+		return s.forwarder.Forward(ctx, node, id, call, true)
+	}
+	return s.dispatcher.call(ctx, id, call)
+}
+
 func (s *Server) RegisterActiveCallHandler(handler ActiveCallHandler, fn func(ActiveCallHandler)) {
 	fn(handler)
 }
 
+func (s *Server) RegisterSyncActiveCallHandler(handler SyncActiveCallHandler, fn func(SyncActiveCallHandler)) {
+	fn(handler)
+}
+
+//RegisterActionPlugin registers the passive plugin of the ocpp1.6 connections
 func (s *Server) RegisterActionPlugin(actionPlugin ActionPlugin) {
-	s.actionPlugin = actionPlugin
+	s.subprotocols[OCPP16].actionPlugin = actionPlugin
 }
 
 func (s *Server) waitStopSignal() {
@@ -145,8 +199,14 @@ func (s *Server) waitStopSignal() {
 	s.cond.L.Unlock()
 }
 
+func (s *Server) isStopping() bool {
+	return atomic.LoadInt32(&s.stopping) == 1
+}
+
 func (s *Server) Stop() {
 	conf := config.GCONF
+	atomic.StoreInt32(&s.stopping, 1)
+	defer s.sessionStore.Close()
 	s.dispatcher.stop(errors.New("stop dispatcher"))
 	if conf.UseEpoll {
 		s.waitStopSignal()
@@ -168,18 +228,23 @@ var defaultServer = func(useEpoll bool, responseTimeout int) *Server {
 			ReadBufferSize:  defaultReadBufferSize,
 			WriteBufferSize: defaultWriteBufferSize},
 		wsconns:      newWsconns(),
-		validate:     protocol.Validate,
-		ocpp16map:    protocol.OCPP16M,
+		subprotocols: defaultSubprotocols(),
+		preference:   defaultPreference,
 		wg:           sync.WaitGroup{},
-		actionPlugin: local.NewActionPlugin(), //default action plugin
+		sessionStore: session.NewMemoryStore(),
 	}
+	s.forwarder = newHTTPForwarder(s, nil)
 	pprof.Register(s.ginServer)
+	s.metrics = newServerMetrics(s)
+	s.ginServer.GET("/metrics", gin.WrapH(promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{})))
 	if responseTimeout > 0 {
 		s.setDispatcher(DispatcherWithTimeout(s, responseTimeout))
 	} else {
 		s.setDispatcher(DefaultDispatcher(s))
 	}
-	s.initOCPPTypePools(s.ocpp16map.SupportActions())
+	for _, p := range s.subprotocols {
+		s.initOCPPTypePools(p.traitMap)
+	}
 	if useEpoll {
 		var epoller *epoller
 		var err error
@@ -221,13 +286,74 @@ var defaultServer = func(useEpoll bool, responseTimeout int) *Server {
 }
 
 func NewDefaultServer() *Server {
-	useEpoll, responseTimeout := config.GCONF.UseEpoll, config.GCONF.ResponseTimeout
-	return defaultServer(useEpoll, responseTimeout)
+	conf := config.GCONF
+	useEpoll, responseTimeout := conf.UseEpoll, conf.ResponseTimeout
+	s := defaultServer(useEpoll, responseTimeout)
+	if len(conf.Subprotocols) > 0 {
+		if err := s.SetSubprotocols(conf.Subprotocols...); err != nil {
+			panic(err)
+		}
+	}
+	s.SetSchemaValidation(conf.SchemaValidation)
+	if err := s.SetSecurityProfile(conf.SecurityProfile); err != nil {
+		panic(err)
+	}
+	if conf.AuthFile != "" {
+		authenticator, err := NewFileAuthenticator(conf.AuthFile)
+		if err != nil {
+			panic(err)
+		}
+		s.SetAuthenticator(authenticator)
+	}
+	if conf.TLSClientCA != "" {
+		pool, err := LoadClientCAs(conf.TLSClientCA)
+		if err != nil {
+			panic(err)
+		}
+		s.SetClientCAs(pool)
+	}
+	if err := s.checkSecurity(); err != nil {
+		panic(err)
+	}
+	if conf.NodeAddr != "" && conf.ForwardSecret == "" {
+		panic(ErrForwardSecret)
+	}
+	s.SetForwardSecret(conf.ForwardSecret)
+	switch conf.SessionStore {
+	case "", "memory":
+		s.SetSessionStore(s.sessionStore, conf.NodeAddr)
+	case "file":
+		store, err := session.NewFileStore(conf.SessionStorePath)
+		if err != nil {
+			panic(err)
+		}
+		s.SetSessionStore(store, conf.NodeAddr)
+	case "etcd":
+		store, err := session.NewEtcdStore(clientv3.Config{Endpoints: conf.ETCDList}, conf.ETCDBasePath+"/session", time.Duration(conf.SessionStoreTTL)*time.Second)
+		if err != nil {
+			panic(err)
+		}
+		s.SetSessionStore(store, conf.NodeAddr)
+	default:
+		panic(fmt.Sprintf("not support session store(%s) current", conf.SessionStore))
+	}
+	if conf.ForwardTLS {
+		tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+		if conf.ForwardCA != "" {
+			pool, err := LoadClientCAs(conf.ForwardCA)
+			if err != nil {
+				panic(err)
+			}
+			tlsConf.RootCAs = pool
+		}
+		s.SetForwardTLS(tlsConf)
+	}
+	return s
 }
 
-func (s *Server) initOCPPTypePools(actions []string) {
-	for _, action := range actions {
-		if ocpptrait, ok := s.ocpp16map.GetTraitAction(action); ok {
+func (s *Server) initOCPPTypePools(traitMap TraitMap) {
+	for _, action := range traitMap.SupportActions() {
+		if ocpptrait, ok := traitMap.GetTraitAction(action); ok {
 			reqTyp, resTyp := ocpptrait.RequestType(), ocpptrait.ResponseType()
 			options.object.init(reqTyp)
 			options.object.init(resTyp)
@@ -249,24 +375,36 @@ func (s *Server) Serve(addr string, path string) {
 }
 
 func (s *Server) ServeTLS(addr string, path string, tlsCertificate string, tlsCertificateKey string) {
+	if err := s.checkSecurity(); err != nil {
+		log.Errorf("serve tls error, addr(%s), err:(%v)", addr, err)
+		return
+	}
 	s.ginServer.GET(path, s.wsHandler)
-	s.ginServer.RunTLS(addr, tlsCertificate, tlsCertificateKey)
+	tlsConfig := s.tlsConfig()
+	if tlsConfig == nil {
+		s.ginServer.RunTLS(addr, tlsCertificate, tlsCertificateKey)
+		return
+	}
+	httpServer := &http.Server{
+		Addr:      addr,
+		Handler:   s.ginServer,
+		TLSConfig: tlsConfig,
+	}
+	if err := httpServer.ListenAndServeTLS(tlsCertificate, tlsCertificateKey); err != nil {
+		log.Errorf("serve tls error, addr(%s), err:(%v)", addr, err)
+	}
 }
 
 func (s *Server) wsHandler(c *gin.Context) {
 	conf := config.GCONF
 	var p point
 	c.ShouldBindUri(&p)
-	var ocppProto string
-	clientSubprotocols := websocket.Subprotocols(c.Request)
-	for _, cproto := range clientSubprotocols {
-		for _, sproto := range serverSubprotocols {
-			if strings.EqualFold(cproto, sproto) {
-				ocppProto = cproto
-				break
-			}
-		}
+	if err := s.authenticate(c.Request, &p); err != nil {
+		s.reject(c, &p, err)
+		return
 	}
+	clientSubprotocols := websocket.Subprotocols(c.Request)
+	ocppProto, subprotocol := s.negotiate(clientSubprotocols)
 	respHeader := http.Header{}
 	if ocppProto != "" {
 		respHeader.Add("Sec-WebSocket-Protocol", ocppProto)
@@ -299,13 +437,15 @@ func (s *Server) wsHandler(c *gin.Context) {
 	}
 	// _ = unix.SetNonblock(fd, true)
 	ws := &Wsconn{
-		server:  s,
-		conn:    conn,
-		id:      p.String(),
-		fd:      fd,
-		timeout: timeoutDuration,
-		ping:    make(chan []byte),
-		closeC:  make(chan error, 1),
+		server:      s,
+		conn:        conn,
+		id:          p.String(),
+		fd:          fd,
+		timeout:     timeoutDuration,
+		ping:        make(chan []byte),
+		closeC:      make(chan error, 1),
+		subprotocol: subprotocol,
+		connectedAt: time.Now(),
 	}
 	ws.setReadDeadTimeout(ws.timeout)
 	ws.conn.SetPingHandler(func(appData string) error {
@@ -326,10 +466,23 @@ func (s *Server) wsHandler(c *gin.Context) {
 	}
 }
 
+//websocketFD2 returns the file descriptor of the tcp connection under conn, a wss connection included
 func websocketFD2(conn *websocket.Conn) int {
-	connVal := reflect.Indirect(reflect.ValueOf(conn)).FieldByName("conn").Elem()
-	tcpConn := reflect.Indirect(connVal).FieldByName("conn")
-	fdVal := tcpConn.FieldByName("fd")
-	pfdVal := reflect.Indirect(fdVal).FieldByName("pfd")
-	return int(pfdVal.FieldByName("Sysfd").Int())
+	netConn := conn.UnderlyingConn()
+	if tlsConn, ok := netConn.(*tls.Conn); ok {
+		netConn = tlsConn.NetConn()
+	}
+	sc, ok := netConn.(syscall.Conn)
+	if !ok {
+		return -1
+	}
+	raw, err := sc.SyscallConn()
+	if err != nil {
+		return -1
+	}
+	fd := -1
+	raw.Control(func(sysfd uintptr) {
+		fd = int(sysfd)
+	})
+	return fd
 }
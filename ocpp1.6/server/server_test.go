@@ -1,7 +1,6 @@
 package server
 
 import (
-	"ocpp16/config"
 	"ocpp16/logwriter"
 	"sync"
 	"testing"
@@ -10,9 +9,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func initLogger() *logrus.Logger {
+//initLogger logs to dir, a temporary directory of the test
+func initLogger(dir string) *logrus.Logger {
 	lw := &logwriter.HourlySplit{
-		Dir:           "logs",
+		Dir:           dir,
 		FileFormat:    "log_2006-01-02T15",
 		MaxFileNumber: 10,
 		MaxDiskUsage:  20480000,
@@ -35,9 +35,6 @@ func initLogger() *logrus.Logger {
 }
 
 func TestServer(t *testing.T) {
-	config.GCONF = config.GConf{
-		HeartbeatTimeout: 30,
-	}
 	// wsEnable, wssEnable := false, true
 	wsEnable, wssEnable := true, false
 	waitGroup := &sync.WaitGroup{}
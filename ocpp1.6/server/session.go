@@ -0,0 +1,126 @@
+package server
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"ocpp16/protocol"
+	"ocpp16/session"
+	"time"
+)
+
+//SetSessionStore replaces the default in-memory store, node is the address other nodes use to forward
+//active calls to this node, an empty node disables ownership and forwarding. forwarding needs the secret shared
+//by the nodes, see SetForwardSecret
+func (s *Server) SetSessionStore(store session.Store, node string) {
+	s.sessionStore = store
+	s.node = node
+	if node != "" {
+		s.forwardOnce.Do(func() {
+			s.ginServer.POST(forwardPath+"/:id", s.forwardHandler)
+		})
+	}
+}
+
+//SetForwardSecret sets the key signing the active calls forwarded between the nodes, the calls received without its
+//signature are rejected
+func (s *Server) SetForwardSecret(secret string) {
+	s.forwardSecret = secret
+}
+
+//SetForwardTLS forwards the active calls over https to the nodes, whose node address is then the one of their wss
+//service, verified with conf. without it the calls go over http: they are signed, but readable on the network
+func (s *Server) SetForwardTLS(conf *tls.Config) {
+	s.forwarder = newHTTPForwarder(s, conf)
+}
+
+func (s *Server) SetForwarder(forwarder Forwarder) {
+	s.forwarder = forwarder
+}
+
+//claimSession records this node as the owner of id and replays the calls that were queued before a restart
+func (s *Server) claimSession(ws *Wsconn) {
+	id := ws.id
+	if s.node != "" {
+		if err := s.sessionStore.SetOwner(id, s.node); err != nil {
+			log.Errorf("set owner of id(%s) to node(%s) error(%v)", id, s.node, err)
+		}
+	}
+	calls, err := s.sessionStore.Calls(id)
+	if err != nil {
+		log.Errorf("get stored calls error, id(%s), err(%v)", id, err)
+		return
+	}
+	for _, stored := range calls {
+		if stored.Subprotocol != "" && stored.Subprotocol != ws.subprotocol.name {
+			log.Errorf("drop stored call of subprotocol(%s), id(%s) reconnected with subprotocol(%s), call(%+v)", stored.Subprotocol, id, ws.subprotocol.name, stored)
+			s.deleteStoredCall(id, stored.UniqueID)
+			continue
+		}
+		req, err := ws.subprotocol.newRequest(stored.Action, stored.Payload)
+		if err != nil {
+			log.Errorf("drop stored call, id(%s), call(%+v), err(%v)", id, stored, err)
+			s.deleteStoredCall(id, stored.UniqueID)
+			continue
+		}
+		call := &protocol.Call{
+			MessageTypeID: protocol.CALL,
+			UniqueID:      stored.UniqueID,
+			Action:        stored.Action,
+			Request:       req,
+		}
+		log.Debugf("replay stored call, id(%s), call(%+v), queuedAt(%s)", id, call, stored.QueuedAt)
+		if err = s.dispatcher.requestQueueMap.pushRequset(id, &request{call: call, reqTime: time.Now().Format(time.RFC3339)}); err != nil {
+			log.Error(err)
+			return
+		}
+		s.dispatcher.requestC <- id
+	}
+}
+
+//releaseSession behaves like the in-process queue: a closed connection drops its queued calls.
+//while the server is stopping nothing is released, so the calls are replayed after the restart
+func (s *Server) releaseSession(id string) {
+	if s.isStopping() {
+		return
+	}
+	if err := s.sessionStore.DeleteCalls(id); err != nil {
+		log.Errorf("delete stored calls error, id(%s), err(%v)", id, err)
+	}
+	if s.node != "" {
+		if err := s.sessionStore.DeleteOwner(id, s.node); err != nil {
+			log.Errorf("delete owner of id(%s) error(%v), node(%s)", id, err, s.node)
+		}
+	}
+}
+
+//ownsSession reports whether id belongs to this node although it is not connected yet, which happens after a restart
+func (s *Server) ownsSession(id string) bool {
+	if s.node == "" {
+		return false
+	}
+	node, ok, err := s.sessionStore.Owner(id)
+	return err == nil && ok && node == s.node
+}
+
+func (s *Server) storeCall(id string, call *protocol.Call) error {
+	payload, err := json.Marshal(call.Request)
+	if err != nil {
+		return err
+	}
+	stored := &session.Call{
+		UniqueID: call.UniqueID,
+		Action:   call.Action,
+		Payload:  payload,
+		QueuedAt: time.Now().Format(time.RFC3339),
+	}
+	if subprotocol, err := s.subprotocolOf(id, call); err == nil {
+		stored.Subprotocol = subprotocol.name
+	}
+	return s.sessionStore.PushCall(id, stored)
+}
+
+func (s *Server) deleteStoredCall(id string, uniqueid string) {
+	if err := s.sessionStore.DeleteCall(id, uniqueid); err != nil {
+		log.Errorf("delete stored call error, id(%s), uniqueid(%s), err(%v)", id, uniqueid, err)
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/ocpp201"
+	"ocpp16/protocol"
+	"ocpp16/schema"
+	"reflect"
+	"strings"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+const (
+	OCPP16  = "ocpp1.6"
+	OCPP201 = "ocpp2.0.1"
+)
+
+//TraitMap is implemented by protocol.OCPP16Map and ocpp201.OCPP201Map
+type TraitMap interface {
+	SupportActions() []string
+	GetTraitAction(action string) (protocol.Trait, bool)
+}
+
+//subprotocol is everything a connection needs to handle the messages of the ocpp version it negotiated
+type subprotocol struct {
+	name         string
+	traitMap     TraitMap
+	validate     *validator.Validate
+	actionPlugin ActionPlugin
+	errorCode    func(protocol.ErrCodeType) protocol.ErrCodeType //converts the error codes used by the server to the ones of the version
+	schemas      *schema.Set                                     //json schemas of the raw payloads, nil when schema validation is disabled
+}
+
+func defaultSubprotocols() map[string]*subprotocol {
+	return map[string]*subprotocol{
+		OCPP16: {
+			name:         OCPP16,
+			traitMap:     protocol.OCPP16M,
+			validate:     protocol.Validate,
+			actionPlugin: local.NewActionPlugin(), //default action plugin
+			errorCode:    func(code protocol.ErrCodeType) protocol.ErrCodeType { return code },
+		},
+		OCPP201: {
+			name:      OCPP201,
+			traitMap:  ocpp201.OCPP201M,
+			validate:  ocpp201.Validate,
+			errorCode: ocpp201.ErrorCode, //no default action plugin, see RegisterSubprotocolActionPlugin
+		},
+	}
+}
+
+//the newest version is preferred when a charging point offers several
+var defaultPreference = []string{OCPP201, OCPP16}
+
+//supportRequest reports whether the request of call is the request type this version defines for the action
+func (p *subprotocol) supportRequest(call *protocol.Call) bool {
+	ocpptrait, ok := p.traitMap.GetTraitAction(call.Action)
+	if !ok {
+		return false
+	}
+	reqType := reflect.TypeOf(call.Request)
+	if reqType != nil && reqType.Kind() == reflect.Ptr {
+		reqType = reqType.Elem()
+	}
+	return reqType == ocpptrait.RequestType()
+}
+
+//newRequest restores the concrete request of action from its json payload, the request is a value like the ones built by the active plugins
+func (p *subprotocol) newRequest(action string, payload []byte) (protocol.Request, error) {
+	ocpptrait, ok := p.traitMap.GetTraitAction(action)
+	if !ok {
+		return nil, fmt.Errorf("not support action(%s) current, subprotocol(%s)", action, p.name)
+	}
+	req := reflectInstance(ocpptrait.RequestType())
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(req).Elem().Interface().(protocol.Request), nil
+}
+
+func (p *subprotocol) newResponse(action string, payload []byte) (protocol.Response, error) {
+	ocpptrait, ok := p.traitMap.GetTraitAction(action)
+	if !ok {
+		return nil, fmt.Errorf("not support action(%s) current, subprotocol(%s)", action, p.name)
+	}
+	res := reflectInstance(ocpptrait.ResponseType())
+	if err := json.Unmarshal(payload, res); err != nil {
+		return nil, err
+	}
+	return res.(protocol.Response), nil
+}
+
+//negotiate returns the first subprotocol in the preference of the server that the charging point offers,
+//together with the name as the charging point spelled it. the subprotocols without action plugin are never chosen
+func (s *Server) negotiate(clientSubprotocols []string) (string, *subprotocol) {
+	for _, name := range s.preference {
+		if s.subprotocols[name].actionPlugin == nil {
+			continue
+		}
+		for _, cproto := range clientSubprotocols {
+			if strings.EqualFold(cproto, name) {
+				return cproto, s.subprotocols[name]
+			}
+		}
+	}
+	return "", nil
+}
+
+//SetSubprotocols restricts the ocpp versions the server accepts, in order of preference
+func (s *Server) SetSubprotocols(names ...string) error {
+	var preference []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if _, ok := s.subprotocols[name]; !ok {
+			return fmt.Errorf("not support subprotocol(%s) current", name)
+		}
+		preference = append(preference, name)
+	}
+	if len(preference) == 0 {
+		return fmt.Errorf("at least one subprotocol is required")
+	}
+	s.preference = preference
+	return nil
+}
+
+//RegisterSubprotocolActionPlugin registers the passive plugin of the connections that negotiated the subprotocol name,
+//RegisterActionPlugin is the same as registering for ocpp1.6. ocpp2.0.1 has no default plugin, the charging points
+//are only accepted with it once its plugin is registered
+func (s *Server) RegisterSubprotocolActionPlugin(name string, actionPlugin ActionPlugin) error {
+	p, ok := s.subprotocols[name]
+	if !ok {
+		return fmt.Errorf("not support subprotocol(%s) current", name)
+	}
+	p.actionPlugin = actionPlugin
+	return nil
+}
+
+//subprotocolOf returns the subprotocol negotiated by id. when id is not connected, which happens while a call is
+//stored or forwarded, the subprotocol is the one the request type of call belongs to
+func (s *Server) subprotocolOf(id string, call *protocol.Call) (*subprotocol, error) {
+	if ws, ok := s.getConn(id); ok {
+		if !ws.subprotocol.supportRequest(call) {
+			return nil, fmt.Errorf("not support action(%s) with request(%T) current, id(%s), subprotocol(%s)", call.Action, call.Request, id, ws.subprotocol.name)
+		}
+		return ws.subprotocol, nil
+	}
+	for _, name := range s.preference {
+		if p := s.subprotocols[name]; p.supportRequest(call) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("not support action(%s) with request(%T) current, id(%s)", call.Action, call.Request, id)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	local "ocpp16/plugin/passive/local"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+//ocpp2.0.1 is only negotiated once its action plugin is registered
+func TestNegotiate(t *testing.T) {
+	s, ts := newTestServer(t, 3)
+	dial := func(subprotocols ...string) string {
+		dialer := websocket.Dialer{Subprotocols: subprotocols}
+		conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ocpp/"+testName+"/CP001", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		return conn.Subprotocol()
+	}
+	if name := dial(OCPP201); name != "" {
+		t.Fatalf("negotiated %s without plugin", name)
+	}
+	if name := dial(OCPP201, OCPP16); name != OCPP16 {
+		t.Fatalf("negotiated %s", name)
+	}
+	if err := s.RegisterSubprotocolActionPlugin(OCPP201, local.NewOCPP201ActionPlugin()); err != nil {
+		t.Fatal(err)
+	}
+	//the previous connection is still registered, the negotiation happens before the check
+	if name := dial(OCPP201, OCPP16); name != OCPP201 {
+		t.Fatalf("negotiated %s", name)
+	}
+}
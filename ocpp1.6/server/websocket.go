@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/websocket"
+	"ocpp16/events"
 	"ocpp16/protocol"
 	"reflect"
 	"sync"
@@ -13,14 +15,16 @@ import (
 )
 
 type Wsconn struct {
-	server  *Server
-	conn    *websocket.Conn
-	id      string
-	fd      int
-	timeout time.Duration
-	ping    chan []byte
-	closeC  chan error
-	closed  bool
+	server      *Server
+	conn        *websocket.Conn
+	id          string
+	fd          int
+	timeout     time.Duration
+	ping        chan []byte
+	closeC      chan error
+	closed      bool
+	subprotocol *subprotocol
+	connectedAt time.Time
 	sync.Mutex
 }
 
@@ -75,6 +79,11 @@ func (ws *Wsconn) ID() string {
 	return ws.id
 }
 
+//Subprotocol returns the ocpp version negotiated by the charging point, OCPP16 or OCPP201
+func (ws *Wsconn) Subprotocol() string {
+	return ws.subprotocol.name
+}
+
 func (ws *Wsconn) stop(err error) {
 	if !ws.closed {
 		ws.server.clientOnDisconnect(ws)
@@ -103,12 +112,13 @@ func (ws *Wsconn) read() error {
 	}
 	log.Debugf("read: id(%s), recv(%s), messagetype(%d)", ws.id, String(message), typ)
 	ws.setReadDeadTimeout(ws.timeout)
+	ws.server.events.Frame(ws.id, events.DirectionIn, message, time.Now())
 	go ws.messageHandler(message)
 	return nil
 }
 
 func (ws *Wsconn) responseHandler(uniqueid string, action string, res protocol.Response) {
-	if handler, ok := ws.server.actionPlugin.ResponseHandler(action); ok {
+	if handler, ok := ws.subprotocol.actionPlugin.ResponseHandler(action); ok {
 		log.Debugf("client response, id(%s), uniqueid(%s),action(%s), response(%+v)", ws.id, uniqueid, action, res)
 		if err := handler(context.Background(), ws.id, uniqueid, res); err != nil {
 			log.Errorf("client response handler failed, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, err)
@@ -119,11 +129,18 @@ func (ws *Wsconn) responseHandler(uniqueid string, action string, res protocol.R
 }
 
 func (ws *Wsconn) requestHandler(uniqueid string, action string, req protocol.Request) {
-	if handler, ok := ws.server.actionPlugin.RequestHandler(action); ok {
+	if handler, ok := ws.subprotocol.actionPlugin.RequestHandler(action); ok {
 		log.Debugf("client request, id(%s), uniqueid(%s),action(%s), request(%+v)", ws.id, uniqueid, action, req)
 		res, err := handler(context.Background(), ws.id, uniqueid, req)
 		if err != nil {
 			log.Errorf("client request handler failed, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, err)
+			//the handler answers with a CallError by returning an *Error, other errors leave the call unanswered
+			var e *Error
+			if errors.As(err, &e) {
+				if err = ws.sendCallError(uniqueid, e); err != nil {
+					log.Errorf("send CallError error(%v), id(%s), uniqueid(%s), action(%s)", err, ws.id, uniqueid, action)
+				}
+			}
 			return
 		}
 		callResult := &protocol.CallResult{
@@ -132,10 +149,16 @@ func (ws *Wsconn) requestHandler(uniqueid string, action string, req protocol.Re
 			Response:      res,
 		}
 		log.Debugf("server response, id(%s), uniqueid(%s),action(%s), callResult(%+v)", ws.id, uniqueid, action, callResult)
-		if err = ws.server.validate.Struct(callResult); err != nil {
+		if err = ws.subprotocol.validate.Struct(callResult); err != nil {
+			ws.server.metrics.validationFailure(directionOut, checkValidatorError(err, action))
 			log.Errorf("server response,validate callResult invalid, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, checkValidatorError(err, action))
 			return
 		}
+		if e := ws.subprotocol.checkSchemaOf(action, false, res); e != nil {
+			ws.server.metrics.validationFailure(directionOut, e)
+			log.Errorf("server response,validate callResult schema invalid, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, e)
+			return
+		}
 		result, err := json.Marshal(callResult)
 		if err != nil {
 			log.Errorf("server response, marshal result error, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, err)
@@ -143,7 +166,9 @@ func (ws *Wsconn) requestHandler(uniqueid string, action string, req protocol.Re
 		}
 		if err = ws.writeMessage(websocket.TextMessage, result); err != nil {
 			log.Errorf("write message error, id:(%s), uniqueid:(%s),action:(%s),err:(%v)", ws.id, uniqueid, action, err)
+			return
 		}
+		ws.server.metrics.message(directionOut, CallResult, action)
 		return
 	}
 	log.Errorf("not support action:(%s) current, id:(%s), uniqueid:(%s)", action, ws.id, uniqueid)
@@ -171,26 +196,35 @@ func (ws *Wsconn) writeMessage(messageType int, data []byte) error {
 	var err error
 	if err = ws.conn.WriteMessage(messageType, data); err != nil {
 		ws.stop(err)
+		return err
+	}
+	if ws.server.events != nil {
+		ws.server.events.Frame(ws.id, events.DirectionOut, append([]byte(nil), data...), time.Now())
 	}
-	return err
+	return nil
 }
 
 func (ws *Wsconn) sendCallError(uniqueID string, e *Error) error {
 	callError := &protocol.CallError{
 		MessageTypeID:    protocol.CALL_ERROR,
 		UniqueID:         uniqueID,
-		ErrorCode:        e.ErrorCode,
+		ErrorCode:        ws.subprotocol.errorCode(e.ErrorCode),
 		ErrorDescription: e.ErrorDescription,
 		ErrorDetails:     e.ErrorDetails,
 	}
-	if err := ws.server.validate.Struct(callError); err != nil {
+	if err := ws.subprotocol.validate.Struct(callError); err != nil {
 		return err
 	}
 	bf := bytes.NewBuffer([]byte{})
 	jsonEncoder := json.NewEncoder(bf)
 	jsonEncoder.SetEscapeHTML(false)
 	jsonEncoder.Encode(callError)
-	return ws.writeMessage(websocket.TextMessage, bf.Bytes())
+	if err := ws.writeMessage(websocket.TextMessage, bf.Bytes()); err != nil {
+		return err
+	}
+	//the action of the message answered with a CallError is not always known, e.g. a malformed Call
+	ws.server.metrics.message(directionOut, CallError, "")
+	return nil
 }
 
 func parseMessage(wsmsg []byte) ([]interface{}, error) {
@@ -230,7 +264,7 @@ func (ws *Wsconn) callHandler(uniqueid string, wsmsg []byte, fields []interface{
 		}
 		return
 	}
-	ocpptrait, ok := ws.server.ocpp16map.GetTraitAction(action)
+	ocpptrait, ok := ws.subprotocol.traitMap.GetTraitAction(action)
 	if !ok {
 		log.Errorf("not support action(%s) current,id(%s),wsmsg(%s),wsmsg_type(%s)", action, ws.id, String(wsmsg), Call)
 		if err := ws.sendCallError(uniqueid, &Error{
@@ -253,6 +287,14 @@ func (ws *Wsconn) callHandler(uniqueid string, wsmsg []byte, fields []interface{
 		}
 		return
 	}
+	if e := ws.subprotocol.checkSchema(action, true, reqByte); e != nil {
+		ws.server.metrics.validationFailure(directionIn, e)
+		log.Errorf("validate Call schema error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", e, ws.id, String(wsmsg), Call)
+		if err = ws.sendCallError(uniqueid, e); err != nil {
+			log.Errorf("send CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", err, ws.id, String(wsmsg), Call)
+		}
+		return
+	}
 	req := get(reqType)
 	defer put(reqType, req)
 	if err = json.Unmarshal(reqByte, &req); err != nil {
@@ -271,7 +313,8 @@ func (ws *Wsconn) callHandler(uniqueid string, wsmsg []byte, fields []interface{
 		Action:        action,
 		Request:       req.(protocol.Request),
 	}
-	if err = ws.server.validate.Struct(call); err != nil {
+	if err = ws.subprotocol.validate.Struct(call); err != nil {
+		ws.server.metrics.validationFailure(directionIn, checkValidatorError(err, action))
 		log.Errorf("validate Call error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", checkValidatorError(err, action), ws.id, String(wsmsg), Call)
 		if err = ws.sendCallError(uniqueid, checkValidatorError(err, action)); err != nil {
 			log.Errorf("send CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", err, ws.id, String(wsmsg), Call)
@@ -307,7 +350,7 @@ func (ws *Wsconn) callResultHandler(uniqueid string, wsmsg []byte, fields []inte
 		return
 	}
 	action := pendingReq.call.Action
-	ocpptrait, ok := ws.server.ocpp16map.GetTraitAction(action)
+	ocpptrait, ok := ws.subprotocol.traitMap.GetTraitAction(action)
 	if !ok {
 		log.Errorf("not support action(%s) current,id(%s),wsmsg(%s),wsmsg_type(%s)", action, ws.id, String(wsmsg), CallResult)
 		if err := ws.sendCallError(uniqueid, &Error{
@@ -330,6 +373,14 @@ func (ws *Wsconn) callResultHandler(uniqueid string, wsmsg []byte, fields []inte
 		}
 		return
 	}
+	if e := ws.subprotocol.checkSchema(action, false, resByte); e != nil {
+		ws.server.metrics.validationFailure(directionIn, e)
+		log.Errorf("validate CallResult schema error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", e, ws.id, String(wsmsg), CallResult)
+		if err = ws.sendCallError(uniqueid, e); err != nil {
+			log.Errorf("send CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", err, ws.id, String(wsmsg), CallResult)
+		}
+		return
+	}
 	res := get(resType)
 	defer put(resType, res)
 	if err = json.Unmarshal(resByte, &res); err != nil {
@@ -347,14 +398,17 @@ func (ws *Wsconn) callResultHandler(uniqueid string, wsmsg []byte, fields []inte
 		UniqueID:      uniqueid,
 		Response:      res.(protocol.Response),
 	}
-	if err = ws.server.validate.Struct(callResult); err != nil {
+	if err = ws.subprotocol.validate.Struct(callResult); err != nil {
+		ws.server.metrics.validationFailure(directionIn, checkValidatorError(err, action))
 		log.Errorf("validate CallResult error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", checkValidatorError(err, action), ws.id, String(wsmsg), CallResult)
 		if err = ws.sendCallError(uniqueid, checkValidatorError(err, action)); err != nil {
 			log.Errorf("send CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", err, ws.id, String(wsmsg), CallResult)
 		}
 		return
 	}
+	ws.server.metrics.replied(pendingReq)
 	ws.server.requestDone(ws.id, uniqueid)
+	ws.server.callReply(ws.id, uniqueid, &callReply{response: clone(res).(protocol.Response)})
 	ws.responseHandler(uniqueid, action, res.(protocol.Response))
 }
 
@@ -380,12 +434,12 @@ func (ws *Wsconn) callErrorHandler(uniqueid string, wsmsg []byte, fields []inter
 		}
 		return
 	}
-	errorDescription, ok := fields[2].(string)
+	errorDescription, ok := fields[3].(string)
 	if !ok {
-		log.Errorf("invalid CallError errorDescription(%v) type,must be string, id(%s), wsmsg(%s), wsmsg_type(%s)", fields[2], ws.id, String(wsmsg), CallError)
+		log.Errorf("invalid CallError errorDescription(%v) type,must be string, id(%s), wsmsg(%s), wsmsg_type(%s)", fields[3], ws.id, String(wsmsg), CallError)
 		if err := ws.sendCallError(uniqueid, &Error{
 			ErrorCode:        protocol.TypeConstraintViolation,
-			ErrorDescription: fmt.Sprintf("invalid CallError errorDescription(%v) type,must be string,uniqieid(%s)", fields[2], uniqueid),
+			ErrorDescription: fmt.Sprintf("invalid CallError errorDescription(%v) type,must be string,uniqieid(%s)", fields[3], uniqueid),
 			ErrorDetails:     protocol.ErrorDetails{}}); err != nil {
 			log.Errorf("send CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", err, ws.id, String(wsmsg), CallError)
 		}
@@ -417,7 +471,8 @@ func (ws *Wsconn) callErrorHandler(uniqueid string, wsmsg []byte, fields []inter
 		log.Errorf("unmarshal callError fields failed due to Errdetails, id(%s),wsmsg(%s),wsmsg_type(%s)", ws.id, String(wsmsg), CallError)
 		return
 	}
-	if err := ws.server.validate.Struct(callError); err != nil {
+	if err := ws.subprotocol.validate.Struct(callError); err != nil {
+		ws.server.metrics.validationFailure(directionIn, checkValidatorError(err, action))
 		log.Errorf("validate CallError error(%v),id(%s),wsmsg(%s),wsmsg_type(%s)", checkValidatorError(err, action), ws.id, String(wsmsg), CallError)
 		action := pendingReq.call.Action
 		if err = ws.sendCallError(uniqueid, checkValidatorError(err, action)); err != nil {
@@ -425,7 +480,10 @@ func (ws *Wsconn) callErrorHandler(uniqueid string, wsmsg []byte, fields []inter
 		}
 		return
 	}
+	ws.server.metrics.replied(pendingReq)
 	ws.server.requestDone(ws.id, uniqueid)
+	replyError := callError
+	ws.server.callReply(ws.id, uniqueid, &callReply{callError: &replyError})
 	ws.responseHandler(uniqueid, protocol.CallErrorName, &callError)
 }
 
@@ -450,6 +508,7 @@ func (ws *Wsconn) messageHandler(wsmsg []byte) {
 		log.Errorf("invalid uniqueid(%s), type(%s),must be string, id(%s),msg(%s)", fields[1], reflect.TypeOf(fields[1]).String(), ws.id, String(wsmsg))
 		return
 	}
+	ws.countMessage(wsmsgTypeid, uniqueid, fields)
 	switch wsmsgTypeid {
 	case protocol.CALL:
 		ws.callHandler(uniqueid, wsmsg, fields)
@@ -463,6 +522,26 @@ func (ws *Wsconn) messageHandler(wsmsg []byte) {
 	return
 }
 
+//countMessage counts a received message, the replies are counted with the action of the pending call they answer
+func (ws *Wsconn) countMessage(wsmsgTypeid float64, uniqueid string, fields []interface{}) {
+	var typ, action string
+	switch wsmsgTypeid {
+	case protocol.CALL:
+		typ = Call
+		action, _ = fields[2].(string)
+	case protocol.CALL_RESULT, protocol.CALL_ERROR:
+		if typ = CallResult; wsmsgTypeid == protocol.CALL_ERROR {
+			typ = CallError
+		}
+		if pendingReq, ok := ws.server.getPendingRequest(ws.id); ok && pendingReq.call.UID() == uniqueid {
+			action = pendingReq.call.Action
+		}
+	default:
+		return
+	}
+	ws.server.metrics.message(directionIn, typ, action)
+}
+
 func (ws *Wsconn) writedump() {
 	for {
 		select {
@@ -90,7 +90,7 @@ var fnMeterValueRequest = func() protocol.MeterValuesRequest {
 		TransactionId: &TransactionId,
 	}
 	var meterValue = protocol.MeterValue{
-		Timestamp: time.Now().Format(protocol.ISO8601),
+		TimeStamp: time.Now().Format(protocol.ISO8601),
 	}
 	var sampledValue = protocol.SampledValue{
 		Value:   "50000",
@@ -124,7 +124,7 @@ var fnStartTransactionRequest = func() protocol.StartTransactionRequest {
 }
 var fnStopTransactionRequest = func() protocol.StopTransactionRequest {
 	var meterValue = protocol.MeterValue{
-		Timestamp: time.Now().Format(protocol.ISO8601),
+		TimeStamp: time.Now().Format(protocol.ISO8601),
 	}
 	var sampledValue = protocol.SampledValue{
 		Value:     RandString(10),
@@ -163,7 +163,6 @@ var fnResetResponse = func() *protocol.ResetResponse {
 }
 
 func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
-	flag.Parse()
 	name, id := RandString(5), RandString(5)
 	path := fmt.Sprintf("/ocpp/%s/%s", name, id)
 	u := url.URL{Scheme: "ws", Host: "localhost:8000", Path: path}
@@ -178,13 +177,18 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 	}
 	t.Log("path", path)
 	defer c.Close()
+	//unblocks the reads once the test is over
+	go func() {
+		<-ctx.Done()
+		c.Close()
+	}()
 	ch := make(chan string, 10)
 	var closed bool
 	defer func() {
 		closed = true
 		//close(ch)
 	}()
-	queue := NewRequestQueue()
+	queue := newRequestQueue()
 	var waitgroup sync.WaitGroup
 	var mtx sync.Mutex
 	go func() {
@@ -212,12 +216,12 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 					Action:        "Reset",
 					Request:       fnBootNotificationRequest(),
 				}
-				queue.Push(call.UniqueID)
+				queue.push(call.UniqueID)
 				if err := d.appendRequest(context.Background(), fmt.Sprintf("%s-%s", name, id), call); err != nil {
 					return
 				}
 				time.Sleep(time.Second * time.Duration(randn.Intn(3)) / 5)
-				time.Sleep(time.Second * 10000)
+				<-ctx.Done()
 			}
 		}
 	}()
@@ -229,9 +233,9 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 			case <-ctx.Done():
 				return
 			case res_uniqueid := <-ch:
-				rep_uniqueid, _ := queue.Pop()
-				next_uniqueid, _ := queue.Peek()
-				t.Logf("ws_id(%s), res_uniqueid(%s),rep_uniqueid(%s),queue remain(%d), next_uniqueid(%v)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid, queue.Len(), next_uniqueid)
+				rep_uniqueid, _ := queue.pop()
+				next_uniqueid, _ := queue.peek()
+				t.Logf("ws_id(%s), res_uniqueid(%s),rep_uniqueid(%s),queue remain(%d), next_uniqueid(%v)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid, queue.len(), next_uniqueid)
 				if res_uniqueid != rep_uniqueid {
 					t.Errorf("ws_id(%s), res_uniqueid(%s) != rep_uniqueid(%s)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid)
 				}
@@ -248,7 +252,10 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 			default:
 				_, message, err := c.ReadMessage()
 				if err != nil {
-					t.Error(time.Now().Format(time.RFC3339), err)
+					//the connection outlives the test, it is closed once the test is over
+					if ctx.Err() == nil {
+						t.Error(time.Now().Format(time.RFC3339), err)
+					}
 					return
 				}
 				fields, err := parseMessage(message)
@@ -280,7 +287,9 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 						err = c.WriteMessage(websocket.TextMessage, callResultMsg)
 						mtx.Unlock()
 						if err != nil {
-							t.Error(err)
+							if ctx.Err() == nil {
+								t.Error(err)
+							}
 							return
 						}
 						if !closed {
@@ -337,11 +346,16 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 				err = c.WriteMessage(websocket.TextMessage, callMsg)
 				mtx.Unlock()
 				if err != nil {
-					t.Error(err)
+					if ctx.Err() == nil {
+						t.Error(err)
+					}
 					return
 				}
 				t.Logf("test for client call:send id(%v), send msg(%s)", fmt.Sprintf("%s-%s", name, id), string(callMsg))
-				time.Sleep(time.Second * 10)
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Second * 10):
+				}
 			}
 		}
 	}()
@@ -351,18 +365,19 @@ func clientHandler(ctx context.Context, t *testing.T, d *dispatcher, i int) {
 
 func WsHandler(t *testing.T, waitGroup *sync.WaitGroup) {
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*10)
-	lg := initLogger()
-	SetLogger(lg)
 	server := NewDefaultServer()
 	plugin := local.NewActionPlugin()
 	server.RegisterActionPlugin(plugin)
 	go func() {
 		server.Serve(":8000", "/ocpp/:name/:id")
 	}()
+	clients := &sync.WaitGroup{}
 	for i := 0; i < 100; i++ { //numbers of client
 		time.Sleep(time.Second / 10)
 		id := i
+		clients.Add(1)
 		go func() {
+			defer clients.Done()
 			clientHandler(ctx, t, server.dispatcher, id)
 		}()
 	}
@@ -371,5 +386,7 @@ func WsHandler(t *testing.T, waitGroup *sync.WaitGroup) {
 		time.Sleep(time.Second * 20)
 		cancel()
 	}
+	//the clients log to t, they must be done before the test
+	clients.Wait()
 	waitGroup.Done()
 }
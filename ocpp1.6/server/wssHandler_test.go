@@ -92,7 +92,6 @@ func createTLSCertificate(certificateFilename string, keyFilename string, cn str
 }
 
 func TLSClientHandler(ctx context.Context, t *testing.T, d *dispatcher, serverCertName string, clientCertName string, clientKeyName string) {
-	flag.Parse()
 	name, id := RandString(5), RandString(5)
 	path := fmt.Sprintf("/ocpp/%s/%s", name, id)
 	u := url.URL{Scheme: "wss", Host: "localhost:8091", Path: path}
@@ -123,7 +122,7 @@ func TLSClientHandler(ctx context.Context, t *testing.T, d *dispatcher, serverCe
 		closed = true
 		// close(ch)
 	}()
-	queue := NewRequestQueue()
+	queue := newRequestQueue()
 	var waitgroup sync.WaitGroup
 	var mtx sync.Mutex
 	waitgroup.Add(1)
@@ -150,7 +149,7 @@ func TLSClientHandler(ctx context.Context, t *testing.T, d *dispatcher, serverCe
 					Action:        "BootNotification",
 					Request:       fnBootNotificationRequest(),
 				}
-				queue.Push(call.UniqueID)
+				queue.push(call.UniqueID)
 				if err := d.appendRequest(context.Background(), fmt.Sprintf("%s-%s", name, id), call); err != nil {
 					return
 				}
@@ -167,9 +166,9 @@ func TLSClientHandler(ctx context.Context, t *testing.T, d *dispatcher, serverCe
 			case <-ctx.Done():
 				return
 			case res_uniqueid := <-ch:
-				rep_uniqueid, _ := queue.Pop()
-				next_uniqueid, _ := queue.Peek()
-				t.Logf("ws_id(%s), res_uniqueid(%s),rep_uniqueid(%s),queue remain(%d), next_uniqueid(%v)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid, queue.Len(), next_uniqueid)
+				rep_uniqueid, _ := queue.pop()
+				next_uniqueid, _ := queue.peek()
+				t.Logf("ws_id(%s), res_uniqueid(%s),rep_uniqueid(%s),queue remain(%d), next_uniqueid(%v)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid, queue.len(), next_uniqueid)
 				if res_uniqueid != rep_uniqueid {
 					t.Errorf("ws_id(%s), res_uniqueid(%s) != rep_uniqueid(%s)", fmt.Sprintf("%s-%s", name, id), res_uniqueid, rep_uniqueid)
 				}
@@ -297,8 +296,6 @@ func WssHandler(t *testing.T, waitGroup *sync.WaitGroup) {
 	require.Nil(t, err)
 	defer os.Remove(serverCertName)
 	defer os.Remove(serverKeyName)
-	lg := initLogger()
-	SetLogger(lg)
 	server := NewDefaultServer()
 	plugin := local.NewActionPlugin()
 	server.RegisterActionPlugin(plugin)
@@ -0,0 +1,210 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+//EtcdStore keeps the session state in etcd, shared by every node of the cluster. The ownerships are attached to a
+//lease of the node that is kept alive while the store is open: when the node crashes or loses etcd for longer than
+//the ttl, its ownerships expire and the charging points can be claimed by the nodes they reconnect to. The calls are
+//kept without a lease, they are replayed when the charging point reconnects. The keys are
+//
+//   {prefix}/owners/{id}             the node holding the connection of id
+//   {prefix}/calls/{id}/{uniqueid}   a queued call, in the order of creation
+//
+//Close does not revoke the lease, a node restarting within the ttl keeps receiving the calls of its charging points
+type EtcdStore struct {
+	client  *clientv3.Client
+	prefix  string
+	ttl     int64
+	timeout time.Duration
+
+	mu     sync.Mutex
+	lease  clientv3.LeaseID
+	owned  map[string]string //id -> node, attached again to a new lease
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+//NewEtcdStore connects to etcd with conf, the ownerships expire ttl after the last keepalive, 10s if ttl < 1s
+func NewEtcdStore(conf clientv3.Config, prefix string, ttl time.Duration) (*EtcdStore, error) {
+	if ttl < time.Second {
+		ttl = 10 * time.Second
+	}
+	if conf.DialTimeout <= 0 {
+		conf.DialTimeout = 5 * time.Second
+	}
+	client, err := clientv3.New(conf)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &EtcdStore{
+		client:  client,
+		prefix:  prefix,
+		ttl:     int64(ttl / time.Second),
+		timeout: conf.DialTimeout,
+		owned:   make(map[string]string),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	keepAlive, err := e.grant(ctx)
+	if err != nil {
+		cancel()
+		client.Close()
+		return nil, fmt.Errorf("session etcd store, grant lease, err(%v)", err)
+	}
+	go e.keepAlive(ctx, keepAlive)
+	return e, nil
+}
+
+func (e *EtcdStore) ownerKey(id string) string {
+	return e.prefix + "/owners/" + url.PathEscape(id)
+}
+
+func (e *EtcdStore) callsKey(id string) string {
+	return e.prefix + "/calls/" + url.PathEscape(id) + "/"
+}
+
+func (e *EtcdStore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), e.timeout)
+}
+
+//grant attaches the ownerships of the node to a new lease and keeps it alive
+func (e *EtcdStore) grant(ctx context.Context) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	grantCtx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+	lease, err := e.client.Grant(grantCtx, e.ttl)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lease = lease.ID
+	for id, node := range e.owned {
+		if _, err = e.client.Put(grantCtx, e.ownerKey(id), node, clientv3.WithLease(lease.ID)); err != nil {
+			return nil, err
+		}
+	}
+	return e.client.KeepAlive(ctx, lease.ID)
+}
+
+//keepAlive grants a new lease when the current one is lost, e.g. after etcd was unreachable for longer than the ttl
+func (e *EtcdStore) keepAlive(ctx context.Context, keepAlive <-chan *clientv3.LeaseKeepAliveResponse) {
+	defer close(e.done)
+	for {
+		for range keepAlive {
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			var err error
+			if keepAlive, err = e.grant(ctx); err == nil {
+				break
+			}
+		}
+	}
+}
+
+func (e *EtcdStore) SetOwner(id string, node string) error {
+	ctx, cancel := e.context()
+	defer cancel()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := e.client.Put(ctx, e.ownerKey(id), node, clientv3.WithLease(e.lease)); err != nil {
+		return err
+	}
+	e.owned[id] = node
+	return nil
+}
+
+func (e *EtcdStore) DeleteOwner(id string, node string) error {
+	ctx, cancel := e.context()
+	defer cancel()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key := e.ownerKey(id)
+	resp, err := e.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value(key), "=", node)).
+		Then(clientv3.OpDelete(key)).
+		Else(clientv3.OpGet(key)).
+		Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded && len(resp.Responses[0].GetResponseRange().Kvs) > 0 {
+		return ErrNotOwner
+	}
+	delete(e.owned, id)
+	return nil
+}
+
+func (e *EtcdStore) Owner(id string) (string, bool, error) {
+	ctx, cancel := e.context()
+	defer cancel()
+	resp, err := e.client.Get(ctx, e.ownerKey(id))
+	if err != nil || len(resp.Kvs) == 0 {
+		return "", false, err
+	}
+	return string(resp.Kvs[0].Value), true, nil
+}
+
+func (e *EtcdStore) PushCall(id string, call *Call) error {
+	data, err := json.Marshal(call)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := e.context()
+	defer cancel()
+	_, err = e.client.Put(ctx, e.callsKey(id)+url.PathEscape(call.UniqueID), string(data))
+	return err
+}
+
+func (e *EtcdStore) DeleteCall(id string, uniqueid string) error {
+	ctx, cancel := e.context()
+	defer cancel()
+	_, err := e.client.Delete(ctx, e.callsKey(id)+url.PathEscape(uniqueid))
+	return err
+}
+
+func (e *EtcdStore) DeleteCalls(id string) error {
+	ctx, cancel := e.context()
+	defer cancel()
+	_, err := e.client.Delete(ctx, e.callsKey(id), clientv3.WithPrefix())
+	return err
+}
+
+func (e *EtcdStore) Calls(id string) ([]*Call, error) {
+	ctx, cancel := e.context()
+	defer cancel()
+	resp, err := e.client.Get(ctx, e.callsKey(id), clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByCreateRevision, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+	calls := make([]*Call, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var call Call
+		if err = json.Unmarshal(kv.Value, &call); err != nil {
+			return nil, fmt.Errorf("session etcd key(%s) corrupted, err(%v)", kv.Key, err)
+		}
+		calls = append(calls, &call)
+	}
+	return calls, nil
+}
+
+//Close stops the keepalive of the lease and disconnects from etcd
+func (e *EtcdStore) Close() error {
+	e.cancel()
+	<-e.done
+	return e.client.Close()
+}
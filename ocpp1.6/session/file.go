@@ -0,0 +1,182 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+//FileStore keeps the whole session state in a single json file. Every operation takes an flock on
+//path.lock and reloads the file, so several nodes on the same host (or on a filesystem with working
+//flock) can share one store. It is meant for hundreds of queued calls, not for high write rates. Its limits:
+//
+//   - every PushCall, DeleteCall and ownership change rewrites the whole file under the exclusive lock, the cost
+//     grows with the number of charging points and queued calls and the writes of all the nodes are serialized
+//   - the nodes must share a filesystem whose flock works across them, a local disk for the nodes of one host.
+//     NFS mounts without lock support corrupt the store
+//   - the ownerships of a node that crashes stay until its charging points connect to another node or the node
+//     restarts with the same address, the calls meanwhile fail to be forwarded to it
+//
+//Larger clusters use the EtcdStore
+type FileStore struct {
+	path     string
+	lockPath string
+	mu       sync.Mutex
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("session file store path is empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	f := &FileStore{path: path, lockPath: path + ".lock"}
+	//make sure the file is readable before the server starts
+	if err := f.view(func(*snapshot) error { return nil }); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileStore) lock(how int) (*os.File, error) {
+	lockFile, err := os.OpenFile(f.lockPath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err = unix.Flock(int(lockFile.Fd()), how); err != nil {
+		lockFile.Close()
+		return nil, err
+	}
+	return lockFile, nil
+}
+
+func (f *FileStore) unlock(lockFile *os.File) {
+	unix.Flock(int(lockFile.Fd()), unix.LOCK_UN)
+	lockFile.Close()
+}
+
+func (f *FileStore) load() (*snapshot, error) {
+	s := newSnapshot()
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if err = json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("session file(%s) corrupted, err(%v)", f.path, err)
+	}
+	if s.Owners == nil {
+		s.Owners = make(map[string]string)
+	}
+	if s.Calls == nil {
+		s.Calls = make(map[string][]*Call)
+	}
+	return s, nil
+}
+
+//save replaces the store file by s
+func (f *FileStore) save(s *snapshot) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(f.path, data)
+}
+
+func (f *FileStore) view(fn func(*snapshot) error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lockFile, err := f.lock(unix.LOCK_SH)
+	if err != nil {
+		return err
+	}
+	defer f.unlock(lockFile)
+	s, err := f.load()
+	if err != nil {
+		return err
+	}
+	return fn(s)
+}
+
+func (f *FileStore) update(fn func(*snapshot) error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lockFile, err := f.lock(unix.LOCK_EX)
+	if err != nil {
+		return err
+	}
+	defer f.unlock(lockFile)
+	s, err := f.load()
+	if err != nil {
+		return err
+	}
+	if err = fn(s); err != nil {
+		return err
+	}
+	return f.save(s)
+}
+
+func (f *FileStore) SetOwner(id string, node string) error {
+	return f.update(func(s *snapshot) error {
+		s.setOwner(id, node)
+		return nil
+	})
+}
+
+func (f *FileStore) DeleteOwner(id string, node string) error {
+	return f.update(func(s *snapshot) error {
+		return s.deleteOwner(id, node)
+	})
+}
+
+func (f *FileStore) Owner(id string) (node string, ok bool, err error) {
+	err = f.view(func(s *snapshot) error {
+		node, ok = s.owner(id)
+		return nil
+	})
+	return
+}
+
+func (f *FileStore) PushCall(id string, call *Call) error {
+	return f.update(func(s *snapshot) error {
+		s.pushCall(id, call)
+		return nil
+	})
+}
+
+func (f *FileStore) DeleteCall(id string, uniqueid string) error {
+	return f.update(func(s *snapshot) error {
+		s.deleteCall(id, uniqueid)
+		return nil
+	})
+}
+
+func (f *FileStore) DeleteCalls(id string) error {
+	return f.update(func(s *snapshot) error {
+		delete(s.Calls, id)
+		return nil
+	})
+}
+
+func (f *FileStore) Calls(id string) (calls []*Call, err error) {
+	err = f.view(func(s *snapshot) error {
+		calls = s.calls(id)
+		return nil
+	})
+	return
+}
+
+func (f *FileStore) Close() error {
+	return nil
+}
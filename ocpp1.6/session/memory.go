@@ -0,0 +1,64 @@
+package session
+
+import "sync"
+
+//MemoryStore is the default store, nothing survives a restart and it can not be shared between nodes
+type MemoryStore struct {
+	snapshot *snapshot
+	sync.RWMutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{snapshot: newSnapshot()}
+}
+
+func (m *MemoryStore) SetOwner(id string, node string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.snapshot.setOwner(id, node)
+	return nil
+}
+
+func (m *MemoryStore) DeleteOwner(id string, node string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.snapshot.deleteOwner(id, node)
+}
+
+func (m *MemoryStore) Owner(id string) (string, bool, error) {
+	m.RLock()
+	defer m.RUnlock()
+	node, ok := m.snapshot.owner(id)
+	return node, ok, nil
+}
+
+func (m *MemoryStore) PushCall(id string, call *Call) error {
+	m.Lock()
+	defer m.Unlock()
+	m.snapshot.pushCall(id, call)
+	return nil
+}
+
+func (m *MemoryStore) DeleteCall(id string, uniqueid string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.snapshot.deleteCall(id, uniqueid)
+	return nil
+}
+
+func (m *MemoryStore) DeleteCalls(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.snapshot.Calls, id)
+	return nil
+}
+
+func (m *MemoryStore) Calls(id string) ([]*Call, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.snapshot.calls(id), nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
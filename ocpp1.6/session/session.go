@@ -0,0 +1,97 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var ErrNotOwner = errors.New("charging point is owned by another node")
+
+//Store records which server node holds the connection of a charging point and the active calls
+//that have not been replied yet, so that queued calls can be replayed after a restart and an active call
+//arriving at the wrong node can be forwarded to the owner
+type Store interface {
+	//SetOwner records node as the owner of the charging point id
+	SetOwner(id string, node string) error
+	//DeleteOwner removes the ownership of id, it returns ErrNotOwner and does nothing if node is not the owner
+	DeleteOwner(id string, node string) error
+	//Owner returns the node holding the connection of id
+	Owner(id string) (node string, ok bool, err error)
+	//PushCall appends call to the queue of id
+	PushCall(id string, call *Call) error
+	//DeleteCall removes the call identified by uniqueid from the queue of id
+	DeleteCall(id string, uniqueid string) error
+	//DeleteCalls removes the whole queue of id
+	DeleteCalls(id string) error
+	//Calls returns the queued calls of id in the order they were pushed
+	Calls(id string) ([]*Call, error)
+	Close() error
+}
+
+//Call is the stored form of an active call, the payload is kept as raw json because the
+//concrete request type can only be restored with the trait map of the protocol
+type Call struct {
+	UniqueID    string          `json:"uniqueId"`
+	Action      string          `json:"action"`
+	Payload     json.RawMessage `json:"payload"`
+	QueuedAt    string          `json:"queuedAt"`
+	Subprotocol string          `json:"subprotocol,omitempty"`
+}
+
+type snapshot struct {
+	Owners map[string]string  `json:"owners"`
+	Calls  map[string][]*Call `json:"calls"`
+}
+
+func newSnapshot() *snapshot {
+	return &snapshot{
+		Owners: make(map[string]string),
+		Calls:  make(map[string][]*Call),
+	}
+}
+
+func (s *snapshot) setOwner(id string, node string) {
+	s.Owners[id] = node
+}
+
+func (s *snapshot) deleteOwner(id string, node string) error {
+	owner, ok := s.Owners[id]
+	if !ok {
+		return nil
+	}
+	if owner != node {
+		return ErrNotOwner
+	}
+	delete(s.Owners, id)
+	return nil
+}
+
+func (s *snapshot) owner(id string) (string, bool) {
+	node, ok := s.Owners[id]
+	return node, ok
+}
+
+func (s *snapshot) pushCall(id string, call *Call) {
+	s.Calls[id] = append(s.Calls[id], call)
+}
+
+func (s *snapshot) deleteCall(id string, uniqueid string) {
+	calls := s.Calls[id]
+	for i, call := range calls {
+		if call.UniqueID == uniqueid {
+			calls = append(calls[:i], calls[i+1:]...)
+			break
+		}
+	}
+	if len(calls) == 0 {
+		delete(s.Calls, id)
+		return
+	}
+	s.Calls[id] = calls
+}
+
+func (s *snapshot) calls(id string) []*Call {
+	calls := make([]*Call, len(s.Calls[id]))
+	copy(calls, s.Calls[id])
+	return calls
+}
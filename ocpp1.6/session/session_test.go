@@ -0,0 +1,123 @@
+package session
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func testStore(t *testing.T, store Store) {
+	if err := store.SetOwner("name-1", "10.0.0.1:8090"); err != nil {
+		t.Fatal(err)
+	}
+	if node, ok, err := store.Owner("name-1"); err != nil || !ok || node != "10.0.0.1:8090" {
+		t.Errorf("owner: node(%s), ok(%v), err(%v)", node, ok, err)
+	}
+	if err := store.DeleteOwner("name-1", "10.0.0.2:8090"); err != ErrNotOwner {
+		t.Errorf("delete owner from another node: expect ErrNotOwner, got %v", err)
+	}
+	for _, uniqueid := range []string{"a", "b", "c"} {
+		call := &Call{UniqueID: uniqueid, Action: "Reset", Payload: json.RawMessage(`{"type":"Hard"}`)}
+		if err := store.PushCall("name-1", call); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.DeleteCall("name-1", "b"); err != nil {
+		t.Fatal(err)
+	}
+	calls, err := store.Calls("name-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 2 || calls[0].UniqueID != "a" || calls[1].UniqueID != "c" {
+		t.Errorf("calls: %+v", calls)
+	}
+	if string(calls[0].Payload) != `{"type":"Hard"}` {
+		t.Errorf("payload: %s", calls[0].Payload)
+	}
+	if err := store.DeleteOwner("name-1", "10.0.0.1:8090"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := store.Owner("name-1"); ok {
+		t.Error("owner should be deleted")
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := os.MkdirTemp("", "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.json")
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+	if err = store.PushCall("name-2", &Call{UniqueID: "d", Action: "ClearCache", Payload: json.RawMessage(`{}`)}); err != nil {
+		t.Fatal(err)
+	}
+	//a restarted node sees the queued call
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls, err := reopened.Calls("name-2")
+	if err != nil || len(calls) != 1 || calls[0].UniqueID != "d" {
+		t.Errorf("calls after reopen: %+v, err(%v)", calls, err)
+	}
+}
+
+//TestEtcdStore runs against the etcd of OCPP16_TEST_ETCD, e.g. 127.0.0.1:2379
+func TestEtcdStore(t *testing.T) {
+	endpoints := os.Getenv("OCPP16_TEST_ETCD")
+	if endpoints == "" {
+		t.Skip("OCPP16_TEST_ETCD not set")
+	}
+	conf := clientv3.Config{Endpoints: strings.Split(endpoints, ",")}
+	prefix := "/ocpp16-test/" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	store, err := NewEtcdStore(conf, prefix, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	testStore(t, store)
+
+	//the ownerships of a node that stops keeping its lease alive expire, its calls stay
+	crashed, err := NewEtcdStore(conf, prefix, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crashed.SetOwner("name-2", "10.0.0.2:8090")
+	crashed.PushCall("name-2", &Call{UniqueID: "d", Action: "ClearCache", Payload: json.RawMessage(`{}`)})
+	time.Sleep(3 * time.Second)
+	if node, ok, err := store.Owner("name-2"); err != nil || !ok || node != "10.0.0.2:8090" {
+		t.Fatalf("owner kept alive: node(%s), ok(%v), err(%v)", node, ok, err)
+	}
+	crashed.Close()
+	for i := 0; ; i++ {
+		if _, ok, _ := store.Owner("name-2"); !ok {
+			break
+		}
+		if i == 100 {
+			t.Fatal("ownership of a closed store did not expire")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	calls, err := store.Calls("name-2")
+	if err != nil || len(calls) != 1 || calls[0].UniqueID != "d" {
+		t.Errorf("calls after expiry: %+v, err(%v)", calls, err)
+	}
+	store.DeleteCalls("name-2")
+}
@@ -0,0 +1,407 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"ocpp16/protocol"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	defaultHeartbeatInterval = 300
+	callTimeout              = 30 * time.Second
+)
+
+var errReset = errors.New("reset")
+
+//reply is the CallResult or CallError answering a call of the charging point
+type reply struct {
+	payload   json.RawMessage
+	callError *protocol.CallError
+}
+
+//ChargePoint is a simulated charging point, it reconnects until its context is done
+type ChargePoint struct {
+	id         string
+	conf       *Config
+	connectors []*connector //index 0 is the charging point itself
+
+	writeMu sync.Mutex
+	conn    *websocket.Conn
+	seq     uint64
+
+	mu                sync.Mutex
+	pending           map[string]chan *reply
+	configuration     map[string]*configValue
+	localListVersion  int
+	profiles          map[int]int //charging profile id to connector id
+	heartbeatInterval time.Duration
+	resetC            chan protocol.ResetType
+}
+
+func NewChargePoint(id string, conf *Config) *ChargePoint {
+	cp := &ChargePoint{
+		id:       id,
+		conf:     conf,
+		pending:  make(map[string]chan *reply),
+		profiles: make(map[int]int),
+		resetC:   make(chan protocol.ResetType, 1),
+	}
+	cp.configuration = defaultConfiguration(conf)
+	for i := 0; i <= conf.Connectors; i++ {
+		cp.connectors = append(cp.connectors, newConnector(cp, i))
+	}
+	return cp
+}
+
+func (cp *ChargePoint) ID() string {
+	return cp.id
+}
+
+//Run connects and boots the charging point, a lost connection or a reset reboots it
+func (cp *ChargePoint) Run(ctx context.Context) {
+	for {
+		err := cp.session(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		if err == errReset {
+			log.Infof("id(%s) reset, rebooting", cp.id)
+			continue
+		}
+		log.Warnf("id(%s) disconnected, reconnect in %s, err:(%v)", cp.id, cp.conf.ReconnectDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cp.conf.ReconnectDelay):
+		}
+	}
+}
+
+func (cp *ChargePoint) dial(ctx context.Context) (*websocket.Conn, error) {
+	header := http.Header{}
+	if cp.conf.Password != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(cp.id, cp.conf.Password)
+		header.Set("Authorization", req.Header.Get("Authorization"))
+	}
+	dialer := websocket.Dialer{
+		Subprotocols:     []string{"ocpp1.6"},
+		HandshakeTimeout: callTimeout,
+	}
+	conn, res, err := dialer.DialContext(ctx, cp.conf.pointURL(cp.id), header)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("%v, status(%s)", err, res.Status)
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+//session lasts one websocket connection
+func (cp *ChargePoint) session(ctx context.Context) error {
+	conn, err := cp.dial(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cp.writeMu.Lock()
+	cp.conn = conn
+	cp.writeMu.Unlock()
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- cp.readLoop(ctx, conn)
+		cancel()
+	}()
+	defer func() {
+		conn.Close()
+		cp.failPending()
+	}()
+	if err = cp.boot(ctx); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cp.heartbeat(ctx)
+	}()
+	for _, c := range cp.connectors {
+		c := c
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.run(ctx)
+		}()
+	}
+	select {
+	case <-ctx.Done():
+		select {
+		case err = <-readErr:
+			return err
+		default:
+			return ctx.Err()
+		}
+	case typ := <-cp.resetC:
+		reason := protocol.Reason("SoftReset")
+		if typ == "Hard" {
+			reason = "HardReset"
+		}
+		for _, c := range cp.connectors[1:] {
+			c.stop(ctx, reason)
+		}
+		return errReset
+	}
+}
+
+//boot sends BootNotification until it is accepted
+func (cp *ChargePoint) boot(ctx context.Context) error {
+	req := protocol.BootNotificationRequest{
+		ChargePointVendor:       cp.conf.Vendor,
+		ChargePointModel:        cp.conf.Model,
+		ChargePointSerialNumber: cp.id,
+		FirmwareVersion:         "1.0.0",
+	}
+	for {
+		res := &protocol.BootNotificationResponse{}
+		if err := cp.call(ctx, req, res); err != nil {
+			return err
+		}
+		interval := defaultHeartbeatInterval
+		if res.Interval != nil && *res.Interval > 0 {
+			interval = *res.Interval
+		}
+		if res.Status == "Accepted" {
+			cp.mu.Lock()
+			cp.heartbeatInterval = time.Duration(interval) * time.Second
+			cp.configuration["HeartbeatInterval"].value = strconv.Itoa(interval)
+			cp.mu.Unlock()
+			log.Infof("id(%s) boot accepted, heartbeat interval(%ds)", cp.id, interval)
+			return nil
+		}
+		log.Infof("id(%s) boot %s, retry in %ds", cp.id, res.Status, interval)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(interval) * time.Second):
+		}
+	}
+}
+
+func (cp *ChargePoint) heartbeat(ctx context.Context) {
+	for {
+		cp.mu.Lock()
+		interval := cp.heartbeatInterval
+		cp.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		if err := cp.call(ctx, protocol.HeartbeatRequest{}, &protocol.HeartbeatResponse{}); err != nil {
+			log.Errorf("id(%s) heartbeat error, err:(%v)", cp.id, err)
+		}
+	}
+}
+
+func (cp *ChargePoint) write(message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	cp.writeMu.Lock()
+	defer cp.writeMu.Unlock()
+	if cp.conn == nil {
+		return errors.New("not connected")
+	}
+	log.Debugf("id(%s) send %s", cp.id, data)
+	return cp.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+//call sends req and decodes the CallResult into res, a CallError is returned as error
+func (cp *ChargePoint) call(ctx context.Context, req protocol.Request, res protocol.Response) error {
+	uniqueid := strconv.FormatUint(atomic.AddUint64(&cp.seq, 1), 10)
+	replyC := make(chan *reply, 1)
+	cp.mu.Lock()
+	cp.pending[uniqueid] = replyC
+	cp.mu.Unlock()
+	defer func() {
+		cp.mu.Lock()
+		delete(cp.pending, uniqueid)
+		cp.mu.Unlock()
+	}()
+	call := &protocol.Call{
+		MessageTypeID: protocol.CALL,
+		UniqueID:      uniqueid,
+		Action:        req.Action(),
+		Request:       req,
+	}
+	if err := cp.write(call); err != nil {
+		return err
+	}
+	timer := time.NewTimer(callTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return fmt.Errorf("%s timeout", req.Action())
+	case r := <-replyC:
+		if r == nil {
+			return errors.New("connection closed")
+		}
+		if r.callError != nil {
+			return fmt.Errorf("%s CallError %s: %s", req.Action(), r.callError.ErrorCode, r.callError.ErrorDescription)
+		}
+		return json.Unmarshal(r.payload, res)
+	}
+}
+
+//notify sends a request whose response carries nothing of interest
+func (cp *ChargePoint) notify(ctx context.Context, req protocol.Request) {
+	ocpptrait, ok := protocol.OCPP16M.GetTraitAction(req.Action())
+	if !ok {
+		return
+	}
+	res := reflect.New(ocpptrait.ResponseType()).Interface().(protocol.Response)
+	if err := cp.call(ctx, req, res); err != nil {
+		log.Errorf("id(%s) %s error, err:(%v)", cp.id, req.Action(), err)
+	}
+}
+
+func (cp *ChargePoint) failPending() {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	for uniqueid, replyC := range cp.pending {
+		close(replyC)
+		delete(cp.pending, uniqueid)
+	}
+}
+
+func (cp *ChargePoint) readLoop(ctx context.Context, conn *websocket.Conn) error {
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		log.Debugf("id(%s) receive %s", cp.id, data)
+		var fields []json.RawMessage
+		if err = json.Unmarshal(data, &fields); err != nil || len(fields) < 3 {
+			log.Errorf("id(%s) invalid message %s", cp.id, data)
+			continue
+		}
+		var messageType protocol.MessageType
+		var uniqueid string
+		if json.Unmarshal(fields[0], &messageType) != nil || json.Unmarshal(fields[1], &uniqueid) != nil {
+			log.Errorf("id(%s) invalid message %s", cp.id, data)
+			continue
+		}
+		switch messageType {
+		case protocol.CALL:
+			if len(fields) != 4 {
+				cp.sendCallError(uniqueid, protocol.FormationViolation, "call must have 4 elements")
+				continue
+			}
+			var action string
+			json.Unmarshal(fields[2], &action)
+			go cp.handleCall(ctx, uniqueid, action, fields[3])
+		case protocol.CALL_RESULT:
+			cp.deliver(uniqueid, &reply{payload: fields[2]})
+		case protocol.CALL_ERROR:
+			callError := &protocol.CallError{MessageTypeID: protocol.CALL_ERROR, UniqueID: uniqueid}
+			json.Unmarshal(fields[2], &callError.ErrorCode)
+			if len(fields) > 3 {
+				json.Unmarshal(fields[3], &callError.ErrorDescription)
+			}
+			cp.deliver(uniqueid, &reply{callError: callError})
+		default:
+			log.Errorf("id(%s) invalid message type(%d)", cp.id, messageType)
+		}
+	}
+}
+
+func (cp *ChargePoint) deliver(uniqueid string, r *reply) {
+	cp.mu.Lock()
+	replyC, ok := cp.pending[uniqueid]
+	delete(cp.pending, uniqueid)
+	cp.mu.Unlock()
+	if !ok {
+		log.Warnf("id(%s) reply of unknown uniqueid(%s)", cp.id, uniqueid)
+		return
+	}
+	replyC <- r
+}
+
+func (cp *ChargePoint) sendCallError(uniqueid string, code protocol.ErrCodeType, description string) {
+	callError := &protocol.CallError{
+		MessageTypeID:    protocol.CALL_ERROR,
+		UniqueID:         uniqueid,
+		ErrorCode:        code,
+		ErrorDescription: description,
+	}
+	if err := cp.write(callError); err != nil {
+		log.Errorf("id(%s) send CallError error, err:(%v)", cp.id, err)
+	}
+}
+
+//handleCall answers a call of the central system, the follow-up of the answer, such as the messages a
+//TriggerMessage asks for, runs once the CallResult is sent
+func (cp *ChargePoint) handleCall(ctx context.Context, uniqueid string, action string, payload json.RawMessage) {
+	handler, ok := handlers[action]
+	ocpptrait, traitOk := protocol.OCPP16M.GetTraitAction(action)
+	if !ok || !traitOk {
+		cp.sendCallError(uniqueid, protocol.NotImplemented, fmt.Sprintf("action(%s) not implemented", action))
+		return
+	}
+	ptr := reflect.New(ocpptrait.RequestType())
+	if err := json.Unmarshal(payload, ptr.Interface()); err != nil {
+		cp.sendCallError(uniqueid, protocol.FormationViolation, err.Error())
+		return
+	}
+	if err := protocol.Validate.Struct(ptr.Interface()); err != nil {
+		cp.sendCallError(uniqueid, protocol.PropertyConstraintViolation, err.Error())
+		return
+	}
+	res, after := handler(ctx, cp, ptr.Elem().Interface().(protocol.Request))
+	callResult := &protocol.CallResult{
+		MessageTypeID: protocol.CALL_RESULT,
+		UniqueID:      uniqueid,
+		Response:      res,
+	}
+	if err := cp.write(callResult); err != nil {
+		log.Errorf("id(%s) send CallResult error, action(%s), err:(%v)", cp.id, action, err)
+		return
+	}
+	if after != nil {
+		after()
+	}
+}
+
+func (cp *ChargePoint) connector(id int) (*connector, bool) {
+	if id < 0 || id >= len(cp.connectors) {
+		return nil, false
+	}
+	return cp.connectors[id], true
+}
+
+func (cp *ChargePoint) reset(typ protocol.ResetType) {
+	select {
+	case cp.resetC <- typ:
+	default:
+	}
+}
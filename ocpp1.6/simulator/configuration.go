@@ -0,0 +1,78 @@
+package simulator
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+type configValue struct {
+	value    string
+	readonly bool
+	validate func(value string) bool
+}
+
+func isInt(value string) bool {
+	i, err := strconv.Atoi(value)
+	return err == nil && i >= 0
+}
+
+func isBool(value string) bool {
+	_, err := strconv.ParseBool(value)
+	return err == nil
+}
+
+//defaultConfiguration returns the standard configuration keys of ocpp1.6 a simulated charging point supports
+func defaultConfiguration(conf *Config) map[string]*configValue {
+	return map[string]*configValue{
+		"AuthorizeRemoteTxRequests":         {value: "false", validate: isBool},
+		"ClockAlignedDataInterval":          {value: "0", validate: isInt},
+		"ConnectionTimeOut":                 {value: "60", validate: isInt},
+		"GetConfigurationMaxKeys":           {value: "50", readonly: true},
+		"HeartbeatInterval":                 {value: strconv.Itoa(defaultHeartbeatInterval), validate: isInt},
+		"LocalAuthListEnabled":              {value: "true", validate: isBool},
+		"LocalAuthListMaxLength":            {value: "1000", readonly: true},
+		"LocalAuthorizeOffline":             {value: "true", validate: isBool},
+		"LocalPreAuthorize":                 {value: "false", validate: isBool},
+		"MeterValueSampleInterval":          {value: strconv.Itoa(int(conf.MeterInterval / time.Second)), validate: isInt},
+		"MeterValuesSampledData":            {value: "Energy.Active.Import.Register,Power.Active.Import,Current.Import,Voltage"},
+		"NumberOfConnectors":                {value: strconv.Itoa(conf.Connectors), readonly: true},
+		"ResetRetries":                      {value: "3", validate: isInt},
+		"SendLocalListMaxLength":            {value: "100", readonly: true},
+		"StopTransactionOnEVSideDisconnect": {value: "true", validate: isBool},
+		"StopTransactionOnInvalidId":        {value: "true", validate: isBool},
+		"SupportedFeatureProfiles":          {value: "Core,FirmwareManagement,LocalAuthListManagement,Reservation,SmartCharging,RemoteTrigger", readonly: true},
+		"TransactionMessageAttempts":        {value: "3", validate: isInt},
+		"TransactionMessageRetryInterval":   {value: "60", validate: isInt},
+		"UnlockConnectorOnEVSideDisconnect": {value: "true", validate: isBool},
+	}
+}
+
+func (cp *ChargePoint) configBool(key string) bool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if v, ok := cp.configuration[key]; ok {
+		b, _ := strconv.ParseBool(v.value)
+		return b
+	}
+	return false
+}
+
+func (cp *ChargePoint) meterInterval() time.Duration {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	seconds, _ := strconv.Atoi(cp.configuration["MeterValueSampleInterval"].value)
+	if seconds <= 0 {
+		return cp.conf.MeterInterval
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (cp *ChargePoint) configurationKeys() []string {
+	keys := make([]string, 0, len(cp.configuration))
+	for key := range cp.configuration {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,374 @@
+package simulator
+
+import (
+	"context"
+	"math/rand"
+	"ocpp16/protocol"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	statusAvailable   protocol.ChargePointStatus = "Available"
+	statusPreparing   protocol.ChargePointStatus = "Preparing"
+	statusCharging    protocol.ChargePointStatus = "Charging"
+	statusFinishing   protocol.ChargePointStatus = "Finishing"
+	statusReserved    protocol.ChargePointStatus = "Reserved"
+	statusUnavailable protocol.ChargePointStatus = "Unavailable"
+
+	voltage = 230.0
+)
+
+type commandType int
+
+const (
+	cmdStart commandType = iota
+	cmdStop
+	cmdAvailability
+	cmdReserve
+	cmdCancelReservation
+	cmdStatus
+	cmdMeterValues
+)
+
+type command struct {
+	typ           commandType
+	idTag         protocol.IdToken
+	reason        protocol.Reason
+	operative     bool
+	reservationId int
+	expiry        time.Time
+	done          chan struct{}
+}
+
+func (cmd command) complete() {
+	if cmd.done != nil {
+		close(cmd.done)
+	}
+}
+
+//connector drives the state machine Available->Preparing->Charging->Finishing->Available of one connector,
+//all the transitions happen in its run goroutine, the handlers of the central system calls post commands to it
+type connector struct {
+	cp   *ChargePoint
+	id   int
+	cmds chan command
+
+	mu                sync.Mutex
+	status            protocol.ChargePointStatus
+	operative         bool
+	pendingOperative  *bool //availability change scheduled at the end of the transaction
+	meter             float64
+	transactionId     int
+	reservationId     int
+	reservationIdTag  protocol.IdToken
+	reservationExpiry time.Time
+	pendingStop       *protocol.StopTransactionRequest //transaction interrupted by a lost connection
+}
+
+func newConnector(cp *ChargePoint, id int) *connector {
+	return &connector{
+		cp:        cp,
+		id:        id,
+		cmds:      make(chan command, 8),
+		status:    statusAvailable,
+		operative: true,
+		meter:     float64(rand.Intn(100000)),
+	}
+}
+
+func (c *connector) snapshot() (protocol.ChargePointStatus, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status, c.transactionId
+}
+
+func (c *connector) post(ctx context.Context, cmd command) bool {
+	select {
+	case c.cmds <- cmd:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+//stop ends the running transaction and waits until StopTransaction is answered
+func (c *connector) stop(ctx context.Context, reason protocol.Reason) {
+	if _, transactionId := c.snapshot(); transactionId == 0 {
+		return
+	}
+	done := make(chan struct{})
+	if !c.post(ctx, command{typ: cmdStop, reason: reason, done: done}) {
+		return
+	}
+	select {
+	case <-done:
+	case <-ctx.Done():
+	case <-time.After(callTimeout):
+	}
+}
+
+func (c *connector) setStatus(ctx context.Context, status protocol.ChargePointStatus) {
+	c.mu.Lock()
+	c.status = status
+	c.mu.Unlock()
+	c.sendStatus(ctx)
+}
+
+func (c *connector) sendStatus(ctx context.Context) {
+	c.mu.Lock()
+	status := c.status
+	c.mu.Unlock()
+	connectorId := c.id
+	c.cp.notify(ctx, protocol.StatusNotificationRequest{
+		ConnectorId: &connectorId,
+		ErrorCode:   "NoError",
+		Status:      status,
+		Timestamp:   now(),
+	})
+}
+
+func (c *connector) idle() time.Duration {
+	mean := c.cp.conf.Idle
+	return mean/2 + time.Duration(rand.Int63n(int64(mean)))
+}
+
+func (c *connector) run(ctx context.Context) {
+	c.mu.Lock()
+	pendingStop := c.pendingStop
+	c.pendingStop = nil
+	c.mu.Unlock()
+	if pendingStop != nil {
+		c.cp.notify(ctx, *pendingStop)
+	}
+	c.sendStatus(ctx)
+	idle := time.NewTimer(c.idle())
+	defer idle.Stop()
+	for {
+		var expiry <-chan time.Time
+		c.mu.Lock()
+		if c.status == statusReserved {
+			expiry = time.After(time.Until(c.reservationExpiry))
+		}
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case cmd := <-c.cmds:
+			c.handle(ctx, cmd)
+		case <-expiry:
+			c.mu.Lock()
+			c.reservationId, c.reservationIdTag = 0, ""
+			c.mu.Unlock()
+			c.setStatus(ctx, statusAvailable)
+		case <-idle.C:
+			status, _ := c.snapshot()
+			if c.id > 0 && c.cp.conf.AutoCharge && status == statusAvailable {
+				c.charge(ctx, protocol.IdToken(c.cp.conf.IdTag), false)
+			}
+			idle.Reset(c.idle())
+		}
+	}
+}
+
+func (c *connector) handle(ctx context.Context, cmd command) {
+	defer cmd.complete()
+	switch cmd.typ {
+	case cmdStart:
+		c.charge(ctx, cmd.idTag, true)
+	case cmdAvailability:
+		c.mu.Lock()
+		c.operative = cmd.operative
+		c.mu.Unlock()
+		if cmd.operative {
+			c.setStatus(ctx, statusAvailable)
+		} else {
+			c.setStatus(ctx, statusUnavailable)
+		}
+	case cmdReserve:
+		c.mu.Lock()
+		c.reservationId, c.reservationIdTag, c.reservationExpiry = cmd.reservationId, cmd.idTag, cmd.expiry
+		c.mu.Unlock()
+		c.setStatus(ctx, statusReserved)
+	case cmdCancelReservation:
+		c.mu.Lock()
+		c.reservationId, c.reservationIdTag = 0, ""
+		c.mu.Unlock()
+		c.setStatus(ctx, statusAvailable)
+	case cmdStatus:
+		c.sendStatus(ctx)
+	case cmdMeterValues:
+		c.sendMeterValues(ctx, "Trigger")
+	}
+}
+
+//charge runs one charging session, remote sessions are authorized by the central system already
+func (c *connector) charge(ctx context.Context, idTag protocol.IdToken, remote bool) {
+	c.setStatus(ctx, statusPreparing)
+	if !remote || c.cp.configBool("AuthorizeRemoteTxRequests") {
+		res := &protocol.AuthorizeResponse{}
+		if err := c.cp.call(ctx, protocol.AuthorizeRequest{IdTag: idTag}, res); err != nil || res.IdTagInfo.Status != "Accepted" {
+			log.Infof("id(%s) connector(%d) idTag(%s) not authorized, status(%s), err:(%v)", c.cp.id, c.id, idTag, res.IdTagInfo.Status, err)
+			c.setStatus(ctx, statusAvailable)
+			return
+		}
+	}
+	c.mu.Lock()
+	connectorId, meterStart := c.id, int(c.meter)
+	var reservationId *int
+	if c.reservationId != 0 {
+		id := c.reservationId
+		reservationId = &id
+		c.reservationId, c.reservationIdTag = 0, ""
+	}
+	c.mu.Unlock()
+	res := &protocol.StartTransactionResponse{}
+	err := c.cp.call(ctx, protocol.StartTransactionRequest{
+		ConnectorId:   &connectorId,
+		IdTag:         idTag,
+		MeterStart:    &meterStart,
+		ReservationId: reservationId,
+		Timestamp:     now(),
+	}, res)
+	if err != nil || res.TransactionId == nil {
+		log.Errorf("id(%s) connector(%d) StartTransaction error, err:(%v)", c.cp.id, c.id, err)
+		c.setStatus(ctx, statusAvailable)
+		return
+	}
+	c.mu.Lock()
+	c.transactionId = *res.TransactionId
+	c.mu.Unlock()
+	if res.IdTagInfo.Status != "Accepted" {
+		c.finish(ctx, idTag, "DeAuthorized")
+		return
+	}
+	c.setStatus(ctx, statusCharging)
+	meterTicker := time.NewTicker(c.cp.meterInterval())
+	defer meterTicker.Stop()
+	deadline := time.NewTimer(c.cp.conf.ChargeDuration)
+	defer deadline.Stop()
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			c.interrupt(idTag)
+			return
+		case t := <-meterTicker.C:
+			c.consume(t.Sub(last))
+			last = t
+			c.sendMeterValues(ctx, "Sample.Periodic")
+		case <-deadline.C:
+			c.consume(time.Since(last))
+			c.finish(ctx, idTag, "EVDisconnected")
+			return
+		case cmd := <-c.cmds:
+			if cmd.typ == cmdStop {
+				c.consume(time.Since(last))
+				c.finish(ctx, idTag, cmd.reason)
+				cmd.complete()
+				return
+			}
+			c.handleCharging(ctx, cmd)
+		}
+	}
+}
+
+//handleCharging handles the commands other than stop received while charging
+func (c *connector) handleCharging(ctx context.Context, cmd command) {
+	defer cmd.complete()
+	switch cmd.typ {
+	case cmdAvailability:
+		operative := cmd.operative
+		c.mu.Lock()
+		c.pendingOperative = &operative
+		c.mu.Unlock()
+	case cmdStatus:
+		c.sendStatus(ctx)
+	case cmdMeterValues:
+		c.sendMeterValues(ctx, "Trigger")
+	}
+}
+
+func (c *connector) consume(d time.Duration) {
+	c.mu.Lock()
+	c.meter += c.cp.conf.Power * d.Hours()
+	c.mu.Unlock()
+}
+
+func (c *connector) stopRequest(idTag protocol.IdToken, reason protocol.Reason) protocol.StopTransactionRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	meterStop, transactionId := int(c.meter), c.transactionId
+	return protocol.StopTransactionRequest{
+		IdTag:         idTag,
+		MeterStop:     &meterStop,
+		Timestamp:     now(),
+		TransactionId: &transactionId,
+		Reason:        reason,
+	}
+}
+
+//finish stops the transaction, the connector is Available again once the driver unplugs
+func (c *connector) finish(ctx context.Context, idTag protocol.IdToken, reason protocol.Reason) {
+	req := c.stopRequest(idTag, reason)
+	c.cp.notify(ctx, req)
+	c.mu.Lock()
+	c.transactionId = 0
+	c.mu.Unlock()
+	c.setStatus(ctx, statusFinishing)
+	select {
+	case <-ctx.Done():
+	case <-time.After(c.cp.conf.StepDelay):
+	}
+	c.mu.Lock()
+	if c.pendingOperative != nil {
+		c.operative = *c.pendingOperative
+		c.pendingOperative = nil
+	}
+	operative := c.operative
+	c.mu.Unlock()
+	if operative {
+		c.setStatus(ctx, statusAvailable)
+	} else {
+		c.setStatus(ctx, statusUnavailable)
+	}
+}
+
+//interrupt keeps StopTransaction of a transaction cut by a lost connection, it is sent after the reconnection
+func (c *connector) interrupt(idTag protocol.IdToken) {
+	req := c.stopRequest(idTag, "PowerLoss")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pendingStop = &req
+	c.transactionId = 0
+	c.status = statusAvailable
+}
+
+func (c *connector) sendMeterValues(ctx context.Context, readingContext protocol.ReadingContext) {
+	c.mu.Lock()
+	connectorId, meter, transactionId := c.id, c.meter, c.transactionId
+	c.mu.Unlock()
+	power := 0.0
+	if transactionId != 0 {
+		power = c.cp.conf.Power * (0.95 + 0.1*rand.Float64())
+	}
+	sampledValues := []protocol.SampledValue{
+		{Value: strconv.Itoa(int(meter)), Context: readingContext, Measurand: "Energy.Active.Import.Register", Unit: "Wh"},
+		{Value: strconv.FormatFloat(power, 'f', 1, 64), Context: readingContext, Measurand: "Power.Active.Import", Unit: "W"},
+		{Value: strconv.FormatFloat(power/voltage, 'f', 1, 64), Context: readingContext, Measurand: "Current.Import", Unit: "A"},
+		{Value: strconv.FormatFloat(voltage, 'f', 1, 64), Context: readingContext, Measurand: "Voltage", Unit: "V"},
+	}
+	req := protocol.MeterValuesRequest{
+		ConnectorId: &connectorId,
+		MeterValue:  []protocol.MeterValue{{TimeStamp: now(), SampledValue: sampledValues}},
+	}
+	if transactionId != 0 {
+		req.TransactionId = &transactionId
+	}
+	c.cp.notify(ctx, req)
+}
+
+func now() string {
+	return time.Now().UTC().Format(protocol.ISO8601)
+}
@@ -0,0 +1,467 @@
+package simulator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"ocpp16/protocol"
+	"strconv"
+	"time"
+)
+
+//handler answers a call of the central system, after is run once the answer is sent
+type handler func(ctx context.Context, cp *ChargePoint, req protocol.Request) (res protocol.Response, after func())
+
+var handlers map[string]handler
+
+func init() {
+	handlers = map[string]handler{
+		protocol.CancelReservationName:          cancelReservation,
+		protocol.ChangeAvailabilityName:         changeAvailability,
+		protocol.ChangeConfigurationName:        changeConfiguration,
+		protocol.ClearCacheName:                 clearCache,
+		protocol.ClearChargingProfileName:       clearChargingProfile,
+		protocol.DataTransferName:               dataTransfer,
+		protocol.GetCompositeScheduleName:       getCompositeSchedule,
+		protocol.GetConfigurationName:           getConfiguration,
+		protocol.GetDiagnosticsName:             getDiagnostics,
+		protocol.GetLocalListVersionName:        getLocalListVersion,
+		protocol.RemoteStartTransactionName:     remoteStartTransaction,
+		protocol.RemoteStopTransactionName:      remoteStopTransaction,
+		protocol.ReserveNowName:                 reserveNow,
+		protocol.ResetName:                      reset,
+		protocol.SendLocalListName:              sendLocalList,
+		protocol.SetChargingProfileName:         setChargingProfile,
+		protocol.TriggerMessageName:             triggerMessage,
+		protocol.UnlockConnectorName:            unlockConnector,
+		protocol.UpdateFirmwareName:             updateFirmware,
+		protocol.CertificateSignedName:          certificateSigned,
+		protocol.InstallCertificateName:         installCertificate,
+		protocol.DeleteCertificateName:          deleteCertificate,
+		protocol.GetInstalledCertificateIdsName: getInstalledCertificateIds,
+		protocol.GetLogName:                     getLog,
+		protocol.SignedUpdateFirmwareName:       signedUpdateFirmware,
+		protocol.ExtendedTriggerMessageName:     extendedTriggerMessage,
+	}
+}
+
+//Core
+
+func changeAvailability(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ChangeAvailabilityRequest)
+	targets := cp.connectors[1:]
+	if *req.ConnectorId != 0 {
+		c, ok := cp.connector(*req.ConnectorId)
+		if !ok {
+			return &protocol.ChangeAvailabilityResponse{Status: protocol.AvailabilityStatusRejected}, nil
+		}
+		targets = []*connector{c}
+	}
+	status := protocol.AvailabilityStatusAccepted
+	for _, c := range targets {
+		if _, transactionId := c.snapshot(); transactionId != 0 {
+			status = protocol.AvailabilityStatusScheduled
+		}
+	}
+	operative := req.Type == protocol.AvailabilityTypeOperative
+	return &protocol.ChangeAvailabilityResponse{Status: status}, func() {
+		for _, c := range targets {
+			c.post(ctx, command{typ: cmdAvailability, operative: operative})
+		}
+	}
+}
+
+func changeConfiguration(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ChangeConfigurationRequest)
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	v, ok := cp.configuration[req.Key]
+	if !ok {
+		return &protocol.ChangeConfigurationResponse{Status: "NotSupported"}, nil
+	}
+	if v.readonly || (v.validate != nil && !v.validate(req.Value)) {
+		return &protocol.ChangeConfigurationResponse{Status: "Rejected"}, nil
+	}
+	v.value = req.Value
+	if req.Key == "HeartbeatInterval" {
+		seconds, _ := strconv.Atoi(req.Value)
+		if seconds > 0 {
+			cp.heartbeatInterval = time.Duration(seconds) * time.Second
+		}
+	}
+	return &protocol.ChangeConfigurationResponse{Status: "Accepted"}, nil
+}
+
+func getConfiguration(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.GetConfigurationRequest)
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	keys := req.Key
+	if len(keys) == 0 {
+		keys = cp.configurationKeys()
+	}
+	res := &protocol.GetConfigurationResponse{}
+	for _, key := range keys {
+		v, ok := cp.configuration[key]
+		if !ok {
+			res.UnknownKey = append(res.UnknownKey, key)
+			continue
+		}
+		readonly := v.readonly
+		res.ConfigurationKey = append(res.ConfigurationKey, protocol.ConfigurationKey{Key: key, Readonly: &readonly, Value: v.value})
+	}
+	return res, nil
+}
+
+func clearCache(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.ClearCacheResponse{Status: protocol.ClearCacheStatusAccepted}, nil
+}
+
+func dataTransfer(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.DataTransferResponse{Status: "UnknownVendorId"}, nil
+}
+
+func remoteStartTransaction(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.RemoteStartTransactionRequest)
+	var target *connector
+	for _, c := range cp.connectors[1:] {
+		if req.ConnectorId != nil && *req.ConnectorId != c.id {
+			continue
+		}
+		c.mu.Lock()
+		free := c.status == statusAvailable || (c.status == statusReserved && c.reservationIdTag == req.IdTag)
+		c.mu.Unlock()
+		if free {
+			target = c
+			break
+		}
+	}
+	if target == nil {
+		return &protocol.RemoteStartTransactionResponse{Status: "Rejected"}, nil
+	}
+	return &protocol.RemoteStartTransactionResponse{Status: "Accepted"}, func() {
+		target.post(ctx, command{typ: cmdStart, idTag: req.IdTag})
+	}
+}
+
+func remoteStopTransaction(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.RemoteStopTransactionRequest)
+	for _, c := range cp.connectors[1:] {
+		if _, transactionId := c.snapshot(); transactionId == *req.TransactionId {
+			return &protocol.RemoteStopTransactionResponse{Status: "Accepted"}, func() {
+				c.stop(ctx, "Remote")
+			}
+		}
+	}
+	return &protocol.RemoteStopTransactionResponse{Status: "Rejected"}, nil
+}
+
+func reset(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ResetRequest)
+	return &protocol.ResetResponse{Status: "Accepted"}, func() {
+		cp.reset(req.Type)
+	}
+}
+
+func unlockConnector(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.UnlockConnectorRequest)
+	c, ok := cp.connector(*req.ConnectorId)
+	if !ok || c.id == 0 {
+		return &protocol.UnlockConnectorResponse{Status: "NotSupported"}, nil
+	}
+	return &protocol.UnlockConnectorResponse{Status: "Unlocked"}, func() {
+		c.stop(ctx, "UnlockCommand")
+	}
+}
+
+//FirmwareManagement
+
+func updateFirmware(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.UpdateFirmwareResponse{}, func() {
+		for _, status := range []protocol.FirmwareStatus{
+			protocol.FirmwareStatusDownloading,
+			protocol.FirmwareStatusDownloaded,
+			protocol.FirmwareStatusInstalling,
+			protocol.FirmwareStatusInstalled,
+		} {
+			if !cp.sleep(ctx) {
+				return
+			}
+			cp.notify(ctx, protocol.FirmwareStatusNotificationRequest{Status: status})
+		}
+	}
+}
+
+func getDiagnostics(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	fileName := fmt.Sprintf("%s-diagnostics-%d.log", cp.id, time.Now().Unix())
+	return &protocol.GetDiagnosticsResponse{FileName: fileName}, func() {
+		for _, status := range []protocol.DiagnosticsStatus{protocol.DiagnosticsStatusUploading, protocol.DiagnosticsStatusUploaded} {
+			if !cp.sleep(ctx) {
+				return
+			}
+			cp.notify(ctx, protocol.DiagnosticsStatusNotificationRequest{Status: status})
+		}
+	}
+}
+
+//LocalAuthListManagement
+
+func getLocalListVersion(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	version := cp.localListVersion
+	return &protocol.GetLocalListVersionResponse{ListVersion: &version}, nil
+}
+
+func sendLocalList(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.SendLocalListRequest)
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	//the parts of a list longer than SendLocalListMaxLength share its version
+	if req.UpdateType == protocol.UpdateTypeDifferential && *req.ListVersion < cp.localListVersion {
+		return &protocol.SendLocalListResponse{Status: protocol.UpdateStatusVersionMismatch}, nil
+	}
+	cp.localListVersion = *req.ListVersion
+	return &protocol.SendLocalListResponse{Status: protocol.UpdateStatusAccepted}, nil
+}
+
+//Reservation
+
+func reserveNow(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ReserveNowRequest)
+	c, ok := cp.connector(*req.ConnectorId)
+	if !ok || c.id == 0 {
+		return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusRejected}, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, req.ExpiryDate)
+	if err != nil || expiry.Before(time.Now()) {
+		return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusRejected}, nil
+	}
+	switch status, _ := c.snapshot(); status {
+	case statusAvailable, statusReserved:
+	case statusUnavailable:
+		return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusUnavailable}, nil
+	default:
+		return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusOccupied}, nil
+	}
+	return &protocol.ReserveNowResponse{Status: protocol.ReservationStatusAccepted}, func() {
+		c.post(ctx, command{typ: cmdReserve, idTag: protocol.IdToken(req.IdTag), reservationId: *req.ReservationId, expiry: expiry})
+	}
+}
+
+func cancelReservation(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.CancelReservationRequest)
+	for _, c := range cp.connectors[1:] {
+		c.mu.Lock()
+		found := c.status == statusReserved && c.reservationId == *req.ReservationId
+		c.mu.Unlock()
+		if found {
+			return &protocol.CancelReservationResponse{Status: protocol.CancelReservationStatusAccepted}, func() {
+				c.post(ctx, command{typ: cmdCancelReservation})
+			}
+		}
+	}
+	return &protocol.CancelReservationResponse{Status: protocol.CancelReservationStatusRejected}, nil
+}
+
+//SmartCharging
+
+func setChargingProfile(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.SetChargingProfileRequest)
+	if _, ok := cp.connector(*req.ConnectorId); !ok {
+		return &protocol.SetChargingProfileResponse{Status: "Rejected"}, nil
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.profiles[*req.ChargingProfile.ChargingProfileId] = *req.ConnectorId
+	return &protocol.SetChargingProfileResponse{Status: "Accepted"}, nil
+}
+
+func clearChargingProfile(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ClearChargingProfileRequest)
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	status := protocol.ClearChargingProfileStatusUnknown
+	for id, connectorId := range cp.profiles {
+		if (req.Id != nil && *req.Id != id) || (req.ConnectorId != nil && *req.ConnectorId != connectorId) {
+			continue
+		}
+		delete(cp.profiles, id)
+		status = protocol.ClearChargingProfileStatusAccepted
+	}
+	return &protocol.ClearChargingProfileResponse{Status: status}, nil
+}
+
+func getCompositeSchedule(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.GetCompositeScheduleRequest)
+	if _, ok := cp.connector(*req.ConnectorId); !ok {
+		return &protocol.GetCompositeScheduleResponse{Status: protocol.GetCompositeScheduleStatusRejected}, nil
+	}
+	unit, limit := req.ChargingRateUnit, cp.conf.Power
+	if unit == "A" || unit == "" {
+		unit, limit = "A", cp.conf.Power/voltage
+	}
+	duration, startPeriod := *req.Duration, 0
+	res := &protocol.GetCompositeScheduleResponse{
+		Status:        protocol.GetCompositeScheduleStatusAccepted,
+		ScheduleStart: now(),
+		ChargingSchedule: protocol.ChargingSchedule{
+			Duration:               &duration,
+			ChargingRateUnit:       unit,
+			ChargingSchedulePeriod: []protocol.ChargingSchedulePeriod{{StartPeriod: &startPeriod, Limit: &limit}},
+		},
+	}
+	if *req.ConnectorId > 0 {
+		res.ConnectorId = req.ConnectorId
+	}
+	return res, nil
+}
+
+//RemoteTrigger
+
+func triggerMessage(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.TriggerMessageRequest)
+	after, ok := cp.trigger(ctx, string(req.RequestedMessage), req.ConnectorId)
+	if !ok {
+		return &protocol.TriggerMessageResponse{Status: protocol.TriggerMessageStatusRejected}, nil
+	}
+	return &protocol.TriggerMessageResponse{Status: protocol.TriggerMessageStatusAccepted}, after
+}
+
+//trigger returns the function sending the requested message
+func (cp *ChargePoint) trigger(ctx context.Context, message string, connectorId *int) (func(), bool) {
+	targets := cp.connectors[1:]
+	if connectorId != nil {
+		c, ok := cp.connector(*connectorId)
+		if !ok {
+			return nil, false
+		}
+		targets = []*connector{c}
+	}
+	switch message {
+	case protocol.BootNotificationName:
+		return func() {
+			cp.notify(ctx, protocol.BootNotificationRequest{ChargePointVendor: cp.conf.Vendor, ChargePointModel: cp.conf.Model, ChargePointSerialNumber: cp.id})
+		}, true
+	case protocol.HeartbeatName:
+		return func() { cp.notify(ctx, protocol.HeartbeatRequest{}) }, true
+	case protocol.DiagnosticsStatusNotificationName:
+		return func() {
+			cp.notify(ctx, protocol.DiagnosticsStatusNotificationRequest{Status: protocol.DiagnosticsStatusIdle})
+		}, true
+	case protocol.FirmwareStatusNotificationName:
+		return func() {
+			cp.notify(ctx, protocol.FirmwareStatusNotificationRequest{Status: protocol.FirmwareStatusIdle})
+		}, true
+	case protocol.LogStatusNotificationName:
+		return func() { cp.notify(ctx, protocol.LogStatusNotificationRequest{Status: protocol.UploadLogStatusIdle}) }, true
+	case protocol.StatusNotificationName:
+		if connectorId == nil {
+			targets = cp.connectors
+		}
+		return func() {
+			for _, c := range targets {
+				c.post(ctx, command{typ: cmdStatus})
+			}
+		}, true
+	case protocol.MeterValuesName:
+		return func() {
+			for _, c := range targets {
+				c.post(ctx, command{typ: cmdMeterValues})
+			}
+		}, true
+	case string(protocol.SignChargePointCertificateTrigger):
+		return func() { cp.signCertificate(ctx) }, true
+	}
+	return nil, false
+}
+
+//Security
+
+func certificateSigned(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.CertificateSignedResponse{Status: protocol.CertificateSignedStatusAccepted}, nil
+}
+
+func installCertificate(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.InstallCertificateResponse{Status: protocol.CertificateStatusAccepted}, nil
+}
+
+func deleteCertificate(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.DeleteCertificateResponse{Status: protocol.DeleteCertificateStatusNotFound}, nil
+}
+
+func getInstalledCertificateIds(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	return &protocol.GetInstalledCertificateIdsResponse{Status: protocol.GetInstalledCertificateStatusNotFound}, nil
+}
+
+func getLog(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.GetLogRequest)
+	fileName := fmt.Sprintf("%s-%s-%d.log", cp.id, req.LogType, time.Now().Unix())
+	return &protocol.GetLogResponse{Status: protocol.LogStatusAccepted, Filename: fileName}, func() {
+		for _, status := range []protocol.UploadLogStatus{protocol.UploadLogStatusUploading, protocol.UploadLogStatusUploaded} {
+			if !cp.sleep(ctx) {
+				return
+			}
+			cp.notify(ctx, protocol.LogStatusNotificationRequest{Status: status, RequestId: req.RequestId})
+		}
+	}
+}
+
+func signedUpdateFirmware(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.SignedUpdateFirmwareRequest)
+	return &protocol.SignedUpdateFirmwareResponse{Status: protocol.UpdateFirmwareStatusAccepted}, func() {
+		for _, status := range []protocol.SignedFirmwareStatus{
+			protocol.SignedFirmwareStatusDownloading,
+			protocol.SignedFirmwareStatusDownloaded,
+			protocol.SignedFirmwareStatusSignatureVerified,
+			protocol.SignedFirmwareStatusInstalling,
+			protocol.SignedFirmwareStatusInstalled,
+		} {
+			if !cp.sleep(ctx) {
+				return
+			}
+			cp.notify(ctx, protocol.SignedFirmwareStatusNotificationRequest{Status: status, RequestId: req.RequestId})
+		}
+	}
+}
+
+func extendedTriggerMessage(ctx context.Context, cp *ChargePoint, request protocol.Request) (protocol.Response, func()) {
+	req := request.(protocol.ExtendedTriggerMessageRequest)
+	after, ok := cp.trigger(ctx, string(req.RequestedMessage), req.ConnectorId)
+	if !ok {
+		return &protocol.ExtendedTriggerMessageResponse{Status: protocol.TriggerMessageStatusRejected}, nil
+	}
+	return &protocol.ExtendedTriggerMessageResponse{Status: protocol.TriggerMessageStatusAccepted}, after
+}
+
+//signCertificate sends a certificate signing request of a fresh key, the common name is the charging point id
+func (cp *ChargePoint) signCertificate(ctx context.Context) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Errorf("id(%s) generate key error, err:(%v)", cp.id, err)
+		return
+	}
+	template := &x509.CertificateRequest{Subject: pkix.Name{CommonName: cp.id, Organization: []string{cp.conf.Vendor}}}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		log.Errorf("id(%s) create csr error, err:(%v)", cp.id, err)
+		return
+	}
+	csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	cp.notify(ctx, protocol.SignCertificateRequest{Csr: string(csr)})
+}
+
+//sleep waits StepDelay, it returns false when ctx is done
+func (cp *ChargePoint) sleep(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(cp.conf.StepDelay):
+		return true
+	}
+}
@@ -0,0 +1,111 @@
+//Package simulator emulates ocpp1.6 charging points, so that a central system can be tested without hardware
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+var log logger = logrus.StandardLogger()
+
+func SetLogger(logger logger) {
+	log = logger
+}
+
+//Config describes the simulated charging points, zero values are replaced by the defaults
+type Config struct {
+	URL            string        //service uri of the central system without the charging point id, e.g. ws://127.0.0.1:8090/ocpp/<uuid>
+	Count          int           //number of charging points
+	Connectors     int           //connectors per charging point
+	IDPrefix       string        //the id of a charging point is IDPrefix followed by its index
+	Password       string        //http basic auth password, empty for none
+	IdTag          string        //the id tag presented by the simulated drivers
+	Vendor         string        //chargePointVendor of the BootNotification
+	Model          string        //chargePointModel of the BootNotification
+	AutoCharge     bool          //whether the drivers start charging sessions on their own
+	Idle           time.Duration //mean idle time of a connector between two sessions
+	ChargeDuration time.Duration //duration of a charging session
+	MeterInterval  time.Duration //MeterValueSampleInterval
+	Power          float64       //charging power in W
+	StepDelay      time.Duration //delay between the notifications of firmware updates, diagnostics and log uploads
+	ReconnectDelay time.Duration
+}
+
+func (c *Config) setDefaults() {
+	if c.Count <= 0 {
+		c.Count = 1
+	}
+	if c.Connectors <= 0 {
+		c.Connectors = 1
+	}
+	if c.IDPrefix == "" {
+		c.IDPrefix = "SIM"
+	}
+	if c.IdTag == "" {
+		c.IdTag = "SIMTAG0001"
+	}
+	if c.Vendor == "" {
+		c.Vendor = "ocpp16"
+	}
+	if c.Model == "" {
+		c.Model = "simulator"
+	}
+	if c.Idle <= 0 {
+		c.Idle = time.Minute
+	}
+	if c.ChargeDuration <= 0 {
+		c.ChargeDuration = 5 * time.Minute
+	}
+	if c.MeterInterval <= 0 {
+		c.MeterInterval = 30 * time.Second
+	}
+	if c.Power <= 0 {
+		c.Power = 11000
+	}
+	if c.StepDelay <= 0 {
+		c.StepDelay = time.Second
+	}
+	if c.ReconnectDelay <= 0 {
+		c.ReconnectDelay = 5 * time.Second
+	}
+}
+
+//ID returns the id of the charging point with index i
+func (c *Config) ID(i int) string {
+	return fmt.Sprintf("%s%04d", c.IDPrefix, i)
+}
+
+func (c *Config) pointURL(id string) string {
+	return strings.TrimRight(c.URL, "/") + "/" + id
+}
+
+//Run starts the charging points and blocks until ctx is done
+func Run(ctx context.Context, conf Config) error {
+	if conf.URL == "" {
+		return fmt.Errorf("simulator url required")
+	}
+	conf.setDefaults()
+	var wg sync.WaitGroup
+	for i := 1; i <= conf.Count; i++ {
+		cp := NewChargePoint(conf.ID(i), &conf)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cp.Run(ctx)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
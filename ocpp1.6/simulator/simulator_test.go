@@ -0,0 +1,228 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"ocpp16/protocol"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type message struct {
+	action  string
+	payload json.RawMessage
+}
+
+//centralSystem is a minimal central system recording the calls of one charging point
+type centralSystem struct {
+	t        *testing.T
+	server   *httptest.Server
+	calls    chan message
+	results  chan json.RawMessage
+	mu       sync.Mutex
+	conn     *websocket.Conn
+	id       string
+	sequence int
+}
+
+func newCentralSystem(t *testing.T) *centralSystem {
+	cs := &centralSystem{t: t, calls: make(chan message, 256), results: make(chan json.RawMessage, 16)}
+	upgrader := websocket.Upgrader{Subprotocols: []string{"ocpp1.6"}}
+	cs.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		cs.mu.Lock()
+		cs.conn, cs.id = conn, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		cs.mu.Unlock()
+		go cs.serve(conn)
+	}))
+	return cs
+}
+
+func (cs *centralSystem) url() string {
+	return "ws" + strings.TrimPrefix(cs.server.URL, "http") + "/ocpp/test"
+}
+
+func (cs *centralSystem) serve(conn *websocket.Conn) {
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var fields []json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			cs.t.Error(err)
+			return
+		}
+		var messageType protocol.MessageType
+		var uniqueid, action string
+		json.Unmarshal(fields[0], &messageType)
+		json.Unmarshal(fields[1], &uniqueid)
+		if messageType != protocol.CALL {
+			cs.results <- json.RawMessage(data)
+			continue
+		}
+		json.Unmarshal(fields[2], &action)
+		cs.calls <- message{action: action, payload: fields[3]}
+		var res string
+		switch action {
+		case protocol.BootNotificationName:
+			res = fmt.Sprintf(`{"status":"Accepted","currentTime":"%s","interval":300}`, now())
+		case protocol.HeartbeatName:
+			res = fmt.Sprintf(`{"currentTime":"%s"}`, now())
+		case protocol.AuthorizeName, protocol.StopTransactionName:
+			res = `{"idTagInfo":{"status":"Accepted"}}`
+		case protocol.StartTransactionName:
+			res = `{"idTagInfo":{"status":"Accepted"},"transactionId":42}`
+		default:
+			res = `{}`
+		}
+		cs.mu.Lock()
+		conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`[3,"%s",%s]`, uniqueid, res)))
+		cs.mu.Unlock()
+	}
+}
+
+//call sends a call to the charging point and returns its raw answer
+func (cs *centralSystem) call(action string, payload string) json.RawMessage {
+	cs.mu.Lock()
+	cs.sequence++
+	err := cs.conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`[2,"cs%d","%s",%s]`, cs.sequence, action, payload)))
+	cs.mu.Unlock()
+	if err != nil {
+		cs.t.Fatal(err)
+	}
+	select {
+	case res := <-cs.results:
+		return res
+	case <-time.After(5 * time.Second):
+		cs.t.Fatalf("%s not answered", action)
+	}
+	return nil
+}
+
+//expect waits for the call action, whose payload satisfies match if any
+func (cs *centralSystem) expect(action string, match func(payload json.RawMessage) bool) json.RawMessage {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case m := <-cs.calls:
+			if m.action == action && (match == nil || match(m.payload)) {
+				return m.payload
+			}
+		case <-timeout:
+			cs.t.Fatalf("%s not received", action)
+		}
+	}
+}
+
+func connectorStatus(connectorId int, status protocol.ChargePointStatus) func(payload json.RawMessage) bool {
+	return func(payload json.RawMessage) bool {
+		req := protocol.StatusNotificationRequest{}
+		json.Unmarshal(payload, &req)
+		return *req.ConnectorId == connectorId && req.Status == status
+	}
+}
+
+func TestAutoCharge(t *testing.T) {
+	cs := newCentralSystem(t)
+	defer cs.server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Run(ctx, Config{
+		URL:            cs.url(),
+		AutoCharge:     true,
+		Idle:           50 * time.Millisecond,
+		ChargeDuration: 300 * time.Millisecond,
+		MeterInterval:  100 * time.Millisecond,
+		Power:          1000000,
+		StepDelay:      10 * time.Millisecond,
+	})
+	cs.expect(protocol.BootNotificationName, nil)
+	cs.expect(protocol.StatusNotificationName, connectorStatus(1, statusAvailable))
+	cs.expect(protocol.StatusNotificationName, connectorStatus(1, statusPreparing))
+	cs.expect(protocol.AuthorizeName, nil)
+	start := protocol.StartTransactionRequest{}
+	json.Unmarshal(cs.expect(protocol.StartTransactionName, nil), &start)
+	if start.IdTag != "SIMTAG0001" || *start.ConnectorId != 1 {
+		t.Fatalf("unexpected StartTransaction %+v", start)
+	}
+	cs.expect(protocol.StatusNotificationName, connectorStatus(1, statusCharging))
+	cs.expect(protocol.MeterValuesName, nil)
+	stop := protocol.StopTransactionRequest{}
+	json.Unmarshal(cs.expect(protocol.StopTransactionName, nil), &stop)
+	if *stop.TransactionId != 42 || *stop.MeterStop <= *start.MeterStart || stop.Reason != "EVDisconnected" {
+		t.Fatalf("unexpected StopTransaction %+v", stop)
+	}
+	cs.expect(protocol.StatusNotificationName, connectorStatus(1, statusFinishing))
+	cs.expect(protocol.StatusNotificationName, connectorStatus(1, statusAvailable))
+	if cs.id != "SIM0001" {
+		t.Fatalf("unexpected charging point id %s", cs.id)
+	}
+}
+
+func TestCentralSystemCalls(t *testing.T) {
+	cs := newCentralSystem(t)
+	defer cs.server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go Run(ctx, Config{
+		URL:            cs.url(),
+		Connectors:     2,
+		ChargeDuration: time.Hour,
+		StepDelay:      10 * time.Millisecond,
+	})
+	cs.expect(protocol.BootNotificationName, nil)
+	cs.expect(protocol.StatusNotificationName, connectorStatus(2, statusAvailable))
+
+	res := cs.call(protocol.GetConfigurationName, `{"key":["NumberOfConnectors","Foo"]}`)
+	if !strings.Contains(string(res), `"key":"NumberOfConnectors","readonly":true,"value":"2"`) || !strings.Contains(string(res), `"unknownKey":["Foo"]`) {
+		t.Fatalf("unexpected GetConfiguration answer %s", res)
+	}
+	res = cs.call(protocol.ChangeConfigurationName, `{"key":"NumberOfConnectors","value":"3"}`)
+	if !strings.Contains(string(res), `"Rejected"`) {
+		t.Fatalf("unexpected ChangeConfiguration answer %s", res)
+	}
+
+	res = cs.call(protocol.RemoteStartTransactionName, `{"connectorId":2,"idTag":"REMOTE"}`)
+	if !strings.Contains(string(res), `"Accepted"`) {
+		t.Fatalf("unexpected RemoteStartTransaction answer %s", res)
+	}
+	cs.expect(protocol.StartTransactionName, nil)
+	cs.expect(protocol.StatusNotificationName, connectorStatus(2, statusCharging))
+	res = cs.call(protocol.RemoteStartTransactionName, `{"connectorId":2,"idTag":"REMOTE"}`)
+	if !strings.Contains(string(res), `"Rejected"`) {
+		t.Fatalf("unexpected RemoteStartTransaction answer %s", res)
+	}
+	res = cs.call(protocol.RemoteStopTransactionName, `{"transactionId":42}`)
+	if !strings.Contains(string(res), `"Accepted"`) {
+		t.Fatalf("unexpected RemoteStopTransaction answer %s", res)
+	}
+	stop := protocol.StopTransactionRequest{}
+	json.Unmarshal(cs.expect(protocol.StopTransactionName, nil), &stop)
+	if stop.Reason != "Remote" {
+		t.Fatalf("unexpected StopTransaction %+v", stop)
+	}
+
+	res = cs.call(protocol.TriggerMessageName, `{"requestedMessage":"Heartbeat"}`)
+	if !strings.Contains(string(res), `"Accepted"`) {
+		t.Fatalf("unexpected TriggerMessage answer %s", res)
+	}
+	cs.expect(protocol.HeartbeatName, nil)
+
+	res = cs.call(protocol.ResetName, `{"type":"Soft"}`)
+	if !strings.Contains(string(res), `"Accepted"`) {
+		t.Fatalf("unexpected Reset answer %s", res)
+	}
+	cs.expect(protocol.BootNotificationName, nil)
+}
@@ -0,0 +1,179 @@
+package smartcharging
+
+import (
+	"fmt"
+	"net/http"
+	"ocpp16/protocol"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//the duration of the composite schedules when the query has none
+const defaultDuration = 24 * time.Hour
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+type compositeReply struct {
+	ConnectorID      int                       `json:"connectorId"`
+	ScheduleStart    time.Time                 `json:"scheduleStart"`
+	ChargingSchedule protocol.ChargingSchedule `json:"chargingSchedule"`
+}
+
+//RegisterAPI serves the profiles on r, e.g. the group of the rest api:
+//
+//	GET    /chargingprofiles/:id            the profiles installed on the charging point and its transactions
+//	POST   /chargingprofiles/:id            sends the SetChargingProfileRequest of the body, replies its status
+//	DELETE /chargingprofiles/:id            sends ClearChargingProfile, ?id=&connectorId=&chargingProfilePurpose=&stackLevel=
+//	GET    /chargingprofiles/:id/composite  the computed schedule, ?connectorId=&duration=&chargingRateUnit=&start=
+//	POST   /chargingprofiles/:id/preview    the computed schedule with the SetChargingProfileRequest of the body installed
+//	GET    /chargingprofiles/:id/check      the schedule reported by the charging point and its mismatches with the
+//	                                        computed one, ?connectorId=&duration=&chargingRateUnit=
+//
+//the durations are in seconds, 86400 if empty, start is an RFC3339 time, now if empty
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/chargingprofiles/:id", m.list)
+	r.POST("/chargingprofiles/:id", m.set)
+	r.DELETE("/chargingprofiles/:id", m.clear)
+	r.GET("/chargingprofiles/:id/composite", m.composited)
+	r.POST("/chargingprofiles/:id/preview", m.preview)
+	r.GET("/chargingprofiles/:id/check", m.check)
+}
+
+func optionalInt(c *gin.Context, name string) (*int, error) {
+	v := c.Query(name)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid %s(%s)", name, v)
+	}
+	return &n, nil
+}
+
+//window parses the connector, the start, the duration and the unit of a composite schedule
+func (m *Manager) window(c *gin.Context) (connectorID int, start time.Time, duration time.Duration, unit protocol.ChargingRateUnitType, err error) {
+	start, duration, unit = m.now(), defaultDuration, protocol.ChargingRateUnitType(c.Query("chargingRateUnit"))
+	if unit != "" && unit != UnitA && unit != UnitW {
+		return 0, start, 0, "", fmt.Errorf("invalid chargingRateUnit(%s)", unit)
+	}
+	n, err := optionalInt(c, "connectorId")
+	if err != nil {
+		return 0, start, 0, "", err
+	}
+	if n != nil {
+		connectorID = *n
+	}
+	if n, err = optionalInt(c, "duration"); err != nil {
+		return 0, start, 0, "", err
+	}
+	if n != nil {
+		duration = time.Duration(*n) * time.Second
+	}
+	if v := c.Query("start"); v != "" {
+		if start, err = time.Parse(time.RFC3339, v); err != nil {
+			return 0, start, 0, "", fmt.Errorf("invalid start(%s)", v)
+		}
+	}
+	return connectorID, start, duration, unit, nil
+}
+
+func (m *Manager) list(c *gin.Context) {
+	id := c.Param("id")
+	profiles := m.Profiles(id)
+	if profiles == nil {
+		profiles = []Installed{}
+	}
+	c.JSON(http.StatusOK, gin.H{"profiles": profiles, "transactions": m.Transactions(id)})
+}
+
+func bindProfile(c *gin.Context) (int, protocol.ChargingProfile, bool) {
+	req := protocol.SetChargingProfileRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil || req.ConnectorId == nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid SetChargingProfileRequest, %v", err)})
+		return 0, req.ChargingProfile, false
+	}
+	if err := validate(*req.ConnectorId, &req.ChargingProfile); err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return 0, req.ChargingProfile, false
+	}
+	return *req.ConnectorId, req.ChargingProfile, true
+}
+
+func (m *Manager) set(c *gin.Context) {
+	connectorID, profile, ok := bindProfile(c)
+	if !ok {
+		return
+	}
+	status, err := m.Set(c.Request.Context(), c.Param("id"), connectorID, profile)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, protocol.SetChargingProfileResponse{Status: status})
+}
+
+func (m *Manager) clear(c *gin.Context) {
+	req := protocol.ClearChargingProfileRequest{ChargingProfilePurpose: protocol.ChargingProfilePurposeType(c.Query("chargingProfilePurpose"))}
+	var err error
+	for name, n := range map[string]**int{"id": &req.Id, "connectorId": &req.ConnectorId, "stackLevel": &req.StackLevel} {
+		if *n, err = optionalInt(c, name); err != nil {
+			c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+			return
+		}
+	}
+	status, err := m.Clear(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, protocol.ClearChargingProfileResponse{Status: status})
+}
+
+func (m *Manager) composited(c *gin.Context) {
+	connectorID, start, duration, unit, err := m.window(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, compositeReply{ConnectorID: connectorID, ScheduleStart: start, ChargingSchedule: m.Composite(c.Param("id"), connectorID, start, duration, unit)})
+}
+
+func (m *Manager) preview(c *gin.Context) {
+	_, start, duration, unit, err := m.window(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	connectorID, profile, ok := bindProfile(c)
+	if !ok {
+		return
+	}
+	schedule, err := m.Preview(c.Param("id"), connectorID, profile, start, duration, unit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, compositeReply{ConnectorID: connectorID, ScheduleStart: start, ChargingSchedule: schedule})
+}
+
+func (m *Manager) check(c *gin.Context) {
+	connectorID, _, duration, unit, err := m.window(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	check, err := m.Check(c.Request.Context(), c.Param("id"), connectorID, duration, unit)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, errorReply{Error: err.Error()})
+		return
+	}
+	if check.Mismatches == nil {
+		check.Mismatches = []Mismatch{}
+	}
+	c.JSON(http.StatusOK, check)
+}
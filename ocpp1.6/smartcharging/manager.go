@@ -0,0 +1,288 @@
+package smartcharging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//Server is the part of *ocpp16server.Server the manager needs
+type Server interface {
+	Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error)
+}
+
+type Config struct {
+	Path    string        //json file of the installed profiles, in memory only if empty
+	Voltage float64       //V between a phase and neutral, 230 if 0
+	Phases  int           //of the periods without numberPhases, 3 if 0
+	Timeout time.Duration //of a call to a charging point, 30s if 0
+}
+
+//Check is a composite schedule reported by a charging point and the one computed for the same start
+type Check struct {
+	Expected   protocol.ChargingSchedule `json:"expected"`
+	Actual     protocol.ChargingSchedule `json:"actual"`
+	Mismatches []Mismatch                `json:"mismatches"`
+}
+
+type Manager struct {
+	Calculator
+	server  Server
+	conf    Config
+	mu      sync.Mutex
+	points  map[string]*chargePoint
+	now     func() time.Time
+	onError func(id string, err error)
+}
+
+//NewManager loads the profiles of conf.Path. The profiles are known to the manager when they are installed and
+//cleared through it, not through the other active plugins
+func NewManager(s Server, conf Config) (*Manager, error) {
+	if conf.Voltage <= 0 {
+		conf.Voltage = 230
+	}
+	if conf.Phases <= 0 {
+		conf.Phases = 3
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = 30 * time.Second
+	}
+	m := &Manager{Calculator: Calculator{Voltage: conf.Voltage, Phases: conf.Phases}, server: s, conf: conf, points: make(map[string]*chargePoint), now: time.Now}
+	if conf.Path == "" {
+		return m, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(conf.Path), 0755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(conf.Path)
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &m.points); err != nil {
+		return nil, fmt.Errorf("charging profile file(%s) corrupted, err(%v)", conf.Path, err)
+	}
+	for _, cp := range m.points {
+		if cp.Transactions == nil {
+			cp.Transactions = make(map[int]Transaction)
+		}
+	}
+	return m, nil
+}
+
+//save writes the profiles to conf.Path, m.mu is held
+func (m *Manager) save() error {
+	if m.conf.Path == "" {
+		return nil
+	}
+	data, err := json.Marshal(m.points)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(m.conf.Path, data)
+}
+
+//update changes the state of the charging point id with fn and saves it. m.mu is held
+func (m *Manager) update(id string, fn func(cp *chargePoint) error) error {
+	cp, ok := m.points[id]
+	if !ok {
+		cp = newChargePoint()
+	}
+	next := cp.clone()
+	if err := fn(next); err != nil {
+		return err
+	}
+	m.points[id] = next
+	return m.save()
+}
+
+//state returns a copy of the state of the charging point id
+func (m *Manager) state(id string) *chargePoint {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cp, ok := m.points[id]; ok {
+		return cp.clone()
+	}
+	return newChargePoint()
+}
+
+//SetErrorHandler gets the errors of saving the transactions learnt through the plugin returned by Wrap
+func (m *Manager) SetErrorHandler(fn func(id string, err error)) {
+	m.onError = fn
+}
+
+//Profiles returns the profiles installed on the charging point id
+func (m *Manager) Profiles(id string) []Installed {
+	return m.state(id).Profiles
+}
+
+//Transactions returns the transactions running on the charging point id by connector
+func (m *Manager) Transactions(id string) map[int]Transaction {
+	return m.state(id).Transactions
+}
+
+//Set sends profile to the connector of the charging point id and keeps it when it is Accepted
+func (m *Manager) Set(ctx context.Context, id string, connectorID int, profile protocol.ChargingProfile) (protocol.ChargingProfileStatus, error) {
+	if err := validate(connectorID, &profile); err != nil {
+		return "", err
+	}
+	res, err := ocpp16server.Send(ctx, m.server, id, protocol.SetChargingProfileRequest{ConnectorId: &connectorID, ChargingProfile: profile}, m.conf.Timeout)
+	if err != nil {
+		return "", err
+	}
+	reply, ok := res.(*protocol.SetChargingProfileResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected SetChargingProfile reply %+v", res)
+	}
+	if reply.Status != "Accepted" {
+		return reply.Status, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return reply.Status, m.update(id, func(cp *chargePoint) error { return cp.install(connectorID, profile) })
+}
+
+//Clear sends req to the charging point id and removes the matching profiles, also when it answers Unknown
+func (m *Manager) Clear(ctx context.Context, id string, req protocol.ClearChargingProfileRequest) (protocol.ClearChargingProfileStatus, error) {
+	res, err := ocpp16server.Send(ctx, m.server, id, req, m.conf.Timeout)
+	if err != nil {
+		return "", err
+	}
+	reply, ok := res.(*protocol.ClearChargingProfileResponse)
+	if !ok {
+		return "", fmt.Errorf("unexpected ClearChargingProfile reply %+v", res)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return reply.Status, m.update(id, func(cp *chargePoint) error {
+		cp.clear(req)
+		return nil
+	})
+}
+
+//Composite computes the schedule of the connector of the charging point id, see Calculator
+func (m *Manager) Composite(id string, connectorID int, start time.Time, duration time.Duration, unit protocol.ChargingRateUnitType) protocol.ChargingSchedule {
+	return m.composite(m.state(id), connectorID, start, duration, unit)
+}
+
+//Preview computes the schedule of the connector as if profile were installed on it, nothing is sent
+func (m *Manager) Preview(id string, connectorID int, profile protocol.ChargingProfile, start time.Time, duration time.Duration, unit protocol.ChargingRateUnitType) (protocol.ChargingSchedule, error) {
+	cp := m.state(id)
+	if err := cp.install(connectorID, profile); err != nil {
+		return protocol.ChargingSchedule{}, err
+	}
+	return m.composite(cp, connectorID, start, duration, unit), nil
+}
+
+//Check asks the charging point id its composite schedule and compares it with the computed one
+func (m *Manager) Check(ctx context.Context, id string, connectorID int, duration time.Duration, unit protocol.ChargingRateUnitType) (*Check, error) {
+	seconds := int(duration / time.Second)
+	res, err := ocpp16server.Send(ctx, m.server, id, protocol.GetCompositeScheduleRequest{ConnectorId: &connectorID, Duration: &seconds, ChargingRateUnit: unit}, m.conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.(*protocol.GetCompositeScheduleResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected GetCompositeSchedule reply %+v", res)
+	}
+	if reply.Status != protocol.GetCompositeScheduleStatusAccepted {
+		return nil, fmt.Errorf("GetCompositeSchedule %s", reply.Status)
+	}
+	start, ok := parseTime(reply.ScheduleStart)
+	if !ok {
+		if start, ok = parseTime(reply.ChargingSchedule.StartSchedule); !ok {
+			start = m.now()
+		}
+	}
+	if unit == "" {
+		unit = reply.ChargingSchedule.ChargingRateUnit
+	}
+	check := &Check{Expected: m.Composite(id, connectorID, start, duration, unit), Actual: reply.ChargingSchedule}
+	check.Mismatches = m.Compare(&check.Expected, &check.Actual)
+	return check, nil
+}
+
+//Start records the transaction of the connector, the manager learns them through the plugin returned by Wrap
+func (m *Manager) Start(id string, connectorID int, tx Transaction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.update(id, func(cp *chargePoint) error {
+		cp.Transactions[connectorID] = tx
+		return nil
+	})
+}
+
+//Stop ends the transaction transactionID of the charging point id and removes its TxProfiles
+func (m *Manager) Stop(id string, transactionID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.points[id]
+	if !ok {
+		return nil
+	}
+	for connectorID, tx := range cp.Transactions {
+		if tx.ID == transactionID {
+			return m.update(id, func(cp *chargePoint) error {
+				cp.stop(connectorID)
+				return nil
+			})
+		}
+	}
+	return nil
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin recording the transactions of the charging points for the TxProfiles and the Relative profiles,
+//register it on the server in place of plugin
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+func (p *managedPlugin) handle(id string, err error) {
+	if err != nil && p.m.onError != nil {
+		p.m.onError(id, err)
+	}
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return handler, ok
+	}
+	switch action {
+	case protocol.StartTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			res, err := handler(ctx, id, uniqueid, request)
+			req := request.(*protocol.StartTransactionRequest)
+			if start, ok := res.(*protocol.StartTransactionResponse); ok && err == nil && start.TransactionId != nil && req.ConnectorId != nil {
+				at, ok := parseTime(req.Timestamp)
+				if !ok {
+					at = p.m.now()
+				}
+				p.handle(id, p.m.Start(id, *req.ConnectorId, Transaction{ID: *start.TransactionId, Start: at}))
+			}
+			return res, err
+		}, true
+	case protocol.StopTransactionName:
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			if req := request.(*protocol.StopTransactionRequest); req.TransactionId != nil {
+				p.handle(id, p.m.Stop(id, *req.TransactionId))
+			}
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	}
+	return handler, ok
+}
@@ -0,0 +1,432 @@
+//Package smartcharging keeps the charging profiles installed on the charging points and computes their composite
+//schedules like a charging point of ocpp1.6 does: per purpose the valid profile with the highest stackLevel prevails,
+//a TxProfile overrides the TxDefaultProfile during its transaction and the ChargePointMaxProfile caps both
+package smartcharging
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"ocpp16/protocol"
+	"sort"
+	"time"
+)
+
+const (
+	ChargePointMaxProfile protocol.ChargingProfilePurposeType = "ChargePointMaxProfile"
+	TxDefaultProfile      protocol.ChargingProfilePurposeType = "TxDefaultProfile"
+	TxProfile             protocol.ChargingProfilePurposeType = "TxProfile"
+
+	Absolute  protocol.ChargingProfileKindType = "Absolute"
+	Recurring protocol.ChargingProfileKindType = "Recurring"
+	Relative  protocol.ChargingProfileKindType = "Relative"
+
+	Daily  protocol.RecurrencyKindType = "Daily"
+	Weekly protocol.RecurrencyKindType = "Weekly"
+
+	UnitA protocol.ChargingRateUnitType = "A"
+	UnitW protocol.ChargingRateUnitType = "W"
+)
+
+//Unlimited is the limit of the periods of a composite schedule no profile applies to
+const Unlimited = -1
+
+//Installed is a profile installed on a connector, 0 for the whole charging point
+type Installed struct {
+	ConnectorID     int                      `json:"connectorId"`
+	ChargingProfile protocol.ChargingProfile `json:"csChargingProfiles"`
+}
+
+//Transaction is the transaction running on a connector, the Relative profiles start with it
+type Transaction struct {
+	ID    int       `json:"transactionId"`
+	Start time.Time `json:"start"`
+}
+
+//chargePoint is the state of a charging point the composite schedules are computed from
+type chargePoint struct {
+	Profiles     []Installed         `json:"profiles"`
+	Transactions map[int]Transaction `json:"transactions"` //connector id -> transaction
+}
+
+func newChargePoint() *chargePoint {
+	return &chargePoint{Transactions: make(map[int]Transaction)}
+}
+
+func (cp *chargePoint) clone() *chargePoint {
+	c := &chargePoint{Profiles: append([]Installed(nil), cp.Profiles...), Transactions: make(map[int]Transaction, len(cp.Transactions))}
+	for connectorID, tx := range cp.Transactions {
+		c.Transactions[connectorID] = tx
+	}
+	return c
+}
+
+func parseTime(s string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return t, err == nil
+}
+
+//validate checks profile may be installed on the connector
+func validate(connectorID int, profile *protocol.ChargingProfile) error {
+	if profile.ChargingProfileId == nil || profile.StackLevel == nil || len(profile.ChargingSchedule.ChargingSchedulePeriod) == 0 {
+		return errors.New("chargingProfileId, stackLevel or chargingSchedulePeriod missing")
+	}
+	if err := protocol.Validate.Struct(profile); err != nil {
+		return err
+	}
+	switch {
+	case profile.ChargingProfilePurpose == ChargePointMaxProfile && connectorID != 0:
+		return errors.New("a ChargePointMaxProfile is installed on connector 0 only")
+	case profile.ChargingProfilePurpose == TxProfile && connectorID == 0:
+		return errors.New("a TxProfile is installed on a connector with a transaction")
+	case profile.ChargingProfileKind == Recurring && profile.RecurrencyKind == "":
+		return errors.New("a Recurring profile needs a recurrencyKind")
+	case profile.ChargingProfileKind == Recurring && profile.ChargingSchedule.StartSchedule == "":
+		return errors.New("a Recurring profile needs a startSchedule")
+	}
+	if s := profile.ChargingSchedule.StartSchedule; s != "" {
+		if _, ok := parseTime(s); !ok {
+			return fmt.Errorf("invalid startSchedule(%s)", s)
+		}
+	}
+	return nil
+}
+
+//install puts profile on the connector: it replaces the profile with its id and the one of the connector with its
+//purpose and stackLevel. A TxProfile without transactionId gets the one of the transaction on the connector
+func (cp *chargePoint) install(connectorID int, profile protocol.ChargingProfile) error {
+	if err := validate(connectorID, &profile); err != nil {
+		return err
+	}
+	if tx, ok := cp.Transactions[connectorID]; ok && profile.ChargingProfilePurpose == TxProfile && profile.TransactionId == nil {
+		id := tx.ID
+		profile.TransactionId = &id
+	}
+	profiles := cp.Profiles[:0:0]
+	for _, p := range cp.Profiles {
+		if *p.ChargingProfile.ChargingProfileId == *profile.ChargingProfileId {
+			continue
+		}
+		if p.ConnectorID == connectorID && p.ChargingProfile.ChargingProfilePurpose == profile.ChargingProfilePurpose && *p.ChargingProfile.StackLevel == *profile.StackLevel {
+			continue
+		}
+		profiles = append(profiles, p)
+	}
+	cp.Profiles = append(profiles, Installed{ConnectorID: connectorID, ChargingProfile: profile})
+	return nil
+}
+
+//clear removes the profiles matching req like ClearChargingProfile: the one with its id, or all of the connector,
+//purpose and stackLevel given. It returns how many were removed
+func (cp *chargePoint) clear(req protocol.ClearChargingProfileRequest) int {
+	profiles := cp.Profiles[:0:0]
+	for _, p := range cp.Profiles {
+		match := req.Id != nil && *p.ChargingProfile.ChargingProfileId == *req.Id
+		if req.Id == nil {
+			match = (req.ConnectorId == nil || *req.ConnectorId == p.ConnectorID) &&
+				(req.ChargingProfilePurpose == "" || req.ChargingProfilePurpose == p.ChargingProfile.ChargingProfilePurpose) &&
+				(req.StackLevel == nil || *req.StackLevel == *p.ChargingProfile.StackLevel)
+		}
+		if !match {
+			profiles = append(profiles, p)
+		}
+	}
+	n := len(cp.Profiles) - len(profiles)
+	cp.Profiles = profiles
+	return n
+}
+
+//stop ends the transaction of the connector, its TxProfiles are removed
+func (cp *chargePoint) stop(connectorID int) {
+	delete(cp.Transactions, connectorID)
+	profiles := cp.Profiles[:0:0]
+	for _, p := range cp.Profiles {
+		if p.ConnectorID != connectorID || p.ChargingProfile.ChargingProfilePurpose != TxProfile {
+			profiles = append(profiles, p)
+		}
+	}
+	cp.Profiles = profiles
+}
+
+//Calculator computes the composite schedules, converting between A and W with Voltage and the phases of a period,
+//Phases when it has none
+type Calculator struct {
+	Voltage float64
+	Phases  int
+}
+
+//limit is a limit in W and the phases it applies to
+type limit struct {
+	watts  float64
+	phases int
+}
+
+//scheduleStart returns the start of the schedule of p in effect at t, false if p has none
+func scheduleStart(p *protocol.ChargingProfile, t time.Time, txStart time.Time) (time.Time, bool) {
+	s := &p.ChargingSchedule
+	switch p.ChargingProfileKind {
+	case Relative:
+		return txStart, true
+	case Recurring:
+		start, ok := parseTime(s.StartSchedule)
+		if !ok || t.Before(start) {
+			return time.Time{}, false
+		}
+		period := 24 * time.Hour
+		if p.RecurrencyKind == Weekly {
+			period *= 7
+		}
+		return start.Add(t.Sub(start) / period * period), true
+	}
+	if start, ok := parseTime(s.StartSchedule); ok {
+		return start, true
+	}
+	return txStart, true
+}
+
+//period returns the limit of p at t, false if p does not apply at t
+func (c *Calculator) period(p *protocol.ChargingProfile, t time.Time, txStart time.Time) (limit, bool) {
+	if from, ok := parseTime(p.ValidFrom); ok && t.Before(from) {
+		return limit{}, false
+	}
+	if to, ok := parseTime(p.ValidTo); ok && !t.Before(to) {
+		return limit{}, false
+	}
+	start, ok := scheduleStart(p, t, txStart)
+	if !ok || t.Before(start) {
+		return limit{}, false
+	}
+	s := &p.ChargingSchedule
+	offset := t.Sub(start)
+	if s.Duration != nil && offset >= time.Duration(*s.Duration)*time.Second {
+		return limit{}, false
+	}
+	var found *protocol.ChargingSchedulePeriod
+	for i := range s.ChargingSchedulePeriod {
+		sp := &s.ChargingSchedulePeriod[i]
+		if sp.StartPeriod != nil && time.Duration(*sp.StartPeriod)*time.Second <= offset && (found == nil || *sp.StartPeriod >= *found.StartPeriod) {
+			found = sp
+		}
+	}
+	if found == nil || found.Limit == nil {
+		return limit{}, false
+	}
+	l := limit{watts: *found.Limit, phases: c.Phases}
+	if found.NumberPhases != nil && *found.NumberPhases > 0 {
+		l.phases = *found.NumberPhases
+	}
+	if s.ChargingRateUnit == UnitA {
+		l.watts *= c.Voltage * float64(l.phases)
+	}
+	return l, true
+}
+
+//prevailing returns the limit of the profile with the highest stackLevel applying at t
+func (c *Calculator) prevailing(profiles []*protocol.ChargingProfile, t time.Time, txStart time.Time) (limit, bool) {
+	for _, p := range profiles {
+		if l, ok := c.period(p, t, txStart); ok {
+			return l, true
+		}
+	}
+	return limit{}, false
+}
+
+//boundaries appends the times in (from, to) the limit of p may change at
+func boundaries(p *protocol.ChargingProfile, from, to time.Time, txStart time.Time, times []time.Time) []time.Time {
+	add := func(t time.Time) {
+		if t.After(from) && t.Before(to) {
+			times = append(times, t)
+		}
+	}
+	if t, ok := parseTime(p.ValidFrom); ok {
+		add(t)
+	}
+	if t, ok := parseTime(p.ValidTo); ok {
+		add(t)
+	}
+	s := &p.ChargingSchedule
+	schedule := func(start time.Time) {
+		add(start)
+		for _, sp := range s.ChargingSchedulePeriod {
+			if sp.StartPeriod != nil {
+				add(start.Add(time.Duration(*sp.StartPeriod) * time.Second))
+			}
+		}
+		if s.Duration != nil {
+			add(start.Add(time.Duration(*s.Duration) * time.Second))
+		}
+	}
+	if p.ChargingProfileKind != Recurring {
+		start, _ := scheduleStart(p, from, txStart)
+		schedule(start)
+		return times
+	}
+	start, ok := parseTime(s.StartSchedule)
+	if !ok {
+		return times
+	}
+	period := 24 * time.Hour
+	if p.RecurrencyKind == Weekly {
+		period *= 7
+	}
+	if from.After(start) {
+		start = start.Add(from.Sub(start) / period * period)
+	}
+	for ; start.Before(to); start = start.Add(period) {
+		schedule(start)
+	}
+	return times
+}
+
+//byStackLevel returns the profiles of the connector with purpose, the highest stackLevel first
+func byStackLevel(cp *chargePoint, connectorID int, purpose protocol.ChargingProfilePurposeType) []*protocol.ChargingProfile {
+	var profiles []*protocol.ChargingProfile
+	tx, inTransaction := cp.Transactions[connectorID]
+	for i := range cp.Profiles {
+		p := &cp.Profiles[i]
+		if p.ConnectorID != connectorID || p.ChargingProfile.ChargingProfilePurpose != purpose {
+			continue
+		}
+		if purpose == TxProfile && (!inTransaction || (p.ChargingProfile.TransactionId != nil && *p.ChargingProfile.TransactionId != tx.ID)) {
+			continue
+		}
+		profiles = append(profiles, &p.ChargingProfile)
+	}
+	sort.SliceStable(profiles, func(i, j int) bool { return *profiles[i].StackLevel > *profiles[j].StackLevel })
+	return profiles
+}
+
+//Composite computes the schedule of the connector from start for duration in unit, A if empty. Connector 0 is
+//limited by the ChargePointMaxProfile, a connector by it and its TxProfile, or its TxDefaultProfile when there is none,
+//the TxDefaultProfiles of connector 0 apply to the connectors without their own. Without a transaction the Relative
+//profiles start at start
+func (c *Calculator) composite(cp *chargePoint, connectorID int, start time.Time, duration time.Duration, unit protocol.ChargingRateUnitType) protocol.ChargingSchedule {
+	if unit == "" {
+		unit = UnitA
+	}
+	end := start.Add(duration)
+	txStart := start
+	if tx, ok := cp.Transactions[connectorID]; ok {
+		txStart = tx.Start
+	}
+	max := byStackLevel(cp, 0, ChargePointMaxProfile)
+	var tx, defaults, fallback []*protocol.ChargingProfile
+	if connectorID > 0 {
+		tx = byStackLevel(cp, connectorID, TxProfile)
+		defaults = byStackLevel(cp, connectorID, TxDefaultProfile)
+		fallback = byStackLevel(cp, 0, TxDefaultProfile)
+	}
+	times := []time.Time{start}
+	for _, profiles := range [][]*protocol.ChargingProfile{max, tx, defaults, fallback} {
+		for _, p := range profiles {
+			times = boundaries(p, start, end, txStart, times)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	d := int(duration / time.Second)
+	schedule := protocol.ChargingSchedule{Duration: &d, StartSchedule: start.UTC().Format(protocol.ISO8601), ChargingRateUnit: unit}
+	for i, t := range times {
+		if i > 0 && !t.After(times[i-1]) {
+			continue
+		}
+		l, limited := c.prevailing(max, t, txStart)
+		if connectorID > 0 {
+			own, ok := c.prevailing(tx, t, txStart)
+			if !ok {
+				if own, ok = c.prevailing(defaults, t, txStart); !ok {
+					own, ok = c.prevailing(fallback, t, txStart)
+				}
+			}
+			if ok && (!limited || own.watts < l.watts) {
+				l, limited = own, true
+			}
+		}
+		value := float64(Unlimited)
+		var phases *int
+		if limited {
+			value = l.watts
+			if unit == UnitA {
+				value /= c.Voltage * float64(l.phases)
+			}
+			value = math.Round(value*10) / 10
+			n := l.phases
+			phases = &n
+		}
+		periods := schedule.ChargingSchedulePeriod
+		if n := len(periods); n > 0 && *periods[n-1].Limit == value && equalPhases(periods[n-1].NumberPhases, phases) {
+			continue
+		}
+		startPeriod := int(t.Sub(start) / time.Second)
+		schedule.ChargingSchedulePeriod = append(periods, protocol.ChargingSchedulePeriod{StartPeriod: &startPeriod, Limit: &value, NumberPhases: phases})
+	}
+	return schedule
+}
+
+func equalPhases(a, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+//Mismatch is a time the schedule reported by a charging point differs from the computed one
+type Mismatch struct {
+	At       time.Time `json:"at"`
+	Expected float64   `json:"expected"` //W
+	Actual   float64   `json:"actual"`   //W, Unlimited when the charging point reports no limit
+}
+
+//at returns the limit of schedule at offset, in W
+func (c *Calculator) at(schedule *protocol.ChargingSchedule, offset int) (float64, bool) {
+	var found *protocol.ChargingSchedulePeriod
+	for i := range schedule.ChargingSchedulePeriod {
+		sp := &schedule.ChargingSchedulePeriod[i]
+		if sp.StartPeriod != nil && *sp.StartPeriod <= offset && (found == nil || *sp.StartPeriod >= *found.StartPeriod) {
+			found = sp
+		}
+	}
+	if found == nil || found.Limit == nil || *found.Limit == Unlimited {
+		return 0, false
+	}
+	if schedule.ChargingRateUnit != UnitA {
+		return *found.Limit, true
+	}
+	phases := c.Phases
+	if found.NumberPhases != nil && *found.NumberPhases > 0 {
+		phases = *found.NumberPhases
+	}
+	return *found.Limit * c.Voltage * float64(phases), true
+}
+
+//Compare returns the times actual differs from expected by more than 1%, both schedules starting at the same time.
+//The unlimited periods of expected are skipped
+func (c *Calculator) Compare(expected, actual *protocol.ChargingSchedule) []Mismatch {
+	start, _ := parseTime(expected.StartSchedule)
+	var offsets []int
+	for _, s := range []*protocol.ChargingSchedule{expected, actual} {
+		for _, sp := range s.ChargingSchedulePeriod {
+			if sp.StartPeriod != nil && (expected.Duration == nil || *sp.StartPeriod < *expected.Duration) {
+				offsets = append(offsets, *sp.StartPeriod)
+			}
+		}
+	}
+	sort.Ints(offsets)
+	var mismatches []Mismatch
+	for i, offset := range offsets {
+		if i > 0 && offset == offsets[i-1] {
+			continue
+		}
+		e, limited := c.at(expected, offset)
+		if !limited {
+			continue
+		}
+		a, ok := c.at(actual, offset)
+		if ok && math.Abs(a-e) <= 0.01*e+0.1 {
+			continue
+		}
+		m := Mismatch{At: start.Add(time.Duration(offset) * time.Second), Expected: math.Round(e*10) / 10, Actual: Unlimited}
+		if ok {
+			m.Actual = math.Round(a*10) / 10
+		}
+		mismatches = append(mismatches, m)
+	}
+	return mismatches
+}
@@ -0,0 +1,180 @@
+package smartcharging
+
+import (
+	"context"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	"ocpp16/transaction"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func intp(i int) *int {
+	return &i
+}
+
+func mustTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+//schedule returns periods of limit every offset, e.g. schedule(UnitA, 0, 32, 3600, 16)
+func schedule(unit protocol.ChargingRateUnitType, periods ...float64) protocol.ChargingSchedule {
+	s := protocol.ChargingSchedule{ChargingRateUnit: unit}
+	for i := 0; i+1 < len(periods); i += 2 {
+		limit := periods[i+1]
+		s.ChargingSchedulePeriod = append(s.ChargingSchedulePeriod, protocol.ChargingSchedulePeriod{StartPeriod: intp(int(periods[i])), Limit: &limit})
+	}
+	return s
+}
+
+func profile(id int, stackLevel int, purpose protocol.ChargingProfilePurposeType, kind protocol.ChargingProfileKindType, s protocol.ChargingSchedule) protocol.ChargingProfile {
+	return protocol.ChargingProfile{ChargingProfileId: intp(id), StackLevel: intp(stackLevel), ChargingProfilePurpose: purpose, ChargingProfileKind: kind, ChargingSchedule: s}
+}
+
+func limits(s protocol.ChargingSchedule) []float64 {
+	var l []float64
+	for _, p := range s.ChargingSchedulePeriod {
+		l = append(l, float64(*p.StartPeriod), *p.Limit)
+	}
+	return l
+}
+
+func equal(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestComposite(t *testing.T) {
+	c := &Calculator{Voltage: 230, Phases: 3}
+	cp := newChargePoint()
+	start := mustTime("2021-01-01T10:00:00Z")
+
+	//32A on the charging point, a daily night limit of 10A from 22:00 for 8 hours
+	max := profile(1, 0, ChargePointMaxProfile, Absolute, schedule(UnitA, 0, 32))
+	max.ChargingSchedule.StartSchedule = "2021-01-01T00:00:00Z"
+	night := profile(2, 1, ChargePointMaxProfile, Recurring, schedule(UnitA, 0, 10))
+	night.RecurrencyKind, night.ChargingSchedule.StartSchedule, night.ChargingSchedule.Duration = Daily, "2020-12-01T22:00:00Z", intp(8*3600)
+	//11kW by default, a TxProfile of 16A for an hour then 6A
+	def := profile(3, 0, TxDefaultProfile, Relative, schedule(UnitW, 0, 11040))
+	tx := profile(4, 0, TxProfile, Relative, schedule(UnitA, 0, 16, 3600, 6))
+	for _, p := range []struct {
+		connectorID int
+		profile     protocol.ChargingProfile
+	}{{0, max}, {0, night}, {0, def}, {1, tx}} {
+		if err := cp.install(p.connectorID, p.profile); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := limits(c.composite(cp, 0, start, 24*time.Hour, UnitA)); !equal(got, []float64{0, 32, 12 * 3600, 10, 20 * 3600, 32}) {
+		t.Fatalf("unexpected composite of connector 0 %v", got)
+	}
+	//without a transaction the TxProfile does not apply, the TxDefaultProfile of connector 0 does
+	if got := limits(c.composite(cp, 1, start, 24*time.Hour, UnitA)); !equal(got, []float64{0, 16, 12 * 3600, 10, 20 * 3600, 16}) {
+		t.Fatalf("unexpected composite without transaction %v", got)
+	}
+	cp.Transactions[1] = Transaction{ID: 7, Start: start.Add(-30 * time.Minute)}
+	got := c.composite(cp, 1, start, 13*time.Hour, UnitW)
+	if !equal(limits(got), []float64{0, 11040, 1800, 4140}) || *got.Duration != 13*3600 {
+		t.Fatalf("unexpected composite in W %v", limits(got))
+	}
+	//the TxProfile of the transaction is removed with it
+	cp.stop(1)
+	if len(cp.Profiles) != 3 {
+		t.Fatalf("TxProfile kept after the transaction %+v", cp.Profiles)
+	}
+
+	//a profile with the same purpose and stackLevel replaces the installed one, ClearChargingProfile by purpose
+	cp.install(0, profile(5, 1, ChargePointMaxProfile, Absolute, schedule(UnitA, 0, 20)))
+	if len(cp.Profiles) != 3 {
+		t.Fatalf("profile not replaced %+v", cp.Profiles)
+	}
+	if n := cp.clear(protocol.ClearChargingProfileRequest{ChargingProfilePurpose: ChargePointMaxProfile}); n != 2 {
+		t.Fatalf("cleared %d profiles", n)
+	}
+	if err := cp.install(1, profile(6, 0, ChargePointMaxProfile, Absolute, schedule(UnitA, 0, 20))); err == nil {
+		t.Fatal("ChargePointMaxProfile installed on a connector")
+	}
+}
+
+//fakeChargePoint accepts the profiles and reports a composite schedule of 16A
+type fakeChargePoint struct{}
+
+func (f *fakeChargePoint) Call(ctx context.Context, id string, call *protocol.Call) (protocol.Response, *protocol.CallError, error) {
+	switch call.Request.(type) {
+	case protocol.SetChargingProfileRequest:
+		return &protocol.SetChargingProfileResponse{Status: "Accepted"}, nil, nil
+	case protocol.ClearChargingProfileRequest:
+		return &protocol.ClearChargingProfileResponse{Status: protocol.ClearChargingProfileStatusAccepted}, nil, nil
+	case protocol.GetCompositeScheduleRequest:
+		return &protocol.GetCompositeScheduleResponse{
+			Status:           protocol.GetCompositeScheduleStatusAccepted,
+			ScheduleStart:    "2021-01-01T10:00:00Z",
+			ChargingSchedule: schedule(UnitA, 0, 16, 1800, 16),
+		}, nil, nil
+	}
+	return nil, &protocol.CallError{ErrorCode: protocol.NotSupported, ErrorDescription: call.Action}, nil
+}
+
+func TestManager(t *testing.T) {
+	f := &fakeChargePoint{}
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	m, err := NewManager(f, Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//the transaction manager allocates the transaction ids
+	transactions, _ := transaction.NewManager(transaction.NewMemoryRepository())
+	plugin := m.Wrap(transactions.Wrap(local.NewActionPlugin()))
+	handler, _ := plugin.RequestHandler(protocol.StartTransactionName)
+	res, err := handler(context.Background(), "CP001", "1", &protocol.StartTransactionRequest{ConnectorId: intp(1), IdTag: "tag", MeterStart: intp(0), Timestamp: "2021-01-01T10:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txID := res.(*protocol.StartTransactionResponse).TransactionId
+	if txID == nil || m.Transactions("CP001")[1].ID != *txID {
+		t.Fatalf("transaction not recorded %+v", m.Transactions("CP001"))
+	}
+
+	tx := profile(1, 0, TxProfile, Relative, schedule(UnitA, 0, 16, 1800, 8))
+	if status, err := m.Set(context.Background(), "CP001", 1, tx); err != nil || status != "Accepted" {
+		t.Fatalf("set got %s(%v)", status, err)
+	}
+	//the charging point ignores the second period of the profile
+	check, err := m.Check(context.Background(), "CP001", 1, time.Hour, UnitA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(check.Mismatches) != 1 || !check.Mismatches[0].At.Equal(mustTime("2021-01-01T10:30:00Z")) || check.Mismatches[0].Expected != 8*230*3 {
+		t.Fatalf("unexpected check %+v", check)
+	}
+	preview, err := m.Preview("CP001", 1, profile(2, 1, TxProfile, Relative, schedule(UnitA, 0, 6)), mustTime("2021-01-01T10:00:00Z"), time.Hour, UnitA)
+	if err != nil || !equal(limits(preview), []float64{0, 6}) || len(m.Profiles("CP001")) != 1 {
+		t.Fatalf("unexpected preview %v(%v)", limits(preview), err)
+	}
+
+	//the profiles and the transactions survive a restart
+	if m, err = NewManager(f, Config{Path: path}); err != nil || len(m.Profiles("CP001")) != 1 {
+		t.Fatalf("profiles not loaded %+v(%v)", m.Profiles("CP001"), err)
+	}
+	handler, _ = m.Wrap(transactions.Wrap(local.NewActionPlugin())).RequestHandler(protocol.StopTransactionName)
+	handler(context.Background(), "CP001", "2", &protocol.StopTransactionRequest{TransactionId: txID, MeterStop: intp(10), Timestamp: "2021-01-01T11:00:00Z"})
+	if len(m.Profiles("CP001")) != 0 || len(m.Transactions("CP001")) != 0 {
+		t.Fatalf("TxProfile kept after the transaction %+v", m.Profiles("CP001"))
+	}
+	if status, err := m.Clear(context.Background(), "CP001", protocol.ClearChargingProfileRequest{Id: intp(1)}); err != nil || status != protocol.ClearChargingProfileStatusAccepted {
+		t.Fatalf("clear got %s(%v)", status, err)
+	}
+}
@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//the page size of the api when the query has no limit
+const defaultLimit = 100
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+//RegisterAPI serves the transactions on r, e.g. the group of the rest api:
+//
+//	GET /transactions                 ?chargePointId=&connectorId=&idTag=&state=&from=&to=&offset=&limit=, from and
+//	                                  to are RFC3339 times bounding the start time
+//	GET /transactions/:transactionId  one transaction with its meter values
+func (m *Manager) RegisterAPI(r gin.IRouter) {
+	r.GET("/transactions", m.list)
+	r.GET("/transactions/:transactionId", m.get)
+}
+
+func parseQuery(c *gin.Context) (Query, error) {
+	q := Query{
+		ChargePointID: c.Query("chargePointId"),
+		IdTag:         c.Query("idTag"),
+		State:         State(c.Query("state")),
+		Limit:         defaultLimit,
+	}
+	var err error
+	for name, n := range map[string]*int{"connectorId": &q.ConnectorID, "offset": &q.Offset, "limit": &q.Limit} {
+		if v := c.Query(name); v != "" {
+			if *n, err = strconv.Atoi(v); err != nil || *n < 0 {
+				return q, fmt.Errorf("invalid %s(%s)", name, v)
+			}
+		}
+	}
+	for name, t := range map[string]*time.Time{"from": &q.From, "to": &q.To} {
+		if v := c.Query(name); v != "" {
+			if *t, err = time.Parse(time.RFC3339, v); err != nil {
+				return q, fmt.Errorf("invalid %s(%s)", name, v)
+			}
+		}
+	}
+	return q, nil
+}
+
+func (m *Manager) list(c *gin.Context) {
+	q, err := parseQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: err.Error()})
+		return
+	}
+	transactions, err := m.Find(q)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+		return
+	}
+	if transactions == nil {
+		transactions = []*Transaction{}
+	}
+	c.JSON(http.StatusOK, transactions)
+}
+
+func (m *Manager) get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("transactionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, errorReply{Error: fmt.Sprintf("invalid transaction id(%s)", c.Param("transactionId"))})
+		return
+	}
+	t, err := m.Get(id)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, errorReply{Error: fmt.Sprintf("transaction(%d) not found", id)})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, errorReply{Error: err.Error()})
+	default:
+		c.JSON(http.StatusOK, t)
+	}
+}
@@ -0,0 +1,176 @@
+package transaction
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"ocpp16/internal/atomicfile"
+	"ocpp16/protocol"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+//FileRepository appends every change as a json line to a log and keeps all the transactions in memory, the log is
+//replayed and compacted when it is opened. A file can only be used by one process
+type FileRepository struct {
+	path  string
+	file  *os.File
+	index *index
+	mu    sync.RWMutex
+}
+
+type record struct {
+	Op            string                `json:"op"`
+	Transaction   *Transaction          `json:"transaction,omitempty"`
+	TransactionID int                   `json:"transactionId,omitempty"`
+	MeterValues   []protocol.MeterValue `json:"meterValues,omitempty"`
+}
+
+const (
+	opSave  = "save"
+	opMeter = "meter"
+)
+
+func NewFileRepository(path string) (*FileRepository, error) {
+	if path == "" {
+		return nil, fmt.Errorf("transaction file repository path is empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	r := &FileRepository{path: path, index: newIndex()}
+	lines, truncated, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	//a log with a torn last line or mostly superseded records is rewritten
+	if truncated || lines > 2*len(r.index.transactions)+1000 {
+		if err = r.compact(); err != nil {
+			return nil, err
+		}
+	}
+	if r.file, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+//load replays the log, a last line that does not parse was torn by a crash and is dropped
+func (r *FileRepository) load() (lines int, truncated bool, err error) {
+	f, err := os.Open(r.path)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	defer f.Close()
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return lines, false, err
+		}
+		eof := err == io.EOF
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var rec record
+			if e := json.Unmarshal(line, &rec); e != nil {
+				if eof {
+					return lines, true, nil
+				}
+				return lines, false, fmt.Errorf("transaction file(%s) corrupted, line %d, err(%v)", r.path, lines+1, e)
+			}
+			lines++
+			r.apply(&rec)
+		}
+		if eof {
+			return lines, false, nil
+		}
+	}
+}
+
+func (r *FileRepository) apply(rec *record) {
+	switch rec.Op {
+	case opSave:
+		if rec.Transaction != nil {
+			r.index.save(rec.Transaction)
+		}
+	case opMeter:
+		r.index.appendMeterValues(rec.TransactionID, rec.MeterValues)
+	}
+}
+
+//compact replaces the log by a save record of every transaction
+func (r *FileRepository) compact() error {
+	return atomicfile.Write(r.path, func(f io.Writer) error {
+		w := bufio.NewWriter(f)
+		enc := json.NewEncoder(w)
+		for _, t := range r.index.find(Query{}) {
+			if err := enc.Encode(&record{Op: opSave, Transaction: t}); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+}
+
+func (r *FileRepository) write(rec *record) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err = r.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return r.file.Sync()
+}
+
+//NextID is not written, the id is persisted by the Save of the transaction that follows
+func (r *FileRepository) NextID() (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.index.nextID(), nil
+}
+
+func (r *FileRepository) Save(t *Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.write(&record{Op: opSave, Transaction: t}); err != nil {
+		return err
+	}
+	r.index.save(t)
+	return nil
+}
+
+func (r *FileRepository) AppendMeterValues(id int, values []protocol.MeterValue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.index.transactions[id]; !ok {
+		return ErrNotFound
+	}
+	if err := r.write(&record{Op: opMeter, TransactionID: id, MeterValues: values}); err != nil {
+		return err
+	}
+	return r.index.appendMeterValues(id, values)
+}
+
+func (r *FileRepository) Get(id int) (*Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.index.get(id)
+}
+
+func (r *FileRepository) Find(q Query) ([]*Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.index.find(q), nil
+}
+
+func (r *FileRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
@@ -0,0 +1,359 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"sync"
+	"time"
+)
+
+type connector struct {
+	id          string
+	connectorID int
+}
+
+//Manager keeps the open transactions in memory, every change is saved to the repository first
+type Manager struct {
+	repo       Repository
+	mu         sync.Mutex
+	open       map[int]*Transaction
+	connectors map[connector]int
+	tags       map[string]map[int]bool //idTag and parentIdTag -> ids of the open transactions
+	now        func() time.Time
+	onClose    func(t *Transaction)
+}
+
+//NewManager loads the open transactions of repo
+func NewManager(repo Repository) (*Manager, error) {
+	m := &Manager{repo: repo, open: make(map[int]*Transaction), connectors: make(map[connector]int), tags: make(map[string]map[int]bool), now: time.Now}
+	active, err := repo.Find(Query{State: Active})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range active {
+		m.add(t)
+	}
+	return m, nil
+}
+
+//SetCloseHandler sets the function called with every transaction that is Finished or Orphaned, outside of the lock
+//of m. A transaction closed as Orphaned and finished later by its StopTransaction is passed again with WasOrphaned set
+func (m *Manager) SetCloseHandler(handler func(t *Transaction)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onClose = handler
+}
+
+func (m *Manager) closed(transactions ...*Transaction) {
+	m.mu.Lock()
+	handler := m.onClose
+	m.mu.Unlock()
+	if handler == nil {
+		return
+	}
+	for _, t := range transactions {
+		handler(t.clone())
+	}
+}
+
+//Start opens a transaction on the connector of req, a transaction still open there is closed as Orphaned. A
+//StartTransaction sent again for the open transaction gets it back, the charging point retries when it misses the
+//response
+func (m *Manager) Start(id string, req *protocol.StartTransactionRequest, info protocol.IdTagInfo) (*Transaction, error) {
+	if req.ConnectorId == nil || req.MeterStart == nil {
+		return nil, fmt.Errorf("connector id or meter start missing")
+	}
+	t, orphan, err := m.start(id, req, info)
+	if orphan != nil {
+		m.closed(orphan)
+	}
+	return t, err
+}
+
+func (m *Manager) start(id string, req *protocol.StartTransactionRequest, info protocol.IdTagInfo) (t *Transaction, orphan *Transaction, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	start := parseTime(req.Timestamp, m.now())
+	key := connector{id, *req.ConnectorId}
+	if txID, ok := m.connectors[key]; ok {
+		open := m.open[txID]
+		if open.IdTag == string(req.IdTag) && open.MeterStart == *req.MeterStart && open.StartTime.Equal(start) {
+			return open.clone(), nil, nil
+		}
+		if orphan, err = m.close(open, start); err != nil {
+			return nil, nil, err
+		}
+	}
+	txID, err := m.repo.NextID()
+	if err != nil {
+		return nil, orphan, err
+	}
+	t = &Transaction{
+		ID:            txID,
+		ChargePointID: id,
+		ConnectorID:   *req.ConnectorId,
+		IdTag:         string(req.IdTag),
+		ParentIdTag:   string(info.ParentIdTag),
+		Authorization: info.Status,
+		ReservationID: req.ReservationId,
+		MeterStart:    *req.MeterStart,
+		StartTime:     start,
+		State:         Active,
+	}
+	if err = m.repo.Save(t); err != nil {
+		return nil, orphan, err
+	}
+	m.add(t)
+	return t.clone(), orphan, nil
+}
+
+//close ends t as Orphaned at stop with the last energy register of its meter values
+func (m *Manager) close(t *Transaction, stop time.Time) (*Transaction, error) {
+	closed := t.clone()
+	meterStop, ok := lastRegister(closed.MeterValues)
+	if !ok || meterStop < closed.MeterStart {
+		meterStop = closed.MeterStart
+	}
+	closed.State, closed.MeterStop, closed.StopTime = Orphaned, &meterStop, &stop
+	if err := m.repo.Save(closed); err != nil {
+		return nil, err
+	}
+	m.remove(t)
+	return closed, nil
+}
+
+func (m *Manager) add(t *Transaction) {
+	m.open[t.ID] = t
+	m.connectors[connector{t.ChargePointID, t.ConnectorID}] = t.ID
+	for _, tag := range []string{t.IdTag, t.ParentIdTag} {
+		if tag == "" {
+			continue
+		}
+		if m.tags[tag] == nil {
+			m.tags[tag] = make(map[int]bool)
+		}
+		m.tags[tag][t.ID] = true
+	}
+}
+
+func (m *Manager) remove(t *Transaction) {
+	delete(m.open, t.ID)
+	key := connector{t.ChargePointID, t.ConnectorID}
+	if m.connectors[key] == t.ID {
+		delete(m.connectors, key)
+	}
+	for _, tag := range []string{t.IdTag, t.ParentIdTag} {
+		if delete(m.tags[tag], t.ID); len(m.tags[tag]) == 0 {
+			delete(m.tags, tag)
+		}
+	}
+}
+
+//MeterValues attaches the values of req to the open transaction of its transaction id, values without one or of
+//an unknown transaction are ignored
+func (m *Manager) MeterValues(id string, req *protocol.MeterValuesRequest) error {
+	if req.TransactionId == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.open[*req.TransactionId]
+	if !ok || t.ChargePointID != id {
+		return nil
+	}
+	if err := m.repo.AppendMeterValues(t.ID, req.MeterValue); err != nil {
+		return err
+	}
+	t.MeterValues = append(t.MeterValues, req.MeterValue...)
+	return nil
+}
+
+//Stop finishes the transaction of req, its transaction data is merged into the meter values received before.
+//An Orphaned transaction is finished too, a stop of a finished or unknown transaction returns nil
+func (m *Manager) Stop(id string, req *protocol.StopTransactionRequest) (*Transaction, error) {
+	if req.TransactionId == nil || req.MeterStop == nil {
+		return nil, fmt.Errorf("transaction id or meter stop missing")
+	}
+	t, err := m.stop(id, req)
+	if t != nil {
+		m.closed(t)
+	}
+	return t, err
+}
+
+func (m *Manager) stop(id string, req *protocol.StopTransactionRequest) (*Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.open[*req.TransactionId]
+	if !ok {
+		stored, err := m.repo.Get(*req.TransactionId)
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if stored.State != Orphaned {
+			return nil, nil
+		}
+		t = stored
+	}
+	if t.ChargePointID != id {
+		return nil, nil
+	}
+	stopped := t.clone()
+	stopped.WasOrphaned = !ok
+	stop := parseTime(req.Timestamp, m.now())
+	meterStop := *req.MeterStop
+	stopped.State, stopped.MeterStop, stopped.StopTime = Finished, &meterStop, &stop
+	stopped.StopIdTag, stopped.StopReason = string(req.IdTag), req.Reason
+	stopped.MeterValues = merge(stopped.MeterValues, req.TransactionData)
+	if err := m.repo.Save(stopped); err != nil {
+		return nil, err
+	}
+	if ok {
+		m.remove(t)
+	}
+	return stopped, nil
+}
+
+//Boot closes the open transactions of the charging point id as Orphaned, it booted so they are over
+func (m *Manager) Boot(id string) ([]*Transaction, error) {
+	closed, err := m.boot(id)
+	m.closed(closed...)
+	return closed, err
+}
+
+func (m *Manager) boot(id string) ([]*Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var closed []*Transaction
+	now := m.now()
+	for _, t := range m.open {
+		if t.ChargePointID != id {
+			continue
+		}
+		c, err := m.close(t, now)
+		if err != nil {
+			return closed, err
+		}
+		closed = append(closed, c)
+	}
+	return closed, nil
+}
+
+//Active returns the open transaction of a connector
+func (m *Manager) Active(id string, connectorID int) (*Transaction, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	txID, ok := m.connectors[connector{id, connectorID}]
+	if !ok {
+		return nil, false
+	}
+	return m.open[txID].clone(), true
+}
+
+//ActiveByTag returns the open transactions started with idTag or with a tag whose parent is idTag
+func (m *Manager) ActiveByTag(idTag string) []*Transaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var transactions []*Transaction
+	for txID := range m.tags[idTag] {
+		transactions = append(transactions, m.open[txID].clone())
+	}
+	return transactions
+}
+
+func (m *Manager) Get(id int) (*Transaction, error) {
+	return m.repo.Get(id)
+}
+
+func (m *Manager) Find(q Query) ([]*Transaction, error) {
+	return m.repo.Find(q)
+}
+
+type managedPlugin struct {
+	ocpp16server.PassivePlugin
+	m *Manager
+}
+
+//Wrap returns plugin with the transactions handled by m, register it on the server in place of plugin. The
+//requests still reach plugin: StartTransaction is authorized by its response, Accepted when it has none, and gets
+//the transaction id of m
+func (m *Manager) Wrap(plugin ocpp16server.PassivePlugin) ocpp16server.PassivePlugin {
+	return &managedPlugin{PassivePlugin: plugin, m: m}
+}
+
+//next calls the handler of plugin for action, the response is empty when it does not handle action or returns nil
+func (p *managedPlugin) next(ctx context.Context, action string, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	handler, ok := p.PassivePlugin.RequestHandler(action)
+	if !ok {
+		return nil, nil
+	}
+	return handler(ctx, id, uniqueid, request)
+}
+
+func (p *managedPlugin) RequestHandler(action string) (protocol.RequestHandler, bool) {
+	switch action {
+	case protocol.StartTransactionName:
+		return p.startTransaction, true
+	case protocol.StopTransactionName:
+		return p.stopTransaction, true
+	case protocol.MeterValuesName:
+		return p.meterValues, true
+	case protocol.BootNotificationName:
+		handler, ok := p.PassivePlugin.RequestHandler(action)
+		if !ok {
+			return nil, false
+		}
+		return func(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+			if _, err := p.m.Boot(id); err != nil {
+				return nil, ocpp16server.InternalError(err)
+			}
+			return handler(ctx, id, uniqueid, request)
+		}, true
+	}
+	return p.PassivePlugin.RequestHandler(action)
+}
+
+func (p *managedPlugin) startTransaction(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	res, err := p.next(ctx, protocol.StartTransactionName, id, uniqueid, request)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*protocol.StartTransactionResponse)
+	if !ok || response == nil {
+		response = &protocol.StartTransactionResponse{IdTagInfo: protocol.IdTagInfo{Status: "Accepted"}}
+	}
+	t, err := p.m.Start(id, request.(*protocol.StartTransactionRequest), response.IdTagInfo)
+	if err != nil {
+		return nil, ocpp16server.InternalError(err)
+	}
+	response.TransactionId = &t.ID
+	return response, nil
+}
+
+func (p *managedPlugin) stopTransaction(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	if _, err := p.m.Stop(id, request.(*protocol.StopTransactionRequest)); err != nil {
+		return nil, ocpp16server.InternalError(err)
+	}
+	res, err := p.next(ctx, protocol.StopTransactionName, id, uniqueid, request)
+	if err != nil || res != nil {
+		return res, err
+	}
+	return &protocol.StopTransactionResponse{}, nil
+}
+
+func (p *managedPlugin) meterValues(ctx context.Context, id string, uniqueid string, request protocol.Request) (protocol.Response, error) {
+	if err := p.m.MeterValues(id, request.(*protocol.MeterValuesRequest)); err != nil {
+		return nil, ocpp16server.InternalError(err)
+	}
+	res, err := p.next(ctx, protocol.MeterValuesName, id, uniqueid, request)
+	if err != nil || res != nil {
+		return res, err
+	}
+	return &protocol.MeterValuesResponse{}, nil
+}
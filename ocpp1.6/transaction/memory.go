@@ -0,0 +1,51 @@
+package transaction
+
+import (
+	"ocpp16/protocol"
+	"sync"
+)
+
+//MemoryRepository loses the transactions on a restart, the ids start at 1 again
+type MemoryRepository struct {
+	index *index
+	sync.RWMutex
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{index: newIndex()}
+}
+
+func (m *MemoryRepository) NextID() (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.index.nextID(), nil
+}
+
+func (m *MemoryRepository) Save(t *Transaction) error {
+	m.Lock()
+	defer m.Unlock()
+	m.index.save(t)
+	return nil
+}
+
+func (m *MemoryRepository) AppendMeterValues(id int, values []protocol.MeterValue) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.index.appendMeterValues(id, values)
+}
+
+func (m *MemoryRepository) Get(id int) (*Transaction, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.index.get(id)
+}
+
+func (m *MemoryRepository) Find(q Query) ([]*Transaction, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.index.find(q), nil
+}
+
+func (m *MemoryRepository) Close() error {
+	return nil
+}
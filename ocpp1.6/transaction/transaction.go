@@ -0,0 +1,219 @@
+//Package transaction follows the transactions of the charging points: it allocates the transaction ids answering
+//StartTransaction, keeps the open transaction of every connector, attaches the MeterValues of a transaction and
+//reconciles the transaction data of StopTransaction. The transactions are stored in a Repository, in memory or
+//in an append only file, and can be queried there
+package transaction
+
+import (
+	"errors"
+	"ocpp16/protocol"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var ErrNotFound = errors.New("transaction not found")
+
+type State string
+
+const (
+	Active   State = "Active"   //started and not stopped yet
+	Finished State = "Finished" //stopped by StopTransaction
+	Orphaned State = "Orphaned" //closed by the server, the charging point booted while the transaction was open
+)
+
+type Transaction struct {
+	ID            int                          `json:"transactionId"`
+	ChargePointID string                       `json:"chargePointId"`
+	ConnectorID   int                          `json:"connectorId"`
+	IdTag         string                       `json:"idTag"`
+	ParentIdTag   string                       `json:"parentIdTag,omitempty"` //of the idTagInfo answering StartTransaction
+	Authorization protocol.AuthorizationStatus `json:"authorization"`         //the status answering StartTransaction
+	ReservationID *int                         `json:"reservationId,omitempty"`
+	MeterStart    int                          `json:"meterStart"`
+	StartTime     time.Time                    `json:"startTime"`
+	State         State                        `json:"state"`
+	MeterStop     *int                         `json:"meterStop,omitempty"`
+	StopTime      *time.Time                   `json:"stopTime,omitempty"`
+	StopIdTag     string                       `json:"stopIdTag,omitempty"`
+	StopReason    protocol.Reason              `json:"stopReason,omitempty"`
+	MeterValues   []protocol.MeterValue        `json:"meterValues,omitempty"`
+	WasOrphaned   bool                         `json:"wasOrphaned,omitempty"` //closed as Orphaned before its StopTransaction
+}
+
+//Energy returns the Wh delivered, 0 while the transaction is open
+func (t *Transaction) Energy() int {
+	if t.MeterStop == nil || *t.MeterStop < t.MeterStart {
+		return 0
+	}
+	return *t.MeterStop - t.MeterStart
+}
+
+func (t *Transaction) clone() *Transaction {
+	c := *t
+	c.MeterValues = append([]protocol.MeterValue(nil), t.MeterValues...)
+	return &c
+}
+
+//Query selects transactions, the zero value of a field matches all of them
+type Query struct {
+	ChargePointID string
+	ConnectorID   int
+	IdTag         string
+	State         State
+	From, To      time.Time //the range of the start time, To excluded
+	Offset, Limit int
+}
+
+func (q *Query) match(t *Transaction) bool {
+	switch {
+	case q.ChargePointID != "" && t.ChargePointID != q.ChargePointID,
+		q.ConnectorID != 0 && t.ConnectorID != q.ConnectorID,
+		q.IdTag != "" && t.IdTag != q.IdTag,
+		q.State != "" && t.State != q.State,
+		!q.From.IsZero() && t.StartTime.Before(q.From),
+		!q.To.IsZero() && !t.StartTime.Before(q.To):
+		return false
+	}
+	return true
+}
+
+//Repository stores the transactions, the ids are allocated by the repository so they stay unique across restarts
+type Repository interface {
+	//NextID allocates a transaction id
+	NextID() (int, error)
+	//Save inserts t or replaces the transaction with its id
+	Save(t *Transaction) error
+	//AppendMeterValues adds values to the meter values of the transaction id
+	AppendMeterValues(id int, values []protocol.MeterValue) error
+	//Get returns the transaction id, ErrNotFound if there is none
+	Get(id int) (*Transaction, error)
+	//Find returns the transactions matching q ordered by id
+	Find(q Query) ([]*Transaction, error)
+	Close() error
+}
+
+//index is the in memory state of the repositories
+type index struct {
+	last         int
+	transactions map[int]*Transaction
+}
+
+func newIndex() *index {
+	return &index{transactions: make(map[int]*Transaction)}
+}
+
+func (x *index) nextID() int {
+	x.last++
+	return x.last
+}
+
+func (x *index) save(t *Transaction) {
+	x.transactions[t.ID] = t.clone()
+	if t.ID > x.last {
+		x.last = t.ID
+	}
+}
+
+func (x *index) appendMeterValues(id int, values []protocol.MeterValue) error {
+	t, ok := x.transactions[id]
+	if !ok {
+		return ErrNotFound
+	}
+	t.MeterValues = append(t.MeterValues, values...)
+	return nil
+}
+
+func (x *index) get(id int) (*Transaction, error) {
+	t, ok := x.transactions[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return t.clone(), nil
+}
+
+func (x *index) find(q Query) []*Transaction {
+	var ids []int
+	for id, t := range x.transactions {
+		if q.match(t) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	if q.Offset >= len(ids) {
+		return nil
+	}
+	ids = ids[q.Offset:]
+	if q.Limit > 0 && q.Limit < len(ids) {
+		ids = ids[:q.Limit]
+	}
+	transactions := make([]*Transaction, len(ids))
+	for i, id := range ids {
+		transactions[i] = x.transactions[id].clone()
+	}
+	return transactions
+}
+
+//parseTime parses the timestamps of the charging points, now if they are invalid
+func parseTime(s string, now time.Time) time.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return now
+	}
+	return t
+}
+
+//Energy returns a sampled energy in Wh, false for the readings of a phase, the signed ones and the values that are not
+//numbers. billing reads the energy of the transactions with it too
+func Energy(v protocol.SampledValue) (float64, bool) {
+	if v.Format == "SignedData" || v.Phase != "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v.Value, 64)
+	if err != nil {
+		return 0, false
+	}
+	if v.Unit == "kWh" {
+		f *= 1000
+	}
+	return f, true
+}
+
+//lastRegister returns the last reading of Energy.Active.Import.Register in Wh
+func lastRegister(values []protocol.MeterValue) (int, bool) {
+	for i := len(values) - 1; i >= 0; i-- {
+		sampled := values[i].SampledValue
+		for j := len(sampled) - 1; j >= 0; j-- {
+			v := sampled[j]
+			if v.Measurand != "" && v.Measurand != "Energy.Active.Import.Register" {
+				continue
+			}
+			if f, ok := Energy(v); ok {
+				return int(f + 0.5), true
+			}
+		}
+	}
+	return 0, false
+}
+
+//merge adds the values of data missing from values, in the order of their timestamps
+func merge(values []protocol.MeterValue, data []protocol.MeterValue) []protocol.MeterValue {
+	for _, v := range data {
+		found := false
+		for _, w := range values {
+			if reflect.DeepEqual(v, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, v)
+		}
+	}
+	var zero time.Time
+	sort.SliceStable(values, func(i, j int) bool {
+		return parseTime(values[i].TimeStamp, zero).Before(parseTime(values[j].TimeStamp, zero))
+	})
+	return values
+}
@@ -0,0 +1,193 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	local "ocpp16/plugin/passive/local"
+	"ocpp16/protocol"
+	ocpp16server "ocpp16/server"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func intp(i int) *int {
+	return &i
+}
+
+func meterValue(timestamp string, wh string) protocol.MeterValue {
+	return protocol.MeterValue{TimeStamp: timestamp, SampledValue: []protocol.SampledValue{{Value: wh, Unit: "Wh"}}}
+}
+
+func request(t *testing.T, plugin ocpp16server.PassivePlugin, id string, req protocol.Request) protocol.Response {
+	handler, ok := plugin.RequestHandler(req.Action())
+	if !ok {
+		t.Fatalf("%s not supported", req.Action())
+	}
+	res, err := handler(context.Background(), id, "1", req)
+	if err != nil {
+		t.Fatalf("%s: %v", req.Action(), err)
+	}
+	return res
+}
+
+func start(t *testing.T, plugin ocpp16server.PassivePlugin, id string, connectorID int, meterStart int, timestamp string) int {
+	res := request(t, plugin, id, &protocol.StartTransactionRequest{ConnectorId: &connectorID, IdTag: "tag", MeterStart: &meterStart, Timestamp: timestamp})
+	start := res.(*protocol.StartTransactionResponse)
+	if start.IdTagInfo.Status != "Accepted" || start.TransactionId == nil {
+		t.Fatalf("unexpected response %+v", start)
+	}
+	return *start.TransactionId
+}
+
+func TestLifecycle(t *testing.T) {
+	m, err := NewManager(NewMemoryRepository())
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := m.Wrap(local.NewActionPlugin())
+
+	txID := start(t, plugin, "CP001", 1, 1000, "2021-01-01T10:00:00Z")
+	if again := start(t, plugin, "CP001", 1, 1000, "2021-01-01T10:00:00Z"); again != txID {
+		t.Fatalf("retransmitted start got %d, want %d", again, txID)
+	}
+	other := start(t, plugin, "CP001", 2, 0, "2021-01-01T10:00:00Z")
+	if other == txID {
+		t.Fatal("transaction id allocated twice")
+	}
+	request(t, plugin, "CP001", &protocol.MeterValuesRequest{ConnectorId: intp(1), TransactionId: &txID, MeterValue: []protocol.MeterValue{meterValue("2021-01-01T10:30:00Z", "1500")}})
+	request(t, plugin, "CP001", &protocol.MeterValuesRequest{ConnectorId: intp(1), TransactionId: intp(99), MeterValue: []protocol.MeterValue{meterValue("2021-01-01T10:30:00Z", "1")}})
+
+	request(t, plugin, "CP001", &protocol.StopTransactionRequest{
+		TransactionId: &txID,
+		MeterStop:     intp(2000),
+		Timestamp:     "2021-01-01T11:00:00Z",
+		Reason:        protocol.Local,
+		TransactionData: []protocol.MeterValue{
+			meterValue("2021-01-01T11:00:00Z", "2000"),
+			meterValue("2021-01-01T10:00:00Z", "1000"),
+			meterValue("2021-01-01T10:30:00Z", "1500"),
+		},
+	})
+	tx, err := m.Get(txID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.State != Finished || tx.Energy() != 1000 || tx.StopReason != protocol.Local || len(tx.MeterValues) != 3 ||
+		tx.MeterValues[0].TimeStamp != "2021-01-01T10:00:00Z" || tx.MeterValues[2].TimeStamp != "2021-01-01T11:00:00Z" {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+	if _, ok := m.Active("CP001", 1); ok {
+		t.Fatal("stopped transaction still active")
+	}
+
+	//the charging point reboots with the transaction of connector 2 open, then sends its queued StopTransaction. the
+	//register of a phase is not the energy of the transaction
+	request(t, plugin, "CP001", &protocol.MeterValuesRequest{ConnectorId: intp(2), TransactionId: &other, MeterValue: []protocol.MeterValue{meterValue("2021-01-01T10:10:00Z", "0.3"), {TimeStamp: "2021-01-01T10:20:00Z", SampledValue: []protocol.SampledValue{{Value: "0.5", Unit: "kWh"}, {Value: "0.2", Unit: "kWh", Phase: "L1"}}}}})
+	request(t, plugin, "CP001", &protocol.BootNotificationRequest{ChargePointVendor: "v", ChargePointModel: "m"})
+	if tx, _ = m.Get(other); tx.State != Orphaned || tx.Energy() != 500 {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+	request(t, plugin, "CP001", &protocol.StopTransactionRequest{TransactionId: &other, MeterStop: intp(800), Timestamp: "2021-01-01T10:40:00Z", Reason: protocol.PowerLoss})
+	if tx, _ = m.Get(other); tx.State != Finished || tx.Energy() != 800 || len(tx.MeterValues) != 2 {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+
+	//a new start on a connector with an open transaction orphans it
+	first := start(t, plugin, "CP002", 1, 0, "2021-01-01T12:00:00Z")
+	second := start(t, plugin, "CP002", 1, 100, "2021-01-01T13:00:00Z")
+	if tx, _ = m.Get(first); tx.State != Orphaned || tx.StopTime == nil || !tx.StopTime.Equal(mustTime("2021-01-01T13:00:00Z")) {
+		t.Fatalf("unexpected transaction %+v", tx)
+	}
+	if tx, ok := m.Active("CP002", 1); !ok || tx.ID != second {
+		t.Fatalf("unexpected active transaction %+v", tx)
+	}
+	found, _ := m.Find(Query{State: Finished, ChargePointID: "CP001"})
+	if len(found) != 2 || found[0].ID != txID || found[1].ID != other {
+		t.Fatalf("unexpected transactions %+v", found)
+	}
+}
+
+func TestFileRepository(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transactions.log")
+	repo, err := NewFileRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManager(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := m.Wrap(local.NewActionPlugin())
+	stopped := start(t, plugin, "CP001", 1, 0, "2021-01-01T10:00:00Z")
+	request(t, plugin, "CP001", &protocol.StopTransactionRequest{TransactionId: &stopped, MeterStop: intp(10), Timestamp: "2021-01-01T11:00:00Z"})
+	open := start(t, plugin, "CP001", 1, 10, "2021-01-01T12:00:00Z")
+	request(t, plugin, "CP001", &protocol.MeterValuesRequest{ConnectorId: intp(1), TransactionId: &open, MeterValue: []protocol.MeterValue{meterValue("2021-01-01T12:30:00Z", "20")}})
+	repo.Close()
+
+	//a crash tears the last line
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	f.WriteString(`{"op":"save","transac`)
+	f.Close()
+
+	if repo, err = NewFileRepository(path); err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	if m, err = NewManager(repo); err != nil {
+		t.Fatal(err)
+	}
+	tx, ok := m.Active("CP001", 1)
+	if !ok || tx.ID != open || len(tx.MeterValues) != 1 {
+		t.Fatalf("unexpected active transaction %+v", tx)
+	}
+	if tx, err = m.Get(stopped); err != nil || tx.State != Finished || tx.Energy() != 10 {
+		t.Fatalf("unexpected transaction %+v, err %v", tx, err)
+	}
+	if next := start(t, m.Wrap(local.NewActionPlugin()), "CP001", 2, 0, "2021-01-01T12:00:00Z"); next <= open {
+		t.Fatalf("transaction id %d reused", next)
+	}
+}
+
+func TestAPI(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	m, _ := NewManager(NewMemoryRepository())
+	plugin := m.Wrap(local.NewActionPlugin())
+	start(t, plugin, "CP001", 1, 0, "2021-01-01T10:00:00Z")
+	start(t, plugin, "CP002", 1, 0, "2021-01-02T10:00:00Z")
+	engine := gin.New()
+	m.RegisterAPI(engine.Group("/api/v1"))
+
+	get := func(url string) (int, []byte) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		return w.Code, w.Body.Bytes()
+	}
+	code, body := get("/api/v1/transactions?from=2021-01-02T00:00:00Z&state=Active")
+	var transactions []*Transaction
+	if json.Unmarshal(body, &transactions); code != http.StatusOK || len(transactions) != 1 || transactions[0].ChargePointID != "CP002" {
+		t.Fatalf("unexpected reply %d %s", code, body)
+	}
+	if code, body = get("/api/v1/transactions/1"); code != http.StatusOK {
+		t.Fatalf("unexpected reply %d %s", code, body)
+	}
+	if code, body = get("/api/v1/transactions/42"); code != http.StatusNotFound {
+		t.Fatalf("unexpected reply %d %s", code, body)
+	}
+	if code, body = get("/api/v1/transactions?limit=x"); code != http.StatusBadRequest {
+		t.Fatalf("unexpected reply %d %s", code, body)
+	}
+}
+
+func mustTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}